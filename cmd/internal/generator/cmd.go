@@ -33,6 +33,9 @@ func Do(plugin Plugin) {
 	}
 
 	options.Run(func(pp *protogen.Plugin) error {
+		if preparer, ok := plugin.(Preparer); ok {
+			preparer.Prepare(pp)
+		}
 		for _, file := range pp.Files {
 			if !file.Generate {
 				continue
@@ -19,3 +19,10 @@ type Plugin interface {
 	// except for the imports, by calling the generator's methods P, In, and Out.
 	Generate(g *protogen.GeneratedFile)
 }
+
+// A Preparer is an optional interface that implemented by Plugin.
+type Preparer interface {
+	// Prepare is called once with the whole request before any file is initialized.
+	// It's used by the plugin that needs the information of imported files, e.g. protogen.Plugin.FilesByPath.
+	Prepare(pp *protogen.Plugin)
+}
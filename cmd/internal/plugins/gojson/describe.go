@@ -0,0 +1,155 @@
+package gojson
+
+import (
+	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// MessageInfo describes the json format of a message that generated by protoc-gen-gojson.
+// It is used by other plugins that need to reflect the json format, e.g. protoc-gen-jsonschema.
+type MessageInfo struct {
+	Message *protogen.Message
+
+	// Ignore is true if protoc-gen-gojson does not generate code for the message.
+	Ignore bool
+
+	DisallowUnknownFields bool
+
+	// Fields is the non-ignored fields in order of encoding.
+	Fields []*FieldInfo
+}
+
+// FieldInfo describes the json format of a field or a oneof.
+type FieldInfo struct {
+	// Key is the json key.
+	Key string
+
+	// Field is the field, it is nil if the FieldInfo describes a oneof with key.
+	Field *protogen.Field
+
+	// Oneof is the oneof with key, the fields of oneof are in OneofFields.
+	// The fields of the oneof that hide key are described as general fields.
+	Oneof       *protogen.Oneof
+	OneofFields []*FieldInfo
+
+	Omitempty     bool
+	Required      bool
+	UseEnumString bool
+
+	// MapAsPairs is true if the map field is encoded as an array of {"key": k, "value": v}.
+	MapAsPairs bool
+
+	// Codec is the Go type that encodes the value of field if specified by option codec.
+	Codec *protogen.GoIdent
+
+	// EnumNames is the enum value names in json format in the order of Enum.Values,
+	// only sets if the field kind (or map value kind) is enum and use enum string.
+	EnumNames []string
+
+	// NonFiniteStrings is the quoted strings of NaN, +Inf and -Inf in order that the values
+	// encoded as, only sets if the field kind (or map value kind) is float or double and the
+	// non-finite values are not rejected by option non_finite_float.
+	NonFiniteStrings []string
+}
+
+// DescribeMessage returns the json format of the message.
+func DescribeMessage(msg *protogen.Message) *MessageInfo {
+	p := &plugin{}
+	p.fileOptions = loadFileOptionsFromDesc(msg.Desc.ParentFile())
+	p.msgOptions = p.loadMessageOptions(msg)
+	p.message = msg
+	p.fields = utils.LoadFieldList(msg)
+
+	info := &MessageInfo{
+		Message:               msg,
+		Ignore:                p.fileOptions.GetIgnore() || p.msgOptions.GetIgnore(),
+		DisallowUnknownFields: *p.msgOptions.DisallowUnknownFields,
+	}
+	if info.Ignore {
+		return info
+	}
+
+	for _, field := range p.fields {
+		if !utils.FieldIsOneOf(field) {
+			if x := p.describeField(field); x != nil {
+				info.Fields = append(info.Fields, x)
+			}
+			continue
+		}
+
+		oneofOptions := p.loadOneOfOptions(field.Oneof)
+		if *oneofOptions.Ignore {
+			continue
+		}
+
+		fields := make([]*FieldInfo, 0, len(field.Oneof.Fields))
+		for _, f := range field.Oneof.Fields {
+			if x := p.describeField(f); x != nil {
+				fields = append(fields, x)
+			}
+		}
+		if *oneofOptions.HideOneofKey {
+			info.Fields = append(info.Fields, fields...)
+			continue
+		}
+		info.Fields = append(info.Fields, &FieldInfo{
+			Key:         p.getOneOfKey(oneofOptions, field.Oneof),
+			Oneof:       field.Oneof,
+			OneofFields: fields,
+			Omitempty:   *oneofOptions.Omitempty,
+		})
+	}
+	return info
+}
+
+func (p *plugin) describeField(field *protogen.Field) *FieldInfo {
+	options := p.loadFieldOptions(field)
+	if *options.Ignore {
+		return nil
+	}
+	x := &FieldInfo{
+		Key:           p.getFieldKey(options, field),
+		Field:         field,
+		Omitempty:     *options.Omitempty,
+		Required:      *options.Required && !utils.FieldIsOneOf(field),
+		UseEnumString: *options.UseEnumString,
+		MapAsPairs:    *options.MapAsPairs && field.Desc.IsMap(),
+	}
+	if codec, ok := p.fieldCodec(field); ok {
+		x.Codec = &codec
+	}
+
+	valueField := field
+	if field.Desc.IsMap() {
+		valueField = field.Message.Fields[1]
+	}
+	if kind := valueField.Desc.Kind(); kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind {
+		switch *options.NonFiniteFloat {
+		case pbjson.NonFiniteFloat_NonFiniteProtoJSON:
+			x.NonFiniteStrings = []string{"NaN", "Infinity", "-Infinity"}
+		case pbjson.NonFiniteFloat_NonFiniteError:
+		default:
+			x.NonFiniteStrings = []string{"NaN", "+Inf", "-Inf"}
+		}
+	}
+	if valueField.Desc.Kind() == protoreflect.EnumKind && x.UseEnumString {
+		x.EnumNames = p.enumValueNames(valueField.Enum, p.msgOptions)
+		if !field.Desc.IsMap() && !field.Desc.IsList() {
+			// Can't omit empty value for type enum field if use enum string.
+			x.Omitempty = false
+		}
+	}
+	return x
+}
+
+func loadFileOptionsFromDesc(file protoreflect.FileDescriptor) *pbjson.SerializeOptions {
+	i := proto.GetExtension(file.Options(), pbjson.E_File)
+	fileOptions := i.(*pbjson.SerializeOptions)
+	if fileOptions == nil {
+		fileOptions = &pbjson.SerializeOptions{}
+	}
+	return fileOptions
+}
@@ -0,0 +1,288 @@
+package gojson
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumValueNames returns the enum value names in json format, in the order of enum.Values.
+func (p *plugin) enumValueNames(enum *protogen.Enum, parentOptions *pbjson.SerializeOptions) []string {
+	options := p.loadEnumOptions(enum, parentOptions)
+
+	names := make([]string, 0, len(enum.Values))
+	for _, value := range enum.Values {
+		valueOptions := p.loadEnumValueOptions(value)
+		if valueOptions.Json != nil {
+			names = append(names, *valueOptions.Json)
+			continue
+		}
+		name := string(value.Desc.Name())
+		if *options.TrimEnumPrefix {
+			name = trimEnumPrefix(string(enum.Desc.Name()), name)
+		}
+		names = append(names, formatEnumValueName(*options.EnumValueStyle, name))
+	}
+	return names
+}
+
+// enumDecodeNames returns the names accepted by decoding, include aliases. The names are
+// grouped by enum number in the order of first appearance, and lowered if lenient decoding.
+func (p *plugin) enumDecodeNames(enum *protogen.Enum, parentOptions *pbjson.SerializeOptions) ([]protoreflect.EnumNumber, map[protoreflect.EnumNumber][]string) {
+	options := p.loadEnumOptions(enum, parentOptions)
+	names := p.enumValueNames(enum, parentOptions)
+
+	numbers := make([]protoreflect.EnumNumber, 0, len(enum.Values))
+	groups := make(map[protoreflect.EnumNumber][]string)
+	seen := make(map[string]bool)
+
+	for i, value := range enum.Values {
+		number := value.Desc.Number()
+		if _, ok := groups[number]; !ok {
+			numbers = append(numbers, number)
+			groups[number] = make([]string, 0)
+		}
+
+		candidates := append([]string{names[i]}, p.loadEnumValueOptions(value).Aliases...)
+		for _, name := range candidates {
+			if *options.LenientDecoding {
+				name = strings.ToLower(name)
+			}
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			groups[number] = append(groups[number], name)
+		}
+	}
+	return numbers, groups
+}
+
+// TASK_STATUS_RUNNING to RUNNING in enum TaskStatus.
+func trimEnumPrefix(enumName string, valueName string) string {
+	for _, prefix := range []string{upperCamelToScreamingSnake(enumName) + "_", enumName + "_"} {
+		if len(valueName) > len(prefix) && strings.EqualFold(valueName[:len(prefix)], prefix) {
+			return valueName[len(prefix):]
+		}
+	}
+	return valueName
+}
+
+func formatEnumValueName(style pbjson.EnumValueStyle, name string) string {
+	switch style {
+	case pbjson.EnumValueStyle_EnumValueName:
+		return name
+	case pbjson.EnumValueStyle_EnumLowerCase:
+		return strings.ToLower(name)
+	case pbjson.EnumValueStyle_EnumUpperCase:
+		return strings.ToUpper(name)
+	case pbjson.EnumValueStyle_EnumKebabCase:
+		return strings.ReplaceAll(strings.ToLower(name), "_", "-")
+	case pbjson.EnumValueStyle_EnumLowerCamelCase:
+		return upperCamelToLowerCamel(underScoreToUpperCamel(strings.ToLower(name)))
+	case pbjson.EnumValueStyle_EnumUpperCamelCase:
+		return underScoreToUpperCamel(strings.ToLower(name))
+	default:
+		panic(fmt.Sprintf("gojson: unsupported EnumValueStyle type [%s] in enum options", style.String()))
+	}
+}
+
+// marshalEnumString generates code to encode the enum value as string by lookup table.
+// The unknown enum number is encoded as number string.
+func (p *plugin) marshalEnumString(enum *protogen.Enum, itemName string) {
+	names := p.enumValueNames(enum, p.msgOptions)
+	seen := make(map[protoreflect.EnumNumber]bool)
+
+	p.g.P("switch ", itemName, ".Number() {")
+	for i, value := range enum.Values {
+		// Use the first name for the alias values as same as the method String.
+		if seen[value.Desc.Number()] {
+			continue
+		}
+		seen[value.Desc.Number()] = true
+		p.g.P("case ", value.Desc.Number(), ":")
+		p.g.P("    encoder.AppendString(", strconv.Quote(names[i]), ")")
+	}
+	p.g.P("default:")
+	p.g.P("    encoder.AppendString(", strconvPackage.Ident("FormatInt"), "(int64(", itemName, ".Number()), 10))")
+	p.g.P("}")
+}
+
+// unmarshalEnum generates code to decode the enum value by lookup table.
+// The enum number is stored to variable `x1`. The returnUnknown is called
+// for the unknown enum value that not allowed by the unknown_enum_policy.
+func (p *plugin) unmarshalEnum(enum *protogen.Enum, parentOptions *pbjson.SerializeOptions, useEnumString bool, returnError func(), returnUnknown func()) {
+	options := p.loadEnumOptions(enum, parentOptions)
+	lenient := *options.LenientDecoding
+	policy := *options.UnknownEnumPolicy
+
+	acceptString := useEnumString || lenient
+	acceptNumber := !useEnumString || lenient
+
+	numbers, groups := p.enumDecodeNames(enum, parentOptions)
+
+	decodeString := func() {
+		p.g.P("s, isString := ", decoderPackage.Ident("UnquoteString"), "(value)")
+		p.g.P("if !isString {")
+		returnError()
+		p.g.P("}")
+		if lenient {
+			p.g.P("switch ", stringsPackage.Ident("ToLower"), "(s) {")
+		} else {
+			p.g.P("switch s {")
+		}
+		for _, number := range numbers {
+			if len(groups[number]) == 0 {
+				continue
+			}
+			p.g.P("case ", quoteNames(groups[number]), ":")
+			p.g.P("    x1 = ", number)
+		}
+		p.g.P("default:")
+		if policy == pbjson.UnknownEnumPolicy_UnknownEnumKeepNumber {
+			p.g.P("    n, err := ", strconvPackage.Ident("ParseInt"), "(s, 10, 32)")
+			p.g.P("    if err != nil {")
+			p.g.P("        ok = false")
+			p.g.P("    } else {")
+			p.g.P("        x1 = int32(n)")
+			p.g.P("    }")
+		} else {
+			p.g.P("    ok = false")
+		}
+		p.g.P("}")
+	}
+
+	decodeNumber := func() {
+		p.g.P("n, err := ", decoderPackage.Ident("ParseInt32"), "(value)")
+		p.g.P("if err != nil {")
+		returnError()
+		p.g.P("}")
+		p.g.P("x1 = n")
+		if policy == pbjson.UnknownEnumPolicy_UnknownEnumKeepNumber {
+			return
+		}
+		cases := make([]string, 0, len(numbers))
+		for _, number := range numbers {
+			cases = append(cases, fmt.Sprintf("%d", number))
+		}
+		p.g.P("switch x1 {")
+		p.g.P("case ", strings.Join(cases, ", "), ":")
+		p.g.P("default:")
+		p.g.P("    ok = false")
+		p.g.P("}")
+	}
+
+	p.g.P("var x1 int32")
+	p.g.P("ok := true")
+	switch {
+	case acceptString && acceptNumber:
+		p.g.P(`if value[0] == '"' {`)
+		decodeString()
+		p.g.P("} else {")
+		decodeNumber()
+		p.g.P("}")
+	case acceptString:
+		decodeString()
+	default:
+		decodeNumber()
+	}
+
+	p.g.P("if !ok {")
+	if policy == pbjson.UnknownEnumPolicy_UnknownEnumToZero {
+		p.g.P("    x1 = 0")
+	} else {
+		returnUnknown()
+	}
+	p.g.P("}")
+}
+
+// checkEnumValueNames check whether have empty or duplicate enum value names in json format.
+func (p *plugin) checkEnumValueNames() {
+	msg := p.message
+
+	checked := make(map[protoreflect.FullName]bool)
+	invalid := false
+
+	for _, field := range msg.Fields {
+		options := p.loadFieldOptions(field)
+		if *options.Ignore {
+			continue
+		}
+		if field.Desc.IsMap() {
+			field = field.Message.Fields[1]
+		}
+		enum := field.Enum
+		if enum == nil || checked[enum.Desc.FullName()] {
+			continue
+		}
+		enumOptions := p.loadEnumOptions(enum, p.msgOptions)
+		if !*options.UseEnumString && !*enumOptions.LenientDecoding {
+			continue
+		}
+		checked[enum.Desc.FullName()] = true
+
+		location := fmt.Sprintf("file(%s) message(%s)", string(p.file.GoImportPath), msg.GoIdent.GoName)
+		if !p.validateEnumValueNames(enum, p.msgOptions, location) {
+			invalid = true
+		}
+	}
+	if invalid {
+		os.Exit(1)
+	}
+}
+
+// validateEnumValueNames prints the empty or duplicate enum value names and returns false if found.
+func (p *plugin) validateEnumValueNames(enum *protogen.Enum, parentOptions *pbjson.SerializeOptions, location string) bool {
+	enumOptions := p.loadEnumOptions(enum, parentOptions)
+	names := p.enumValueNames(enum, parentOptions)
+	cache := make(map[string]*protogen.EnumValue)
+	dupNames := make(map[string][]string)
+	emptyValues := make([]string, 0)
+
+	for i, value := range enum.Values {
+		candidates := append([]string{names[i]}, p.loadEnumValueOptions(value).Aliases...)
+		for _, name := range candidates {
+			if name == "" {
+				emptyValues = append(emptyValues, string(value.Desc.Name()))
+				continue
+			}
+			if *enumOptions.LenientDecoding {
+				name = strings.ToLower(name)
+			}
+			x, ok := cache[name]
+			if !ok {
+				cache[name] = value
+				continue
+			}
+			if x.Desc.Number() == value.Desc.Number() {
+				continue // alias of the same number.
+			}
+			if _, ok := dupNames[name]; !ok {
+				dupNames[name] = append(dupNames[name], string(x.Desc.Name()))
+			}
+			dupNames[name] = append(dupNames[name], string(value.Desc.Name()))
+		}
+	}
+
+	valid := true
+	if len(emptyValues) != 0 {
+		valid = false
+		println(fmt.Sprintf(
+			"gojson: <%s>: the enum value name or alias is empty in enum %s with values %v",
+			location, enum.Desc.FullName(), emptyValues,
+		))
+	}
+	for name, values := range dupNames {
+		valid = false
+		println(fmt.Sprintf(
+			"gojson: <%s>: Found duplicate enum value name [%s] in enum %s both in values %v",
+			location, name, enum.Desc.FullName(), values,
+		))
+	}
+	return valid
+}
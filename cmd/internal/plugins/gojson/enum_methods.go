@@ -0,0 +1,145 @@
+package gojson
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// enumInfo is the enum that generates methods for encoding and decoding.
+type enumInfo struct {
+	enum *protogen.Enum
+
+	// The options of the message or file that the enum defined in.
+	parentOptions *pbjson.SerializeOptions
+}
+
+// loadMethodEnums returns the enums that set the option use_enum_string in enum level.
+func (p *plugin) loadMethodEnums(file *protogen.File, messages []*protogen.Message) []*enumInfo {
+	enums := make([]*enumInfo, 0)
+
+	isEnabled := func(enum *protogen.Enum) bool {
+		options, _ := proto.GetExtension(enum.Desc.Options(), pbjson.E_Enum).(*pbjson.EnumOptions)
+		return options.GetUseEnumString()
+	}
+
+	for _, enum := range file.Enums {
+		if isEnabled(enum) {
+			enums = append(enums, &enumInfo{enum: enum, parentOptions: p.fileOptions})
+		}
+	}
+	for _, msg := range messages {
+		var msgOptions *pbjson.SerializeOptions
+		for _, enum := range msg.Enums {
+			if !isEnabled(enum) {
+				continue
+			}
+			if msgOptions == nil {
+				msgOptions = p.loadMessageOptions(msg)
+			}
+			enums = append(enums, &enumInfo{enum: enum, parentOptions: msgOptions})
+		}
+	}
+	return enums
+}
+
+// generateEnumMethods generates the methods MarshalJSON, UnmarshalJSON, MarshalText
+// and UnmarshalText for the enum, so that the enum is encoded as string when it used
+// in non-proto Go structs. The UnmarshalJSON is not generated for the enum in proto2.
+func (p *plugin) generateEnumMethods(info *enumInfo) {
+	enum := info.enum
+	typeName := enum.GoIdent.GoName
+
+	location := fmt.Sprintf("file(%s) enum(%s)", string(p.file.GoImportPath), typeName)
+	if !p.validateEnumValueNames(enum, info.parentOptions, location) {
+		os.Exit(1)
+	}
+
+	names := p.enumValueNames(enum, info.parentOptions)
+	seen := make(map[protoreflect.EnumNumber]bool)
+
+	p.g.P("// MarshalText for implements interface encoding.TextMarshaler.")
+	p.g.P("func (x ", typeName, ") MarshalText() ([]byte, error) {")
+	p.g.P("    switch x {")
+	for i, value := range enum.Values {
+		// Use the first name for the alias values as same as the method String.
+		if seen[value.Desc.Number()] {
+			continue
+		}
+		seen[value.Desc.Number()] = true
+		p.g.P("case ", value.Desc.Number(), ":")
+		p.g.P("    return []byte(", strconv.Quote(names[i]), "), nil")
+	}
+	p.g.P("    default:")
+	p.g.P("        return []byte(", strconvPackage.Ident("FormatInt"), "(int64(x), 10)), nil")
+	p.g.P("    }")
+	p.g.P("}")
+	p.g.P()
+
+	p.g.P("// MarshalJSON for implements interface json.Marshaler.")
+	p.g.P("func (x ", typeName, ") MarshalJSON() ([]byte, error) {")
+	p.g.P("    text, err := x.MarshalText()")
+	p.g.P("    if err != nil {")
+	p.g.P("        return nil, err")
+	p.g.P("    }")
+	p.g.P("    encoder := ", encoderPackage.Ident("New"), "(len(text) + 2)")
+	p.g.P("    encoder.AppendString(string(text))")
+	p.g.P("    return encoder.Bytes(), nil")
+	p.g.P("}")
+	p.g.P()
+
+	checkNil := func() {
+		p.g.P("    if x == nil {")
+		p.g.P("        return ", errorsPackage.Ident("New"), "(\"json: Unmarshal: ", string(enum.GoIdent.GoImportPath), ".(*", typeName, ") is nil\")")
+		p.g.P("    }")
+	}
+	decodeValue := func() {
+		returnError := func() {
+			p.g.P("return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s into value of type `, typeName, `", string(value))`)
+		}
+		returnUnknown := func() {
+			p.g.P("return ", fmtPackage.Ident("Errorf"), `("json: unknown enum value %s of type `, typeName, `", string(value))`)
+		}
+		p.unmarshalEnum(enum, info.parentOptions, true, returnError, returnUnknown)
+		p.g.P("    *x = ", typeName, "(x1)")
+		p.g.P("    return nil")
+	}
+
+	// The protoc-gen-go generates the method UnmarshalJSON for the enum in proto2,
+	// so the UnmarshalText decodes the text by itself and the UnmarshalJSON is omitted.
+	if enum.Desc.Syntax() == protoreflect.Proto2 {
+		p.g.P("// UnmarshalText for implements interface encoding.TextUnmarshaler.")
+		p.g.P("func (x *", typeName, ") UnmarshalText(text []byte) error {")
+		checkNil()
+		p.g.P("    encoder := ", encoderPackage.Ident("New"), "(len(text) + 2)")
+		p.g.P("    encoder.AppendString(string(text))")
+		p.g.P("    value := encoder.Bytes()")
+		decodeValue()
+		p.g.P("}")
+		p.g.P()
+		return
+	}
+
+	p.g.P("// UnmarshalText for implements interface encoding.TextUnmarshaler.")
+	p.g.P("func (x *", typeName, ") UnmarshalText(text []byte) error {")
+	p.g.P("    encoder := ", encoderPackage.Ident("New"), "(len(text) + 2)")
+	p.g.P("    encoder.AppendString(string(text))")
+	p.g.P("    return x.UnmarshalJSON(encoder.Bytes())")
+	p.g.P("}")
+	p.g.P()
+
+	p.g.P("// UnmarshalJSON for implements interface json.Unmarshaler.")
+	p.g.P("func (x *", typeName, ") UnmarshalJSON(value []byte) error {")
+	checkNil()
+	p.g.P(`    if string(value) == "null" {`)
+	p.g.P("        return nil")
+	p.g.P("    }")
+	decodeValue()
+	p.g.P("}")
+	p.g.P()
+}
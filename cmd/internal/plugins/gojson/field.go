@@ -2,12 +2,18 @@ package gojson
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
 	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
 
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
+// keyTemplatePlaceholder is the placeholder of json key in option key_template.
+const keyTemplatePlaceholder = "{name}"
+
 func (p *plugin) getFieldKey(fieldOptions *pbjson.FieldOptions, field *protogen.Field) string {
 	msgOptions := p.msgOptions
 
@@ -28,10 +34,18 @@ func (p *plugin) getFieldKey(fieldOptions *pbjson.FieldOptions, field *protogen.
 		k = field.GoName
 	case pbjson.NameStyle_JSONName:
 		k = field.Desc.JSONName()
+	case pbjson.NameStyle_SnakeCase:
+		k = upperCamelToUnderScore(field.GoName)
+	case pbjson.NameStyle_KebabCase:
+		k = upperCamelToKebab(field.GoName)
+	case pbjson.NameStyle_LowerCamelCase:
+		k = upperCamelToLowerCamel(field.GoName)
+	case pbjson.NameStyle_ScreamingSnakeCase:
+		k = upperCamelToScreamingSnake(field.GoName)
 	default:
 		panic(fmt.Sprintf("gojson: unsupported NameStyle type [%s] in field options", msgOptions.NameStyle.String()))
 	}
-	return k
+	return p.applyKeyTemplate(k)
 }
 
 func (p *plugin) getOneOfKey(oneofOptions *pbjson.OneofOptions, oneof *protogen.Oneof) string {
@@ -53,10 +67,81 @@ func (p *plugin) getOneOfKey(oneofOptions *pbjson.OneofOptions, oneof *protogen.
 		k = oneof.GoName
 	case pbjson.NameStyle_JSONName:
 		k = string(oneof.Desc.Name())
+	case pbjson.NameStyle_SnakeCase:
+		k = upperCamelToUnderScore(oneof.GoName)
+	case pbjson.NameStyle_KebabCase:
+		k = upperCamelToKebab(oneof.GoName)
+	case pbjson.NameStyle_LowerCamelCase:
+		k = upperCamelToLowerCamel(oneof.GoName)
+	case pbjson.NameStyle_ScreamingSnakeCase:
+		k = upperCamelToScreamingSnake(oneof.GoName)
 	default:
 		panic(fmt.Sprintf("gojson: unsupported NameStyle type [%s] in oneof options", msgOptions.NameStyle.String()))
 	}
-	return k
+	return p.applyKeyTemplate(k)
+}
+
+// applyKeyTemplate decorates the key generated by name_style with the option key_template.
+func (p *plugin) applyKeyTemplate(key string) string {
+	tpl := p.msgOptions.KeyTemplate
+	if tpl == nil || *tpl == "" {
+		return key
+	}
+	return strings.Replace(*tpl, keyTemplatePlaceholder, key, 1)
+}
+
+// requiredFields returns the non-ignored fields that marked with `required`.
+func (p *plugin) requiredFields() []*protogen.Field {
+	fields := make([]*protogen.Field, 0)
+	for _, field := range p.fields {
+		if utils.FieldIsOneOf(field) {
+			continue
+		}
+		options := p.loadFieldOptions(field)
+		if *options.Ignore || !*options.Required {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// objectKeys returns all the json keys in the top level of the message.
+func (p *plugin) objectKeys() []string {
+	keys := make([]string, 0, len(p.fields))
+	for _, field := range p.fields {
+		if utils.FieldIsOneOf(field) {
+			options := p.loadOneOfOptions(field.Oneof)
+			if *options.Ignore {
+				continue
+			}
+			if *options.HideOneofKey {
+				keys = append(keys, p.oneofKeys(field.Oneof)...)
+			} else {
+				keys = append(keys, p.getOneOfKey(options, field.Oneof))
+			}
+			continue
+		}
+		options := p.loadFieldOptions(field)
+		if *options.Ignore {
+			continue
+		}
+		keys = append(keys, p.getFieldKey(options, field))
+	}
+	return keys
+}
+
+// oneofKeys returns the json keys of fields in the oneof.
+func (p *plugin) oneofKeys(oneof *protogen.Oneof) []string {
+	keys := make([]string, 0, len(oneof.Fields))
+	for _, field := range oneof.Fields {
+		options := p.loadFieldOptions(field)
+		if *options.Ignore {
+			continue
+		}
+		keys = append(keys, p.getFieldKey(options, field))
+	}
+	return keys
 }
 
 func (p *plugin) guessBufLength(fields []*protogen.Field) int {
@@ -139,3 +224,28 @@ func (p *plugin) guessBufLength(fields []*protogen.Field) int {
 //
 //	return n
 //}
+
+// fieldMaskNames returns the names that select the field in jsonencoder.FieldMask.
+// It's the json key of field, so the paths are in the same form as the keys under the NameStyle.
+func (p *plugin) fieldMaskNames(field *protogen.Field) []string {
+	return []string{p.getFieldKey(p.loadFieldOptions(field), field)}
+}
+
+// oneofMaskNames returns the names that select the oneof in jsonencoder.FieldMask.
+// It's the json key of oneof, or nothing if the key is hidden.
+func (p *plugin) oneofMaskNames(oneof *protogen.Oneof) []string {
+	options := p.loadOneOfOptions(oneof)
+	if *options.HideOneofKey {
+		return nil
+	}
+	return []string{p.getOneOfKey(options, oneof)}
+}
+
+// quoteNames returns the names in the form of go arguments, e.g. `"a", "b"`.
+func quoteNames(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = strconv.Quote(name)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,134 @@
+package gojson
+
+import (
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var (
+	protojsonPackage = protogen.GoImportPath("google.golang.org/protobuf/encoding/protojson")
+	protoPackage     = protogen.GoImportPath("google.golang.org/protobuf/proto")
+)
+
+// The proto package of well-known types.
+const wellKnownPackage protoreflect.FullName = "google.protobuf"
+
+// messageHasMethods reports whether the message has the methods generated by protoc-gen-gojson.
+//
+// The message in the same file always has the methods. The message from other file is detected
+// by the info of imported file in protogen.Plugin.FilesByPath: it has the methods if the file is
+// generated in the same request or imports proto/json.proto, and not ignored by option `ignore`.
+// So the file that generated by protoc-gen-gojson in other request must import proto/json.proto
+// to be recognized, otherwise it's handled as the foreign message.
+func (p *plugin) messageHasMethods(msg *protogen.Message) bool {
+	filePath := msg.Desc.ParentFile().Path()
+	if filePath == p.file.Desc.Path() {
+		return true
+	}
+	file, ok := p.filesByPath[filePath]
+	if !ok {
+		return false
+	}
+	if !file.Generate && !importsJSONProto(file.Desc) {
+		return false
+	}
+
+	fileOptions, _ := proto.GetExtension(file.Desc.Options(), pbjson.E_File).(*pbjson.SerializeOptions)
+	if fileOptions.GetIgnore() {
+		return false
+	}
+	msgOptions, _ := proto.GetExtension(msg.Desc.Options(), pbjson.E_Message).(*pbjson.SerializeOptions)
+	if msgOptions != nil && msgOptions.Ignore != nil {
+		return !*msgOptions.Ignore
+	}
+	return true
+}
+
+// importsJSONProto reports whether the file imports proto/json.proto that defines the options of protoc-gen-gojson.
+func importsJSONProto(file protoreflect.FileDescriptor) bool {
+	jsonProto := pbjson.File_json_proto
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		imp := imports.Get(i)
+		if imp.Package() == jsonProto.Package() && path.Base(imp.Path()) == path.Base(jsonProto.Path()) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWellKnownType reports whether the message is one of the well-known types, e.g. google.protobuf.Timestamp.
+// They are encoded by encoding/json intentionally, so no warning is printed for them under ForeignMessageWarn.
+func isWellKnownType(msg *protogen.Message) bool {
+	return msg.Desc.ParentFile().Package() == wellKnownPackage
+}
+
+// fieldForeignMessage returns the message of field if it is a foreign message that has no methods.
+// The value message is checked for the map field.
+func (p *plugin) fieldForeignMessage(field *protogen.Field) (*protogen.Message, bool) {
+	if field.Desc.IsMap() {
+		field = field.Message.Fields[1]
+	}
+	if field.Message == nil || p.messageHasMethods(field.Message) {
+		return nil, false
+	}
+	return field.Message, true
+}
+
+// fieldUseProtoJSON reports whether the message of field is encoded and decoded by protojson.
+func (p *plugin) fieldUseProtoJSON(field *protogen.Field) bool {
+	if _, ok := p.fieldForeignMessage(field); !ok {
+		return false
+	}
+	options := p.loadFieldOptions(field)
+	return *options.ForeignMessage == pbjson.ForeignMessagePolicy_ForeignMessageProtoJSON
+}
+
+// checkForeignMessages handles the fields that refer to the foreign message that has
+// no methods by the option foreign_message.
+func (p *plugin) checkForeignMessages() {
+	msg := p.message
+
+	warnFields := make([]string, 0)
+	invalidFields := make([]string, 0)
+	for _, field := range msg.Fields {
+		options := p.loadFieldOptions(field)
+		if *options.Ignore {
+			continue
+		}
+		foreign, ok := p.fieldForeignMessage(field)
+		if !ok {
+			continue
+		}
+		info := fmt.Sprintf("%s(%s)", field.Desc.Name(), foreign.Desc.FullName())
+		switch *options.ForeignMessage {
+		case pbjson.ForeignMessagePolicy_ForeignMessageError:
+			invalidFields = append(invalidFields, info)
+		case pbjson.ForeignMessagePolicy_ForeignMessageWarn:
+			if isWellKnownType(foreign) {
+				continue
+			}
+			warnFields = append(warnFields, info)
+		}
+	}
+	if len(warnFields) != 0 {
+		println(fmt.Sprintf(
+			"gojson: <file(%s) message(%s)>: warning: the fields refer to the message that has no methods generated by protoc-gen-gojson, they are encoded by encoding/json; set the option foreign_message to ForeignMessageProtoJSON to use protojson: %v",
+			string(p.file.GoImportPath), msg.GoIdent.GoName, warnFields,
+		))
+	}
+	if len(invalidFields) == 0 {
+		return
+	}
+	println(fmt.Sprintf(
+		"gojson: <file(%s) message(%s)>: the fields refer to the message that has no methods generated by protoc-gen-gojson %v",
+		string(p.file.GoImportPath), msg.GoIdent.GoName, invalidFields,
+	))
+	os.Exit(1)
+}
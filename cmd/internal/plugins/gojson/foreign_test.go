@@ -0,0 +1,81 @@
+package gojson
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// newForeignFile returns a proto3 file that has one message with the fields refer to the given messages.
+func newForeignFile(name string, pkg string, deps []string, msgName string, fieldTypes []string) *descriptorpb.FileDescriptorProto {
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(msgName)}
+	for i, typeName := range fieldTypes {
+		msg.Field = append(msg.Field, &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String("f" + string(rune('a'+i))),
+			Number:   proto.Int32(int32(i + 1)),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+			TypeName: proto.String(typeName),
+		})
+	}
+	return &descriptorpb.FileDescriptorProto{
+		Name:        proto.String(name),
+		Package:     proto.String(pkg),
+		Dependency:  deps,
+		MessageType: []*descriptorpb.DescriptorProto{msg},
+		Options:     &descriptorpb.FileOptions{GoPackage: proto.String("example.com/" + pkg)},
+		Syntax:      proto.String("proto3"),
+	}
+}
+
+func Test_messageHasMethods(t *testing.T) {
+	jsonProto := protodesc.ToFileDescriptorProto(pbjson.File_json_proto)
+	timestampProto := protodesc.ToFileDescriptorProto(timestamppb.File_google_protobuf_timestamp_proto)
+
+	// Generated by protoc-gen-gojson in other request.
+	withJSON := newForeignFile("withjson.proto", "withjson", []string{jsonProto.GetName()}, "WithJSON", nil)
+	// Ignored by option `ignore`.
+	ignored := newForeignFile("ignored.proto", "ignored", []string{jsonProto.GetName()}, "Ignored", nil)
+	proto.SetExtension(ignored.Options, pbjson.E_File, &pbjson.SerializeOptions{Ignore: proto.Bool(true)})
+	// Not generated by protoc-gen-gojson.
+	plain := newForeignFile("plain.proto", "plain", nil, "Plain", nil)
+	// Generated in the same request.
+	other := newForeignFile("other.proto", "other", nil, "Other", nil)
+
+	root := newForeignFile("main.proto", "main",
+		[]string{"withjson.proto", "ignored.proto", "plain.proto", "other.proto", timestampProto.GetName()},
+		"Main", []string{".main.Main", ".withjson.WithJSON", ".ignored.Ignored", ".plain.Plain", ".other.Other", ".google.protobuf.Timestamp"},
+	)
+
+	pp, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{"main.proto", "other.proto"},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+			jsonProto, timestampProto, withJSON, ignored, plain, other, root,
+		},
+	})
+	require.Nil(t, err)
+
+	p := &plugin{}
+	p.Prepare(pp)
+	p.file = pp.FilesByPath["main.proto"]
+
+	fields := p.file.Messages[0].Fields
+	require.True(t, p.messageHasMethods(fields[0].Message))
+	require.True(t, p.messageHasMethods(fields[1].Message))
+	require.False(t, p.messageHasMethods(fields[2].Message))
+	require.False(t, p.messageHasMethods(fields[3].Message))
+	require.True(t, p.messageHasMethods(fields[4].Message))
+	require.False(t, p.messageHasMethods(fields[5].Message))
+
+	// No warning for the well-known types.
+	require.False(t, isWellKnownType(fields[3].Message))
+	require.True(t, isWellKnownType(fields[5].Message))
+}
@@ -19,8 +19,11 @@ var (
 	base64Package  = protogen.GoImportPath("encoding/base64")
 	jsonPackage    = protogen.GoImportPath("encoding/json")
 	errorsPackage  = protogen.GoImportPath("errors")
+	stringsPackage = protogen.GoImportPath("strings")
 	encoderPackage = protogen.GoImportPath("github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder")
 	decoderPackage = protogen.GoImportPath("github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder")
+
+	fieldmaskpbPackage = protogen.GoImportPath("google.golang.org/protobuf/types/known/fieldmaskpb")
 )
 
 type plugin struct {
@@ -29,8 +32,14 @@ type plugin struct {
 
 	messages []*protogen.Message
 
+	// The enums that generates methods for encoding and decoding.
+	enums []*enumInfo
+
 	fileOptions *pbjson.SerializeOptions
 
+	// All the files in request include the imported files, keyed by the path of proto file.
+	filesByPath map[string]*protogen.File
+
 	// The message options of currently being processed.
 	msgOptions *pbjson.SerializeOptions
 
@@ -55,13 +64,18 @@ func (p *plugin) Version() string {
 	return version
 }
 
+// Prepare implements generator.Preparer.
+func (p *plugin) Prepare(pp *protogen.Plugin) {
+	p.filesByPath = pp.FilesByPath
+}
+
 func (p *plugin) Init(file *protogen.File) bool {
 	//p.fileOptions = p.loadFileOptions(file)
 	//p.file = file
 	//p.messages = utils.LoadValidMessages(file.Messages)
 	//return true
 
-	if len(file.Messages) == 0 {
+	if len(file.Messages) == 0 && len(file.Enums) == 0 {
 		return false
 	}
 
@@ -71,7 +85,11 @@ func (p *plugin) Init(file *protogen.File) bool {
 	}
 	p.file = file
 	p.messages = utils.LoadValidMessages(file.Messages)
+	p.enums = p.loadMethodEnums(file, p.messages)
 
+	if len(p.enums) != 0 {
+		return true
+	}
 	for _, msg := range p.messages {
 		options := p.loadMessageOptions(msg)
 		if options.Ignore == nil || !(*options.Ignore) {
@@ -91,6 +109,9 @@ func (p *plugin) Generate(g *protogen.GeneratedFile) {
 	for _, msg := range p.messages {
 		p.generateMessage(msg)
 	}
+	for _, enum := range p.enums {
+		p.generateEnumMethods(enum)
+	}
 }
 
 func (p *plugin) generateMessage(msg *protogen.Message) {
@@ -120,6 +141,18 @@ func (p *plugin) generateMessage(msg *protogen.Message) {
 
 	// check whether have duplicate json key.
 	p.checkJSONKey()
+	// check whether the option required is valid.
+	p.checkRequired()
+	// check whether the option key_template is valid.
+	p.checkKeyTemplate()
+	// check whether the option codec is valid.
+	p.checkCodec()
+	// check whether the options of float format is valid.
+	p.checkFloatOptions()
+	// check whether the fields refer to the foreign message that has no methods.
+	p.checkForeignMessages()
+	// check whether have duplicate enum value name.
+	p.checkEnumValueNames()
 
 	// Marshal
 	p.generateMarshalCode()
@@ -2,11 +2,129 @@ package gojson
 
 import (
 	"fmt"
+	"go/token"
 	"os"
+	"strings"
 
+	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
+// checkRequired check whether the option `required` is used in an unsupported field.
+func (p *plugin) checkRequired() {
+	msg := p.message
+
+	invalidFields := make([]string, 0)
+	for _, field := range msg.Fields {
+		options := p.loadFieldOptions(field)
+		if !*options.Required {
+			continue
+		}
+		if utils.FieldIsOneOf(field) {
+			invalidFields = append(invalidFields, string(field.Desc.Name()))
+		}
+	}
+	if len(invalidFields) == 0 {
+		return
+	}
+	println(fmt.Sprintf(
+		"gojson: <file(%s) message(%s)>: the option required is not supported for the field in oneof %v",
+		string(p.file.GoImportPath), msg.GoIdent.GoName, invalidFields,
+	))
+	os.Exit(1)
+}
+
+// checkKeyTemplate check whether the option key_template contains the placeholder.
+func (p *plugin) checkKeyTemplate() {
+	tpl := p.msgOptions.KeyTemplate
+	if tpl == nil || *tpl == "" || strings.Count(*tpl, keyTemplatePlaceholder) == 1 {
+		return
+	}
+	println(fmt.Sprintf(
+		"gojson: <file(%s) message(%s)>: the option key_template [%s] must contains the placeholder %s exactly once",
+		string(p.file.GoImportPath), p.message.GoIdent.GoName, *tpl, keyTemplatePlaceholder,
+	))
+	os.Exit(1)
+}
+
+// fieldCodec returns the Go type specified by the option codec of the field.
+func (p *plugin) fieldCodec(field *protogen.Field) (protogen.GoIdent, bool) {
+	options := p.loadFieldOptions(field)
+	if options.Codec == nil || *options.Codec == "" {
+		return protogen.GoIdent{}, false
+	}
+	i := strings.LastIndex(*options.Codec, ".")
+	if i <= 0 {
+		return protogen.GoIdent{}, false
+	}
+	return protogen.GoImportPath((*options.Codec)[:i]).Ident((*options.Codec)[i+1:]), true
+}
+
+// checkCodec check whether the option codec is in format "<go import path>.<type name>".
+func (p *plugin) checkCodec() {
+	msg := p.message
+
+	invalidFields := make([]string, 0)
+	for _, field := range msg.Fields {
+		options := p.loadFieldOptions(field)
+		if options.Codec == nil || *options.Codec == "" {
+			continue
+		}
+		if ident, ok := p.fieldCodec(field); ok && token.IsIdentifier(ident.GoName) {
+			continue
+		}
+		invalidFields = append(invalidFields, fmt.Sprintf("%s(%s)", field.Desc.Name(), *options.Codec))
+	}
+	if len(invalidFields) == 0 {
+		return
+	}
+	println(fmt.Sprintf(
+		"gojson: <file(%s) message(%s)>: the option codec must be in format \"<go import path>.<type name>\" in fields %v",
+		string(p.file.GoImportPath), msg.GoIdent.GoName, invalidFields,
+	))
+	os.Exit(1)
+}
+
+// checkFloatOptions check whether the options float_format, non_finite_float and float_precision
+// are used in the float or double field, and the float_precision is in the range.
+func (p *plugin) checkFloatOptions() {
+	msg := p.message
+
+	invalidFields := make([]string, 0)
+	for _, field := range msg.Fields {
+		options := p.loadFieldOptions(field)
+		if options.FloatFormat == nil && options.NonFiniteFloat == nil && options.FloatPrecision == nil {
+			continue
+		}
+		if !fieldIsFloat(field) {
+			invalidFields = append(invalidFields, string(field.Desc.Name()))
+			continue
+		}
+		if options.FloatPrecision != nil && (*options.FloatPrecision < 0 || *options.FloatPrecision > 17) {
+			invalidFields = append(invalidFields, fmt.Sprintf("%s(float_precision: %d)", field.Desc.Name(), *options.FloatPrecision))
+		}
+	}
+	if len(invalidFields) == 0 {
+		return
+	}
+	println(fmt.Sprintf(
+		"gojson: <file(%s) message(%s)>: the options float_format, non_finite_float and float_precision are only valid for the float and double field, and float_precision must be in [0, 17]; invalid fields %v",
+		string(p.file.GoImportPath), msg.GoIdent.GoName, invalidFields,
+	))
+	os.Exit(1)
+}
+
+// fieldIsFloat reports whether the field is a float or double field, include the repeated
+// field and the map field of float or double values.
+func fieldIsFloat(field *protogen.Field) bool {
+	if field.Desc.IsMap() {
+		field = field.Message.Fields[1]
+	}
+	kind := field.Desc.Kind()
+	return kind == protoreflect.FloatKind || kind == protoreflect.DoubleKind
+}
+
 func (p *plugin) checkJSONKey() {
 	msg := p.message
 	fields := p.fields
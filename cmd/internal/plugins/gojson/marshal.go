@@ -2,8 +2,10 @@ package gojson
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -16,13 +18,74 @@ func (p *plugin) generateMarshalCode() {
 
 	p.g.P("// MarshalJSON for implements interface json.Marshaler. ")
 	p.g.P("func (this *", msg.GoIdent.GoName, ") MarshalJSON() ([]byte, error) {")
+	p.g.P("    return this.MarshalJSONWithOptions(", encoderPackage.Ident("Options"), "{")
+	p.g.P("        Indent: ", strconv.Quote(*p.msgOptions.Indent), ",")
+	p.g.P("        EscapeHTML: ", *p.msgOptions.EscapeHtml, ",")
+	p.g.P("    })")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// MarshalJSONIndent is like MarshalJSON but applies prefix and indent to format the output.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") MarshalJSONIndent(prefix, indent string) ([]byte, error) {")
+	p.g.P("    return this.MarshalJSONWithOptions(", encoderPackage.Ident("Options"), "{")
+	p.g.P("        Prefix: prefix,")
+	p.g.P("        Indent: indent,")
+	p.g.P("        EscapeHTML: ", *p.msgOptions.EscapeHtml, ",")
+	p.g.P("    })")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// MarshalJSONFields is like MarshalJSON but only encodes the fields selected by mask,")
+	p.g.P("// all fields are encoded if mask is empty. The paths are made of the json keys, and an")
+	p.g.P("// error is returned if any path selects no field. See jsonencoder.FieldMask for details.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") MarshalJSONFields(mask *", fieldmaskpbPackage.Ident("FieldMask"), ") ([]byte, error) {")
+	p.g.P("    fieldMask := ", encoderPackage.Ident("NewFieldMask"), "(mask.GetPaths())")
+	p.g.P("    if err := this.CheckJSONFieldMask(fieldMask); err != nil {")
+	p.g.P("        return nil, err")
+	p.g.P("    }")
+	p.g.P("    return this.MarshalJSONFieldsWithOptions(fieldMask, ", encoderPackage.Ident("Options"), "{")
+	p.g.P("        Indent: ", strconv.Quote(*p.msgOptions.Indent), ",")
+	p.g.P("        EscapeHTML: ", *p.msgOptions.EscapeHtml, ",")
+	p.g.P("    })")
+	p.g.P("}")
+	p.g.P("")
+
+	p.generateCheckFieldMask()
+
+	p.g.P("// MarshalJSONWithOptions for implements jsonencoder.Marshaler.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") MarshalJSONWithOptions(opts ", encoderPackage.Ident("Options"), ") ([]byte, error) {")
+	p.g.P("    return this.MarshalJSONFieldsWithOptions(nil, opts)")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// MarshalJSONFieldsWithOptions for implements jsonencoder.FieldsMarshaler.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") MarshalJSONFieldsWithOptions(mask ", encoderPackage.Ident("FieldMask"), ", opts ", encoderPackage.Ident("Options"), ") ([]byte, error) {")
 	p.g.P("    if this == nil {")
 	p.g.P(`        return []byte("null"), nil`)
 	p.g.P("    }")
-	p.g.P("    var err error")
-	p.g.P("")
 	// create a new encoder object.
-	p.g.P("    encoder := ", encoderPackage.Ident("New"), "(", bufLen, ")")
+	p.g.P("    encoder := ", encoderPackage.Ident("NewWithOptions"), "(", bufLen, ", opts)")
+	p.g.P("    if err := this.marshalJSONFieldsTo(encoder, mask); err != nil {")
+	p.g.P("        return nil, err")
+	p.g.P("    }")
+	p.g.P("    return encoder.Output()")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// MarshalJSONTo for implements jsonencoder.EncoderMarshaler.")
+	p.g.P("// It appends the JSON object into encoder with the options of encoder.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") MarshalJSONTo(encoder *", encoderPackage.Ident("Encoder"), ") error {")
+	p.g.P("    return this.marshalJSONFieldsTo(encoder, nil)")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// marshalJSONFieldsTo appends the fields selected by mask into encoder.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") marshalJSONFieldsTo(encoder *", encoderPackage.Ident("Encoder"), ", mask ", encoderPackage.Ident("FieldMask"), ") error {")
+	p.g.P("    if this == nil {")
+	p.g.P("        encoder.AppendNil()")
+	p.g.P("        return nil")
+	p.g.P("    }")
+	p.g.P("    var err error")
 	p.g.P("")
 	p.g.P("    // Add JSON end identifier")
 	p.g.P("    encoder.AppendObjectBegin()")
@@ -32,26 +95,97 @@ func (p *plugin) generateMarshalCode() {
 		switch {
 		case utils.FieldIsOneOf(field):
 			p.marshalOneOf(field.Oneof)
-		case field.Desc.IsMap():
-			p.marshalMap(field)
-		case field.Desc.IsList():
-			p.marshalList(field)
+		case *p.loadFieldOptions(field).Ignore:
+			continue
 		default:
-			p.marshalBasic(field)
+			p.g.P("if mask.Has(", quoteNames(p.fieldMaskNames(field)), ") {")
+			switch {
+			case field.Desc.IsMap():
+				p.marshalMap(field)
+			case field.Desc.IsList():
+				p.marshalList(field)
+			default:
+				p.marshalBasic(field)
+			}
+			p.g.P("}")
 		}
 	}
 
 	p.g.P("")
 	p.g.P("    // Add JSON end identifier")
 	p.g.P("    encoder.AppendObjectEnd()")
-	p.g.P("    return encoder.Bytes(), err")
+	p.g.P("    return err")
+
+	// End of marshalJSONFieldsTo.
+	p.g.P("}")
+}
+
+// generateCheckFieldMask generates the method CheckJSONFieldMask that checks the paths of mask
+// by the json keys of fields and oneofs in the message.
+func (p *plugin) generateCheckFieldMask() {
+	msg := p.message
+
+	p.g.P("// CheckJSONFieldMask for implements jsonencoder.FieldMaskChecker.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") CheckJSONFieldMask(mask ", encoderPackage.Ident("FieldMask"), ") error {")
+	p.g.P("    for _, name := range mask.Names() {")
+	p.g.P("        var err error")
+	p.g.P("        switch name {")
+
+	checkedOneofs := make(map[*protogen.Oneof]bool)
+	for _, field := range msg.Fields {
+		if utils.FieldIsOneOf(field) {
+			oneof := field.Oneof
+			if checkedOneofs[oneof] {
+				continue
+			}
+			checkedOneofs[oneof] = true
+			if *p.loadOneOfOptions(oneof).Ignore {
+				continue
+			}
+			// The oneof is selected as a whole.
+			if names := p.oneofMaskNames(oneof); len(names) != 0 {
+				p.g.P("case ", quoteNames(names), ":")
+				p.g.P("    err = ", encoderPackage.Ident("CheckFieldMask"), "(name, mask[name], nil)")
+			}
+			for _, oneofField := range oneof.Fields {
+				p.generateCheckFieldMaskCase(oneofField)
+			}
+			continue
+		}
+		p.generateCheckFieldMaskCase(field)
+	}
 
-	// End of MarshalJSON.
+	p.g.P("        default:")
+	p.g.P("            err = &", encoderPackage.Ident("FieldMaskError"), "{Path: name}")
+	p.g.P("        }")
+	p.g.P("        if err != nil {")
+	p.g.P("            return err")
+	p.g.P("        }")
+	p.g.P("    }")
+	p.g.P("    return nil")
 	p.g.P("}")
+	p.g.P("")
+}
+
+func (p *plugin) generateCheckFieldMaskCase(field *protogen.Field) {
+	if *p.loadFieldOptions(field).Ignore {
+		return
+	}
+	// The mask of the message in the elements of repeated and map fields is same as the message field.
+	value := "nil"
+	valueField := field
+	if field.Desc.IsMap() {
+		valueField = field.Message.Fields[1]
+	}
+	if valueField.Message != nil {
+		value = "(*" + p.g.QualifiedGoIdent(valueField.Message.GoIdent) + ")(nil)"
+	}
+	p.g.P("case ", quoteNames(p.fieldMaskNames(field)), ":")
+	p.g.P("    err = ", encoderPackage.Ident("CheckFieldMask"), "(name, mask[name], ", value, ")")
 }
 
 func (p *plugin) marshalEncodeKey(key string) {
-	p.g.P(`encoder.AppendObjectKey("`, key, `")`)
+	p.g.P("encoder.AppendObjectKey(", strconv.Quote(key), ")")
 }
 
 func (p *plugin) marshalOneOf(oneof *protogen.Oneof) {
@@ -69,15 +203,30 @@ func (p *plugin) marshalOneOf(oneof *protogen.Oneof) {
 	}
 
 	oneOfKey := p.getOneOfKey(oneOfOptions, oneof)
+	oneofNames := p.oneofMaskNames(oneof)
+
+	names := oneofNames
+	for _, field := range oneof.Fields {
+		if !*p.loadFieldOptions(field).Ignore {
+			names = append(names, p.fieldMaskNames(field)...)
+		}
+	}
 
+	p.g.P("if mask.Has(", quoteNames(names), ") {")
 	p.g.P("if this.", oneof.GoName, "!= nil {")
 	p.g.P("    switch v := this.", oneof.GoName, ".(type) {")
 	for _, field := range oneof.Fields {
 		p.g.P("case *", p.g.QualifiedGoIdent(field.GoIdent), ":")
+		if *p.loadFieldOptions(field).Ignore {
+			p.marshalBasic(field)
+			continue
+		}
+		p.g.P("if mask.Has(", quoteNames(append(oneofNames[:len(oneofNames):len(oneofNames)], p.fieldMaskNames(field)...)), ") {")
 		p.marshalBasic(field)
+		p.g.P("}")
 	}
 	p.g.P("    default:")
-	p.g.P("        return nil, ", fmtPackage.Ident("Errorf"), `("invalid oneof field type: %v, jsonKey: `, oneOfKey, ", goName: ", oneof.GoName, ", field: ", oneof.Desc.FullName(), `"`, ", v)")
+	p.g.P("        return ", fmtPackage.Ident("Errorf"), `("invalid oneof field type: %v, jsonKey: %s, goName: `, oneof.GoName, ", field: ", oneof.Desc.FullName(), `", v, `, strconv.Quote(oneOfKey), ")")
 	// end switch
 	p.g.P("   }")
 	if !(*oneOfOptions.HideOneofKey) && !(*oneOfOptions.Omitempty) {
@@ -87,6 +236,8 @@ func (p *plugin) marshalOneOf(oneof *protogen.Oneof) {
 	}
 	// end if
 	p.g.P("}")
+	// end if of mask
+	p.g.P("}")
 }
 
 func (p *plugin) marshalMap(field *protogen.Field) {
@@ -109,29 +260,25 @@ func (p *plugin) marshalMap(field *protogen.Field) {
 	encodeValue := func() {
 		p.g.P("encoder.AppendObjectBegin()")
 		p.g.P("for k, v := range ", "this.", field.GoName, " {")
-		switch field.Desc.MapKey().Kind() {
-		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
-			p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatInt"), "(int64(k), 10)", ")")
-		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
-			p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatInt"), "(k, 10)", ")")
-		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
-			p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatUint"), "(uint64(k), 10)", ")")
-		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
-			p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatUint"), "(k, 10)", ")")
-		case protoreflect.StringKind:
-			p.g.P("encoder.AppendObjectKey(k)")
-		default:
-			panic(fmt.Sprintf(
-				"gojson: marshal: unsupported type of map key, field: %s, kind: %s",
-				field.Desc.FullName(), field.Desc.MapKey().Kind().String(),
-			))
-		}
-
+		p.marshalEncodeMapKey(field)
 		p.marshalEncodeValue(field)
-
 		p.g.P("}")
 		p.g.P("encoder.AppendObjectEnd()")
 	}
+	if *fieldOptions.MapAsPairs {
+		encodeValue = func() {
+			p.g.P("encoder.AppendListBegin()")
+			p.g.P("for k, v := range ", "this.", field.GoName, " {")
+			p.g.P("encoder.AppendObjectBegin()")
+			p.g.P(`encoder.AppendObjectKey("key")`)
+			p.marshalEncodeMapKey(field)
+			p.g.P(`encoder.AppendObjectKey("value")`)
+			p.marshalEncodeValue(field)
+			p.g.P("encoder.AppendObjectEnd()")
+			p.g.P("}")
+			p.g.P("encoder.AppendListEnd()")
+		}
+	}
 
 	if *fieldOptions.Omitempty {
 		p.g.P("if len(this.", field.GoName, ") != 0 {")
@@ -148,6 +295,56 @@ func (p *plugin) marshalMap(field *protogen.Field) {
 	}
 }
 
+// marshalEncodeMapKey encodes the map key k. The key is encoded as object key in string form,
+// or as a json value of its type if the option map_as_pairs is enabled.
+func (p *plugin) marshalEncodeMapKey(field *protogen.Field) {
+	fieldOptions := p.loadFieldOptions(field)
+	kind := field.Desc.MapKey().Kind()
+
+	if *fieldOptions.MapAsPairs {
+		switch kind {
+		case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+			p.g.P("encoder.AppendInt32(k)")
+		case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+			p.g.P("encoder.AppendInt64(k)")
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+			p.g.P("encoder.AppendUint32(k)")
+		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			p.g.P("encoder.AppendUint64(k)")
+		case protoreflect.BoolKind:
+			p.g.P("encoder.AppendBool(k)")
+		case protoreflect.StringKind:
+			p.g.P("encoder.AppendString(k)")
+		default:
+			panic(fmt.Sprintf(
+				"gojson: marshal: unsupported type of map key, field: %s, kind: %s",
+				field.Desc.FullName(), kind.String(),
+			))
+		}
+		return
+	}
+
+	switch kind {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatInt"), "(int64(k), 10)", ")")
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatInt"), "(k, 10)", ")")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatUint"), "(uint64(k), 10)", ")")
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatUint"), "(k, 10)", ")")
+	case protoreflect.BoolKind:
+		p.g.P("encoder.AppendObjectKey(", strconvPackage.Ident("FormatBool"), "(k)", ")")
+	case protoreflect.StringKind:
+		p.g.P("encoder.AppendObjectKey(k)")
+	default:
+		panic(fmt.Sprintf(
+			"gojson: marshal: unsupported type of map key, field: %s, kind: %s",
+			field.Desc.FullName(), kind.String(),
+		))
+	}
+}
+
 func (p *plugin) marshalList(field *protogen.Field) {
 	fieldOptions := p.loadFieldOptions(field)
 	p.g.P("// encode field type of list;",
@@ -329,19 +526,33 @@ func (p *plugin) marshalEncodeValue(field *protogen.Field) {
 		itemName = "this." + field.GoName
 	}
 
+	if codec, ok := p.fieldCodec(field); ok {
+		if utils.FieldIsPointer(field) {
+			itemName = "*" + itemName
+		}
+		p.g.P("err = new(", codec, ").EncodeJSON(encoder, ", itemName, ")")
+		p.g.P("if err != nil {")
+		p.g.P("    return err")
+		p.g.P("}")
+		return
+	}
+
 	if utils.FieldIsPointer(field) && field.Desc.Kind() != protoreflect.EnumKind {
 		itemName = "*" + itemName
 	}
 
+	useProtoJSON := p.fieldUseProtoJSON(field)
+	maskNames := p.fieldMaskNames(field)
+
 	if field.Desc.IsMap() {
 		field = field.Message.Fields[1]
 	}
 
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
-		p.g.P("encoder.AppendFloat64(", itemName, ")")
+		p.marshalFloat(options, "AppendFloat64", itemName)
 	case protoreflect.FloatKind:
-		p.g.P("encoder.AppendFloat32(", itemName, ")")
+		p.marshalFloat(options, "AppendFloat32", itemName)
 	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
 		p.g.P("encoder.AppendInt32(", itemName, ")")
 	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
@@ -357,13 +568,17 @@ func (p *plugin) marshalEncodeValue(field *protogen.Field) {
 	case protoreflect.BytesKind:
 		p.g.P("encoder.AppendBytes(", itemName, ")")
 	case protoreflect.MessageKind:
-		p.g.P("err = encoder.AppendInterface(", itemName, ")")
+		if useProtoJSON {
+			p.marshalProtoJSON(itemName)
+			return
+		}
+		p.g.P("err = encoder.AppendInterfaceFields(", itemName, ", mask.Sub(", quoteNames(maskNames), "))")
 		p.g.P("if err != nil {")
-		p.g.P("    return nil, err")
+		p.g.P("    return err")
 		p.g.P("}")
 	case protoreflect.EnumKind:
 		if *options.UseEnumString {
-			p.g.P("encoder.AppendString(", itemName, ".String()", ")")
+			p.marshalEnumString(field.Enum, itemName)
 		} else {
 			p.g.P("encoder.AppendInt32(int32(", itemName, ".Number()", "))")
 		}
@@ -371,3 +586,54 @@ func (p *plugin) marshalEncodeValue(field *protogen.Field) {
 		panic(fmt.Sprintf("gojson: marshal: unsupported kind of %s, field: %s", field.Desc.Kind().String(), field.Desc.FullName()))
 	}
 }
+
+// marshalProtoJSON encodes the foreign message that has no methods by protojson.
+func (p *plugin) marshalProtoJSON(itemName string) {
+	p.g.P("if ", itemName, " == nil {")
+	p.g.P("    encoder.AppendNil()")
+	p.g.P("} else {")
+	p.g.P("    b, err := ", protojsonPackage.Ident("MarshalOptions"), "{UseProtoNames: true}.Marshal(", itemName, ")")
+	p.g.P("    if err != nil {")
+	p.g.P("        return err")
+	p.g.P("    }")
+	p.g.P("    encoder.AppendRawJSON(b)")
+	p.g.P("}")
+}
+
+// marshalFloat encodes the float value by the options float_format, non_finite_float and float_precision.
+// The default format uses the simple method without error.
+func (p *plugin) marshalFloat(options *pbjson.FieldOptions, method string, itemName string) {
+	format := make([]interface{}, 0)
+	switch {
+	case options.FloatPrecision != nil:
+		format = append(format, "Mode: ", encoderPackage.Ident("FloatFixed"), ", Precision: ", *options.FloatPrecision)
+	case *options.FloatFormat == pbjson.FloatFormat_FloatES6:
+		format = append(format, "Mode: ", encoderPackage.Ident("FloatES6"))
+	}
+	switch *options.NonFiniteFloat {
+	case pbjson.NonFiniteFloat_NonFiniteProtoJSON:
+		format = appendFloatFormatField(format, "NonFinite: ", encoderPackage.Ident("NonFiniteProtoJSON"))
+	case pbjson.NonFiniteFloat_NonFiniteError:
+		format = appendFloatFormatField(format, "NonFinite: ", encoderPackage.Ident("NonFiniteError"))
+	}
+
+	if len(format) == 0 {
+		p.g.P("encoder.", method, "(", itemName, ")")
+		return
+	}
+
+	args := []interface{}{"err = encoder.", method, "WithFormat(", itemName, ", ", encoderPackage.Ident("FloatFormat"), "{"}
+	args = append(args, format...)
+	args = append(args, "})")
+	p.g.P(args...)
+	p.g.P("if err != nil {")
+	p.g.P("    return err")
+	p.g.P("}")
+}
+
+func appendFloatFormatField(format []interface{}, v ...interface{}) []interface{} {
+	if len(format) != 0 {
+		format = append(format, ", ")
+	}
+	return append(format, v...)
+}
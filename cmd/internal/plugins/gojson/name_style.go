@@ -45,3 +45,31 @@ func underScoreToUpperCamel(s string) string {
 	}
 	return string(data[:])
 }
+
+// XxYy to xxYy, XXYy to xxYy, XX to xx
+func upperCamelToLowerCamel(s string) string {
+	data := []byte(s)
+	num := len(data)
+	for i := 0; i < num; i++ {
+		d := data[i]
+		if d < 'A' || d > 'Z' {
+			break
+		}
+		// Keep the last upper letter of initialism. e.g. XXYy to xxYy.
+		if i > 0 && num > i+1 && data[i+1] >= 'a' && data[i+1] <= 'z' {
+			break
+		}
+		data[i] = d + 32
+	}
+	return string(data)
+}
+
+// XxYy to xx-yy
+func upperCamelToKebab(s string) string {
+	return strings.ReplaceAll(upperCamelToUnderScore(s), "_", "-")
+}
+
+// XxYy to XX_YY
+func upperCamelToScreamingSnake(s string) string {
+	return strings.ToUpper(upperCamelToUnderScore(s))
+}
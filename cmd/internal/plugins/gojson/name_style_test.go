@@ -20,3 +20,22 @@ func Test_underScoreToUpperCamel(t *testing.T) {
 	require.Equal(t, underScoreToUpperCamel("xx_y_y"), "XxYY")
 	require.Equal(t, underScoreToUpperCamel("Xx_Y_y"), "Xx_YY")
 }
+
+func Test_upperCamelToLowerCamel(t *testing.T) {
+	require.Equal(t, upperCamelToLowerCamel("XxYy"), "xxYy")
+	require.Equal(t, upperCamelToLowerCamel("XXYy"), "xxYy")
+	require.Equal(t, upperCamelToLowerCamel("XX"), "xx")
+	require.Equal(t, upperCamelToLowerCamel("X"), "x")
+	require.Equal(t, upperCamelToLowerCamel("xxYy"), "xxYy")
+	require.Equal(t, upperCamelToLowerCamel("X_Yy"), "x_Yy")
+}
+
+func Test_upperCamelToKebab(t *testing.T) {
+	require.Equal(t, upperCamelToKebab("XxYy"), "xx-yy")
+	require.Equal(t, upperCamelToKebab("XxY_Y"), "xx-y-y")
+}
+
+func Test_upperCamelToScreamingSnake(t *testing.T) {
+	require.Equal(t, upperCamelToScreamingSnake("XxYy"), "XX_YY")
+	require.Equal(t, upperCamelToScreamingSnake("XxY_Y"), "XX_Y_Y")
+}
@@ -43,6 +43,36 @@ func (p *plugin) loadMessageOptions(msg *protogen.Message) *pbjson.SerializeOpti
 	if msgOptions.DisallowUnknownFields == nil {
 		msgOptions.DisallowUnknownFields = fileOptions.DisallowUnknownFields
 	}
+	if msgOptions.UnmarshalMode == nil {
+		msgOptions.UnmarshalMode = fileOptions.UnmarshalMode
+	}
+	if msgOptions.KeyTemplate == nil {
+		msgOptions.KeyTemplate = fileOptions.KeyTemplate
+	}
+	if msgOptions.EnumValueStyle == nil {
+		msgOptions.EnumValueStyle = fileOptions.EnumValueStyle
+	}
+	if msgOptions.TrimEnumPrefix == nil {
+		msgOptions.TrimEnumPrefix = fileOptions.TrimEnumPrefix
+	}
+	if msgOptions.Indent == nil {
+		msgOptions.Indent = fileOptions.Indent
+	}
+	if msgOptions.EscapeHtml == nil {
+		msgOptions.EscapeHtml = fileOptions.EscapeHtml
+	}
+	if msgOptions.MapAsPairs == nil {
+		msgOptions.MapAsPairs = fileOptions.MapAsPairs
+	}
+	if msgOptions.FloatFormat == nil {
+		msgOptions.FloatFormat = fileOptions.FloatFormat
+	}
+	if msgOptions.NonFiniteFloat == nil {
+		msgOptions.NonFiniteFloat = fileOptions.NonFiniteFloat
+	}
+	if msgOptions.ForeignMessage == nil {
+		msgOptions.ForeignMessage = fileOptions.ForeignMessage
+	}
 
 	// Set default value for message options.
 	if msgOptions.NameStyle == nil {
@@ -53,6 +83,30 @@ func (p *plugin) loadMessageOptions(msg *protogen.Message) *pbjson.SerializeOpti
 		ok := false
 		msgOptions.DisallowUnknownFields = &ok
 	}
+	if msgOptions.UnmarshalMode == nil || *msgOptions.UnmarshalMode == pbjson.UnmarshalMode_UnmarshalModeUnset {
+		mode := pbjson.UnmarshalMode_MergeOverwrite
+		msgOptions.UnmarshalMode = &mode
+	}
+	if msgOptions.Indent == nil {
+		indent := ""
+		msgOptions.Indent = &indent
+	}
+	if msgOptions.EscapeHtml == nil {
+		ok := true
+		msgOptions.EscapeHtml = &ok
+	}
+	if msgOptions.FloatFormat == nil || *msgOptions.FloatFormat == pbjson.FloatFormat_FloatFormatUnset {
+		format := pbjson.FloatFormat_FloatDecimal
+		msgOptions.FloatFormat = &format
+	}
+	if msgOptions.NonFiniteFloat == nil || *msgOptions.NonFiniteFloat == pbjson.NonFiniteFloat_NonFiniteFloatUnset {
+		policy := pbjson.NonFiniteFloat_NonFiniteGo
+		msgOptions.NonFiniteFloat = &policy
+	}
+	if msgOptions.ForeignMessage == nil || *msgOptions.ForeignMessage == pbjson.ForeignMessagePolicy_ForeignMessagePolicyUnset {
+		policy := pbjson.ForeignMessagePolicy_ForeignMessageWarn
+		msgOptions.ForeignMessage = &policy
+	}
 
 	return msgOptions
 }
@@ -94,8 +148,10 @@ func (p *plugin) loadOneOfOptions(oneof *protogen.Oneof) *pbjson.OneofOptions {
 	return oneOfOptions
 }
 
-func (p *plugin) loadEnumOptions(enum *protogen.Enum) *pbjson.EnumOptions {
-	msgOptions := p.msgOptions
+// loadEnumOptions returns the options of enum, the unset options are inherited from
+// the parentOptions that the options of message or file where the enum used in.
+func (p *plugin) loadEnumOptions(enum *protogen.Enum, parentOptions *pbjson.SerializeOptions) *pbjson.EnumOptions {
+	msgOptions := parentOptions
 	i := proto.GetExtension(enum.Desc.Options(), pbjson.E_Enum)
 	enumOptions := i.(*pbjson.EnumOptions)
 	if enumOptions == nil {
@@ -104,6 +160,30 @@ func (p *plugin) loadEnumOptions(enum *protogen.Enum) *pbjson.EnumOptions {
 	if enumOptions.UseEnumString == nil {
 		enumOptions.UseEnumString = msgOptions.UseEnumString
 	}
+	if enumOptions.EnumValueStyle == nil {
+		enumOptions.EnumValueStyle = msgOptions.EnumValueStyle
+	}
+	if enumOptions.TrimEnumPrefix == nil {
+		enumOptions.TrimEnumPrefix = msgOptions.TrimEnumPrefix
+	}
+
+	// Set default value for enum options.
+	if enumOptions.EnumValueStyle == nil || *enumOptions.EnumValueStyle == pbjson.EnumValueStyle_EnumValueStyleUnset {
+		style := pbjson.EnumValueStyle_EnumValueName
+		enumOptions.EnumValueStyle = &style
+	}
+	if enumOptions.TrimEnumPrefix == nil {
+		ok := false
+		enumOptions.TrimEnumPrefix = &ok
+	}
+	if enumOptions.UnknownEnumPolicy == nil || *enumOptions.UnknownEnumPolicy == pbjson.UnknownEnumPolicy_UnknownEnumPolicyUnset {
+		policy := pbjson.UnknownEnumPolicy_UnknownEnumError
+		enumOptions.UnknownEnumPolicy = &policy
+	}
+	if enumOptions.LenientDecoding == nil {
+		ok := false
+		enumOptions.LenientDecoding = &ok
+	}
 
 	return enumOptions
 }
@@ -124,9 +204,26 @@ func (p *plugin) loadFieldOptions(field *protogen.Field) *pbjson.FieldOptions {
 	if fieldOptions.Ignore == nil {
 		fieldOptions.Ignore = msgOptions.Ignore
 	}
+	if fieldOptions.MapAsPairs == nil {
+		fieldOptions.MapAsPairs = msgOptions.MapAsPairs
+	}
+	if fieldOptions.ForeignMessage == nil || *fieldOptions.ForeignMessage == pbjson.ForeignMessagePolicy_ForeignMessagePolicyUnset {
+		fieldOptions.ForeignMessage = msgOptions.ForeignMessage
+	}
+
+	// Only the float field inherits the float options, so that checkFloatOptions can find
+	// the options set in other fields.
+	if fieldIsFloat(field) {
+		if fieldOptions.FloatFormat == nil || *fieldOptions.FloatFormat == pbjson.FloatFormat_FloatFormatUnset {
+			fieldOptions.FloatFormat = msgOptions.FloatFormat
+		}
+		if fieldOptions.NonFiniteFloat == nil || *fieldOptions.NonFiniteFloat == pbjson.NonFiniteFloat_NonFiniteFloatUnset {
+			fieldOptions.NonFiniteFloat = msgOptions.NonFiniteFloat
+		}
+	}
 
 	if field.Enum != nil && fieldOptions.UseEnumString == nil {
-		enumOptions := p.loadEnumOptions(field.Enum)
+		enumOptions := p.loadEnumOptions(field.Enum, msgOptions)
 		fieldOptions.UseEnumString = enumOptions.UseEnumString
 	}
 
@@ -141,6 +238,12 @@ func (p *plugin) loadFieldOptions(field *protogen.Field) *pbjson.FieldOptions {
 	if fieldOptions.UseEnumString == nil {
 		fieldOptions.UseEnumString = &ok1
 	}
+	if fieldOptions.Required == nil {
+		fieldOptions.Required = &ok1
+	}
+	if fieldOptions.MapAsPairs == nil {
+		fieldOptions.MapAsPairs = &ok1
+	}
 
 	// Ignore field if json == "-"
 	if fieldOptions.Json != nil && *fieldOptions.Json == "-" {
@@ -149,3 +252,12 @@ func (p *plugin) loadFieldOptions(field *protogen.Field) *pbjson.FieldOptions {
 	}
 	return fieldOptions
 }
+
+func (p *plugin) loadEnumValueOptions(value *protogen.EnumValue) *pbjson.EnumValueOptions {
+	i := proto.GetExtension(value.Desc.Options(), pbjson.E_EnumValue)
+	valueOptions := i.(*pbjson.EnumValueOptions)
+	if valueOptions == nil {
+		valueOptions = &pbjson.EnumValueOptions{}
+	}
+	return valueOptions
+}
@@ -2,8 +2,10 @@ package gojson
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/reflect/protoreflect"
 )
@@ -14,9 +16,52 @@ func (p *plugin) generateUnmarshalCode() {
 
 	p.g.P("// UnmarshalJSON for implements json.Unmarshaler.")
 	p.g.P("func (this *", msg.GoIdent.GoName, ") UnmarshalJSON(b []byte) error {")
+	p.g.P("    return this.UnmarshalJSONWithOptions(b, ", decoderPackage.Ident("Options"), "{")
+	p.g.P("        DisallowUnknownFields: ", *p.msgOptions.DisallowUnknownFields, ",")
+	p.g.P("        RequireFields: true,")
+	p.g.P("    })")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// UnmarshalJSONFields is like UnmarshalJSON but also returns the paths of fields that present in b.")
+	p.g.P("// A message field is present by the paths of its nested fields, or by itself if the value is null or")
+	p.g.P("// an empty object. The fields in the elements of repeated and map fields are not reported.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") UnmarshalJSONFields(b []byte) (*", fieldmaskpbPackage.Ident("FieldMask"), ", error) {")
+	p.g.P("    present := &", decoderPackage.Ident("FieldPaths"), "{}")
+	p.g.P("    err := this.UnmarshalJSONWithOptions(b, ", decoderPackage.Ident("Options"), "{")
+	p.g.P("        DisallowUnknownFields: ", *p.msgOptions.DisallowUnknownFields, ",")
+	p.g.P("        RequireFields: true,")
+	p.g.P("        Present: present,")
+	p.g.P("    })")
+	p.g.P("    if err != nil {")
+	p.g.P("        return nil, err")
+	p.g.P("    }")
+	p.g.P("    return &", fieldmaskpbPackage.Ident("FieldMask"), "{Paths: present.Leaves()}, nil")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// UnmarshalJSONWithOptions for implements jsondecoder.Unmarshaler.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") UnmarshalJSONWithOptions(b []byte, opts ", decoderPackage.Ident("Options"), ") error {")
+	p.g.P("    if this == nil {")
+	p.g.P("        return ", errorsPackage.Ident("New"), "(\"json: Unmarshal: ", string(msg.GoIdent.GoImportPath), ".(*", msg.GoIdent.GoName, ") is nil\")")
+	p.g.P("    }")
+	p.g.P("    decoder, err := ", decoderPackage.Ident("NewWithOptions"), "(b, opts)")
+	p.g.P("    if err != nil {")
+	p.g.P("        return err")
+	p.g.P("    }")
+	p.g.P("    return this.UnmarshalJSONFrom(decoder)")
+	p.g.P("}")
+	p.g.P("")
+
+	p.g.P("// UnmarshalJSONFrom for implements jsondecoder.DecoderUnmarshaler.")
+	p.g.P("// It decodes the next value of decoder with the options of decoder, the value is decoded in place")
+	p.g.P("// if the decoder is already at the beginning of it.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") UnmarshalJSONFrom(decoder *", decoderPackage.Ident("Decoder"), ") error {")
 	p.g.P("    if this == nil {")
 	p.g.P("        return ", errorsPackage.Ident("New"), "(\"json: Unmarshal: ", string(msg.GoIdent.GoImportPath), ".(*", msg.GoIdent.GoName, ") is nil\")")
 	p.g.P("    }")
+	p.g.P("    var err error")
+	p.g.P("    opts := decoder.Options()")
 
 	if len(fields) >= 0 {
 		// Generated flag variables to check oneof.
@@ -29,6 +74,10 @@ func (p *plugin) generateUnmarshalCode() {
 				}
 			}
 		}
+		// Generated flag variables to check required field.
+		for _, field := range p.requiredFields() {
+			p.g.P("var ", p.genVariableRequiredIsStore(field.GoName), " bool")
+		}
 		// Generated scan code.
 		p.unmarshalScanCode()
 	}
@@ -40,14 +89,8 @@ func (p *plugin) generateUnmarshalCode() {
 
 func (p *plugin) unmarshalScanCode() {
 	p.g.P("")
-	p.g.P("decoder, err := ", decoderPackage.Ident("New"), "(b)")
-	p.g.P("if err != nil {")
-	p.g.P("    return err")
-	p.g.P("}")
-	p.g.P("")
-
 	p.g.P("// check null.")
-	p.g.P("decoder.ScanWhile(", decoderPackage.Ident("ScanSkipSpace"), ")")
+	p.g.P("decoder.ScanBeginValue()")
 	p.g.P("if decoder.OpCode == ", decoderPackage.Ident("ScanBeginLiteral"), " {")
 	p.g.P("    value := decoder.ReadItem()")
 	p.g.P("    if value[0] != 'n' {")
@@ -58,7 +101,8 @@ func (p *plugin) unmarshalScanCode() {
 	p.g.P("}")
 
 	p.g.P("if decoder.OpCode != ", decoderPackage.Ident("ScanBeginObject"), " {")
-	p.g.P("    panic(", decoderPackage.Ident("PhasePanicMsg"), ")")
+	p.g.P("    value := decoder.ReadItem()")
+	p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s into object", string(value))`)
 	p.g.P("}")
 
 	// generate code for scan object.
@@ -71,11 +115,31 @@ func (p *plugin) unmarshalScanCode() {
 
 	// LOOP_OBJECT end.
 	p.g.P("}")
+	p.g.P("decoder.ScanNext()")
 
 	p.g.P("")
 	p.g.P("if err = decoder.ScanError(); err != nil {")
 	p.g.P("    return err")
 	p.g.P("}")
+
+	p.unmarshalCheckRequired()
+}
+
+func (p *plugin) unmarshalCheckRequired() {
+	fields := p.requiredFields()
+	if len(fields) == 0 {
+		return
+	}
+	p.g.P("")
+	p.g.P("// check required fields.")
+	p.g.P("if opts.RequireFields {")
+	for _, field := range fields {
+		jsonKey := p.getFieldKey(p.loadFieldOptions(field), field)
+		p.g.P("if !", p.genVariableRequiredIsStore(field.GoName), " {")
+		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: missing required field %q", `, strconv.Quote(jsonKey), ")")
+		p.g.P("}")
+	}
+	p.g.P("}")
 }
 
 func (p *plugin) unmarshalLoopObject() {
@@ -92,6 +156,7 @@ func (p *plugin) unmarshalLoopObject() {
 	p.g.P("")
 	p.g.P("objKey := decoder.ReadObjectKey() // Read key")
 	p.g.P("_ = objKey // avoid objKey not used")
+	p.unmarshalMatchKey("objKey", p.objectKeys())
 
 	// Before read value
 	p.unmarshalObjectBeforeReadValue()
@@ -126,7 +191,13 @@ LOOP:
 			jsonKey = p.getFieldKey(options, field)
 		}
 
-		p.g.P("case objKey == ", `"`, jsonKey, `"`, ":")
+		p.g.P("case objKey == ", strconv.Quote(jsonKey), ":")
+		if !utils.FieldIsOneOf(field) {
+			p.g.P("decoder.MarkPresent(", strconv.Quote(jsonKey), ")")
+		}
+		if !utils.FieldIsOneOf(field) && *p.loadFieldOptions(field).Required {
+			p.g.P(p.genVariableRequiredIsStore(field.GoName), " = true")
+		}
 		switch {
 		case utils.FieldIsOneOf(field):
 			p.unmarshalOneOf(field)
@@ -139,11 +210,10 @@ LOOP:
 		}
 	}
 	p.g.P("default:")
-	if *p.msgOptions.DisallowUnknownFields {
-		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: unknown field %q", objKey)`)
-	} else {
-		p.g.P("_ = decoder.ReadItem() // discard unknown field")
-	}
+	p.g.P("if opts.DisallowUnknownFields {")
+	p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: unknown field %q", objKey)`)
+	p.g.P("}")
+	p.g.P("_ = decoder.ReadItem() // discard unknown field")
 	// enc switch
 	p.g.P("}")
 
@@ -161,7 +231,7 @@ func (p *plugin) unmarshalOneOf(field *protogen.Field) {
 
 	goType := utils.FieldGoType(p.g, field)
 
-	loopLabel := "LOOP_ONEOF_" + p.getOneOfKey(p.loadOneOfOptions(oneof), oneof)
+	loopLabel := "LOOP_ONEOF_" + oneof.GoName
 
 	decodeOneOf := func() {
 		//p.g.P("decoder.Skip()")
@@ -173,6 +243,7 @@ func (p *plugin) unmarshalOneOf(field *protogen.Field) {
 
 		// Read key
 		p.g.P("    oneofKey := decoder.ReadObjectKey() // Read key")
+		p.unmarshalMatchKey("oneofKey", p.oneofKeys(oneof))
 
 		// Before read value
 		p.unmarshalObjectBeforeReadValue()
@@ -181,11 +252,10 @@ func (p *plugin) unmarshalOneOf(field *protogen.Field) {
 		p.g.P("   switch {")
 		p.unmarshalDecodeOneOf(oneof, "oneofKey")
 		p.g.P("    default:")
-		if *p.msgOptions.DisallowUnknownFields {
-			p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: unknown oneof field %q", oneofKey)`)
-		} else {
-			p.g.P("_ = decoder.ReadItem() // discard unknown field")
-		}
+		p.g.P("if opts.DisallowUnknownFields {")
+		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: unknown oneof field %q", oneofKey)`)
+		p.g.P("}")
+		p.g.P("_ = decoder.ReadItem() // discard unknown field")
 		//p.g.P("       return ", fmtPackage.Ident("Errorf"), `("json: unknown oneof field %q", oneofKey)`)
 		// switch end.
 		p.g.P("    }")
@@ -224,7 +294,7 @@ func (p *plugin) unmarshalMap(field *protogen.Field) {
 	)
 
 	goType := utils.FieldGoType(p.g, field)
-	loopLabel := "LOOP_MAP_" + p.getFieldKey(p.loadFieldOptions(field), field)
+	loopLabel := "LOOP_MAP_" + field.GoName
 
 	decodeKey := func() {
 		checkKeyError := func() {
@@ -247,6 +317,15 @@ func (p *plugin) unmarshalMap(field *protogen.Field) {
 		case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
 			p.g.P("mapKey, err := ", strconvPackage.Ident("ParseUint"), "(key, 10, 64)")
 			checkKeyError()
+		case protoreflect.BoolKind:
+			p.g.P("var mapKey bool")
+			p.g.P("switch key {")
+			p.g.P(`case "true":`)
+			p.g.P("    mapKey = true")
+			p.g.P(`case "false":`)
+			p.g.P("default:")
+			p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s as map key into field %s of type `, goType, `", key, objKey)`)
+			p.g.P("}")
 		case protoreflect.StringKind:
 			p.g.P("mapKey := key")
 		default:
@@ -261,6 +340,12 @@ func (p *plugin) unmarshalMap(field *protogen.Field) {
 		// create map if not initialized.
 		p.g.P("if this.", field.GoName, " == nil { // create map if not initialized.")
 		p.g.P("    this.", field.GoName, " = ", "make(", goType, ")")
+		if p.unmarshalMode() == pbjson.UnmarshalMode_Replace {
+			p.g.P("} else { // reset the map in mode Replace.")
+			p.g.P("    for k := range this.", field.GoName, " {")
+			p.g.P("        delete(this.", field.GoName, ", k)")
+			p.g.P("    }")
+		}
 		p.g.P("}")
 
 		p.g.P(loopLabel, ":")
@@ -294,20 +379,112 @@ func (p *plugin) unmarshalMap(field *protogen.Field) {
 		p.g.P("decoder.ScanNext()")
 	}
 
+	decodePairs := func() {
+		entryLabel := "LOOP_MAP_ENTRY_" + field.GoName
+
+		p.g.P("if this.", field.GoName, " == nil { // create map if not initialized.")
+		p.g.P("    this.", field.GoName, " = ", "make(", goType, ")")
+		if p.unmarshalMode() == pbjson.UnmarshalMode_Replace {
+			p.g.P("} else { // reset the map in mode Replace.")
+			p.g.P("    for k := range this.", field.GoName, " {")
+			p.g.P("        delete(this.", field.GoName, ", k)")
+			p.g.P("    }")
+		}
+		p.g.P("}")
+
+		p.g.P(loopLabel, ":")
+		p.g.P("for {")
+		p.g.P("if err = decoder.ScanError(); err != nil {")
+		p.g.P("    return err")
+		p.g.P("}")
+
+		// Before Read entry.
+		p.unmarshalArrayBeforeReadValue(loopLabel)
+
+		// Read the entry in format {"key": k, "value": v}.
+		p.g.P("if decoder.OpCode != ", decoderPackage.Ident("ScanBeginObject"), " {")
+		p.g.P("    value := decoder.ReadItem()")
+		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s as map entry into field %s of type `, goType, `", string(value), objKey)`)
+		p.g.P("}")
+		inPlace := p.unmarshalInPlace(field)
+		if inPlace {
+			p.g.P("var entryKey []byte")
+			p.g.P("var x *", p.g.QualifiedGoIdent(field.Message.Fields[1].Message.GoIdent))
+			p.g.P("var hasValue bool")
+		} else {
+			p.g.P("var entryKey, entryValue []byte")
+		}
+		p.g.P(entryLabel, ":")
+		p.g.P("for {")
+		p.g.P("if err = decoder.ScanError(); err != nil {")
+		p.g.P("    return err")
+		p.g.P("}")
+		p.unmarshalObjectBeforeReadKey(entryLabel)
+		p.g.P("name := decoder.ReadObjectKey()")
+		p.unmarshalObjectBeforeReadValue()
+		p.g.P("switch name {")
+		p.g.P(`case "key":`)
+		p.g.P("    entryKey = decoder.ReadItem()")
+		p.g.P(`case "value":`)
+		if inPlace {
+			p.unmarshalPairMessage(field)
+		} else {
+			p.g.P("    entryValue = decoder.ReadItem()")
+		}
+		p.g.P("default:")
+		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: unknown key %q in map entry of field %s", name, objKey)`)
+		p.g.P("}")
+		p.unmarshalObjectAfterReadValue(entryLabel)
+		p.g.P("}")
+		p.g.P("decoder.ScanNext()")
+		if inPlace {
+			p.g.P("if entryKey == nil || !hasValue {")
+		} else {
+			p.g.P("if entryKey == nil || entryValue == nil {")
+		}
+		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: map entry of field %s must have both key and value", objKey)`)
+		p.g.P("}")
+
+		// Parse key.
+		p.unmarshalDecodePairKey(field)
+
+		// Decode value.
+		if inPlace {
+			p.g.P("this.", field.GoName, "[mapKey] = x")
+		} else {
+			p.unmarshalDecodeValueFrom(field, "entryValue")
+		}
+
+		// After read entry.
+		p.unmarshalArrayAfterReadValue(loopLabel)
+
+		// End loop.
+		p.g.P("}")
+		p.g.P("decoder.ScanNext()")
+	}
+
+	kindName := "map"
+	beginCode := "ScanBeginObject"
+	if *p.loadFieldOptions(field).MapAsPairs {
+		kindName = "array"
+		beginCode = "ScanBeginArray"
+		decodeMap = decodePairs
+	}
+
 	// Check whether null.
 	p.g.P("if decoder.OpCode == ", decoderPackage.Ident("ScanBeginLiteral"), " {")
 	p.g.P("    value := decoder.ReadItem()")
 	p.g.P("    if value[0] != 'n' {")
-	p.g.P("        return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s as map into field %s of type `, goType, `", string(value), objKey)`)
+	p.g.P("        return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s as `, kindName, ` into field %s of type `, goType, `", string(value), objKey)`)
 	p.g.P("    } else {")
 	p.g.P("        this.", field.GoName, " = nil")
 	p.g.P("    }")
 	p.g.P("} else {")
 
 	// check is map.
-	p.g.P("    if decoder.OpCode != ", decoderPackage.Ident("ScanBeginObject"), " {")
+	p.g.P("    if decoder.OpCode != ", decoderPackage.Ident(beginCode), " {")
 	p.g.P("        value := decoder.ReadItem()")
-	p.g.P("        return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s as map into field %s of type `, goType, `", string(value), objKey)`)
+	p.g.P("        return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s as `, kindName, ` into field %s of type `, goType, `", string(value), objKey)`)
 	p.g.P("    }")
 
 	decodeMap()
@@ -315,6 +492,71 @@ func (p *plugin) unmarshalMap(field *protogen.Field) {
 	p.g.P("}")
 }
 
+// unmarshalPairMessage decodes the message value of map entry in place. In mode MergeOverwrite
+// the existing value is merged only if the key is read before the value, as the encoder writes.
+func (p *plugin) unmarshalPairMessage(field *protogen.Field) {
+	valueType := p.g.QualifiedGoIdent(field.Message.Fields[1].Message.GoIdent)
+
+	p.g.P("hasValue = true")
+	p.g.P("x = nil")
+	p.g.P("if !decoder.ReadNull() {")
+	if p.unmarshalMode() == pbjson.UnmarshalMode_MergeOverwrite {
+		p.g.P("if entryKey != nil {")
+		p.unmarshalDecodePairKey(field)
+		p.g.P("    x = this.", field.GoName, "[mapKey]")
+		p.g.P("}")
+		p.g.P("if x == nil {")
+		p.g.P("    x = new(", valueType, ")")
+		p.g.P("}")
+	} else {
+		p.g.P("    x = new(", valueType, ")")
+	}
+	p.unmarshalNestedMessage(field, false)
+	p.g.P("}")
+}
+
+// unmarshalDecodePairKey decodes the map key from entryKey that encoded as a json value of its type.
+func (p *plugin) unmarshalDecodePairKey(field *protogen.Field) {
+	goType := utils.FieldGoType(p.g, field)
+
+	returnError := func() {
+		p.g.P("return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s as map key into field %s of type `, goType, `", string(entryKey), objKey)`)
+	}
+	checkError := func() {
+		p.g.P("if err != nil {")
+		returnError()
+		p.g.P("}")
+	}
+
+	switch field.Desc.MapKey().Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		p.g.P("mapKey, err := ", decoderPackage.Ident("ParseInt32"), "(entryKey)")
+		checkError()
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		p.g.P("mapKey, err := ", decoderPackage.Ident("ParseInt64"), "(entryKey)")
+		checkError()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		p.g.P("mapKey, err := ", decoderPackage.Ident("ParseUint32"), "(entryKey)")
+		checkError()
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		p.g.P("mapKey, err := ", decoderPackage.Ident("ParseUint64"), "(entryKey)")
+		checkError()
+	case protoreflect.BoolKind:
+		p.g.P("mapKey, err := ", decoderPackage.Ident("ParseBoolKey"), "(entryKey)")
+		checkError()
+	case protoreflect.StringKind:
+		p.g.P("mapKey, ok := ", decoderPackage.Ident("UnquoteString"), "(entryKey)")
+		p.g.P("if !ok {")
+		returnError()
+		p.g.P("}")
+	default:
+		panic(fmt.Sprintf(
+			"gojson: umarshal: unsupported type of map key, field: %s, kind: %s",
+			field.Desc.FullName(), field.Desc.MapKey().Kind().String(),
+		))
+	}
+}
+
 func (p *plugin) unmarshalList(field *protogen.Field) {
 	p.g.P("// decode filed type of list;",
 		" | field: ", field.Desc.FullName(),
@@ -324,15 +566,23 @@ func (p *plugin) unmarshalList(field *protogen.Field) {
 
 	goType := utils.FieldGoType(p.g, field)
 
-	loopLabel := "LOOP_LIST_" + p.getFieldKey(p.loadFieldOptions(field), field)
+	loopLabel := "LOOP_LIST_" + field.GoName
+
+	mode := p.unmarshalMode()
 
 	decodeList := func() {
 		p.g.P("if this.", field.GoName, " == nil {")
 		p.g.P("    this.", field.GoName, " = ", "make(", goType, ", 0)")
+		if mode == pbjson.UnmarshalMode_Replace {
+			p.g.P("} else { // truncate the slice in mode Replace.")
+			p.g.P("    this.", field.GoName, " = this.", field.GoName, "[:0]")
+		}
 		p.g.P("}")
 
-		p.g.P("i := 0")
-		p.g.P("length := len(this.", field.GoName, ")")
+		if mode == pbjson.UnmarshalMode_MergeOverwrite {
+			p.g.P("i := 0")
+			p.g.P("length := len(this.", field.GoName, ")")
+		}
 		p.g.P(loopLabel, ":")
 		p.g.P("for {")
 
@@ -346,7 +596,9 @@ func (p *plugin) unmarshalList(field *protogen.Field) {
 		// Read list value.
 		p.unmarshalDecodeValue(field)
 
-		p.g.P("i++")
+		if mode == pbjson.UnmarshalMode_MergeOverwrite {
+			p.g.P("i++")
+		}
 
 		// After read value.
 		p.unmarshalArrayAfterReadValue(loopLabel)
@@ -354,10 +606,12 @@ func (p *plugin) unmarshalList(field *protogen.Field) {
 		// end LOOP_LIST.
 		p.g.P("}")
 
-		// truncate the slice if necessary.
-		p.g.P("if i < length {")
-		p.g.P("    this.", field.GoName, " = this.", field.GoName, "[:i]")
-		p.g.P("}")
+		if mode == pbjson.UnmarshalMode_MergeOverwrite {
+			// truncate the slice if necessary.
+			p.g.P("if i < length {")
+			p.g.P("    this.", field.GoName, " = this.", field.GoName, "[:i]")
+			p.g.P("}")
+		}
 
 		p.g.P("decoder.ScanNext()")
 	}
@@ -418,12 +672,95 @@ func (p *plugin) unmarshalDecodeOneOf(oneof *protogen.Oneof, keyVariable string)
 		if *options.Ignore {
 			continue
 		}
-		p.g.P("case ", keyVariable, " == ", `"`, p.getFieldKey(options, field), `"`, ":")
+		p.g.P("case ", keyVariable, " == ", strconv.Quote(p.getFieldKey(options, field)), ":")
+		p.g.P("decoder.MarkPresent(", strconv.Quote(p.getFieldKey(options, field)), ")")
 		p.unmarshalDecodeValue(field)
 	}
 }
 
+// unmarshalInPlace reports whether the value of field is a message that read from decoder and decoded in place.
+func (p *plugin) unmarshalInPlace(field *protogen.Field) bool {
+	if _, hasCodec := p.fieldCodec(field); hasCodec || p.fieldUseProtoJSON(field) {
+		return false
+	}
+	if field.Desc.IsMap() {
+		return field.Desc.MapValue().Kind() == protoreflect.MessageKind
+	}
+	return field.Desc.Kind() == protoreflect.MessageKind
+}
+
+// unmarshalNestedMessage decodes the value of decoder into the non-nil message x. The message that
+// implements jsondecoder.DecoderUnmarshaler is decoded in place, so the data is not scanned again.
+func (p *plugin) unmarshalNestedMessage(field *protogen.Field, collectPaths bool) {
+	unmarshalNested := "decoder.UnmarshalNested(um)"
+	nestedOptions := "decoder.NestedOptions()"
+	if collectPaths {
+		name := p.fieldMaskNames(field)[0]
+		unmarshalNested = "decoder.UnmarshalNestedField(um, " + strconv.Quote(name) + ")"
+		nestedOptions = "decoder.NestedFieldOptions(" + strconv.Quote(name) + ")"
+	}
+	p.g.P("if um, ok := interface{}(x).(", decoderPackage.Ident("DecoderUnmarshaler"), "); ok {")
+	p.g.P("    err = ", unmarshalNested)
+	p.g.P("} else {")
+	p.g.P("    value := decoder.ReadItem()")
+	p.g.P("    if um, ok := interface{}(x).(", decoderPackage.Ident("Unmarshaler"), "); ok {")
+	p.g.P("        err = um.UnmarshalJSONWithOptions(value, ", nestedOptions, ")")
+	p.g.P("    } else if um, ok := interface{}(x).(", jsonPackage.Ident("Unmarshaler"), "); ok {")
+	p.g.P("        err = um.UnmarshalJSON(value)")
+	p.g.P("    } else {")
+	p.g.P("        err = ", jsonPackage.Ident("Unmarshal"), "(value, x)")
+	p.g.P("    }")
+	p.g.P("}")
+	p.g.P("if err != nil {")
+	p.g.P("    return err")
+	p.g.P("}")
+}
+
+// unmarshalProtoJSON decodes the value of the foreign message field by protojson. The protojson resets
+// the message before decoding, so the value is decoded into a new message and merged into the existing
+// one as the message with methods. The well-known types are replaced since they are atomic values in json.
+// The field is the value field of map.
+func (p *plugin) unmarshalProtoJSON(field *protogen.Field, goName string, isMap, isList, isOneOf bool) {
+	mode := p.unmarshalMode()
+
+	p.g.P("if value[0] != 'n' { // value[0] == 'n' means null")
+	p.g.P("    x = new(", p.g.QualifiedGoIdent(field.Message.GoIdent), ")")
+	p.g.P("    err = ", protojsonPackage.Ident("UnmarshalOptions"), "{")
+	p.g.P("        DiscardUnknown: !decoder.Options().DisallowUnknownFields,")
+	p.g.P("        RecursionLimit: decoder.NestedDepthLimit(),")
+	p.g.P("    }.Unmarshal(value, x)")
+	p.g.P("    if err != nil {")
+	p.g.P("        return err")
+	p.g.P("    }")
+	if !isWellKnownType(field.Message) {
+		var existing string
+		switch {
+		case isMap && mode == pbjson.UnmarshalMode_MergeOverwrite:
+			existing = "this." + goName + "[mapKey]"
+			p.g.P("if ", existing, " != nil {")
+		case isList && mode == pbjson.UnmarshalMode_MergeOverwrite:
+			existing = "this." + goName + "[i]"
+			p.g.P("if i < length && ", existing, " != nil {")
+		case isMap, isList, isOneOf:
+		default:
+			existing = "this." + goName
+			p.g.P("if ", existing, " != nil {")
+		}
+		if existing != "" {
+			p.g.P("    ", protoPackage.Ident("Merge"), "(", existing, ", x)")
+			p.g.P("    x = ", existing)
+			p.g.P("}")
+		}
+	}
+	p.g.P("}")
+}
+
 func (p *plugin) unmarshalDecodeValue(field *protogen.Field) {
+	p.unmarshalDecodeValueFrom(field, "decoder.ReadItem()")
+}
+
+// unmarshalDecodeValueFrom decodes the value that read by the expression readValue.
+func (p *plugin) unmarshalDecodeValueFrom(field *protogen.Field, readValue string) {
 	options := p.loadFieldOptions(field)
 
 	isMap := field.Desc.IsMap()
@@ -433,6 +770,7 @@ func (p *plugin) unmarshalDecodeValue(field *protogen.Field) {
 
 	goName := field.GoName
 	goType := utils.FieldGoType(p.g, field)
+	mode := p.unmarshalMode()
 
 	oneOfName := ""
 	oneOfType := ""
@@ -447,11 +785,15 @@ func (p *plugin) unmarshalDecodeValue(field *protogen.Field) {
 		case isMap:
 			p.g.P("this.", goName, "[mapKey] = x")
 		case isList:
-			p.g.P("if i < length {")
-			p.g.P("    this.", goName, "[i] = x")
-			p.g.P("} else {")
-			p.g.P("    this.", goName, " = append(", "this.", goName, ", x", ")")
-			p.g.P("}")
+			if mode == pbjson.UnmarshalMode_MergeOverwrite {
+				p.g.P("if i < length {")
+				p.g.P("    this.", goName, "[i] = x")
+				p.g.P("} else {")
+				p.g.P("    this.", goName, " = append(", "this.", goName, ", x", ")")
+				p.g.P("}")
+			} else {
+				p.g.P("this.", goName, " = append(", "this.", goName, ", x", ")")
+			}
 		case isOneOf:
 			p.g.P("if ", p.genVariableOneofIsStore(oneOfName), " {")
 			p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: unmarshal: the field %s is type oneof, allow contains only one", objKey)`)
@@ -492,11 +834,33 @@ func (p *plugin) unmarshalDecodeValue(field *protogen.Field) {
 		p.g.P("}")
 	}
 
+	codec, hasCodec := p.fieldCodec(field)
+	useProtoJSON := p.fieldUseProtoJSON(field)
+	inPlace := p.unmarshalInPlace(field)
+
 	if isMap {
 		field = field.Message.Fields[1]
 	}
 
-	p.g.P("value := decoder.ReadItem()")
+	if !inPlace {
+		p.g.P("value := ", readValue)
+	}
+
+	if hasCodec {
+		position := ""
+		switch {
+		case isMap:
+			position = " as map value"
+		case isList:
+			position = " as array element"
+		}
+		p.g.P("x, err := new(", codec, ").DecodeJSON(value)")
+		p.g.P("if err != nil {")
+		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: cannot unmarshal %s`, position, ` into field %s of type `, goType, `: %w", string(value), objKey, err)`)
+		p.g.P("}")
+		storeValue()
+		return
+	}
 
 	switch field.Desc.Kind() {
 	case protoreflect.DoubleKind:
@@ -551,20 +915,32 @@ func (p *plugin) unmarshalDecodeValue(field *protogen.Field) {
 
 		p.g.P("var x *", valueType)
 
-		p.g.P("if value[0] != 'n' { // value[0] == 'n' means null")
+		if useProtoJSON {
+			p.unmarshalProtoJSON(field, goName, isMap, isList, isOneOf)
+			storeValue()
+			return
+		}
+
+		if inPlace {
+			p.g.P("if !decoder.ReadNull() {")
+		} else {
+			p.g.P("if value[0] != 'n' { // value[0] == 'n' means null")
+		}
 		switch {
-		case isMap:
+		case isMap && mode == pbjson.UnmarshalMode_MergeOverwrite:
 			p.g.P("x = this.", goName, "[mapKey]")
 			p.g.P("if x == nil {")
 			p.g.P("    x = new(", valueType, ")")
 			p.g.P("}")
-		case isList:
+		case isList && mode == pbjson.UnmarshalMode_MergeOverwrite:
 			p.g.P("if i < length {")
 			p.g.P("    x = this.", goName, "[i]")
 			p.g.P("}")
 			p.g.P("if x == nil {")
 			p.g.P("    x = new(", valueType, ")")
 			p.g.P("}")
+		case isMap, isList:
+			p.g.P("    x = new(", valueType, ")")
 		case isOneOf:
 			p.g.P("    x = new(", valueType, ")")
 		default:
@@ -575,32 +951,16 @@ func (p *plugin) unmarshalDecodeValue(field *protogen.Field) {
 			p.g.P("}")
 		}
 
-		p.g.P("    if um, ok := interface{}(x).(", jsonPackage.Ident("Unmarshaler"), "); ok {")
-		p.g.P("        err = um.UnmarshalJSON(value)")
-		p.g.P("    } else {")
-		p.g.P("        err = ", jsonPackage.Ident("Unmarshal"), "(value, x)")
-		p.g.P("    }")
-		p.g.P("    if err != nil {")
-		p.g.P("        return err")
-		p.g.P("    }")
+		p.unmarshalNestedMessage(field, !isMap && !isList)
 		p.g.P("}")
 		storeValue()
 	case protoreflect.EnumKind:
 		valueType := p.g.QualifiedGoIdent(field.Enum.GoIdent)
 
-		if *options.UseEnumString {
-			p.g.P("s, ok := ", decoderPackage.Ident("UnquoteString"), "(value)")
-			checkOk()
-			p.g.P("x1, ok := ", valueType, "_value[s]")
-		} else {
-			p.g.P("x1, err := ", decoderPackage.Ident("ParseInt32"), "(value)")
-			checkError()
-			p.g.P("_, ok := ", valueType, "_name[x1]")
+		returnUnknown := func() {
+			p.g.P("return ", fmtPackage.Ident("Errorf"), `("json: unknown enum value %s in field %s", string(value), objKey)`)
 		}
-
-		p.g.P("if !ok {")
-		p.g.P("    return ", fmtPackage.Ident("Errorf"), `("json: unknown enum value %s in field %s", string(value), objKey)`)
-		p.g.P("}")
+		p.unmarshalEnum(field.Enum, p.msgOptions, *options.UseEnumString, returnError, returnUnknown)
 
 		p.g.P("x := ", valueType, "(x1)")
 		storeValue()
@@ -1,9 +1,38 @@
 package gojson
 
+import (
+	"strconv"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+)
+
 func (p *plugin) genVariableOneofIsStore(oneofName string) string {
 	return "oneof" + oneofName + "isStore"
 }
 
+func (p *plugin) genVariableRequiredIsStore(goName string) string {
+	return "required" + goName + "isStore"
+}
+
+// unmarshalMode returns the mode of handling the existing elements of repeated and map fields.
+func (p *plugin) unmarshalMode() pbjson.UnmarshalMode {
+	return *p.msgOptions.UnmarshalMode
+}
+
+// unmarshalMatchKey generates code to match the object key with case-insensitive if necessary.
+func (p *plugin) unmarshalMatchKey(keyVariable string, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+	p.g.P("if opts.CaseInsensitiveKeys {")
+	p.g.P("    ", keyVariable, " = ", decoderPackage.Ident("MatchKey"), "(", keyVariable, ", []string{")
+	for _, key := range keys {
+		p.g.P(strconv.Quote(key), ",")
+	}
+	p.g.P("    })")
+	p.g.P("}")
+}
+
 func (p *plugin) unmarshalObjectBeforeReadKey(loopLabel string) {
 	p.g.P("if decoder.ObjectBeforeReadKey() { // before read object key")
 	p.g.P("    break ", loopLabel)
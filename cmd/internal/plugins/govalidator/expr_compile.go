@@ -0,0 +1,710 @@
+package govalidator
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type exprKind int
+
+const (
+	exprKindBool exprKind = iota
+	exprKindInt
+	exprKindUint
+	exprKindDouble
+	exprKindString
+	exprKindBytes
+	exprKindMessage
+	exprKindList
+	exprKindMap
+)
+
+// exprType is the type of value in expression. The int, uint and double are
+// represented as int64, uint64 and float64 in the generated code.
+type exprType struct {
+	kind    exprKind
+	message *protogen.Message // for message.
+
+	elem     *exprType // the element of list or the value of map.
+	elemConv string    // the conversion from the go type of element to the represented type, e.g. "int64".
+	key      *exprType // the key of map.
+	keyConv  string    // the conversion from the go type of key to the represented type.
+}
+
+var (
+	exprTypeBool   = &exprType{kind: exprKindBool}
+	exprTypeInt    = &exprType{kind: exprKindInt}
+	exprTypeUint   = &exprType{kind: exprKindUint}
+	exprTypeDouble = &exprType{kind: exprKindDouble}
+	exprTypeString = &exprType{kind: exprKindString}
+	exprTypeBytes  = &exprType{kind: exprKindBytes}
+)
+
+func (t *exprType) String() string {
+	switch t.kind {
+	case exprKindBool:
+		return "bool"
+	case exprKindInt:
+		return "int"
+	case exprKindUint:
+		return "uint"
+	case exprKindDouble:
+		return "double"
+	case exprKindString:
+		return "string"
+	case exprKindBytes:
+		return "bytes"
+	case exprKindMessage:
+		return string(t.message.Desc.FullName())
+	case exprKindList:
+		return "list(" + t.elem.String() + ")"
+	case exprKindMap:
+		return "map(" + t.key.String() + ", " + t.elem.String() + ")"
+	}
+	return "unknown"
+}
+
+func (t *exprType) isNumber() bool {
+	return t.kind == exprKindInt || t.kind == exprKindUint || t.kind == exprKindDouble
+}
+
+// isOrdered reports whether the values of type can be compared by "<", "<=", ">" and ">=".
+func (t *exprType) isOrdered() bool {
+	switch t.kind {
+	case exprKindInt, exprKindUint, exprKindDouble, exprKindString, exprKindBytes:
+		return true
+	case exprKindMessage:
+		return t.isTimeMessage()
+	}
+	return false
+}
+
+func (t *exprType) isTimeMessage() bool {
+	if t.kind != exprKindMessage {
+		return false
+	}
+	name := string(t.message.Desc.FullName())
+	return name == timestampFullName || name == durationFullName
+}
+
+func (t *exprType) equal(o *exprType) bool {
+	if t.kind != o.kind {
+		return false
+	}
+	switch t.kind {
+	case exprKindMessage:
+		return t.message.Desc.FullName() == o.message.Desc.FullName()
+	case exprKindList:
+		return t.elem.equal(o.elem)
+	case exprKindMap:
+		return t.key.equal(o.key) && t.elem.equal(o.elem)
+	}
+	return true
+}
+
+// exprValue is the result of type checking of node.
+type exprValue struct {
+	typ  *exprType
+	code string // the go code of value.
+
+	// untyped reports whether the value is made of the int or double literals without suffix,
+	// it can be used as the value of uint or double like the untyped constant in go.
+	untyped  bool
+	negative bool // the untyped value is a negative constant.
+	literal  bool // the value is a literal, or a literal with unary minus.
+	zero     bool // the literal is a zero number.
+}
+
+// exprVariable is the variable declared by the macros "all" and "exists".
+type exprVariable struct {
+	name string // the name in go code.
+	typ  *exprType
+	conv string
+	used bool
+}
+
+// exprCompiler checks the expression against the message and compiles it to go code.
+type exprCompiler struct {
+	p *plugin
+
+	vars    map[string]*exprVariable
+	regexps []exprRegexp
+}
+
+// exprRegexp is a package level variable of the compiled regexp used by method "matches".
+type exprRegexp struct {
+	name    string
+	pattern string
+}
+
+func newExprCompiler(p *plugin) *exprCompiler {
+	return &exprCompiler{p: p, vars: make(map[string]*exprVariable)}
+}
+
+// compile parses and checks the expression, it returns the go code of boolean condition.
+func (c *exprCompiler) compile(s string) (string, error) {
+	node, err := parseExpr(s)
+	if err != nil {
+		return "", err
+	}
+	v, err := c.check(node)
+	if err != nil {
+		return "", err
+	}
+	if v.typ.kind != exprKindBool {
+		return "", newExprError(node.Pos(), "the expression must be bool, found %s", v.typ)
+	}
+	return v.code, nil
+}
+
+func (c *exprCompiler) ident(ident protogen.GoIdent) string {
+	return c.p.g.QualifiedGoIdent(ident)
+}
+
+func (c *exprCompiler) check(node exprNode) (*exprValue, error) {
+	switch x := node.(type) {
+	case *exprIdent:
+		return c.checkIdent(x)
+	case *exprLiteral:
+		return c.checkLiteral(x)
+	case *exprSelect:
+		return c.checkSelect(x)
+	case *exprCall:
+		if x.target == nil {
+			return c.checkFunction(x)
+		}
+		return c.checkMethod(x)
+	case *exprUnary:
+		return c.checkUnary(x)
+	case *exprBinary:
+		return c.checkBinary(x)
+	case *exprList:
+		return nil, newExprError(x.pos, "the list literal can only be used on the right of \"in\"")
+	}
+	return nil, newExprError(node.Pos(), "unsupported expression")
+}
+
+func (c *exprCompiler) checkIdent(x *exprIdent) (*exprValue, error) {
+	if x.name == "this" {
+		return &exprValue{typ: &exprType{kind: exprKindMessage, message: c.p.message}, code: "this"}, nil
+	}
+	variable, ok := c.vars[x.name]
+	if !ok {
+		return nil, newExprError(x.pos, "undefined identifier %q, the fields must be referenced by \"this.<field>\"", x.name)
+	}
+	variable.used = true
+	code := variable.name
+	if variable.conv != "" {
+		code = variable.conv + "(" + code + ")"
+	}
+	return &exprValue{typ: variable.typ, code: code}, nil
+}
+
+func (c *exprCompiler) checkLiteral(x *exprLiteral) (*exprValue, error) {
+	switch x.kind {
+	case exprTokenIdent:
+		return &exprValue{typ: exprTypeBool, code: x.value, literal: true}, nil
+	case exprTokenString:
+		return &exprValue{typ: exprTypeString, code: strconv.Quote(x.value), literal: true}, nil
+	case exprTokenInt:
+		n, _ := strconv.ParseInt(x.value, 0, 64)
+		return &exprValue{typ: exprTypeInt, code: x.value, untyped: true, literal: true, zero: n == 0}, nil
+	case exprTokenUint:
+		text := x.value[:len(x.value)-1]
+		n, _ := strconv.ParseUint(text, 0, 64)
+		return &exprValue{typ: exprTypeUint, code: text, literal: true, zero: n == 0}, nil
+	case exprTokenDouble:
+		f, _ := strconv.ParseFloat(x.value, 64)
+		return &exprValue{typ: exprTypeDouble, code: x.value, untyped: true, literal: true, zero: f == 0}, nil
+	}
+	return nil, newExprError(x.pos, "unsupported literal")
+}
+
+// lookupField returns the field of message by its name in proto.
+func (c *exprCompiler) lookupField(operand *exprValue, name string, pos int) (*protogen.Field, error) {
+	if operand.typ.kind != exprKindMessage {
+		return nil, newExprError(pos, "cannot select field %q of type %s", name, operand.typ)
+	}
+	for _, field := range operand.typ.message.Fields {
+		if string(field.Desc.Name()) == name {
+			return field, nil
+		}
+	}
+	return nil, newExprError(pos, "undefined field %q in message %s", name, operand.typ)
+}
+
+func (c *exprCompiler) checkSelect(x *exprSelect) (*exprValue, error) {
+	operand, err := c.check(x.operand)
+	if err != nil {
+		return nil, err
+	}
+	field, err := c.lookupField(operand, x.field, x.pos)
+	if err != nil {
+		return nil, err
+	}
+
+	code := operand.code + ".Get" + field.GoName + "()"
+	switch {
+	case field.Desc.IsMap():
+		key, keyConv := c.scalarType(field.Message.Fields[0])
+		elem, elemConv := c.scalarType(field.Message.Fields[1])
+		typ := &exprType{kind: exprKindMap, key: key, keyConv: keyConv, elem: elem, elemConv: elemConv}
+		return &exprValue{typ: typ, code: code}, nil
+	case field.Desc.IsList():
+		elem, elemConv := c.scalarType(field)
+		typ := &exprType{kind: exprKindList, elem: elem, elemConv: elemConv}
+		return &exprValue{typ: typ, code: code}, nil
+	}
+	typ, conv := c.scalarType(field)
+	if conv != "" {
+		code = conv + "(" + code + ")"
+	}
+	return &exprValue{typ: typ, code: code}, nil
+}
+
+// scalarType returns the type of a single value of field and the conversion from its go type.
+func (c *exprCompiler) scalarType(field *protogen.Field) (*exprType, string) {
+	switch field.Desc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.EnumKind:
+		return exprTypeInt, "int64"
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return exprTypeInt, ""
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return exprTypeUint, "uint64"
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return exprTypeUint, ""
+	case protoreflect.FloatKind:
+		return exprTypeDouble, "float64"
+	case protoreflect.DoubleKind:
+		return exprTypeDouble, ""
+	case protoreflect.StringKind:
+		return exprTypeString, ""
+	case protoreflect.BytesKind:
+		return exprTypeBytes, ""
+	case protoreflect.BoolKind:
+		return exprTypeBool, ""
+	default:
+		return &exprType{kind: exprKindMessage, message: field.Message}, ""
+	}
+}
+
+func (c *exprCompiler) checkArgs(x *exprCall, n int) ([]*exprValue, error) {
+	if len(x.args) != n {
+		return nil, newExprError(x.pos, "%s() expects %d argument(s), found %d", x.name, n, len(x.args))
+	}
+	values := make([]*exprValue, n)
+	for i, arg := range x.args {
+		v, err := c.check(arg)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func (c *exprCompiler) checkFunction(x *exprCall) (*exprValue, error) {
+	switch x.name {
+	case "has":
+		if len(x.args) != 1 {
+			return nil, newExprError(x.pos, "has() expects 1 argument(s), found %d", len(x.args))
+		}
+		return c.checkHas(x.args[0])
+	case "size":
+		args, err := c.checkArgs(x, 1)
+		if err != nil {
+			return nil, err
+		}
+		return c.checkSize(x, args[0])
+	case "int", "uint", "double":
+		args, err := c.checkArgs(x, 1)
+		if err != nil {
+			return nil, err
+		}
+		arg := args[0]
+		if !arg.typ.isNumber() {
+			return nil, newExprError(x.pos, "%s() expects a number, found %s", x.name, arg.typ)
+		}
+		if arg.untyped && arg.typ.kind == exprKindDouble && x.name != "double" {
+			return nil, newExprError(x.pos, "cannot convert the constant %s to %s", arg.code, x.name)
+		}
+		code := arg.code
+		switch x.name {
+		case "int":
+			return &exprValue{typ: exprTypeInt, code: "int64(" + code + ")"}, nil
+		case "uint":
+			if arg.untyped && arg.negative {
+				return nil, newExprError(x.pos, "the constant %s overflows uint", arg.code)
+			}
+			return &exprValue{typ: exprTypeUint, code: "uint64(" + code + ")"}, nil
+		default:
+			return &exprValue{typ: exprTypeDouble, code: "float64(" + code + ")"}, nil
+		}
+	}
+	return nil, newExprError(x.pos, "undefined function %q", x.name)
+}
+
+func (c *exprCompiler) checkSize(x *exprCall, arg *exprValue) (*exprValue, error) {
+	switch arg.typ.kind {
+	case exprKindString:
+		code := "int64(" + c.ident(utf8Package.Ident("RuneCountInString")) + "(" + arg.code + "))"
+		return &exprValue{typ: exprTypeInt, code: code}, nil
+	case exprKindBytes, exprKindList, exprKindMap:
+		return &exprValue{typ: exprTypeInt, code: "int64(len(" + arg.code + "))"}, nil
+	}
+	return nil, newExprError(x.pos, "size() expects string, bytes, list or map, found %s", arg.typ)
+}
+
+// checkHas checks the macro "has" that reports whether the field is set. The message and
+// optional fields are set if they are not null, the field in oneof is set if it is chosen,
+// the other fields are set if they are not the zero value.
+func (c *exprCompiler) checkHas(arg exprNode) (*exprValue, error) {
+	sel, ok := arg.(*exprSelect)
+	if !ok {
+		return nil, newExprError(arg.Pos(), "has() expects a field selection, e.g. has(this.name)")
+	}
+	operand, err := c.check(sel.operand)
+	if err != nil {
+		return nil, err
+	}
+	field, err := c.lookupField(operand, sel.field, sel.pos)
+	if err != nil {
+		return nil, err
+	}
+
+	getter := operand.code + ".Get" + field.GoName + "()"
+	var code string
+	switch {
+	case field.Desc.IsList(), field.Desc.IsMap():
+		code = "len(" + getter + ") != 0"
+	case utils.FieldIsOneOf(field):
+		code = fmt.Sprintf("func() bool { _, ok := %s.Get%s().(*%s); return ok }()",
+			operand.code, field.Oneof.GoName, c.ident(field.GoIdent))
+	case field.Desc.Kind() == protoreflect.MessageKind || field.Desc.Kind() == protoreflect.GroupKind:
+		code = getter + " != nil"
+	case utils.FieldIsPointer(field):
+		code = operand.code + "." + field.GoName + " != nil"
+		if operand.code != "this" {
+			code = operand.code + " != nil && " + code
+		}
+	case field.Desc.Kind() == protoreflect.BoolKind:
+		code = getter
+	case field.Desc.Kind() == protoreflect.StringKind || field.Desc.Kind() == protoreflect.BytesKind:
+		code = "len(" + getter + ") != 0"
+	default:
+		code = getter + " != 0"
+	}
+	return &exprValue{typ: exprTypeBool, code: "(" + code + ")"}, nil
+}
+
+func (c *exprCompiler) checkMethod(x *exprCall) (*exprValue, error) {
+	switch x.name {
+	case "all", "exists":
+		return c.checkMacro(x)
+	}
+
+	target, err := c.check(x.target)
+	if err != nil {
+		return nil, err
+	}
+	switch x.name {
+	case "size":
+		if _, err := c.checkArgs(x, 0); err != nil {
+			return nil, err
+		}
+		return c.checkSize(x, target)
+	case "startsWith", "endsWith", "contains", "matches":
+		args, err := c.checkArgs(x, 1)
+		if err != nil {
+			return nil, err
+		}
+		arg := args[0]
+		if target.typ.kind != exprKindString || arg.typ.kind != exprKindString {
+			return nil, newExprError(x.pos, "%s() expects string receiver and argument, found %s and %s", x.name, target.typ, arg.typ)
+		}
+		var fn protogen.GoIdent
+		switch x.name {
+		case "startsWith":
+			fn = stringsPackage.Ident("HasPrefix")
+		case "endsWith":
+			fn = stringsPackage.Ident("HasSuffix")
+		case "contains":
+			fn = stringsPackage.Ident("Contains")
+		default:
+			return c.checkMatches(x, target)
+		}
+		return &exprValue{typ: exprTypeBool, code: c.ident(fn) + "(" + target.code + ", " + arg.code + ")"}, nil
+	}
+	return nil, newExprError(x.pos, "undefined method %q of type %s", x.name, target.typ)
+}
+
+// checkMatches checks the method "matches", the pattern must be a string literal.
+func (c *exprCompiler) checkMatches(x *exprCall, target *exprValue) (*exprValue, error) {
+	lit, ok := x.args[0].(*exprLiteral)
+	if !ok || lit.kind != exprTokenString {
+		return nil, newExprError(x.args[0].Pos(), "matches() expects a string literal of pattern")
+	}
+	if _, err := regexp.Compile(lit.value); err != nil {
+		return nil, newExprError(lit.pos, "cannot compile regex: %v", err)
+	}
+	name := prefix + c.p.message.GoIdent.GoName + "_Expr_Regex_" + strconv.Itoa(len(c.regexps))
+	c.regexps = append(c.regexps, exprRegexp{name: name, pattern: lit.value})
+	return &exprValue{typ: exprTypeBool, code: name + ".MatchString(" + target.code + ")"}, nil
+}
+
+// checkMacro checks the macros "all" and "exists", e.g. "this.items.all(x, x > 0)".
+// The variable is bound to each element of list or each key of map.
+func (c *exprCompiler) checkMacro(x *exprCall) (*exprValue, error) {
+	if len(x.args) != 2 {
+		return nil, newExprError(x.pos, "%s() expects 2 argument(s), found %d", x.name, len(x.args))
+	}
+	ident, ok := x.args[0].(*exprIdent)
+	if !ok || ident.name == "this" || ident.name == "_" {
+		return nil, newExprError(x.args[0].Pos(), "%s() expects a variable name as the first argument", x.name)
+	}
+	target, err := c.check(x.target)
+	if err != nil {
+		return nil, err
+	}
+
+	variable := &exprVariable{name: "v_" + ident.name}
+	switch target.typ.kind {
+	case exprKindList:
+		variable.typ, variable.conv = target.typ.elem, target.typ.elemConv
+	case exprKindMap:
+		variable.typ, variable.conv = target.typ.key, target.typ.keyConv
+	default:
+		return nil, newExprError(x.pos, "%s() expects list or map, found %s", x.name, target.typ)
+	}
+
+	shadowed := c.vars[ident.name]
+	c.vars[ident.name] = variable
+	pred, err := c.check(x.args[1])
+	if shadowed != nil {
+		c.vars[ident.name] = shadowed
+	} else {
+		delete(c.vars, ident.name)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if pred.typ.kind != exprKindBool {
+		return nil, newExprError(x.args[1].Pos(), "%s() expects a bool predicate, found %s", x.name, pred.typ)
+	}
+
+	// The variable cannot be declared with "_" only, so the unused one is omitted from the loop.
+	var loop string
+	switch {
+	case !variable.used:
+		loop = "for range " + target.code
+	case target.typ.kind == exprKindList:
+		loop = "for _, " + variable.name + " := range " + target.code
+	default:
+		loop = "for " + variable.name + " := range " + target.code
+	}
+	var code string
+	if x.name == "all" {
+		code = fmt.Sprintf("func() bool { %s { if !(%s) { return false } }; return true }()", loop, pred.code)
+	} else {
+		code = fmt.Sprintf("func() bool { %s { if %s { return true } }; return false }()", loop, pred.code)
+	}
+	return &exprValue{typ: exprTypeBool, code: code}, nil
+}
+
+func (c *exprCompiler) checkUnary(x *exprUnary) (*exprValue, error) {
+	operand, err := c.check(x.operand)
+	if err != nil {
+		return nil, err
+	}
+	code := operand.code
+	if strings.HasPrefix(code, "-") {
+		code = "(" + code + ")"
+	}
+	switch x.op {
+	case "!":
+		if operand.typ.kind != exprKindBool {
+			return nil, newExprError(x.pos, "operator ! expects bool, found %s", operand.typ)
+		}
+		return &exprValue{typ: exprTypeBool, code: "!" + code}, nil
+	default:
+		if operand.typ.kind != exprKindInt && operand.typ.kind != exprKindDouble {
+			return nil, newExprError(x.pos, "operator - expects int or double, found %s", operand.typ)
+		}
+		return &exprValue{
+			typ:      operand.typ,
+			code:     "-" + code,
+			untyped:  operand.untyped,
+			negative: operand.untyped && !operand.negative,
+			literal:  operand.literal,
+			zero:     operand.zero,
+		}, nil
+	}
+}
+
+// unify returns the common type of two values for the binary operation.
+func (c *exprCompiler) unify(pos int, op string, left, right *exprValue) (*exprType, error) {
+	if left.typ.equal(right.typ) {
+		return left.typ, nil
+	}
+	for _, x := range []struct{ constant, other *exprValue }{{left, right}, {right, left}} {
+		if !x.constant.untyped || !x.other.typ.isNumber() {
+			continue
+		}
+		switch {
+		case x.constant.typ.kind == exprKindInt && x.other.typ.kind == exprKindUint:
+			if x.constant.negative {
+				return nil, newExprError(pos, "the constant %s overflows uint", x.constant.code)
+			}
+			return x.other.typ, nil
+		case x.constant.typ.kind == exprKindInt && x.other.typ.kind == exprKindDouble:
+			return x.other.typ, nil
+		}
+	}
+	return nil, newExprError(pos, "operator %s: mismatched types %s and %s", op, left.typ, right.typ)
+}
+
+func (c *exprCompiler) checkBinary(x *exprBinary) (*exprValue, error) {
+	left, err := c.check(x.left)
+	if err != nil {
+		return nil, err
+	}
+	if x.op == "in" {
+		return c.checkIn(x, left)
+	}
+	right, err := c.check(x.right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x.op {
+	case "&&", "||":
+		if left.typ.kind != exprKindBool || right.typ.kind != exprKindBool {
+			return nil, newExprError(x.pos, "operator %s expects bool, found %s and %s", x.op, left.typ, right.typ)
+		}
+		return &exprValue{typ: exprTypeBool, code: "(" + left.code + " " + x.op + " " + right.code + ")"}, nil
+	}
+
+	typ, err := c.unify(x.pos, x.op, left, right)
+	if err != nil {
+		return nil, err
+	}
+
+	switch x.op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		switch {
+		case (x.op == "==" || x.op == "!=") && (typ.kind == exprKindBool || typ.isNumber() || typ.kind == exprKindString):
+		case typ.isOrdered():
+		default:
+			return nil, newExprError(x.pos, "operator %s is not defined on %s", x.op, typ)
+		}
+		return &exprValue{typ: exprTypeBool, code: "(" + c.compareCode(typ, left.code, x.op, right.code) + ")"}, nil
+	case "+":
+		if !typ.isNumber() && typ.kind != exprKindString {
+			return nil, newExprError(x.pos, "operator + is not defined on %s", typ)
+		}
+	case "-", "*":
+		if !typ.isNumber() {
+			return nil, newExprError(x.pos, "operator %s is not defined on %s", x.op, typ)
+		}
+	case "/", "%":
+		if !typ.isNumber() || (x.op == "%" && typ.kind == exprKindDouble) {
+			return nil, newExprError(x.pos, "operator %s is not defined on %s", x.op, typ)
+		}
+		if typ.kind != exprKindDouble && (!right.literal || right.zero) {
+			// The integer division by zero panics in go, so only the non-zero constant divisor is allowed.
+			return nil, newExprError(x.pos, "operator %s on %s expects a non-zero constant divisor", x.op, typ)
+		}
+	}
+	return &exprValue{
+		typ:     typ,
+		code:    "(" + left.code + " " + x.op + " " + right.code + ")",
+		untyped: left.untyped && right.untyped,
+	}, nil
+}
+
+// compareCode returns the go code that compares two values of type.
+func (c *exprCompiler) compareCode(typ *exprType, left string, op string, right string) string {
+	switch typ.kind {
+	case exprKindBytes:
+		if op == "==" || op == "!=" {
+			code := c.ident(bytesPackage.Ident("Equal")) + "(" + left + ", " + right + ")"
+			if op == "!=" {
+				code = "!" + code
+			}
+			return code
+		}
+		return c.ident(bytesPackage.Ident("Compare")) + "(" + left + ", " + right + ") " + op + " 0"
+	case exprKindMessage:
+		fn := "CompareTimestamp"
+		if string(typ.message.Desc.FullName()) == durationFullName {
+			fn = "CompareDuration"
+		}
+		// The null one is the zero value, so the comparisons are consistent under the operator !.
+		return c.ident(validatorPackage.Ident(fn)) + "(" + left + ", " + right + ") " + op + " 0"
+	}
+	return left + " " + op + " " + right
+}
+
+// checkIn checks the operator "in", the right must be a list literal, list or map.
+func (c *exprCompiler) checkIn(x *exprBinary, left *exprValue) (*exprValue, error) {
+	if list, ok := x.right.(*exprList); ok {
+		if len(list.elems) == 0 {
+			return &exprValue{typ: exprTypeBool, code: "false"}, nil
+		}
+		conds := make([]string, 0, len(list.elems))
+		for _, elem := range list.elems {
+			v, err := c.check(elem)
+			if err != nil {
+				return nil, err
+			}
+			typ, err := c.unify(elem.Pos(), x.op, left, v)
+			if err != nil {
+				return nil, err
+			}
+			if !typ.isOrdered() && typ.kind != exprKindBool {
+				return nil, newExprError(x.pos, "operator in is not defined on %s", typ)
+			}
+			conds = append(conds, c.compareCode(typ, left.code, "==", v.code))
+		}
+		return &exprValue{typ: exprTypeBool, code: "(" + strings.Join(conds, " || ") + ")"}, nil
+	}
+
+	right, err := c.check(x.right)
+	if err != nil {
+		return nil, err
+	}
+	var elem *exprType
+	var conv, loop string
+	switch right.typ.kind {
+	case exprKindList:
+		elem, conv = right.typ.elem, right.typ.elemConv
+		loop = "for _, v := range " + right.code
+	case exprKindMap:
+		elem, conv = right.typ.key, right.typ.keyConv
+		loop = "for v := range " + right.code
+	default:
+		return nil, newExprError(x.pos, "operator in expects list or map on the right, found %s", right.typ)
+	}
+	item := &exprValue{typ: elem, code: "v"}
+	if conv != "" {
+		item.code = conv + "(v)"
+	}
+	typ, err := c.unify(x.pos, x.op, left, item)
+	if err != nil {
+		return nil, err
+	}
+	if !typ.isOrdered() && typ.kind != exprKindBool {
+		return nil, newExprError(x.pos, "operator in is not defined on %s", typ)
+	}
+	if right.typ.kind == exprKindMap && conv == "" && !left.untyped {
+		return &exprValue{typ: exprTypeBool, code: "func() bool { _, ok := " + right.code + "[" + left.code + "]; return ok }()"}, nil
+	}
+	code := "func() bool { " + loop + " { if " + c.compareCode(typ, item.code, "==", left.code) + " { return true } }; return false }()"
+	return &exprValue{typ: exprTypeBool, code: code}, nil
+}
@@ -0,0 +1,538 @@
+package govalidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The grammar of option <expr>, a small subset of CEL:
+//
+//	Expr    = Or .
+//	Or      = And { "||" And } .
+//	And     = Rel { "&&" Rel } .
+//	Rel     = Add [ ( "==" | "!=" | "<" | "<=" | ">" | ">=" | "in" ) Add ] .
+//	Add     = Mul { ( "+" | "-" ) Mul } .
+//	Mul     = Unary { ( "*" | "/" | "%" ) Unary } .
+//	Unary   = ( "!" | "-" ) Unary | Member .
+//	Member  = Primary { "." IDENT [ "(" [ Args ] ")" ] } .
+//	Primary = "this" | IDENT | IDENT "(" [ Args ] ")" | "(" Expr ")" | "[" [ Args ] "]" | Literal .
+//	Args    = Expr { "," Expr } .
+//	Literal = INT | UINT | DOUBLE | STRING | "true" | "false" .
+
+type exprTokenKind int
+
+const (
+	exprTokenEOF exprTokenKind = iota
+	exprTokenIdent
+	exprTokenInt
+	exprTokenUint
+	exprTokenDouble
+	exprTokenString
+	exprTokenOperator
+)
+
+type exprToken struct {
+	kind exprTokenKind
+	pos  int    // the offset in bytes of the token in expression.
+	text string // the source text of token, the unquoted value for string.
+}
+
+// exprError is the syntax or type error of expression.
+type exprError struct {
+	pos int
+	msg string
+}
+
+func (e *exprError) Error() string {
+	return fmt.Sprintf("%s at column %d", e.msg, e.pos+1)
+}
+
+func newExprError(pos int, format string, a ...interface{}) *exprError {
+	return &exprError{pos: pos, msg: fmt.Sprintf(format, a...)}
+}
+
+// exprOperators is the operators and punctuations, the longer one must be first.
+var exprOperators = []string{
+	"&&", "||", "==", "!=", "<=", ">=",
+	"<", ">", "!", "+", "-", "*", "/", "%", "(", ")", "[", "]", ".", ",",
+}
+
+// lexExpr splits the expression into tokens, the last token is always exprTokenEOF.
+func lexExpr(s string) ([]exprToken, error) {
+	var tokens []exprToken
+	i := 0
+LOOP:
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '_' || isLetter(c):
+			j := i + 1
+			for j < len(s) && (s[j] == '_' || isLetter(s[j]) || isDigit(s[j])) {
+				j++
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenIdent, pos: i, text: s[i:j]})
+			i = j
+		case isDigit(c):
+			token, err := lexNumber(s, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token)
+			i += len(token.text)
+		case c == '"' || c == '\'':
+			value, n, err := lexString(s, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, exprToken{kind: exprTokenString, pos: i, text: value})
+			i += n
+		default:
+			for _, op := range exprOperators {
+				if strings.HasPrefix(s[i:], op) {
+					tokens = append(tokens, exprToken{kind: exprTokenOperator, pos: i, text: op})
+					i += len(op)
+					continue LOOP
+				}
+			}
+			r, _ := utf8.DecodeRuneInString(s[i:])
+			return nil, newExprError(i, "unexpected character %q", r)
+		}
+	}
+	tokens = append(tokens, exprToken{kind: exprTokenEOF, pos: len(s)})
+	return tokens, nil
+}
+
+func lexNumber(s string, start int) (exprToken, error) {
+	i := start
+	kind := exprTokenInt
+	if strings.HasPrefix(s[i:], "0x") || strings.HasPrefix(s[i:], "0X") {
+		i += 2
+		for i < len(s) && isHexDigit(s[i]) {
+			i++
+		}
+	} else {
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+		if i < len(s) && s[i] == '.' && i+1 < len(s) && isDigit(s[i+1]) {
+			kind = exprTokenDouble
+			i++
+			for i < len(s) && isDigit(s[i]) {
+				i++
+			}
+		}
+		if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+			j := i + 1
+			if j < len(s) && (s[j] == '+' || s[j] == '-') {
+				j++
+			}
+			if j < len(s) && isDigit(s[j]) {
+				kind = exprTokenDouble
+				i = j
+				for i < len(s) && isDigit(s[i]) {
+					i++
+				}
+			}
+		}
+	}
+	if kind == exprTokenInt && i < len(s) && (s[i] == 'u' || s[i] == 'U') {
+		kind = exprTokenUint
+		i++
+	}
+	if i < len(s) && (s[i] == '_' || isLetter(s[i]) || isDigit(s[i])) {
+		return exprToken{}, newExprError(start, "invalid number %q", s[start:i+1])
+	}
+
+	text := s[start:i]
+	var err error
+	switch kind {
+	case exprTokenInt:
+		_, err = strconv.ParseInt(text, 0, 64)
+	case exprTokenUint:
+		_, err = strconv.ParseUint(text[:len(text)-1], 0, 64)
+	case exprTokenDouble:
+		_, err = strconv.ParseFloat(text, 64)
+	}
+	if err != nil {
+		return exprToken{}, newExprError(start, "invalid number %q", text)
+	}
+	return exprToken{kind: kind, pos: start, text: text}, nil
+}
+
+// lexString returns the unquoted value of string that starts at s[start] and the length of source text.
+func lexString(s string, start int) (string, int, error) {
+	quote := s[start]
+	var b strings.Builder
+	i := start + 1
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case c == quote:
+			return b.String(), i + 1 - start, nil
+		case c == '\n':
+			return "", 0, newExprError(start, "unterminated string")
+		case c == '\\':
+			if i+1 >= len(s) {
+				return "", 0, newExprError(start, "unterminated string")
+			}
+			switch e := s[i+1]; e {
+			case '\\', '"', '\'':
+				b.WriteByte(e)
+			case 'n':
+				b.WriteByte('\n')
+			case 'r':
+				b.WriteByte('\r')
+			case 't':
+				b.WriteByte('\t')
+			default:
+				return "", 0, newExprError(i, "unknown escape sequence \\%c", e)
+			}
+			i += 2
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+	return "", 0, newExprError(start, "unterminated string")
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+func isDigit(c byte) bool  { return c >= '0' && c <= '9' }
+func isHexDigit(c byte) bool {
+	return isDigit(c) || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// exprNode is the node of the syntax tree of expression.
+type exprNode interface {
+	// Pos returns the offset in bytes of the node in expression.
+	Pos() int
+	// String returns the expression in fully parenthesized form, it's used by tests.
+	String() string
+}
+
+// exprIdent is an identifier, e.g. "this" or the variable of macros.
+type exprIdent struct {
+	pos  int
+	name string
+}
+
+// exprLiteral is a literal of number, string or bool.
+type exprLiteral struct {
+	pos   int
+	kind  exprTokenKind // exprTokenIdent for bool.
+	value string        // the source text for number and bool, the unquoted value for string.
+}
+
+// exprSelect is the field selection, e.g. "this.name".
+type exprSelect struct {
+	pos     int
+	operand exprNode
+	field   string
+}
+
+// exprCall is the function call, e.g. "size(x)", or the method call if target is not nil, e.g. "x.startsWith(y)".
+type exprCall struct {
+	pos    int
+	target exprNode
+	name   string
+	args   []exprNode
+}
+
+// exprUnary is the unary operation, e.g. "!x" and "-x".
+type exprUnary struct {
+	pos     int
+	op      string
+	operand exprNode
+}
+
+// exprBinary is the binary operation, e.g. "x + y" and "x in y".
+type exprBinary struct {
+	pos   int
+	op    string
+	left  exprNode
+	right exprNode
+}
+
+// exprList is the list literal, e.g. "[1, 2, 3]".
+type exprList struct {
+	pos   int
+	elems []exprNode
+}
+
+func (x *exprIdent) Pos() int   { return x.pos }
+func (x *exprLiteral) Pos() int { return x.pos }
+func (x *exprSelect) Pos() int  { return x.pos }
+func (x *exprCall) Pos() int    { return x.pos }
+func (x *exprUnary) Pos() int   { return x.pos }
+func (x *exprBinary) Pos() int  { return x.pos }
+func (x *exprList) Pos() int    { return x.pos }
+
+func (x *exprIdent) String() string { return x.name }
+
+func (x *exprLiteral) String() string {
+	if x.kind == exprTokenString {
+		return strconv.Quote(x.value)
+	}
+	return x.value
+}
+
+func (x *exprSelect) String() string { return x.operand.String() + "." + x.field }
+
+func (x *exprCall) String() string {
+	s := x.name + "(" + joinExprNodes(x.args) + ")"
+	if x.target != nil {
+		s = x.target.String() + "." + s
+	}
+	return s
+}
+
+func (x *exprUnary) String() string { return "(" + x.op + x.operand.String() + ")" }
+func (x *exprBinary) String() string {
+	return "(" + x.left.String() + " " + x.op + " " + x.right.String() + ")"
+}
+func (x *exprList) String() string { return "[" + joinExprNodes(x.elems) + "]" }
+
+func joinExprNodes(nodes []exprNode) string {
+	ss := make([]string, len(nodes))
+	for i, node := range nodes {
+		ss[i] = node.String()
+	}
+	return strings.Join(ss, ", ")
+}
+
+// exprParser is a recursive descent parser of expression.
+type exprParser struct {
+	tokens []exprToken
+	offset int
+}
+
+// parseExpr parses the expression to the syntax tree.
+func parseExpr(s string) (exprNode, error) {
+	tokens, err := lexExpr(s)
+	if err != nil {
+		return nil, err
+	}
+	p := &exprParser{tokens: tokens}
+	node, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if token := p.peek(); token.kind != exprTokenEOF {
+		return nil, newExprError(token.pos, "unexpected %s", describeExprToken(token))
+	}
+	return node, nil
+}
+
+func (p *exprParser) peek() exprToken {
+	return p.tokens[p.offset]
+}
+
+func (p *exprParser) next() exprToken {
+	token := p.tokens[p.offset]
+	if token.kind != exprTokenEOF {
+		p.offset++
+	}
+	return token
+}
+
+// accept consumes the next token if it is one of the operators.
+func (p *exprParser) accept(ops ...string) (exprToken, bool) {
+	token := p.peek()
+	if token.kind != exprTokenOperator {
+		return token, false
+	}
+	for _, op := range ops {
+		if token.text == op {
+			return p.next(), true
+		}
+	}
+	return token, false
+}
+
+func (p *exprParser) expect(op string) error {
+	if token, ok := p.accept(op); !ok {
+		return newExprError(token.pos, "expected %q, found %s", op, describeExprToken(token))
+	}
+	return nil
+}
+
+// parseBinary parses the left-associative binary operations of ops.
+func (p *exprParser) parseBinary(operand func() (exprNode, error), ops ...string) (exprNode, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		token, ok := p.accept(ops...)
+		if !ok {
+			return left, nil
+		}
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = &exprBinary{pos: token.pos, op: token.text, left: left, right: right}
+	}
+}
+
+func (p *exprParser) parseOr() (exprNode, error) {
+	return p.parseBinary(p.parseAnd, "||")
+}
+
+func (p *exprParser) parseAnd() (exprNode, error) {
+	return p.parseBinary(p.parseRel, "&&")
+}
+
+func (p *exprParser) parseRel() (exprNode, error) {
+	left, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+	token, ok := p.accept("==", "!=", "<", "<=", ">", ">=")
+	if !ok && token.kind == exprTokenIdent && token.text == "in" {
+		token, ok = p.next(), true
+	}
+	if !ok {
+		return left, nil
+	}
+	right, err := p.parseAdd()
+	if err != nil {
+		return nil, err
+	}
+	node := &exprBinary{pos: token.pos, op: token.text, left: left, right: right}
+	// The relations are non-associative, e.g. "a < b < c" is invalid.
+	if next := p.peek(); (next.kind == exprTokenOperator && isExprRelation(next.text)) || (next.kind == exprTokenIdent && next.text == "in") {
+		return nil, newExprError(next.pos, "unexpected %s, the relations cannot be chained", describeExprToken(next))
+	}
+	return node, nil
+}
+
+func (p *exprParser) parseAdd() (exprNode, error) {
+	return p.parseBinary(p.parseMul, "+", "-")
+}
+
+func (p *exprParser) parseMul() (exprNode, error) {
+	return p.parseBinary(p.parseUnary, "*", "/", "%")
+}
+
+func (p *exprParser) parseUnary() (exprNode, error) {
+	if token, ok := p.accept("!", "-"); ok {
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &exprUnary{pos: token.pos, op: token.text, operand: operand}, nil
+	}
+	return p.parseMember()
+}
+
+func (p *exprParser) parseMember() (exprNode, error) {
+	node, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		if _, ok := p.accept("."); !ok {
+			return node, nil
+		}
+		token := p.next()
+		if token.kind != exprTokenIdent {
+			return nil, newExprError(token.pos, "expected field or method name, found %s", describeExprToken(token))
+		}
+		if _, ok := p.accept("("); ok {
+			args, err := p.parseArgs(")")
+			if err != nil {
+				return nil, err
+			}
+			node = &exprCall{pos: token.pos, target: node, name: token.text, args: args}
+		} else {
+			node = &exprSelect{pos: token.pos, operand: node, field: token.text}
+		}
+	}
+}
+
+func (p *exprParser) parsePrimary() (exprNode, error) {
+	token := p.next()
+	switch token.kind {
+	case exprTokenIdent:
+		switch token.text {
+		case "true", "false":
+			return &exprLiteral{pos: token.pos, kind: exprTokenIdent, value: token.text}, nil
+		case "in":
+			return nil, newExprError(token.pos, "unexpected %s", describeExprToken(token))
+		}
+		if _, ok := p.accept("("); ok {
+			args, err := p.parseArgs(")")
+			if err != nil {
+				return nil, err
+			}
+			return &exprCall{pos: token.pos, name: token.text, args: args}, nil
+		}
+		return &exprIdent{pos: token.pos, name: token.text}, nil
+	case exprTokenInt, exprTokenUint, exprTokenDouble, exprTokenString:
+		return &exprLiteral{pos: token.pos, kind: token.kind, value: token.text}, nil
+	case exprTokenOperator:
+		switch token.text {
+		case "(":
+			node, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return node, nil
+		case "[":
+			elems, err := p.parseArgs("]")
+			if err != nil {
+				return nil, err
+			}
+			return &exprList{pos: token.pos, elems: elems}, nil
+		}
+	}
+	return nil, newExprError(token.pos, "unexpected %s", describeExprToken(token))
+}
+
+// parseArgs parses the comma-separated expressions until the closing operator.
+func (p *exprParser) parseArgs(closing string) ([]exprNode, error) {
+	var args []exprNode
+	if _, ok := p.accept(closing); ok {
+		return args, nil
+	}
+	for {
+		arg, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if _, ok := p.accept(","); ok {
+			continue
+		}
+		if err := p.expect(closing); err != nil {
+			return nil, err
+		}
+		return args, nil
+	}
+}
+
+func isExprRelation(op string) bool {
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+	return false
+}
+
+func describeExprToken(token exprToken) string {
+	switch token.kind {
+	case exprTokenEOF:
+		return "end of expression"
+	case exprTokenString:
+		return "string " + strconv.Quote(token.text)
+	}
+	return strconv.Quote(token.text)
+}
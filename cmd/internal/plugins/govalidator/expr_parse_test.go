@@ -0,0 +1,98 @@
+package govalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_lexExpr(t *testing.T) {
+	tokens, err := lexExpr(`this.a_1 >= 0x1F && 'a\'b' != "c\n" || 10u < 1.5e3`)
+	require.Nil(t, err)
+
+	var kinds []exprTokenKind
+	var texts []string
+	for _, token := range tokens {
+		kinds = append(kinds, token.kind)
+		texts = append(texts, token.text)
+	}
+	require.Equal(t, []exprTokenKind{
+		exprTokenIdent, exprTokenOperator, exprTokenIdent, exprTokenOperator, exprTokenInt, exprTokenOperator,
+		exprTokenString, exprTokenOperator, exprTokenString, exprTokenOperator, exprTokenUint, exprTokenOperator,
+		exprTokenDouble, exprTokenEOF,
+	}, kinds)
+	require.Equal(t, []string{
+		"this", ".", "a_1", ">=", "0x1F", "&&", "a'b", "!=", "c\n", "||", "10u", "<", "1.5e3", "",
+	}, texts)
+	require.Equal(t, 9, tokens[3].pos)
+}
+
+func Test_parseExpr(t *testing.T) {
+	cases := []struct {
+		Expr   string
+		Expect string
+	}{
+		{"this.a", "this.a"},
+		{"this.a.b.c", "this.a.b.c"},
+		{"true", "true"},
+		{"-1", "(-1)"},
+		{"!!this.ok", "(!(!this.ok))"},
+		{"1 + 2 * 3", "(1 + (2 * 3))"},
+		{"(1 + 2) * 3", "((1 + 2) * 3)"},
+		{"1 - 2 - 3", "((1 - 2) - 3)"},
+		{"8 / 4 % 3", "((8 / 4) % 3)"},
+		{"a || b && c", "(a || (b && c))"},
+		{"a && b || c && d", "((a && b) || (c && d))"},
+		{"-this.a < 3 == true", ""},
+		{"this.a + 1 <= this.b - 1", "((this.a + 1) <= (this.b - 1))"},
+		{"!(this.a in [1, 2u, 3.0])", "(!(this.a in [1, 2u, 3.0]))"},
+		{"this.a in []", "(this.a in [])"},
+		{"size(this.items) <= this.max_items && this.name.startsWith(this.prefix)",
+			"((size(this.items) <= this.max_items) && this.name.startsWith(this.prefix))"},
+		{"this.items.all(x, x.price > 0)", "this.items.all(x, (x.price > 0))"},
+		{"this.s.size() == 0", "(this.s.size() == 0)"},
+		{`this.s == 'a"b'`, `(this.s == "a\"b")`},
+		{"has(this.a.b)", "has(this.a.b)"},
+		{"f()", "f()"},
+	}
+	for _, c := range cases {
+		node, err := parseExpr(c.Expr)
+		if c.Expect == "" {
+			require.NotNil(t, err, c.Expr)
+			continue
+		}
+		require.Nil(t, err, c.Expr)
+		require.Equal(t, c.Expect, node.String(), c.Expr)
+	}
+}
+
+func Test_parseExpr_Error(t *testing.T) {
+	cases := []struct {
+		Expr   string
+		Expect string
+	}{
+		{"", "unexpected end of expression at column 1"},
+		{"this.", "expected field or method name, found end of expression at column 6"},
+		{"this.a +", "unexpected end of expression at column 9"},
+		{"this.a this.b", `unexpected "this" at column 8`},
+		{"(this.a", `expected ")", found end of expression at column 8`},
+		{"f(1, 2", `expected ")", found end of expression at column 7`},
+		{"[1, 2", `expected "]", found end of expression at column 6`},
+		{"this.a # 1", `unexpected character '#' at column 8`},
+		{"this.a & 1", `unexpected character '&' at column 8`},
+		{"a < b < c", `unexpected "<", the relations cannot be chained at column 7`},
+		{"a == b in c", `unexpected "in", the relations cannot be chained at column 8`},
+		{"in", `unexpected "in" at column 1`},
+		{"'abc", "unterminated string at column 1"},
+		{`'a\qb'`, `unknown escape sequence \q at column 3`},
+		{"12ab", `invalid number "12a" at column 1`},
+		{"99999999999999999999", `invalid number "99999999999999999999" at column 1`},
+		{"1.5u", `invalid number "1.5u" at column 1`},
+		{"this.1", `expected field or method name, found "1" at column 6`},
+	}
+	for _, c := range cases {
+		_, err := parseExpr(c.Expr)
+		require.NotNil(t, err, c.Expr)
+		require.Equal(t, c.Expect, err.Error(), c.Expr)
+	}
+}
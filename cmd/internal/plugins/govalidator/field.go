@@ -18,11 +18,13 @@ type FieldInfo struct {
 	IsOneOf    bool
 	InOneOf    bool
 	TagOptions *pbvalidator.TagOptions
+	Options    *pbvalidator.ValidOptions // The options of field, it's nil for check_if.
 	IsCheckIf  bool
 	Parent     *FieldInfo
 	IsListItem bool
 	IsMapKey   bool
 	IsMapValue bool
+	Wrapper    *protogen.Field // The field of wrapper type if Field is the value of wrapper.
 }
 
 func (p *plugin) loadFieldList() {
@@ -78,6 +80,7 @@ func (p *plugin) loadFieldList() {
 			IsOneOf:    isOneOf,
 			InOneOf:    inOneOf,
 			TagOptions: validOptions.Tags,
+			Options:    validOptions,
 			IsCheckIf:  false,
 			Parent:     nil,
 			IsListItem: false,
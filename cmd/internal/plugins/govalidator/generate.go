@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
 
 	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
@@ -18,6 +19,7 @@ func (p *plugin) generateCode() {
 		p.generateCodeForField(fieldInfo)
 	}
 
+	p.generateMethodCheckMessage()
 	p.generateMethodValidate()
 }
 
@@ -39,6 +41,7 @@ func (p *plugin) generateCodeForField(fieldInfo *FieldInfo) {
 	}()
 
 	p.checkTagOptions(fieldInfo)
+	p.checkMessageOptions(fieldInfo)
 
 	p.generateMethodCheckIf(fieldInfo)
 	p.generateMethodCheckError(fieldInfo)
@@ -46,6 +49,9 @@ func (p *plugin) generateCodeForField(fieldInfo *FieldInfo) {
 
 func (p *plugin) checkTagOptions(fieldInfo *FieldInfo) {
 	checkBasic := func(field *protogen.Field, tagOptions *pbvalidator.TagOptions) {
+		if field.Desc.Kind() != protoreflect.MessageKind {
+			p.checkScalarNotNull(field, tagOptions)
+		}
 		switch field.Desc.Kind() {
 		case protoreflect.FloatKind, protoreflect.DoubleKind:
 			p.loadFloatTags(field, tagOptions)
@@ -64,7 +70,7 @@ func (p *plugin) checkTagOptions(fieldInfo *FieldInfo) {
 		case protoreflect.EnumKind:
 			p.loadEnumTags(field, tagOptions)
 		case protoreflect.MessageKind:
-			p.loadMessageTags(field, tagOptions)
+			p.checkMessageKindTags(field, tagOptions)
 		}
 	}
 
@@ -96,6 +102,25 @@ func (p *plugin) checkTagOptions(fieldInfo *FieldInfo) {
 	doCheck(fieldInfo.CheckIf.Field)
 }
 
+// checkMessageOptions checks the custom message templates of field at generation time.
+func (p *plugin) checkMessageOptions(fieldInfo *FieldInfo) {
+	options := fieldInfo.Options
+	if options == nil {
+		return
+	}
+	if err := protovalidator.CheckTemplate(options.Message); err != nil {
+		p.exitWithMsg("%s: invalid option <message>: %v", p.buildIdentifierWithName(fieldInfo.Name), err)
+	}
+	for tag, template := range options.Messages {
+		if !protovalidator.IsTag(tag) {
+			p.exitWithMsg("%s: invalid option <messages>: unknown tag <%s>", p.buildIdentifierWithName(fieldInfo.Name), tag)
+		}
+		if err := protovalidator.CheckTemplate(template); err != nil {
+			p.exitWithMsg("%s: invalid option <messages> for tag <%s>: %v", p.buildIdentifierWithName(fieldInfo.Name), tag, err)
+		}
+	}
+}
+
 func (p *plugin) generateMethodCheckIf(fieldInfo *FieldInfo) {
 	if fieldInfo.CheckIf == nil {
 		return
@@ -128,8 +153,12 @@ func (p *plugin) generateMethodCheckIf(fieldInfo *FieldInfo) {
 
 func (p *plugin) generateMethodCheckError(fieldInfo *FieldInfo) {
 	p.generateVariableForField(fieldInfo)
+	p.generateVariableForFieldDesc(fieldInfo)
 
-	p.g.P("func (this *", p.message.GoIdent.GoName, ") ", p.buildMethodNameForFieldValidate(fieldInfo), "() error {")
+	// The argument path is the path of message, it's "" for the message being validated.
+	// The argument all indicates whether to collects all violations or returns on the first one.
+	errorsType := p.g.QualifiedGoIdent(validatorPackage.Ident("ValidationErrors"))
+	p.g.P("func (this *", p.message.GoIdent.GoName, ") ", p.buildMethodNameForFieldValidate(fieldInfo), "(path string, all bool) (errs ", errorsType, ") {")
 
 	if fieldInfo.CheckIf != nil {
 		p.g.P("if !this.", p.buildMethodNameForFieldCheckIf(fieldInfo), "() {")
@@ -151,7 +180,24 @@ func (p *plugin) generateMethodCheckError(fieldInfo *FieldInfo) {
 		p.processFieldTags(fieldInfo)
 	}
 
-	p.g.P("    return nil")
+	p.g.P("    return errs")
+	p.g.P("}")
+	p.g.P("")
+}
+
+// generateVariableForFieldDesc generates the variable of FieldDesc that used by ValidateError.
+func (p *plugin) generateVariableForFieldDesc(fieldInfo *FieldInfo) {
+	field := fieldInfo.Field
+	jsonName := fieldInfo.Name
+	if !fieldInfo.IsOneOf || fieldInfo.InOneOf {
+		jsonName = field.Desc.JSONName()
+	}
+
+	p.g.P("var ", p.buildVariableNameForFieldDesc(fieldInfo), " = &", validatorPackage.Ident("FieldDesc"), "{")
+	p.g.P("Message: ", strconv.Quote(string(p.message.Desc.FullName())), ",")
+	p.g.P("Struct: ", strconv.Quote(p.message.GoIdent.GoName), ",")
+	p.g.P("Name: ", strconv.Quote(fieldInfo.Name), ",")
+	p.g.P("JSONName: ", strconv.Quote(jsonName), ",")
 	p.g.P("}")
 	p.g.P("")
 }
@@ -162,16 +208,42 @@ func (p *plugin) generateMethodValidate() {
 	// Generated Validate Method.
 	p.g.P("// Set default value for message ", msg.Desc.FullName())
 	p.g.P("func (this *", msg.GoIdent.GoName, ") ", p.getValidateMethodName(), "() error {")
+	p.g.P("    return this.", p.getValidateWithPathMethodName(), `("", false)`)
+	p.g.P("}")
+	p.g.P("")
+
+	// Generated ValidateAll Method.
+	p.g.P("// ValidateAll checks all fields of message ", msg.Desc.FullName(), " and its nested messages.")
+	p.g.P("// The error is a ", validatorPackage.Ident("ValidationErrors"), " that contains all violations.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") ", p.getValidateAllMethodName(), "() error {")
+	p.g.P("    return this.", p.getValidateWithPathMethodName(), `("", true)`)
+	p.g.P("}")
+	p.g.P("")
+
+	// Generated internal method that used by the parent messages.
+	p.g.P("// ", p.getValidateWithPathMethodName(), " is an internal method used by the generated code, the path is")
+	p.g.P("// the path of message in the message being validated.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") ", p.getValidateWithPathMethodName(), "(path string, all bool) error {")
 	p.g.P("    if this == nil {")
 	p.g.P(`        return nil`)
 	p.g.P("    }")
-
+	p.g.P("var errs ", validatorPackage.Ident("ValidationErrors"))
 	for _, fieldInfo := range p.filedInfos {
-		p.g.P("if err := this.", p.buildMethodNameForFieldValidate(fieldInfo), "(); err != nil {")
-		p.g.P("	return err")
+		p.g.P("errs = append(errs, this.", p.buildMethodNameForFieldValidate(fieldInfo), "(path, all)...)")
+		p.g.P("if !all && len(errs) != 0 {")
+		p.g.P("    return errs[0]")
 		p.g.P("}")
 	}
-
+	if p.hasMessageRules() {
+		// The cross-field constraints are checked after all fields.
+		p.g.P("errs = append(errs, this.", p.buildMethodNameForMessageValidate(), "(path, all)...)")
+		p.g.P("if !all && len(errs) != 0 {")
+		p.g.P("    return errs[0]")
+		p.g.P("}")
+	}
+	p.g.P("if len(errs) != 0 {")
+	p.g.P("    return errs")
+	p.g.P("}")
 	p.g.P("    return nil")
 	p.g.P("}")
 	p.g.P("")
@@ -229,7 +301,7 @@ func (p *plugin) getTagInfos(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
 	case protoreflect.EnumKind:
 		tagInfos = p.processEnumTags(fieldInfo)
 	case protoreflect.MessageKind:
-		tagInfos = p.processMessageTags(fieldInfo)
+		tagInfos = p.processMessageKindTags(fieldInfo)
 	default:
 		panic(fmt.Sprintf("%s: unsupported case: %s", p.buildIdentifierWithField(fieldInfo.Field), fieldInfo.Field.Desc.Kind()))
 	}
@@ -237,21 +309,23 @@ func (p *plugin) getTagInfos(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
 }
 
 func (p *plugin) genCodeWithTagInfos(fieldInfo *FieldInfo, tagInfos []*protovalidator.TagInfo) {
-	var fieldDescX string
+	// The subject describes the field or item in the message of error.
+	var subject string
 	switch {
 	case fieldInfo.IsListItem:
-		fieldDescX = fmt.Sprintf("array item where in field '%s'", fieldInfo.Name)
+		subject = "SubjectListItem"
 	case fieldInfo.IsMapKey:
-		fieldDescX = fmt.Sprintf("map key where in field '%s'", fieldInfo.Name)
+		subject = "SubjectMapKey"
 	case fieldInfo.IsMapValue:
-		fieldDescX = fmt.Sprintf("map value where in field '%s'", fieldInfo.Name)
+		subject = "SubjectMapValue"
 	default:
-		fieldDescX = fmt.Sprintf("field '%s'", fieldInfo.Name)
+		subject = "SubjectField"
 	}
 
 	for _, tagInfo := range tagInfos {
 		var cond string
-		if strings.HasPrefix(tagInfo.Cond, "!") {
+		// The leading "!" only be stripped from the single negated condition, e.g. "!x[v]".
+		if strings.HasPrefix(tagInfo.Cond, "!") && !strings.Contains(tagInfo.Cond, "&&") && !strings.Contains(tagInfo.Cond, "||") {
 			cond = strings.TrimPrefix(tagInfo.Cond, "!")
 		} else {
 			cond = fmt.Sprintf("!(%s)", tagInfo.Cond)
@@ -262,23 +336,42 @@ func (p *plugin) genCodeWithTagInfos(fieldInfo *FieldInfo, tagInfos []*protovali
 		if fieldInfo.IsCheckIf {
 			retValue = "false"
 		} else {
-			reason := protovalidator.BuildErrorReason(tagInfo, fieldDescX)
+			if !protovalidator.IsTag(tagInfo.Tag) {
+				panic(fmt.Sprintf("message format not defined for tag option %s", tagInfo.Tag))
+			}
+			subjectX := p.g.QualifiedGoIdent(validatorPackage.Ident(subject))
 
 			var errFunc string
 
+			fieldDescVar := p.buildVariableNameForFieldDesc(fieldInfo)
+			expectedValue := protovalidator.BuildExpectedValue(tagInfo)
+
+			pathSuffix := p.buildPathSuffix(fieldInfo)
+
 			fieldValueX := tagInfo.FieldValue
 			if fieldValueX != "" {
-				errFunc = p.g.QualifiedGoIdent(validatorPackage.Ident("FieldError1"))
-				retValue = fmt.Sprintf(`%s("%s","%s", %s)`, errFunc, p.message.GoIdent.GoName, reason, fieldValueX)
+				errFunc = p.g.QualifiedGoIdent(validatorPackage.Ident("TagError1"))
+				retValue = fmt.Sprintf(`%s(%s, path, %s, %s, "%s", %s, %s)`, errFunc, fieldDescVar, subjectX, pathSuffix, tagInfo.Tag, strconv.Quote(expectedValue), fieldValueX)
 			} else {
-				errFunc = p.g.QualifiedGoIdent(validatorPackage.Ident("FieldError2"))
-				retValue = fmt.Sprintf(`%s("%s","%s")`, errFunc, p.message.GoIdent.GoName, reason)
+				errFunc = p.g.QualifiedGoIdent(validatorPackage.Ident("TagError2"))
+				retValue = fmt.Sprintf(`%s(%s, path, %s, %s, "%s", %s)`, errFunc, fieldDescVar, subjectX, pathSuffix, tagInfo.Tag, strconv.Quote(expectedValue))
+			}
+
+			if code, template := p.getCustomMessage(fieldInfo, tagInfo.Tag); code != "" || template != "" {
+				retValue += fmt.Sprintf(".WithCustom(%s, %s)", strconv.Quote(code), strconv.Quote(template))
 			}
 
 		}
 
 		p.g.P("if ", cond, " {")
-		p.g.P("    return ", retValue)
+		if fieldInfo.IsCheckIf {
+			p.g.P("    return ", retValue)
+		} else {
+			p.g.P("    errs = append(errs, ", retValue, ")")
+			p.g.P("    if !all {")
+			p.g.P("        return errs")
+			p.g.P("    }")
+		}
 		p.g.P("}")
 	}
 
@@ -302,9 +395,65 @@ func (p *plugin) genCodeWithTagInfos(fieldInfo *FieldInfo, tagInfos []*protovali
 	//p.g.P("    return err")
 	//p.g.P("}")
 
-	p.g.P("if dt, ok := interface{}(", p.getGoItemName(fieldInfo.Field), ").(interface {", p.getValidateMethodName(), "() error }); ok {")
-	p.g.P("   if err := dt.", p.getValidateMethodName(), "(); err != nil {")
-	p.g.P("       return err")
-	p.g.P("   }")
+	// The path of field is passed to the nested message.
+	fieldPath := fmt.Sprintf("%s(path, %s)", p.g.QualifiedGoIdent(validatorPackage.Ident("JoinPath")), strconv.Quote(fieldInfo.Name))
+	if pathSuffix := p.buildPathSuffix(fieldInfo); pathSuffix != `""` {
+		fieldPath += "+" + pathSuffix
+	}
+	p.g.P("if err := ", validatorPackage.Ident("InvokeNestedValidator"), "(", p.getGoItemName(fieldInfo.Field), ", ", fieldPath, ", all); err != nil {")
+	p.g.P("    errs = ", validatorPackage.Ident("AppendError"), "(errs, err)")
+	p.g.P("    if !all {")
+	p.g.P("        return errs")
+	p.g.P("    }")
 	p.g.P("}")
 }
+
+// buildPathSuffix returns the expression of path suffix for the item of list or map.
+func (p *plugin) buildPathSuffix(fieldInfo *FieldInfo) string {
+	switch {
+	case fieldInfo.IsListItem:
+		return fmt.Sprintf("%s(index)", p.g.QualifiedGoIdent(validatorPackage.Ident("IndexSuffix")))
+	case fieldInfo.IsMapKey:
+		return fmt.Sprintf("%s(%s)", p.g.QualifiedGoIdent(validatorPackage.Ident("KeySuffix")), p.mapKeyToString(fieldInfo.Field, "item"))
+	case fieldInfo.IsMapValue:
+		keyField := fieldInfo.Field.Parent.Fields[0]
+		return fmt.Sprintf("%s(%s)", p.g.QualifiedGoIdent(validatorPackage.Ident("KeySuffix")), p.mapKeyToString(keyField, "key"))
+	default:
+		return `""`
+	}
+}
+
+// mapKeyToString returns the expression that converts the map key to string in path.
+func (p *plugin) mapKeyToString(field *protogen.Field, itemName string) string {
+	var convertMethod string
+	switch field.Desc.Kind() {
+	case protoreflect.StringKind:
+		convertMethod = p.g.QualifiedGoIdent(strconvPackage.Ident("Quote"))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		convertMethod = p.g.QualifiedGoIdent(validatorPackage.Ident("Int32ToString"))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		convertMethod = p.g.QualifiedGoIdent(validatorPackage.Ident("Int64ToString"))
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		convertMethod = p.g.QualifiedGoIdent(validatorPackage.Ident("Uint32ToString"))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		convertMethod = p.g.QualifiedGoIdent(validatorPackage.Ident("Uint64ToString"))
+	case protoreflect.BoolKind:
+		convertMethod = p.g.QualifiedGoIdent(validatorPackage.Ident("BoolToString"))
+	default:
+		panic(fmt.Sprintf("%s: unsupported map key kind: %s", p.buildIdentifierWithField(field), field.Desc.Kind()))
+	}
+	return fmt.Sprintf("%s(%s)", convertMethod, itemName)
+}
+
+// getCustomMessage returns the custom error code and message template of the tag for field.
+func (p *plugin) getCustomMessage(fieldInfo *FieldInfo, tag string) (code string, template string) {
+	options := fieldInfo.Options
+	if options == nil {
+		return "", ""
+	}
+	template = options.Message
+	if x, ok := options.Messages[tag]; ok {
+		template = x
+	}
+	return options.ErrorCode, template
+}
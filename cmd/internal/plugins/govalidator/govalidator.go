@@ -14,6 +14,8 @@ var (
 	stringsPackage   = protogen.GoImportPath("strings")
 	utf8Package      = protogen.GoImportPath("unicode/utf8")
 	strconvPackage   = protogen.GoImportPath("strconv")
+	bytesPackage     = protogen.GoImportPath("bytes")
+	mathPackage      = protogen.GoImportPath("math")
 )
 
 type plugin struct {
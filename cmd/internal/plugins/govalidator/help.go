@@ -15,6 +15,14 @@ func (p *plugin) getValidateMethodName() string {
 	return "Validate"
 }
 
+func (p *plugin) getValidateAllMethodName() string {
+	return "ValidateAll"
+}
+
+func (p *plugin) getValidateWithPathMethodName() string {
+	return "XXX_ValidateWithPath"
+}
+
 func (p *plugin) buildIdentifierWithField(field *protogen.Field) string {
 	name := string(field.Desc.Name())
 	if field.Parent.Desc.IsMapEntry() {
@@ -41,6 +49,13 @@ func (p *plugin) buildIdentifierWithName(name string) string {
 	)
 }
 
+func (p *plugin) buildIdentifierWithMessage() string {
+	return fmt.Sprintf(
+		"govalidator: <file(%s) message(%s)>",
+		string(p.file.GoImportPath), p.message.GoIdent.GoName,
+	)
+}
+
 func (p *plugin) exitWithMsg(format string, a ...interface{}) {
 	println(fmt.Sprintf(format, a...))
 	os.Exit(1)
@@ -0,0 +1,317 @@
+package govalidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
+	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	timestampFullName = "google.protobuf.Timestamp"
+	durationFullName  = "google.protobuf.Duration"
+)
+
+// compareOperators is the operators supported by option compare, the longer one must be first.
+var compareOperators = []string{"<=", ">=", "==", "!=", "<", ">"}
+
+func (p *plugin) loadMessageOptions(msg *protogen.Message) *pbvalidator.MessageOptions {
+	i := proto.GetExtension(msg.Desc.Options(), pbvalidator.E_Message)
+	options := i.(*pbvalidator.MessageOptions)
+	return options
+}
+
+// hasMessageRules reports whether the message has the cross-field constraints.
+func (p *plugin) hasMessageRules() bool {
+	options := p.loadMessageOptions(p.message)
+	if options == nil {
+		return false
+	}
+	return len(options.Compare) != 0 || len(options.AtLeastOneOf) != 0 || len(options.ExactlyOneOf) != 0 ||
+		len(options.AllOrNoneOf) != 0 || len(options.MutuallyExclusive) != 0 || len(options.Expr) != 0
+}
+
+// generateMethodCheckMessage generates the method to check the cross-field constraints of message.
+func (p *plugin) generateMethodCheckMessage() {
+	if !p.hasMessageRules() {
+		return
+	}
+	options := p.loadMessageOptions(p.message)
+
+	// Compile the expressions first to generate the variables of regexp it used.
+	compiler := newExprCompiler(p)
+	exprConds := make([]string, len(options.Expr))
+	for i, expr := range options.Expr {
+		cond, err := compiler.compile(expr)
+		if err != nil {
+			p.exitWithMsg("%s: option <expr>: invalid expression %q: %v", p.buildIdentifierWithMessage(), expr, err)
+		}
+		exprConds[i] = cond
+	}
+	for _, x := range compiler.regexps {
+		p.g.P("var ", x.name, " = ", regexpPackage.Ident("MustCompile"), "(", strconv.Quote(x.pattern), ")")
+	}
+	if len(compiler.regexps) != 0 {
+		p.g.P("")
+	}
+
+	descVar := p.buildVariableNameForMessageDesc()
+	p.g.P("var ", descVar, " = &", validatorPackage.Ident("FieldDesc"), "{")
+	p.g.P("Message: ", strconv.Quote(string(p.message.Desc.FullName())), ",")
+	p.g.P("Struct: ", strconv.Quote(p.message.GoIdent.GoName), ",")
+	p.g.P("}")
+	p.g.P("")
+
+	errorsType := p.g.QualifiedGoIdent(validatorPackage.Ident("ValidationErrors"))
+	p.g.P("func (this *", p.message.GoIdent.GoName, ") ", p.buildMethodNameForMessageValidate(), "(path string, all bool) (errs ", errorsType, ") {")
+
+	genError := func(tag string, value interface{}) {
+		expectedValue := protovalidator.BuildExpectedValue(&protovalidator.TagInfo{Tag: tag, Value: value})
+		p.g.P("    errs = append(errs, ", validatorPackage.Ident("TagError2"), "(", descVar, ", path, ",
+			validatorPackage.Ident("SubjectMessage"), `, "", "`, tag, `", `, strconv.Quote(expectedValue), "))")
+		p.g.P("    if !all {")
+		p.g.P("        return errs")
+		p.g.P("    }")
+		p.g.P("}")
+	}
+
+	for _, rule := range options.Compare {
+		cond, expr := p.buildCompareCond(rule)
+		p.g.P("if !(", cond, ") {")
+		genError(protovalidator.TagMessageCompare, expr)
+	}
+
+	groups := []struct {
+		tag    string
+		option string
+		groups []*pbvalidator.FieldGroup
+	}{
+		{tag: protovalidator.TagMessageAtLeastOneOf, option: "at_least_one_of", groups: options.AtLeastOneOf},
+		{tag: protovalidator.TagMessageExactlyOneOf, option: "exactly_one_of", groups: options.ExactlyOneOf},
+		{tag: protovalidator.TagMessageAllOrNoneOf, option: "all_or_none_of", groups: options.AllOrNoneOf},
+	}
+	for _, x := range groups {
+		for _, group := range x.groups {
+			exprs := p.buildGroupSetExprs(x.option, group)
+
+			var cond string
+			switch x.tag {
+			case protovalidator.TagMessageAtLeastOneOf:
+				cond = "n >= 1"
+			case protovalidator.TagMessageExactlyOneOf:
+				cond = "n == 1"
+			case protovalidator.TagMessageAllOrNoneOf:
+				cond = fmt.Sprintf("n == 0 || n == %d", len(exprs))
+			}
+
+			p.g.P("if n := ", validatorPackage.Ident("CountSet"), "(", strings.Join(exprs, ", "), "); !(", cond, ") {")
+			genError(x.tag, group.Fields)
+		}
+	}
+
+	for _, exclusive := range options.MutuallyExclusive {
+		if len(exclusive.Groups) < 2 {
+			p.exitWithMsg("%s: option <mutually_exclusive> requires at least 2 groups", p.buildIdentifierWithMessage())
+		}
+		var exprs []string
+		var value [][]string
+		seen := make(map[string]bool)
+		for _, group := range exclusive.Groups {
+			for _, name := range group.Fields {
+				if seen[name] {
+					p.exitWithMsg("%s: option <mutually_exclusive>: duplicate field <%s>", p.buildIdentifierWithMessage(), name)
+				}
+				seen[name] = true
+			}
+			exprs = append(exprs, "("+strings.Join(p.buildGroupSetExprs("mutually_exclusive", group), " || ")+")")
+			value = append(value, group.Fields)
+		}
+		p.g.P("if n := ", validatorPackage.Ident("CountSet"), "(", strings.Join(exprs, ", "), "); !(n <= 1) {")
+		genError(protovalidator.TagMessageMutuallyExclusive, value)
+	}
+
+	for i, expr := range options.Expr {
+		p.g.P("if !(", exprConds[i], ") {")
+		genError(protovalidator.TagMessageExpr, strings.TrimSpace(expr))
+	}
+
+	p.g.P("    return errs")
+	p.g.P("}")
+	p.g.P("")
+}
+
+// lookupPlainField returns the plain field by name in message, the program exits if not found.
+func (p *plugin) lookupPlainField(option string, name string) *protogen.Field {
+	for _, field := range p.message.Fields {
+		if string(field.Desc.Name()) != name {
+			continue
+		}
+		if utils.FieldIsOneOf(field) {
+			p.exitWithMsg("%s: option <%s>: the field <%s> in oneof is unsupported", p.buildIdentifierWithMessage(), option, name)
+		}
+		return field
+	}
+	p.exitWithMsg("%s: option <%s>: field <%s> not found", p.buildIdentifierWithMessage(), option, name)
+	return nil
+}
+
+// buildGroupSetExprs returns the expressions that report whether each field in group is set.
+func (p *plugin) buildGroupSetExprs(option string, group *pbvalidator.FieldGroup) []string {
+	if group == nil || len(group.Fields) == 0 {
+		p.exitWithMsg("%s: option <%s>: the group of fields cannot be empty", p.buildIdentifierWithMessage(), option)
+	}
+	seen := make(map[string]bool)
+	exprs := make([]string, 0, len(group.Fields))
+	for _, name := range group.Fields {
+		if seen[name] {
+			p.exitWithMsg("%s: option <%s>: duplicate field <%s>", p.buildIdentifierWithMessage(), option, name)
+		}
+		seen[name] = true
+		field := p.lookupPlainField(option, name)
+		exprs = append(exprs, p.buildFieldIsSetExpr(field))
+	}
+	return exprs
+}
+
+// buildFieldIsSetExpr returns the expression that reports whether the field is not the zero value.
+func (p *plugin) buildFieldIsSetExpr(field *protogen.Field) string {
+	itemName := "this." + field.GoName
+	switch {
+	case field.Desc.IsList(), field.Desc.IsMap():
+		return fmt.Sprintf("len(%s) != 0", itemName)
+	case utils.FieldIsPointer(field):
+		return fmt.Sprintf("%s != nil", itemName)
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return fmt.Sprintf("%s != nil", itemName)
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return fmt.Sprintf("len(%s) != 0", itemName)
+	case protoreflect.BoolKind:
+		return itemName
+	default:
+		return fmt.Sprintf("%s != 0", itemName)
+	}
+}
+
+// parseCompareRule parses the rule like "start_time < end_time".
+func (p *plugin) parseCompareRule(rule string) (left string, op string, right string) {
+	i := strings.IndexAny(rule, "<>=!")
+	if i != -1 {
+		for _, x := range compareOperators {
+			if strings.HasPrefix(rule[i:], x) {
+				op = x
+				break
+			}
+		}
+	}
+	if op == "" {
+		p.exitWithMsg("%s: option <compare>: invalid rule %q, missing operator", p.buildIdentifierWithMessage(), rule)
+	}
+	left = strings.TrimSpace(rule[:i])
+	right = strings.TrimSpace(rule[i+len(op):])
+	if !isIdentifier(left) || !isIdentifier(right) {
+		p.exitWithMsg("%s: option <compare>: invalid rule %q, expect <field> <operator> <field>", p.buildIdentifierWithMessage(), rule)
+	}
+	return left, op, right
+}
+
+// compareType returns the type of field used to check whether two fields can be compared.
+func (p *plugin) compareType(field *protogen.Field) string {
+	if field.Desc.IsList() || field.Desc.IsMap() {
+		return ""
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.BytesKind:
+		return ""
+	case protoreflect.EnumKind:
+		return string(field.Enum.Desc.FullName())
+	case protoreflect.MessageKind:
+		switch name := string(field.Message.Desc.FullName()); name {
+		case timestampFullName, durationFullName:
+			return name
+		}
+		return ""
+	default:
+		return p.fieldToGoType(field)
+	}
+}
+
+// buildCompareCond returns the condition of rule and the normalized rule.
+func (p *plugin) buildCompareCond(rule string) (cond string, expr string) {
+	left, op, right := p.parseCompareRule(rule)
+	expr = left + " " + op + " " + right
+
+	f1 := p.lookupPlainField("compare", left)
+	f2 := p.lookupPlainField("compare", right)
+
+	t1, t2 := p.compareType(f1), p.compareType(f2)
+	for _, x := range []struct{ name, typ string }{{left, t1}, {right, t2}} {
+		if x.typ == "" {
+			p.exitWithMsg("%s: option <compare>: invalid rule %q, the type of field <%s> cannot be compared",
+				p.buildIdentifierWithMessage(), rule, x.name)
+		}
+	}
+	if t1 != t2 {
+		p.exitWithMsg("%s: option <compare>: invalid rule %q, mismatched types <%s> and <%s>",
+			p.buildIdentifierWithMessage(), rule, t1, t2)
+	}
+	if t1 == "bool" && op != "==" && op != "!=" {
+		p.exitWithMsg("%s: option <compare>: invalid rule %q, the bool only supports == and !=",
+			p.buildIdentifierWithMessage(), rule)
+	}
+
+	x1, x2 := "this."+f1.GoName, "this."+f2.GoName
+	var nullable bool
+	switch {
+	case t1 == timestampFullName:
+		nullable = true
+		cond = fmt.Sprintf("%s(%s, %s) %s 0", p.g.QualifiedGoIdent(validatorPackage.Ident("CompareTimestamp")), x1, x2, op)
+	case t1 == durationFullName:
+		nullable = true
+		cond = fmt.Sprintf("%s(%s, %s) %s 0", p.g.QualifiedGoIdent(validatorPackage.Ident("CompareDuration")), x1, x2, op)
+	default:
+		p1, p2 := utils.FieldIsPointer(f1), utils.FieldIsPointer(f2)
+		nullable = p1 || p2
+		if p1 {
+			x1 = "*" + x1
+		}
+		if p2 {
+			x2 = "*" + x2
+		}
+		cond = fmt.Sprintf("%s %s %s", x1, op, x2)
+	}
+
+	if nullable {
+		// The rule is skipped if any one of the fields is null.
+		var nulls []string
+		for _, f := range []*protogen.Field{f1, f2} {
+			if utils.FieldIsPointer(f) || f.Desc.Kind() == protoreflect.MessageKind {
+				nulls = append(nulls, fmt.Sprintf("this.%s == nil", f.GoName))
+			}
+		}
+		cond = strings.Join(nulls, " || ") + " || " + cond
+	}
+	return cond, expr
+}
+
+func isIdentifier(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, c := range s {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i > 0:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -11,13 +11,17 @@ func (p *plugin) buildVariableName(fieldInfo *FieldInfo, tagName string) string
 	//	x += string(fieldInfo.Field.Parent.Desc.Name()) + "_"
 	//}
 	x += tagName + "_"
+	field := fieldInfo.Field
+	if fieldInfo.Wrapper != nil {
+		field = fieldInfo.Wrapper
+	}
 	if fieldInfo.IsCheckIf {
-		x += fieldInfo.Parent.Field.GoName + "_By_" + fieldInfo.Field.GoName
+		x += fieldInfo.Parent.Field.GoName + "_By_" + field.GoName
 	} else {
-		x += fieldInfo.Field.GoName
+		x += field.GoName
 	}
-	if fieldInfo.Field.Parent.Desc.IsMapEntry() {
-		x += "_" + string(fieldInfo.Field.Parent.Desc.Name())
+	if field.Parent.Desc.IsMapEntry() {
+		x += "_" + string(field.Parent.Desc.Name())
 	}
 	return x
 }
@@ -38,6 +42,10 @@ func (p *plugin) buildVariableNameForTagInEnums(fieldInfo *FieldInfo) string {
 	return p.buildVariableName(fieldInfo, "InEnums")
 }
 
+func (p *plugin) buildVariableNameForFieldDesc(fieldInfo *FieldInfo) string {
+	return prefix + p.message.GoIdent.GoName + "_FieldDesc_" + fieldInfo.Name
+}
+
 func (p *plugin) buildMethodNameForFieldValidate(fieldInfo *FieldInfo) string {
 	return prefix + "Validate_" + fieldInfo.Name
 }
@@ -45,3 +53,11 @@ func (p *plugin) buildMethodNameForFieldValidate(fieldInfo *FieldInfo) string {
 func (p *plugin) buildMethodNameForFieldCheckIf(fieldInfo *FieldInfo) string {
 	return prefix + "CheckIf_" + fieldInfo.Name
 }
+
+func (p *plugin) buildVariableNameForMessageDesc() string {
+	return prefix + p.message.GoIdent.GoName + "_MessageDesc"
+}
+
+func (p *plugin) buildMethodNameForMessageValidate() string {
+	return prefix + "ValidateMessage"
+}
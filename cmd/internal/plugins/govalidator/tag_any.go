@@ -0,0 +1,59 @@
+package govalidator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const anyFullName = "google.protobuf.Any"
+
+func (p *plugin) loadAnyTags(field *protogen.Field, tagOptions *pbvalidator.TagOptions) *pbvalidator.AnyTags {
+	if tagOptions == nil || tagOptions.Kind == nil {
+		return nil
+	}
+
+	switch ot := tagOptions.Kind.(type) {
+	case *pbvalidator.TagOptions_Any:
+		return ot.Any
+	default:
+		p.exitWithMsg(
+			"%s: types <google.protobuf.Any> only support the kind of TagOptions <any/message>; and you provided: <%s>",
+			p.buildIdentifierWithField(field), reflect.TypeOf(ot).Elem().Name(),
+		)
+	}
+	return nil
+}
+
+func (p *plugin) processAnyTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
+	options := p.loadAnyTags(fieldInfo.Field, fieldInfo.TagOptions)
+	if options == nil {
+		return nil
+	}
+
+	itemName := p.getGoItemName(fieldInfo.Field)
+	fieldValue := fmt.Sprintf("%s.GetTypeUrl()", itemName)
+
+	var tagInfos []*protovalidator.TagInfo
+	var cond string
+
+	if options.NotNull != nil && *options.NotNull {
+		cond = fmt.Sprintf("%s != nil", itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagMessageNotNull, Cond: cond, Value: nil, FieldValue: ""})
+	}
+	if len(options.In) != 0 {
+		varName := p.buildVariableNameForTagIn(fieldInfo)
+		cond = fmt.Sprintf("%s == nil || %s[%s]", itemName, varName, fieldValue)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagAnyIn, Cond: cond, Value: options.In, FieldValue: fieldValue})
+	}
+	if len(options.NotIn) != 0 {
+		varName := p.buildVariableNameForTagNotIn(fieldInfo)
+		cond = fmt.Sprintf("%s == nil || !%s[%s]", itemName, varName, fieldValue)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagAnyNotIn, Cond: cond, Value: options.NotIn, FieldValue: fieldValue})
+	}
+
+	return tagInfos
+}
@@ -1,8 +1,10 @@
 package govalidator
 
 import (
+	"encoding/hex"
 	"fmt"
 	"reflect"
+	"strconv"
 
 	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
 	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
@@ -32,7 +34,7 @@ func (p *plugin) processBytesTags(fieldInfo *FieldInfo) []*protovalidator.TagInf
 		return nil
 	}
 
-	itemName := p.getGoItemName(fieldInfo.Field)
+	itemName := p.getGoItemNameForInfo(fieldInfo)
 
 	var tagInfos []*protovalidator.TagInfo
 	var cond string
@@ -43,6 +45,26 @@ func (p *plugin) processBytesTags(fieldInfo *FieldInfo) []*protovalidator.TagInf
 		return filedValue
 	}
 
+	getValueFieldValue := func() string {
+		convertMethod := p.g.QualifiedGoIdent(validatorPackage.Ident("BytesToString"))
+		filedValue := fmt.Sprintf("%s(%s)", convertMethod, itemName)
+		return filedValue
+	}
+
+	// quote returns the bytes in the form of go string literal.
+	quote := func(b []byte) string {
+		return strconv.Quote(string(b))
+	}
+
+	// hexValues returns the bytes list in hexadecimal that shown in error message.
+	hexValues := func(values [][]byte) []string {
+		hs := make([]string, len(values))
+		for i, v := range values {
+			hs[i] = hex.EncodeToString(v)
+		}
+		return hs
+	}
+
 	if options.LenEq != nil {
 		value := *options.LenEq
 		cond = fmt.Sprintf("len(%s) == %d", itemName, value)
@@ -74,5 +96,63 @@ func (p *plugin) processBytesTags(fieldInfo *FieldInfo) []*protovalidator.TagInf
 		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesLenLte, Cond: cond, Value: value, FieldValue: getFieldValue()})
 	}
 
+	if options.Eq != nil {
+		cond = fmt.Sprintf("string(%s) == %s", itemName, quote(options.Eq))
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesEq, Cond: cond, Value: hex.EncodeToString(options.Eq), FieldValue: getValueFieldValue()})
+	}
+	if len(options.In) != 0 {
+		varName := p.buildVariableNameForTagIn(fieldInfo)
+		cond = fmt.Sprintf("%s[string(%s)]", varName, itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesIn, Cond: cond, Value: hexValues(options.In), FieldValue: getValueFieldValue()})
+	}
+	if len(options.NotIn) != 0 {
+		varName := p.buildVariableNameForTagNotIn(fieldInfo)
+		cond = fmt.Sprintf("!%s[string(%s)]", varName, itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesNotIn, Cond: cond, Value: hexValues(options.NotIn), FieldValue: getValueFieldValue()})
+	}
+
+	if options.Prefix != nil {
+		method := p.g.QualifiedGoIdent(bytesPackage.Ident("HasPrefix"))
+		cond = fmt.Sprintf("%s(%s, []byte(%s))", method, itemName, quote(options.Prefix))
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesPrefix, Cond: cond, Value: hex.EncodeToString(options.Prefix), FieldValue: getValueFieldValue()})
+	}
+	if options.Suffix != nil {
+		method := p.g.QualifiedGoIdent(bytesPackage.Ident("HasSuffix"))
+		cond = fmt.Sprintf("%s(%s, []byte(%s))", method, itemName, quote(options.Suffix))
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesSuffix, Cond: cond, Value: hex.EncodeToString(options.Suffix), FieldValue: getValueFieldValue()})
+	}
+	if options.Contains != nil {
+		method := p.g.QualifiedGoIdent(bytesPackage.Ident("Contains"))
+		cond = fmt.Sprintf("%s(%s, []byte(%s))", method, itemName, quote(options.Contains))
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesContains, Cond: cond, Value: hex.EncodeToString(options.Contains), FieldValue: getValueFieldValue()})
+	}
+	if options.Regex != nil && *options.Regex != "" {
+		varName := p.buildVariableNameForTagRegex(fieldInfo)
+		cond = fmt.Sprintf("%s.Match(%s)", varName, itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesRegex, Cond: cond, Value: *options.Regex, FieldValue: getValueFieldValue()})
+	}
+
+	if options.Ip != nil && *options.Ip {
+		method := p.g.QualifiedGoIdent(validatorPackage.Ident("BytesIsIP"))
+		cond = fmt.Sprintf("%s(%s)", method, itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesIp, Cond: cond, Value: nil, FieldValue: getValueFieldValue()})
+	}
+	if options.Ipv4 != nil && *options.Ipv4 {
+		method := p.g.QualifiedGoIdent(validatorPackage.Ident("BytesIsIPv4"))
+		cond = fmt.Sprintf("%s(%s)", method, itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesIpv4, Cond: cond, Value: nil, FieldValue: getValueFieldValue()})
+	}
+	if options.Ipv6 != nil && *options.Ipv6 {
+		method := p.g.QualifiedGoIdent(validatorPackage.Ident("BytesIsIPv6"))
+		cond = fmt.Sprintf("%s(%s)", method, itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesIpv6, Cond: cond, Value: nil, FieldValue: getValueFieldValue()})
+	}
+
+	if options.Utf8 != nil && *options.Utf8 {
+		method := p.g.QualifiedGoIdent(utf8Package.Ident("Valid"))
+		cond = fmt.Sprintf("%s(%s)", method, itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagBytesUtf8, Cond: cond, Value: nil, FieldValue: getValueFieldValue()})
+	}
+
 	return tagInfos
 }
@@ -0,0 +1,88 @@
+package govalidator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func (p *plugin) loadDurationTags(field *protogen.Field, tagOptions *pbvalidator.TagOptions) *pbvalidator.DurationTags {
+	if tagOptions == nil || tagOptions.Kind == nil {
+		return nil
+	}
+
+	switch ot := tagOptions.Kind.(type) {
+	case *pbvalidator.TagOptions_Duration:
+		p.checkDurationTags(field, ot.Duration)
+		return ot.Duration
+	default:
+		p.exitWithMsg(
+			"%s: types <google.protobuf.Duration> only support the kind of TagOptions <duration/message>; and you provided: <%s>",
+			p.buildIdentifierWithField(field), reflect.TypeOf(ot).Elem().Name(),
+		)
+	}
+	return nil
+}
+
+// checkDurationTags checks the values of options at generation time.
+func (p *plugin) checkDurationTags(field *protogen.Field, options *pbvalidator.DurationTags) {
+	for _, x := range []struct {
+		name  string
+		value *durationpb.Duration
+	}{{"lt", options.Lt}, {"gt", options.Gt}, {"lte", options.Lte}, {"gte", options.Gte}} {
+		if x.value == nil {
+			continue
+		}
+		if err := x.value.CheckValid(); err != nil {
+			p.exitWithMsg("%s: invalid option <%s>: %v", p.buildIdentifierWithField(field), x.name, err)
+		}
+	}
+}
+
+func (p *plugin) processDurationTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
+	options := p.loadDurationTags(fieldInfo.Field, fieldInfo.TagOptions)
+	if options == nil {
+		return nil
+	}
+
+	itemName := p.getGoItemName(fieldInfo.Field)
+
+	var tagInfos []*protovalidator.TagInfo
+	var cond string
+
+	getFieldValue := func() string {
+		convertMethod := p.g.QualifiedGoIdent(validatorPackage.Ident("DurationToString"))
+		return fmt.Sprintf("%s(%s)", convertMethod, itemName)
+	}
+	compareTo := func(value *durationpb.Duration, op string) string {
+		compareMethod := p.g.QualifiedGoIdent(validatorPackage.Ident("CompareDurationTo"))
+		return fmt.Sprintf("%s == nil || %s(%s, %d, %d) %s 0", itemName, compareMethod, itemName, value.Seconds, value.Nanos, op)
+	}
+
+	if options.NotNull != nil && *options.NotNull {
+		cond = fmt.Sprintf("%s != nil", itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagMessageNotNull, Cond: cond, Value: nil, FieldValue: ""})
+	}
+	if options.Lt != nil {
+		cond = compareTo(options.Lt, "<")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagDurationLt, Cond: cond, Value: formatDuration(options.Lt), FieldValue: getFieldValue()})
+	}
+	if options.Gt != nil {
+		cond = compareTo(options.Gt, ">")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagDurationGt, Cond: cond, Value: formatDuration(options.Gt), FieldValue: getFieldValue()})
+	}
+	if options.Lte != nil {
+		cond = compareTo(options.Lte, "<=")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagDurationLte, Cond: cond, Value: formatDuration(options.Lte), FieldValue: getFieldValue()})
+	}
+	if options.Gte != nil {
+		cond = compareTo(options.Gte, ">=")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagDurationGte, Cond: cond, Value: formatDuration(options.Gte), FieldValue: getFieldValue()})
+	}
+
+	return tagInfos
+}
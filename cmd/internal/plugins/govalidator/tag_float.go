@@ -2,7 +2,9 @@ package govalidator
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"strconv"
 
 	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
 	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
@@ -18,6 +20,7 @@ func (p *plugin) loadFloatTags(field *protogen.Field, tagOptions *pbvalidator.Ta
 
 	switch ot := tagOptions.Kind.(type) {
 	case *pbvalidator.TagOptions_Float:
+		p.checkFloatTags(field, ot.Float)
 		return ot.Float
 	default:
 		p.exitWithMsg(
@@ -28,6 +31,19 @@ func (p *plugin) loadFloatTags(field *protogen.Field, tagOptions *pbvalidator.Ta
 	return nil
 }
 
+// checkFloatTags checks the values of options at generation time.
+func (p *plugin) checkFloatTags(field *protogen.Field, options *pbvalidator.FloatTags) {
+	if options.MultipleOf != nil {
+		value := *options.MultipleOf
+		if math.IsNaN(value) || math.IsInf(value, 0) || value <= 0 {
+			p.exitWithMsg("%s: invalid option <multiple_of>: must be a positive number", p.buildIdentifierWithField(field))
+		}
+	}
+	if options.Range != nil {
+		p.loadRange(field, *options.Range)
+	}
+}
+
 func (p *plugin) processFloatTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
 	options := p.loadFloatTags(fieldInfo.Field, fieldInfo.TagOptions)
 	if options == nil {
@@ -35,7 +51,7 @@ func (p *plugin) processFloatTags(fieldInfo *FieldInfo) []*protovalidator.TagInf
 	}
 
 	isPointer := utils.FieldIsPointer(fieldInfo.Field)
-	itemName := p.getGoItemName(fieldInfo.Field)
+	itemName := p.getGoItemNameForInfo(fieldInfo)
 
 	var tagInfos []*protovalidator.TagInfo
 	var cond string
@@ -60,6 +76,20 @@ func (p *plugin) processFloatTags(fieldInfo *FieldInfo) []*protovalidator.TagInf
 		return filedValue
 	}
 
+	bitSize := numberBitSize(fieldInfo.Field.Desc.Kind())
+	// toFloat64 converts the value x of field to float64 for the functions of math and validator.
+	toFloat64 := func(x string) string {
+		if bitSize == 32 {
+			return fmt.Sprintf("float64(%s)", x)
+		}
+		return x
+	}
+	// notNaN returns the condition that the value x is not NaN, the NaN satisfies the
+	// comparison of "!=" and the check of map, so these checks must exclude it explicitly.
+	notNaN := func(x string) string {
+		return fmt.Sprintf("!%s(%s)", p.g.QualifiedGoIdent(mathPackage.Ident("IsNaN")), toFloat64(x))
+	}
+
 	if options.Eq != nil {
 		value := *options.Eq
 		if isPointer {
@@ -72,9 +102,9 @@ func (p *plugin) processFloatTags(fieldInfo *FieldInfo) []*protovalidator.TagInf
 	if options.Ne != nil {
 		value := *options.Ne
 		if isPointer {
-			cond = fmt.Sprintf("%s != nil && *%s != %f", itemName, itemName, value)
+			cond = fmt.Sprintf("%s != nil && *%s != %f && %s", itemName, itemName, value, notNaN("*"+itemName))
 		} else {
-			cond = fmt.Sprintf("%s != %f", itemName, value)
+			cond = fmt.Sprintf("%s != %f && %s", itemName, value, notNaN(itemName))
 		}
 		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagFloatNe, Cond: cond, Value: value, FieldValue: getFieldValue()})
 	}
@@ -127,12 +157,52 @@ func (p *plugin) processFloatTags(fieldInfo *FieldInfo) []*protovalidator.TagInf
 	if len(options.NotIn) != 0 {
 		varName := p.buildVariableNameForTagNotIn(fieldInfo)
 		if isPointer {
-			cond = fmt.Sprintf("%s != nil && !%s[*%s]", itemName, varName, itemName)
+			cond = fmt.Sprintf("%s != nil && !%s[*%s] && %s", itemName, varName, itemName, notNaN("*"+itemName))
 		} else {
-			cond = fmt.Sprintf("!%s[%s]", varName, itemName)
+			cond = fmt.Sprintf("!%s[%s] && %s", varName, itemName, notNaN(itemName))
 		}
 		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagFloatNotIn, Cond: cond, Value: options.NotIn, FieldValue: getFieldValue()})
 	}
 
+	if options.Range != nil {
+		r := p.loadRange(fieldInfo.Field, *options.Range)
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && %s", itemName, r.cond("*"+itemName))
+		} else {
+			cond = r.cond(itemName)
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagFloatRange, Cond: cond, Value: *options.Range, FieldValue: getFieldValue()})
+	}
+	if options.MultipleOf != nil {
+		method := p.g.QualifiedGoIdent(validatorPackage.Ident("FloatIsMultipleOf"))
+		value := *options.MultipleOf
+		literal := strconv.FormatFloat(value, 'g', -1, 64)
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && %s(%s, %d, %s)", itemName, method, toFloat64("*"+itemName), bitSize, literal)
+		} else {
+			cond = fmt.Sprintf("%s(%s, %d, %s)", method, toFloat64(itemName), bitSize, literal)
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagFloatMultipleOf, Cond: cond, Value: value, FieldValue: getFieldValue()})
+	}
+	if options.Finite != nil && *options.Finite {
+		method := p.g.QualifiedGoIdent(validatorPackage.Ident("FloatIsFinite"))
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && %s(%s)", itemName, method, toFloat64("*"+itemName))
+		} else {
+			cond = fmt.Sprintf("%s(%s)", method, toFloat64(itemName))
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagFloatFinite, Cond: cond, Value: nil, FieldValue: getFieldValue()})
+	}
+	if options.DecimalPlaces != nil {
+		method := p.g.QualifiedGoIdent(validatorPackage.Ident("FloatHasDecimalPlaces"))
+		value := *options.DecimalPlaces
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && %s(%s, %d, %d)", itemName, method, toFloat64("*"+itemName), bitSize, value)
+		} else {
+			cond = fmt.Sprintf("%s(%s, %d, %d)", method, toFloat64(itemName), bitSize, value)
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagFloatDecimalPlaces, Cond: cond, Value: value, FieldValue: getFieldValue()})
+	}
+
 	return tagInfos
 }
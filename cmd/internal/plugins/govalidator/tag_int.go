@@ -2,6 +2,7 @@ package govalidator
 
 import (
 	"fmt"
+	"math"
 	"reflect"
 
 	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
@@ -18,6 +19,7 @@ func (p *plugin) loadIntTags(field *protogen.Field, tagOptions *pbvalidator.TagO
 
 	switch ot := tagOptions.Kind.(type) {
 	case *pbvalidator.TagOptions_Int:
+		p.checkIntTags(field, ot.Int)
 		return ot.Int
 	default:
 		p.exitWithMsg(
@@ -28,6 +30,22 @@ func (p *plugin) loadIntTags(field *protogen.Field, tagOptions *pbvalidator.TagO
 	return nil
 }
 
+// checkIntTags checks the values of options at generation time.
+func (p *plugin) checkIntTags(field *protogen.Field, options *pbvalidator.IntTags) {
+	if options.MultipleOf != nil {
+		value := *options.MultipleOf
+		if value == 0 {
+			p.exitWithMsg("%s: invalid option <multiple_of>: must be non-zero", p.buildIdentifierWithField(field))
+		}
+		if numberBitSize(field.Desc.Kind()) == 32 && (value < math.MinInt32 || value > math.MaxInt32) {
+			p.exitWithMsg("%s: invalid option <multiple_of>: %d overflows the type of field", p.buildIdentifierWithField(field), value)
+		}
+	}
+	if options.Range != nil {
+		p.loadRange(field, *options.Range)
+	}
+}
+
 func (p *plugin) processIntTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
 	options := p.loadIntTags(fieldInfo.Field, fieldInfo.TagOptions)
 	if options == nil {
@@ -35,7 +53,7 @@ func (p *plugin) processIntTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo
 	}
 
 	isPointer := utils.FieldIsPointer(fieldInfo.Field)
-	itemName := p.getGoItemName(fieldInfo.Field)
+	itemName := p.getGoItemNameForInfo(fieldInfo)
 
 	var tagInfos []*protovalidator.TagInfo
 	var cond string
@@ -136,5 +154,24 @@ func (p *plugin) processIntTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo
 		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagIntNotIn, Cond: cond, Value: options.NotIn, FieldValue: getFieldValue()})
 	}
 
+	if options.Range != nil {
+		r := p.loadRange(fieldInfo.Field, *options.Range)
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && %s", itemName, r.cond("*"+itemName))
+		} else {
+			cond = r.cond(itemName)
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagIntRange, Cond: cond, Value: *options.Range, FieldValue: getFieldValue()})
+	}
+	if options.MultipleOf != nil {
+		value := *options.MultipleOf
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && *%s %% %d == 0", itemName, itemName, value)
+		} else {
+			cond = fmt.Sprintf("%s %% %d == 0", itemName, value)
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagIntMultipleOf, Cond: cond, Value: value, FieldValue: getFieldValue()})
+	}
+
 	return tagInfos
 }
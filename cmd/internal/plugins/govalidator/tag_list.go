@@ -140,6 +140,7 @@ func (p *plugin) processListItems(fieldInfo *FieldInfo) {
 			IsOneOf:    false,
 			InOneOf:    false,
 			TagOptions: subTagOptions,
+			Options:    fieldInfo.Options,
 			IsCheckIf:  fieldInfo.IsCheckIf,
 			Parent:     fieldInfo.Parent,
 			IsListItem: true,
@@ -155,7 +156,13 @@ func (p *plugin) processListItems(fieldInfo *FieldInfo) {
 
 		tagInfos := p.getTagInfos(subFieldInfo)
 		if len(tagInfos) != 0 || checkMessage {
-			p.g.P("for _, item := range ", itemName, " {")
+			if fieldInfo.IsCheckIf {
+				p.g.P("for _, item := range ", itemName, " {")
+			} else {
+				// The index is used to build the path of item.
+				p.g.P("for index, item := range ", itemName, " {")
+				p.g.P("    _ = index // To avoid unused panics.")
+			}
 			p.g.P("    _ = item // To avoid unused panics.")
 
 			p.genCodeWithTagInfos(subFieldInfo, tagInfos)
@@ -102,6 +102,7 @@ func (p *plugin) processMapItems(fieldInfo *FieldInfo) {
 			IsOneOf:    false,
 			InOneOf:    false,
 			TagOptions: options.Key,
+			Options:    fieldInfo.Options,
 			IsCheckIf:  fieldInfo.IsCheckIf,
 			Parent:     fieldInfo.Parent,
 			IsListItem: false,
@@ -137,6 +138,7 @@ func (p *plugin) processMapItems(fieldInfo *FieldInfo) {
 			IsOneOf:    false,
 			InOneOf:    false,
 			TagOptions: subTagOptions,
+			Options:    fieldInfo.Options,
 			IsCheckIf:  fieldInfo.IsCheckIf,
 			Parent:     fieldInfo.Parent,
 			IsListItem: false,
@@ -152,7 +154,13 @@ func (p *plugin) processMapItems(fieldInfo *FieldInfo) {
 
 		tagInfos := p.getTagInfos(subFieldInfo)
 		if len(tagInfos) != 0 || checkMessage {
-			p.g.P("for _, item := range ", itemName, " {")
+			if fieldInfo.IsCheckIf {
+				p.g.P("for _, item := range ", itemName, " {")
+			} else {
+				// The key is used to build the path of item.
+				p.g.P("for key, item := range ", itemName, " {")
+				p.g.P("    _ = key // To avoid unused panics.")
+			}
 			p.g.P("    _ = item // To avoid unused panics.")
 
 			p.genCodeWithTagInfos(subFieldInfo, tagInfos)
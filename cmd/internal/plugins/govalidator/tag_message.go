@@ -46,9 +46,61 @@ func (p *plugin) processMessageTags(fieldInfo *FieldInfo) []*protovalidator.TagI
 }
 
 func (p *plugin) isNeedCheckMessage(fieldInfo *FieldInfo) bool {
-	options := p.loadMessageTags(fieldInfo.Field, fieldInfo.TagOptions)
-	if options != nil && options.Skip != nil && *options.Skip {
+	if fieldInfo.TagOptions == nil {
+		return true
+	}
+	// The tags of well-known types has no option skip.
+	ot, ok := fieldInfo.TagOptions.Kind.(*pbvalidator.TagOptions_Message)
+	if ok && ot.Message.Skip != nil && *ot.Message.Skip {
 		return false
 	}
 	return true
 }
+
+// checkMessageKindTags checks the TagOptions of message field, it dispatches on the full name of message.
+// The well-known types support the MessageTags and the tags for the type.
+func (p *plugin) checkMessageKindTags(field *protogen.Field, tagOptions *pbvalidator.TagOptions) {
+	if tagOptions == nil || tagOptions.Kind == nil {
+		return
+	}
+	if _, ok := tagOptions.Kind.(*pbvalidator.TagOptions_Message); ok {
+		return
+	}
+	switch string(field.Message.Desc.FullName()) {
+	case timestampFullName:
+		p.loadTimestampTags(field, tagOptions)
+	case durationFullName:
+		p.loadDurationTags(field, tagOptions)
+	case anyFullName:
+		p.loadAnyTags(field, tagOptions)
+	default:
+		if isWrapperField(field) {
+			p.checkWrapperTags(field, tagOptions)
+			return
+		}
+		p.loadMessageTags(field, tagOptions)
+	}
+}
+
+// processMessageKindTags processes the TagOptions of message field, it dispatches on the full name of message.
+func (p *plugin) processMessageKindTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
+	if fieldInfo.TagOptions == nil || fieldInfo.TagOptions.Kind == nil {
+		return nil
+	}
+	if _, ok := fieldInfo.TagOptions.Kind.(*pbvalidator.TagOptions_Message); ok {
+		return p.processMessageTags(fieldInfo)
+	}
+	switch string(fieldInfo.Field.Message.Desc.FullName()) {
+	case timestampFullName:
+		return p.processTimestampTags(fieldInfo)
+	case durationFullName:
+		return p.processDurationTags(fieldInfo)
+	case anyFullName:
+		return p.processAnyTags(fieldInfo)
+	default:
+		if isWrapperField(fieldInfo.Field) {
+			return p.processWrapperTags(fieldInfo)
+		}
+		return p.processMessageTags(fieldInfo)
+	}
+}
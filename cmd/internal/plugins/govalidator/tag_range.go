@@ -0,0 +1,154 @@
+package govalidator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// numberRange is the interval of option range, e.g. "[0, 100)".
+type numberRange struct {
+	lower, upper                   string // The bounds, empty if unbounded.
+	lowerInclusive, upperInclusive bool
+}
+
+// parseRange parses the interval of option range. The "[" and "]" include the bound,
+// the "(" and ")" exclude it, and the empty bound is unbounded.
+func parseRange(s string) (*numberRange, error) {
+	s = strings.TrimSpace(s)
+	if len(s) < 2 {
+		return nil, errors.New("must be in the form of '[a, b]', '(a, b)', '[a, b)' or '(a, b]'")
+	}
+
+	r := &numberRange{}
+	switch s[0] {
+	case '[':
+		r.lowerInclusive = true
+	case '(':
+	default:
+		return nil, fmt.Errorf("expected '[' or '(' at the start, found %q", s[0])
+	}
+	switch s[len(s)-1] {
+	case ']':
+		r.upperInclusive = true
+	case ')':
+	default:
+		return nil, fmt.Errorf("expected ']' or ')' at the end, found %q", s[len(s)-1])
+	}
+
+	bounds := strings.Split(s[1:len(s)-1], ",")
+	if len(bounds) != 2 {
+		return nil, errors.New("expected two bounds separated by ','")
+	}
+	r.lower = strings.TrimSpace(bounds[0])
+	r.upper = strings.TrimSpace(bounds[1])
+	if r.lower == "" && r.upper == "" {
+		return nil, errors.New("at least one bound must be specified")
+	}
+	return r, nil
+}
+
+// normalize checks the bounds with the type of field and formats them as go literals.
+func (r *numberRange) normalize(kind protoreflect.Kind) error {
+	format := func(bound string) (string, error) {
+		if bound == "" {
+			return "", nil
+		}
+		bitSize := numberBitSize(kind)
+		switch kind {
+		case protoreflect.FloatKind, protoreflect.DoubleKind:
+			v, err := strconv.ParseFloat(bound, bitSize)
+			if err != nil {
+				return "", fmt.Errorf("invalid bound %q", bound)
+			}
+			if math.IsNaN(v) || math.IsInf(v, 0) {
+				return "", fmt.Errorf("invalid bound %q, use the empty bound for unbounded", bound)
+			}
+			return strconv.FormatFloat(v, 'g', -1, bitSize), nil
+		case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+			v, err := strconv.ParseUint(bound, 10, bitSize)
+			if err != nil {
+				return "", fmt.Errorf("invalid bound %q", bound)
+			}
+			return strconv.FormatUint(v, 10), nil
+		default:
+			v, err := strconv.ParseInt(bound, 10, bitSize)
+			if err != nil {
+				return "", fmt.Errorf("invalid bound %q", bound)
+			}
+			return strconv.FormatInt(v, 10), nil
+		}
+	}
+
+	var err error
+	if r.lower, err = format(r.lower); err != nil {
+		return err
+	}
+	if r.upper, err = format(r.upper); err != nil {
+		return err
+	}
+	if r.lower == "" || r.upper == "" {
+		return nil
+	}
+
+	// The bounds are exact in the precision of 256.
+	lower, _, _ := big.ParseFloat(r.lower, 10, 256, big.ToNearestEven)
+	upper, _, _ := big.ParseFloat(r.upper, 10, 256, big.ToNearestEven)
+	switch c := lower.Cmp(upper); {
+	case c > 0:
+		return errors.New("the lower bound is greater than the upper bound")
+	case c == 0 && !(r.lowerInclusive && r.upperInclusive):
+		return errors.New("the interval is empty")
+	}
+	return nil
+}
+
+// cond returns the condition that the value x is in the interval.
+func (r *numberRange) cond(x string) string {
+	var conds []string
+	if r.lower != "" {
+		op := ">"
+		if r.lowerInclusive {
+			op = ">="
+		}
+		conds = append(conds, fmt.Sprintf("%s %s %s", x, op, r.lower))
+	}
+	if r.upper != "" {
+		op := "<"
+		if r.upperInclusive {
+			op = "<="
+		}
+		conds = append(conds, fmt.Sprintf("%s %s %s", x, op, r.upper))
+	}
+	return strings.Join(conds, " && ")
+}
+
+// loadRange parses and checks the option range of the numeric field at generation time.
+func (p *plugin) loadRange(field *protogen.Field, s string) *numberRange {
+	r, err := parseRange(s)
+	if err == nil {
+		err = r.normalize(field.Desc.Kind())
+	}
+	if err != nil {
+		p.exitWithMsg("%s: invalid option <range> '%s': %v", p.buildIdentifierWithField(field), s, err)
+	}
+	return r
+}
+
+// numberBitSize returns the size of the numeric kind in bits.
+func numberBitSize(kind protoreflect.Kind) int {
+	switch kind {
+	case protoreflect.FloatKind,
+		protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return 32
+	default:
+		return 64
+	}
+}
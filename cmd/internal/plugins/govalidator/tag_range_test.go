@@ -0,0 +1,65 @@
+package govalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func Test_parseRange(t *testing.T) {
+	cases := []struct {
+		Range  string
+		Kind   protoreflect.Kind
+		Expect string
+	}{
+		{"[0, 100)", protoreflect.Int64Kind, "x >= 0 && x < 100"},
+		{" ( -5 , 5 ] ", protoreflect.Int32Kind, "x > -5 && x <= 5"},
+		{"(0, ]", protoreflect.Uint64Kind, "x > 0"},
+		{"[, 10)", protoreflect.Uint32Kind, "x < 10"},
+		{"[1, 1]", protoreflect.Int64Kind, "x >= 1 && x <= 1"},
+		{"(0, 1.5e3)", protoreflect.DoubleKind, "x > 0 && x < 1500"},
+		{"[0.1, 0.25]", protoreflect.FloatKind, "x >= 0.1 && x <= 0.25"},
+		{"[-9223372036854775808, 9223372036854775807]", protoreflect.Int64Kind,
+			"x >= -9223372036854775808 && x <= 9223372036854775807"},
+		{"[9223372036854775807, 9223372036854775808]", protoreflect.Uint64Kind,
+			"x >= 9223372036854775807 && x <= 9223372036854775808"},
+	}
+	for _, c := range cases {
+		r, err := parseRange(c.Range)
+		require.Nil(t, err, c.Range)
+		require.Nil(t, r.normalize(c.Kind), c.Range)
+		require.Equal(t, c.Expect, r.cond("x"), c.Range)
+	}
+}
+
+func Test_parseRange_Error(t *testing.T) {
+	cases := []struct {
+		Range  string
+		Kind   protoreflect.Kind
+		Expect string
+	}{
+		{"", protoreflect.Int64Kind, "must be in the form of '[a, b]', '(a, b)', '[a, b)' or '(a, b]'"},
+		{"0, 1]", protoreflect.Int64Kind, `expected '[' or '(' at the start, found '0'`},
+		{"[0, 1", protoreflect.Int64Kind, `expected ']' or ')' at the end, found '1'`},
+		{"[0]", protoreflect.Int64Kind, "expected two bounds separated by ','"},
+		{"[0, 1, 2]", protoreflect.Int64Kind, "expected two bounds separated by ','"},
+		{"[ , ]", protoreflect.Int64Kind, "at least one bound must be specified"},
+		{"[a, 1]", protoreflect.Int64Kind, `invalid bound "a"`},
+		{"[0.5, 1]", protoreflect.Int64Kind, `invalid bound "0.5"`},
+		{"[-1, 1]", protoreflect.Uint64Kind, `invalid bound "-1"`},
+		{"[0, 2147483648]", protoreflect.Int32Kind, `invalid bound "2147483648"`},
+		{"[0, 1e39]", protoreflect.FloatKind, `invalid bound "1e39"`},
+		{"[0, inf]", protoreflect.DoubleKind, `invalid bound "inf", use the empty bound for unbounded`},
+		{"[2, 1]", protoreflect.Int64Kind, "the lower bound is greater than the upper bound"},
+		{"[1, 1)", protoreflect.DoubleKind, "the interval is empty"},
+	}
+	for _, c := range cases {
+		r, err := parseRange(c.Range)
+		if err == nil {
+			err = r.normalize(c.Kind)
+		}
+		require.NotNil(t, err, c.Range)
+		require.Equal(t, c.Expect, err.Error(), c.Range)
+	}
+}
@@ -34,7 +34,7 @@ func (p *plugin) processStringTags(fieldInfo *FieldInfo) []*protovalidator.TagIn
 	}
 
 	isPointer := utils.FieldIsPointer(fieldInfo.Field)
-	itemName := p.getGoItemName(fieldInfo.Field)
+	itemName := p.getGoItemNameForInfo(fieldInfo)
 
 	var tagInfos []*protovalidator.TagInfo
 	var cond string
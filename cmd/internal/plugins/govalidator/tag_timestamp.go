@@ -0,0 +1,119 @@
+package govalidator
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (p *plugin) loadTimestampTags(field *protogen.Field, tagOptions *pbvalidator.TagOptions) *pbvalidator.TimestampTags {
+	if tagOptions == nil || tagOptions.Kind == nil {
+		return nil
+	}
+
+	switch ot := tagOptions.Kind.(type) {
+	case *pbvalidator.TagOptions_Timestamp:
+		p.checkTimestampTags(field, ot.Timestamp)
+		return ot.Timestamp
+	default:
+		p.exitWithMsg(
+			"%s: types <google.protobuf.Timestamp> only support the kind of TagOptions <timestamp/message>; and you provided: <%s>",
+			p.buildIdentifierWithField(field), reflect.TypeOf(ot).Elem().Name(),
+		)
+	}
+	return nil
+}
+
+// checkTimestampTags checks the values of options at generation time.
+func (p *plugin) checkTimestampTags(field *protogen.Field, options *pbvalidator.TimestampTags) {
+	for _, x := range []struct {
+		name  string
+		value *timestamppb.Timestamp
+	}{{"lt", options.Lt}, {"gt", options.Gt}, {"lte", options.Lte}, {"gte", options.Gte}} {
+		if x.value == nil {
+			continue
+		}
+		if err := x.value.CheckValid(); err != nil {
+			p.exitWithMsg("%s: invalid option <%s>: %v", p.buildIdentifierWithField(field), x.name, err)
+		}
+	}
+	if options.Within != nil {
+		if err := options.Within.CheckValid(); err != nil || options.Within.AsDuration() <= 0 {
+			p.exitWithMsg("%s: invalid option <within>: must be a positive duration", p.buildIdentifierWithField(field))
+		}
+	}
+	if options.LtNow != nil && *options.LtNow && options.GtNow != nil && *options.GtNow {
+		p.exitWithMsg("%s: the option <lt_now> and <gt_now> cannot be both true", p.buildIdentifierWithField(field))
+	}
+}
+
+func (p *plugin) processTimestampTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
+	options := p.loadTimestampTags(fieldInfo.Field, fieldInfo.TagOptions)
+	if options == nil {
+		return nil
+	}
+
+	itemName := p.getGoItemName(fieldInfo.Field)
+
+	var tagInfos []*protovalidator.TagInfo
+	var cond string
+
+	getFieldValue := func() string {
+		convertMethod := p.g.QualifiedGoIdent(validatorPackage.Ident("TimestampToString"))
+		return fmt.Sprintf("%s(%s)", convertMethod, itemName)
+	}
+	compareTo := func(value *timestamppb.Timestamp, op string) string {
+		compareMethod := p.g.QualifiedGoIdent(validatorPackage.Ident("CompareTimestampTo"))
+		return fmt.Sprintf("%s == nil || %s(%s, %d, %d) %s 0", itemName, compareMethod, itemName, value.Seconds, value.Nanos, op)
+	}
+	formatValue := func(value *timestamppb.Timestamp) string {
+		return value.AsTime().Format(time.RFC3339Nano)
+	}
+
+	if options.NotNull != nil && *options.NotNull {
+		cond = fmt.Sprintf("%s != nil", itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagMessageNotNull, Cond: cond, Value: nil, FieldValue: ""})
+	}
+	if options.Lt != nil {
+		cond = compareTo(options.Lt, "<")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagTimestampLt, Cond: cond, Value: formatValue(options.Lt), FieldValue: getFieldValue()})
+	}
+	if options.Gt != nil {
+		cond = compareTo(options.Gt, ">")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagTimestampGt, Cond: cond, Value: formatValue(options.Gt), FieldValue: getFieldValue()})
+	}
+	if options.Lte != nil {
+		cond = compareTo(options.Lte, "<=")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagTimestampLte, Cond: cond, Value: formatValue(options.Lte), FieldValue: getFieldValue()})
+	}
+	if options.Gte != nil {
+		cond = compareTo(options.Gte, ">=")
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagTimestampGte, Cond: cond, Value: formatValue(options.Gte), FieldValue: getFieldValue()})
+	}
+	if options.LtNow != nil && *options.LtNow {
+		cond = fmt.Sprintf("%s == nil || %s(%s) < 0", itemName, p.g.QualifiedGoIdent(validatorPackage.Ident("CompareTimestampNow")), itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagTimestampLtNow, Cond: cond, Value: nil, FieldValue: getFieldValue()})
+	}
+	if options.GtNow != nil && *options.GtNow {
+		cond = fmt.Sprintf("%s == nil || %s(%s) > 0", itemName, p.g.QualifiedGoIdent(validatorPackage.Ident("CompareTimestampNow")), itemName)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagTimestampGtNow, Cond: cond, Value: nil, FieldValue: getFieldValue()})
+	}
+	if options.Within != nil {
+		value := options.Within
+		cond = fmt.Sprintf("%s == nil || %s(%s, %d, %d)", itemName, p.g.QualifiedGoIdent(validatorPackage.Ident("TimestampWithin")), itemName, value.Seconds, value.Nanos)
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagTimestampWithin, Cond: cond, Value: formatDuration(value), FieldValue: getFieldValue()})
+	}
+
+	return tagInfos
+}
+
+// formatDuration returns the duration in the format of time.Duration that shown in error message.
+func formatDuration(value *durationpb.Duration) string {
+	return value.AsDuration().String()
+}
@@ -2,6 +2,7 @@ package govalidator
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
 	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
@@ -21,9 +22,26 @@ func (p *plugin) loadUintTags(field *protogen.Field, tagOptions *pbvalidator.Tag
 			p.buildIdentifierWithField(field),
 		)
 	}
+	p.checkUintTags(field, ot.Uint)
 	return ot.Uint
 }
 
+// checkUintTags checks the values of options at generation time.
+func (p *plugin) checkUintTags(field *protogen.Field, options *pbvalidator.UintTags) {
+	if options.MultipleOf != nil {
+		value := *options.MultipleOf
+		if value == 0 {
+			p.exitWithMsg("%s: invalid option <multiple_of>: must be non-zero", p.buildIdentifierWithField(field))
+		}
+		if numberBitSize(field.Desc.Kind()) == 32 && value > math.MaxUint32 {
+			p.exitWithMsg("%s: invalid option <multiple_of>: %d overflows the type of field", p.buildIdentifierWithField(field), value)
+		}
+	}
+	if options.Range != nil {
+		p.loadRange(field, *options.Range)
+	}
+}
+
 func (p *plugin) processUintTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
 	options := p.loadUintTags(fieldInfo.Field, fieldInfo.TagOptions)
 	if options == nil {
@@ -31,7 +49,7 @@ func (p *plugin) processUintTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo
 	}
 
 	isPointer := utils.FieldIsPointer(fieldInfo.Field)
-	itemName := p.getGoItemName(fieldInfo.Field)
+	itemName := p.getGoItemNameForInfo(fieldInfo)
 
 	var tagInfos []*protovalidator.TagInfo
 	var cond string
@@ -133,5 +151,24 @@ func (p *plugin) processUintTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo
 		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagUintNotIn, Cond: cond, Value: options.NotIn, FieldValue: getFieldValue()})
 	}
 
+	if options.Range != nil {
+		r := p.loadRange(fieldInfo.Field, *options.Range)
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && %s", itemName, r.cond("*"+itemName))
+		} else {
+			cond = r.cond(itemName)
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagUintRange, Cond: cond, Value: *options.Range, FieldValue: getFieldValue()})
+	}
+	if options.MultipleOf != nil {
+		value := *options.MultipleOf
+		if isPointer {
+			cond = fmt.Sprintf("%s != nil && *%s %% %d == 0", itemName, itemName, value)
+		} else {
+			cond = fmt.Sprintf("%s %% %d == 0", itemName, value)
+		}
+		tagInfos = append(tagInfos, &protovalidator.TagInfo{Tag: protovalidator.TagUintMultipleOf, Cond: cond, Value: value, FieldValue: getFieldValue()})
+	}
+
 	return tagInfos
 }
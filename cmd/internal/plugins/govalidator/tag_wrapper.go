@@ -0,0 +1,130 @@
+package govalidator
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// wrapperTagKinds is the kind of TagOptions for the value of wrapper types.
+var wrapperTagKinds = map[string]string{
+	"google.protobuf.DoubleValue": "float",
+	"google.protobuf.FloatValue":  "float",
+	"google.protobuf.Int64Value":  "int",
+	"google.protobuf.Int32Value":  "int",
+	"google.protobuf.UInt64Value": "uint",
+	"google.protobuf.UInt32Value": "uint",
+	"google.protobuf.BoolValue":   "bool",
+	"google.protobuf.StringValue": "string",
+	"google.protobuf.BytesValue":  "bytes",
+}
+
+// isWrapperField reports whether the type of field is one of the wrapper types, e.g. google.protobuf.Int64Value.
+func isWrapperField(field *protogen.Field) bool {
+	if field.Desc.Kind() != protoreflect.MessageKind {
+		return false
+	}
+	_, ok := wrapperTagKinds[string(field.Message.Desc.FullName())]
+	return ok
+}
+
+// checkWrapperTags checks the kind of TagOptions for the field of wrapper type.
+func (p *plugin) checkWrapperTags(field *protogen.Field, tagOptions *pbvalidator.TagOptions) {
+	if tagOptions == nil || tagOptions.Kind == nil {
+		return
+	}
+
+	name := string(field.Message.Desc.FullName())
+	expected := wrapperTagKinds[name]
+
+	var kind string
+	switch tagOptions.Kind.(type) {
+	case *pbvalidator.TagOptions_Message:
+		return
+	case *pbvalidator.TagOptions_Float:
+		kind = "float"
+	case *pbvalidator.TagOptions_Int:
+		kind = "int"
+	case *pbvalidator.TagOptions_Uint:
+		kind = "uint"
+	case *pbvalidator.TagOptions_Bool:
+		kind = "bool"
+	case *pbvalidator.TagOptions_String_:
+		kind = "string"
+	case *pbvalidator.TagOptions_Bytes:
+		kind = "bytes"
+	}
+	if kind != expected {
+		p.exitWithMsg(
+			"%s: types <%s> only support the kind of TagOptions <%s/message>; and you provided: <%s>",
+			p.buildIdentifierWithField(field), name, expected, reflect.TypeOf(tagOptions.Kind).Elem().Name(),
+		)
+	}
+}
+
+// buildWrapperValueInfo returns the FieldInfo of the value in the field of wrapper type.
+func (p *plugin) buildWrapperValueInfo(fieldInfo *FieldInfo) *FieldInfo {
+	valueInfo := *fieldInfo
+	valueInfo.Field = fieldInfo.Field.Message.Fields[0]
+	valueInfo.Wrapper = fieldInfo.Field
+	return &valueInfo
+}
+
+// wrapperNotNull returns the option not_null in the tags for the value of wrapper type.
+func wrapperNotNull(tagOptions *pbvalidator.TagOptions) *bool {
+	if tagOptions == nil {
+		return nil
+	}
+	switch ot := tagOptions.Kind.(type) {
+	case *pbvalidator.TagOptions_Float:
+		return ot.Float.NotNull
+	case *pbvalidator.TagOptions_Int:
+		return ot.Int.NotNull
+	case *pbvalidator.TagOptions_Uint:
+		return ot.Uint.NotNull
+	case *pbvalidator.TagOptions_Bool:
+		return ot.Bool.NotNull
+	case *pbvalidator.TagOptions_String_:
+		return ot.String_.NotNull
+	case *pbvalidator.TagOptions_Bytes:
+		return ot.Bytes.NotNull
+	}
+	return nil
+}
+
+// checkScalarNotNull checks the option not_null is not used in the tags of scalar field.
+func (p *plugin) checkScalarNotNull(field *protogen.Field, tagOptions *pbvalidator.TagOptions) {
+	if wrapperNotNull(tagOptions) != nil {
+		p.exitWithMsg("%s: the option <not_null> is only valid for the wrapper types", p.buildIdentifierWithField(field))
+	}
+}
+
+// processWrapperTags processes the tags of the value in the field of wrapper type with the tags of
+// its type. Like the other well-known types, the null wrapper only fails the option not_null.
+func (p *plugin) processWrapperTags(fieldInfo *FieldInfo) []*protovalidator.TagInfo {
+	valueInfo := p.buildWrapperValueInfo(fieldInfo)
+	tagInfos := p.getTagInfos(valueInfo)
+
+	itemName := p.getGoItemName(fieldInfo.Field)
+	for _, tagInfo := range tagInfos {
+		tagInfo.Cond = fmt.Sprintf("%s == nil || (%s)", itemName, tagInfo.Cond)
+	}
+	if notNull := wrapperNotNull(fieldInfo.TagOptions); notNull != nil && *notNull {
+		cond := fmt.Sprintf("%s != nil", itemName)
+		tagInfo := &protovalidator.TagInfo{Tag: protovalidator.TagMessageNotNull, Cond: cond, Value: nil, FieldValue: ""}
+		tagInfos = append([]*protovalidator.TagInfo{tagInfo}, tagInfos...)
+	}
+	return tagInfos
+}
+
+// getGoItemNameForInfo returns the item name of field, it's the value of wrapper if the field is in wrapper.
+func (p *plugin) getGoItemNameForInfo(fieldInfo *FieldInfo) string {
+	if fieldInfo.Wrapper != nil {
+		return p.getGoItemName(fieldInfo.Wrapper) + ".GetValue()"
+	}
+	return p.getGoItemName(fieldInfo.Field)
+}
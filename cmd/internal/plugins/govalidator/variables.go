@@ -9,6 +9,7 @@ import (
 
 	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
 	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 func (p *plugin) generateVariableForField(fieldInfo *FieldInfo) {
@@ -85,6 +86,9 @@ func (p *plugin) generateVariableForBasic(fieldInfo *FieldInfo) {
 	if fieldInfo.TagOptions == nil {
 		return
 	}
+	if isWrapperField(fieldInfo.Field) {
+		fieldInfo = p.buildWrapperValueInfo(fieldInfo)
+	}
 	switch v := fieldInfo.TagOptions.Kind.(type) {
 	case *pbvalidator.TagOptions_Float:
 		if len(v.Float.In) != 0 {
@@ -139,6 +143,40 @@ func (p *plugin) generateVariableForBasic(fieldInfo *FieldInfo) {
 				regexpPackage.Ident("MustCompile"),
 				"(`", *v.String_.Regex, "`)")
 		}
+	case *pbvalidator.TagOptions_Bytes:
+		if len(v.Bytes.In) != 0 {
+			varName := p.buildVariableNameForTagIn(fieldInfo)
+			p.encodeInAndNotInVariableValue(fieldInfo, protovalidator.TagBytesIn, varName, v.Bytes.In)
+		}
+		if len(v.Bytes.NotIn) != 0 {
+			varName := p.buildVariableNameForTagNotIn(fieldInfo)
+			p.encodeInAndNotInVariableValue(fieldInfo, protovalidator.TagBytesNotIn, varName, v.Bytes.NotIn)
+		}
+
+		if v.Bytes.Regex != nil && *v.Bytes.Regex != "" {
+			// Check the regex expression.
+			_, err := regexp.Compile(*v.Bytes.Regex)
+			if err != nil {
+				p.exitWithMsg(
+					"%s, option: <regex>; cannot compile regex expr, error: %v",
+					p.buildIdentifierWithName(fieldInfo.Name), err,
+				)
+			}
+			p.g.P("var ",
+				p.buildVariableNameForTagRegex(fieldInfo),
+				" = ",
+				regexpPackage.Ident("MustCompile"),
+				"(`", *v.Bytes.Regex, "`)")
+		}
+	case *pbvalidator.TagOptions_Any:
+		if len(v.Any.In) != 0 {
+			varName := p.buildVariableNameForTagIn(fieldInfo)
+			p.encodeInAndNotInVariableValue(fieldInfo, protovalidator.TagAnyIn, varName, v.Any.In)
+		}
+		if len(v.Any.NotIn) != 0 {
+			varName := p.buildVariableNameForTagNotIn(fieldInfo)
+			p.encodeInAndNotInVariableValue(fieldInfo, protovalidator.TagAnyNotIn, varName, v.Any.NotIn)
+		}
 	case *pbvalidator.TagOptions_Enum:
 		if len(v.Enum.In) != 0 {
 			varName := p.buildVariableNameForTagIn(fieldInfo)
@@ -187,7 +225,16 @@ func (p *plugin) encodeInAndNotInVariableValue(fieldInfo *FieldInfo, option stri
 	var s strings.Builder
 
 	s.WriteString("map[")
-	s.WriteString(p.fieldToGoType(fieldInfo.Field))
+	switch fieldInfo.Field.Desc.Kind() {
+	case protoreflect.MessageKind:
+		// The type url of google.protobuf.Any.
+		s.WriteString("string")
+	case protoreflect.BytesKind:
+		// The []byte cannot be used as map key.
+		s.WriteString("string")
+	default:
+		s.WriteString(p.fieldToGoType(fieldInfo.Field))
+	}
 	s.WriteString("]bool {")
 
 	valueOf := reflect.ValueOf(values)
@@ -213,6 +260,10 @@ func (p *plugin) encodeInAndNotInVariableValue(fieldInfo *FieldInfo, option stri
 		case reflect.String:
 			key = item.String()
 			s.WriteString(strconv.Quote(item.String()))
+		case reflect.Slice:
+			// The values of bytes.
+			key = string(item.Bytes())
+			s.WriteString(strconv.Quote(string(item.Bytes())))
 		case reflect.Int32:
 			if fieldInfo.Field.Enum != nil {
 				if _, ok := validEnums[int32(item.Int())]; !ok {
@@ -0,0 +1,106 @@
+package jsonschema
+
+import (
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/yu31/protoc-plugin/cmd/internal/generator"
+	"github.com/yu31/protoc-plugin/cmd/internal/generator/utils"
+	"github.com/yu31/protoc-plugin/cmd/internal/plugins/gojson"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+const version = "0.0.1"
+
+// The dialect of the generated schema.
+const schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+type plugin struct {
+	pp   *protogen.Plugin
+	g    *protogen.GeneratedFile
+	file *protogen.File
+
+	messages []*protogen.Message
+
+	// schemaFiles records the names of schema files that already generated, the Generate
+	// may be called more than once for the same file by the public import.
+	schemaFiles map[string]bool
+}
+
+func New() generator.Plugin {
+	return &plugin{}
+}
+
+// Name identifies the plugin.
+func (p *plugin) Name() string {
+	return "jsonschema"
+}
+
+// Version identifies the plugin version.
+func (p *plugin) Version() string {
+	return version
+}
+
+// Prepare implements generator.Preparer.
+func (p *plugin) Prepare(pp *protogen.Plugin) {
+	p.pp = pp
+	p.schemaFiles = make(map[string]bool)
+}
+
+func (p *plugin) Init(file *protogen.File) bool {
+	if len(file.Messages) == 0 {
+		return false
+	}
+	p.file = file
+	p.messages = make([]*protogen.Message, 0)
+
+	// Only generate for the messages that protoc-gen-gojson generates code for.
+	for _, msg := range utils.LoadValidMessages(file.Messages) {
+		if gojson.DescribeMessage(msg).Ignore {
+			continue
+		}
+		p.messages = append(p.messages, msg)
+	}
+	return len(p.messages) != 0
+}
+
+// Generate produces the code generated by the plugin for this file,
+// except for the imports, by calling the generator's methods P, In, and Out.
+func (p *plugin) Generate(g *protogen.GeneratedFile) {
+	p.g = g
+	for _, msg := range p.messages {
+		p.generateMessage(msg)
+	}
+}
+
+func (p *plugin) generateMessage(msg *protogen.Message) {
+	b := newBuilder()
+	schema := b.buildSchema(msg)
+
+	p.g.P("// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message ", msg.GoIdent.GoName)
+	p.g.P("// that generated by protoc-gen-gojson.")
+	p.g.P("func (this *", msg.GoIdent.GoName, ") JSONSchema() string {")
+	if strings.Contains(schema, "`") {
+		p.g.P("return ", strconv.Quote(schema))
+	} else {
+		p.g.P("return `", schema, "`")
+	}
+	p.g.P("}")
+	p.g.P()
+
+	p.generateSchemaFile(msg, schema)
+}
+
+// generateSchemaFile writes the schema of message to the file "<message>.schema.json" in the
+// same directory as the generated code, so it can be used without Go, e.g. by the frontend.
+func (p *plugin) generateSchemaFile(msg *protogen.Message, schema string) {
+	name := path.Join(path.Dir(p.file.GeneratedFilenamePrefix), msg.GoIdent.GoName+".schema.json")
+	if p.schemaFiles[name] {
+		return
+	}
+	p.schemaFiles[name] = true
+
+	f := p.pp.NewGeneratedFile(name, "")
+	f.P(schema)
+}
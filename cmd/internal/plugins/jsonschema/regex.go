@@ -0,0 +1,210 @@
+package jsonschema
+
+import (
+	"fmt"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ecmaPattern translates the regular expression in RE2 syntax that used by the validator to
+// the ECMA-262 dialect that used by the keyword "pattern". The ok is false if expr is invalid.
+//
+// The expression is parsed and printed again, so the syntax only supported by RE2 is rewritten,
+// e.g. \A, \z, (?i), (?s), (?P<name>) and [[:alpha:]]. The result may differ from expr in form.
+func ecmaPattern(expr string) (pattern string, ok bool) {
+	re, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+	var b strings.Builder
+	writeECMA(&b, re)
+	return b.String(), true
+}
+
+func writeECMA(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		b.WriteString(`[^\s\S]`)
+	case syntax.OpEmptyMatch:
+		b.WriteString(`(?:)`)
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				writeFoldRune(b, r)
+			} else {
+				writeLiteralRune(b, r)
+			}
+		}
+	case syntax.OpCharClass:
+		writeCharClass(b, re.Rune)
+	case syntax.OpAnyCharNotNL:
+		// The dot of ECMA-262 also excludes \r, U+2028 and U+2029.
+		b.WriteString(`[^\n]`)
+	case syntax.OpAnyChar:
+		b.WriteString(`[\s\S]`)
+	case syntax.OpBeginLine:
+		b.WriteString(`(?:^|(?<=\n))`)
+	case syntax.OpEndLine:
+		b.WriteString(`(?:$|(?=\n))`)
+	case syntax.OpBeginText:
+		b.WriteString(`^`)
+	case syntax.OpEndText:
+		// The $ of ECMA-262 only matches at the end of input without the flag m.
+		b.WriteString(`$`)
+	case syntax.OpWordBoundary:
+		b.WriteString(`\b`)
+	case syntax.OpNoWordBoundary:
+		b.WriteString(`\B`)
+	case syntax.OpCapture:
+		// The name of group is dropped, (?P<name>) is not supported by ECMA-262.
+		b.WriteString(`(`)
+		writeECMA(b, re.Sub[0])
+		b.WriteString(`)`)
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		writeAtom(b, re.Sub[0])
+		switch re.Op {
+		case syntax.OpStar:
+			b.WriteString(`*`)
+		case syntax.OpPlus:
+			b.WriteString(`+`)
+		case syntax.OpQuest:
+			b.WriteString(`?`)
+		default:
+			b.WriteString(`{` + strconv.Itoa(re.Min))
+			switch {
+			case re.Max == -1:
+				b.WriteString(`,`)
+			case re.Max != re.Min:
+				b.WriteString(`,` + strconv.Itoa(re.Max))
+			}
+			b.WriteString(`}`)
+		}
+		if re.Flags&syntax.NonGreedy != 0 {
+			b.WriteString(`?`)
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			if sub.Op == syntax.OpAlternate {
+				b.WriteString(`(?:`)
+				writeECMA(b, sub)
+				b.WriteString(`)`)
+			} else {
+				writeECMA(b, sub)
+			}
+		}
+	case syntax.OpAlternate:
+		for i, sub := range re.Sub {
+			if i != 0 {
+				b.WriteString(`|`)
+			}
+			writeECMA(b, sub)
+		}
+	default:
+		panic(fmt.Sprintf("jsonschema: unsupported regexp op %v", re.Op))
+	}
+}
+
+// writeAtom writes the operand of the repetition, it is grouped if it's not a single atom.
+func writeAtom(b *strings.Builder, re *syntax.Regexp) {
+	switch {
+	case re.Op == syntax.OpLiteral && len(re.Rune) == 1,
+		re.Op == syntax.OpCharClass, re.Op == syntax.OpAnyChar, re.Op == syntax.OpAnyCharNotNL,
+		re.Op == syntax.OpCapture:
+		writeECMA(b, re)
+	default:
+		b.WriteString(`(?:`)
+		writeECMA(b, re)
+		b.WriteString(`)`)
+	}
+}
+
+// writeFoldRune writes the rune that matched with case-insensitive as a class of its case folding.
+func writeFoldRune(b *strings.Builder, r rune) {
+	runes := []rune{r}
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		runes = append(runes, f)
+	}
+	if len(runes) == 1 {
+		writeLiteralRune(b, r)
+		return
+	}
+	b.WriteString(`[`)
+	for _, x := range runes {
+		writeClassRune(b, x)
+	}
+	b.WriteString(`]`)
+}
+
+// writeCharClass writes the class of ranges, the negated form is used if it's shorter.
+func writeCharClass(b *strings.Builder, ranges []rune) {
+	if len(ranges) == 2 && ranges[0] == 0 && ranges[1] == unicode.MaxRune {
+		b.WriteString(`[\s\S]`)
+		return
+	}
+
+	// The complement of ranges.
+	negated := make([]rune, 0, len(ranges)+2)
+	next := rune(0)
+	for i := 0; i < len(ranges); i += 2 {
+		if ranges[i] > next {
+			negated = append(negated, next, ranges[i]-1)
+		}
+		next = ranges[i+1] + 1
+	}
+	if next <= unicode.MaxRune {
+		negated = append(negated, next, unicode.MaxRune)
+	}
+
+	b.WriteString(`[`)
+	if len(negated) < len(ranges) {
+		b.WriteString(`^`)
+		ranges = negated
+	}
+	for i := 0; i < len(ranges); i += 2 {
+		lo, hi := ranges[i], ranges[i+1]
+		writeClassRune(b, lo)
+		switch {
+		case hi == lo:
+		case hi == lo+1:
+			writeClassRune(b, hi)
+		default:
+			b.WriteString(`-`)
+			writeClassRune(b, hi)
+		}
+	}
+	b.WriteString(`]`)
+}
+
+func writeLiteralRune(b *strings.Builder, r rune) {
+	if strings.ContainsRune(`\^$.|?*+()[]{}/`, r) {
+		b.WriteByte('\\')
+		b.WriteRune(r)
+		return
+	}
+	writeRune(b, r)
+}
+
+func writeClassRune(b *strings.Builder, r rune) {
+	if strings.ContainsRune(`\^-[]/`, r) {
+		b.WriteByte('\\')
+		b.WriteRune(r)
+		return
+	}
+	writeRune(b, r)
+}
+
+// writeRune writes the rune as is if printable, otherwise in escape sequence of ECMA-262.
+func writeRune(b *strings.Builder, r rune) {
+	switch {
+	case unicode.IsPrint(r):
+		b.WriteRune(r)
+	case r <= 0xFF:
+		fmt.Fprintf(b, `\x%02X`, r)
+	case r <= 0xFFFF:
+		fmt.Fprintf(b, `\u%04X`, r)
+	default:
+		fmt.Fprintf(b, `\u{%X}`, r)
+	}
+}
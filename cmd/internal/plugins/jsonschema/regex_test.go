@@ -0,0 +1,40 @@
+package jsonschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ecmaPattern(t *testing.T) {
+	cases := []struct {
+		Expr   string
+		Expect string
+	}{
+		{`^[a-z]+$`, `^[a-z]+$`},
+		{`\Aabc\z`, `^abc$`},
+		{`^\d{3,}-\d{2}$`, `^[0-9]{3,}-[0-9]{2}$`},
+		{`(?i)ab`, `[Aa][Bb]`},
+		{`(?s)a.b`, `a[\s\S]b`},
+		{`a.b`, `a[^\n]b`},
+		{`(?m)^a$`, `(?:^|(?<=\n))a(?:$|(?=\n))`},
+		{`(?P<year>\d{4})`, `([0-9]{4})`},
+		{`[[:alpha:]]+`, `[A-Za-z]+`},
+		{`[^/]*`, `[^\/]*`},
+		{`\Q.+\E`, `\.\+`},
+		{`(?:ab)+?`, `(?:ab)+?`},
+		{`x(a|bc)y`, `x(a|bc)y`},
+		{`a{2,5}`, `a{2,5}`},
+		{`\x00`, `\x00`},
+		{`\x{2028}`, `\u2028`},
+		{`[é-ü]`, `[é-ü]`},
+	}
+	for _, c := range cases {
+		pattern, ok := ecmaPattern(c.Expr)
+		require.True(t, ok, c.Expr)
+		require.Equal(t, c.Expect, pattern, c.Expr)
+	}
+
+	_, ok := ecmaPattern(`a(b`)
+	require.False(t, ok)
+}
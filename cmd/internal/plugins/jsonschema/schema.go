@@ -0,0 +1,160 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/yu31/protoc-plugin/cmd/internal/plugins/gojson"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// object is a json object that keeps the keys in order of insertion,
+// so that the generated schema is stable and readable.
+type object struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newObject() *object {
+	return &object{values: make(map[string]interface{})}
+}
+
+func (o *object) set(key string, value interface{}) *object {
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+	return o
+}
+
+func (o *object) get(key string) (interface{}, bool) {
+	v, ok := o.values[key]
+	return v, ok
+}
+
+func (o *object) MarshalJSON() ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	buf.WriteByte('{')
+	for i, key := range o.keys {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		if err := encodeValue(buf, key); err != nil {
+			return nil, err
+		}
+		buf.WriteByte(':')
+		if err := encodeValue(buf, o.values[key]); err != nil {
+			return nil, err
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func encodeValue(buf *bytes.Buffer, v interface{}) error {
+	enc := json.NewEncoder(buf)
+	// Keeps the regex pattern readable.
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	// Removes the newline that added by Encode.
+	buf.Truncate(buf.Len() - 1)
+	return nil
+}
+
+// builder builds the schema of a message, the schemas of all referenced
+// messages are placed in "$defs" and keyed by the message full name.
+type builder struct {
+	defs *object
+}
+
+func newBuilder() *builder {
+	return &builder{defs: newObject()}
+}
+
+func (b *builder) buildSchema(msg *protogen.Message) string {
+	root := newObject()
+	root.set("$schema", schemaDialect)
+	root.set("$ref", b.messageRef(msg))
+	root.set("$defs", b.defs)
+
+	buf := bytes.NewBuffer(nil)
+	if err := encodeValue(buf, root); err != nil {
+		panic(fmt.Sprintf("jsonschema: encode schema of message %s: %v", msg.Desc.FullName(), err))
+	}
+	out := bytes.NewBuffer(nil)
+	if err := json.Indent(out, buf.Bytes(), "", "  "); err != nil {
+		panic(fmt.Sprintf("jsonschema: indent schema of message %s: %v", msg.Desc.FullName(), err))
+	}
+	return out.String()
+}
+
+// messageRef returns the reference to the schema of the message in "$defs".
+func (b *builder) messageRef(msg *protogen.Message) string {
+	name := string(msg.Desc.FullName())
+	if _, ok := b.defs.get(name); !ok {
+		// Sets a placeholder first to stop the recursion of the self-referencing message.
+		b.defs.set(name, newObject())
+		b.defs.set(name, b.messageSchema(msg))
+	}
+	return "#/$defs/" + name
+}
+
+func (b *builder) messageSchema(msg *protogen.Message) *object {
+	if msg.Desc.ParentFile().Package() == "google.protobuf" {
+		// The well-known types are not encoded by protoc-gen-gojson, accept any value.
+		return newObject()
+	}
+	info := gojson.DescribeMessage(msg)
+	if info.Ignore {
+		// The message is encoded by encoding/json, accept any value.
+		return newObject()
+	}
+
+	properties := newObject()
+	required := make([]string, 0)
+
+	for _, field := range info.Fields {
+		if field.Oneof != nil {
+			properties.set(field.Key, b.oneofSchema(field))
+			continue
+		}
+		properties.set(field.Key, b.fieldSchema(field))
+		if field.Required {
+			required = append(required, field.Key)
+		}
+	}
+
+	s := newObject()
+	s.set("type", "object")
+	s.set("properties", properties)
+	if len(required) != 0 {
+		s.set("required", required)
+	}
+	if info.DisallowUnknownFields {
+		s.set("additionalProperties", false)
+	}
+	return s
+}
+
+// oneofSchema returns the schema of the oneof that not hide the key, it encoded
+// as an object that contains the key of the field that is set.
+func (b *builder) oneofSchema(info *gojson.FieldInfo) *object {
+	properties := newObject()
+	for _, field := range info.OneofFields {
+		properties.set(field.Key, b.fieldSchema(field))
+	}
+	s := newObject()
+	if info.Omitempty || loadOneofNotNull(info.Oneof) {
+		s.set("type", "object")
+	} else {
+		s.set("type", []string{"object", "null"})
+	}
+	s.set("properties", properties)
+	s.set("minProperties", 1)
+	s.set("maxProperties", 1)
+	s.set("additionalProperties", false)
+	return s
+}
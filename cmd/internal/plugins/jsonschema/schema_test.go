@@ -0,0 +1,496 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yu31/protoc-plugin/xgo/tests/gojsontest"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Test_Schema_GoJSON validates the output of the messages that generated by protoc-gen-gojson
+// against the schemas that built from the same proto file.
+func Test_Schema_GoJSON(t *testing.T) {
+	file := loadProtogenFile(t, gojsontest.File_xgo_tests_gojsontest_gojson_test_proto)
+
+	var messages []*protogen.Message
+	var walk func(msgs []*protogen.Message)
+	walk = func(msgs []*protogen.Message) {
+		for _, msg := range msgs {
+			if msg.Desc.IsMapEntry() {
+				continue
+			}
+			messages = append(messages, msg)
+			walk(msg.Messages)
+		}
+	}
+	walk(file.Messages)
+	require.NotEqual(t, 0, len(messages))
+
+	for _, msg := range messages {
+		var schema map[string]interface{}
+		decodeJSONValue(t, []byte(newBuilder().buildSchema(msg)), &schema)
+
+		mt, err := protoregistry.GlobalTypes.FindMessageByName(msg.Desc.FullName())
+		require.Nil(t, err)
+
+		for _, values := range []fixtureValues{zeroValues, normalValues, extremeValues} {
+			m := mt.New()
+			populateMessage(m, values, 2)
+
+			marshaler, ok := m.Interface().(json.Marshaler)
+			if !ok {
+				// The message that ignored by protoc-gen-gojson.
+				break
+			}
+			b, err := marshaler.MarshalJSON()
+			if err != nil {
+				// e.g. the NaN and Infinity with option non_finite_float NonFiniteError.
+				continue
+			}
+			var data interface{}
+			decodeJSONValue(t, b, &data)
+			v := &schemaValidator{t: t, root: schema}
+			require.Nil(t, v.validate(schema, data, "$"), "%s: %s", msg.Desc.FullName(), string(b))
+		}
+	}
+}
+
+func Test_Schema_Validator(t *testing.T) {
+	var schema map[string]interface{}
+	decodeJSONValue(t, []byte(`{"anyOf":[{"type":"integer","minimum":1,"maximum":9223372036854775807},{"type":"string","enum":["NaN"]}]}`), &schema)
+
+	cases := []struct {
+		Data  string
+		Valid bool
+	}{
+		{`1`, true},
+		{`9223372036854775807`, true},
+		{`9223372036854775808`, false},
+		{`0`, false},
+		{`1.5`, false},
+		{`"NaN"`, true},
+		{`"+Inf"`, false},
+		{`null`, false},
+	}
+	for _, c := range cases {
+		var data interface{}
+		decodeJSONValue(t, []byte(c.Data), &data)
+		v := &schemaValidator{t: t, root: schema}
+		require.Equal(t, c.Valid, v.validate(schema, data, "$") == nil, c.Data)
+	}
+}
+
+// loadProtogenFile returns the protogen.File of fd as if it's passed to a plugin.
+func loadProtogenFile(t *testing.T, fd protoreflect.FileDescriptor) *protogen.File {
+	files := make([]*descriptorpb.FileDescriptorProto, 0)
+	seen := make(map[string]bool)
+	var add func(fd protoreflect.FileDescriptor)
+	add = func(fd protoreflect.FileDescriptor) {
+		path := fd.Path()
+		if seen[path] {
+			return
+		}
+		seen[path] = true
+		if fd.IsPlaceholder() {
+			// The imported files are registered by the path that relative to other include directory,
+			// e.g. "json.proto" for "proto/json.proto" and "xgo/tests/..." for "tests/...".
+			var err error
+			for _, name := range []string{strings.TrimPrefix(path, "proto/"), "xgo/" + path} {
+				if fd, err = protoregistry.GlobalFiles.FindFileByPath(name); err == nil {
+					break
+				}
+			}
+			require.Nil(t, err, path)
+		}
+		imports := fd.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		fdp := protodesc.ToFileDescriptorProto(fd)
+		fdp.Name = proto.String(path)
+		files = append(files, fdp)
+	}
+	add(fd)
+
+	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{fd.Path()},
+		Parameter:      proto.String("paths=source_relative"),
+		ProtoFile:      files,
+	})
+	require.Nil(t, err)
+	return gen.FilesByPath[fd.Path()]
+}
+
+type fixtureValues struct {
+	zero     bool
+	int      int64
+	uint     uint64
+	float    float64
+	str      string
+	bytes    []byte
+	boolean  bool
+	elements int
+}
+
+var (
+	zeroValues   = fixtureValues{zero: true}
+	normalValues = fixtureValues{int: -7, uint: 7, float: 1.5, str: "s<&>", bytes: []byte("b"), boolean: true, elements: 2}
+	// The extreme values, the floats are non-finite and replaced by the next one for each element.
+	extremeValues = fixtureValues{int: math.MinInt64, uint: math.MaxUint64, float: math.NaN(), str: "é\u2028\"\n", bytes: []byte{0xff}, boolean: false, elements: 3}
+)
+
+// populateMessage sets all fields except the well-known types, only the first field of each oneof is set.
+// The optional scalar fields are set with the default value for the zero values, the unset
+// ones are not supported by the generated MarshalJSON unless omitempty.
+func populateMessage(m protoreflect.Message, values fixtureValues, depth int) {
+	if depth == 0 {
+		return
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if values.zero {
+			if fd.HasOptionalKeyword() && fd.Message() == nil {
+				m.Set(fd, fd.Default())
+			}
+			continue
+		}
+		if oneof := fd.ContainingOneof(); oneof != nil && oneof.Fields().Get(0) != fd {
+			continue
+		}
+		if msg := fd.Message(); msg != nil && !fd.IsMap() && msg.ParentFile().Package() == "google.protobuf" {
+			continue
+		}
+		switch {
+		case fd.IsList():
+			list := m.Mutable(fd).List()
+			for j := 0; j < values.elements; j++ {
+				if fd.Message() != nil {
+					populateMessage(list.AppendMutable().Message(), values, depth-1)
+				} else {
+					list.Append(fixtureValue(fd, values, j))
+				}
+			}
+		case fd.IsMap():
+			if vd := fd.MapValue(); vd.Message() != nil && vd.Message().ParentFile().Package() == "google.protobuf" {
+				continue
+			}
+			mp := m.Mutable(fd).Map()
+			key := fixtureValue(fd.MapKey(), values, 0).MapKey()
+			if fd.MapValue().Message() != nil {
+				populateMessage(mp.Mutable(key).Message(), values, depth-1)
+			} else {
+				mp.Set(key, fixtureValue(fd.MapValue(), values, 0))
+			}
+		case fd.Message() != nil:
+			populateMessage(m.Mutable(fd).Message(), values, depth-1)
+		default:
+			m.Set(fd, fixtureValue(fd, values, 0))
+		}
+	}
+}
+
+func fixtureValue(fd protoreflect.FieldDescriptor, values fixtureValues, i int) protoreflect.Value {
+	switch fd.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if values.int < math.MinInt32 {
+			return protoreflect.ValueOfInt32(math.MinInt32)
+		}
+		return protoreflect.ValueOfInt32(int32(values.int))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return protoreflect.ValueOfInt64(values.int)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if values.uint > math.MaxUint32 {
+			return protoreflect.ValueOfUint32(math.MaxUint32)
+		}
+		return protoreflect.ValueOfUint32(uint32(values.uint))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return protoreflect.ValueOfUint64(values.uint)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		v := values.float
+		if math.IsNaN(v) {
+			v = []float64{math.NaN(), math.Inf(1), math.Inf(-1)}[i%3]
+		}
+		if fd.Kind() == protoreflect.FloatKind {
+			return protoreflect.ValueOfFloat32(float32(v))
+		}
+		return protoreflect.ValueOfFloat64(v)
+	case protoreflect.BoolKind:
+		return protoreflect.ValueOfBool(values.boolean)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(values.str)
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes(values.bytes)
+	case protoreflect.EnumKind:
+		enumValues := fd.Enum().Values()
+		return protoreflect.ValueOfEnum(enumValues.Get(enumValues.Len() - 1).Number())
+	}
+	panic(fmt.Sprintf("unsupported kind %v", fd.Kind()))
+}
+
+func decodeJSONValue(t *testing.T, b []byte, v interface{}) {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	require.Nil(t, dec.Decode(v), string(b))
+}
+
+// schemaValidator validates the data against the keywords that used by the generated schema.
+// The annotations such as format and contentEncoding are not validated.
+type schemaValidator struct {
+	t    *testing.T
+	root map[string]interface{}
+}
+
+func (v *schemaValidator) validate(schema map[string]interface{}, data interface{}, path string) error {
+	for key, value := range schema {
+		if err := v.validateKeyword(schema, key, value, data, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v *schemaValidator) validateKeyword(schema map[string]interface{}, key string, value interface{}, data interface{}, path string) error {
+	switch key {
+	case "$schema", "$defs", "format", "contentEncoding", "contentMediaType":
+		return nil
+	case "$ref":
+		ref := value.(string)
+		require.True(v.t, strings.HasPrefix(ref, "#/$defs/"), ref)
+		def, ok := v.root["$defs"].(map[string]interface{})[strings.TrimPrefix(ref, "#/$defs/")]
+		require.True(v.t, ok, ref)
+		return v.validate(def.(map[string]interface{}), data, path)
+	case "type":
+		types, ok := value.([]interface{})
+		if !ok {
+			types = []interface{}{value}
+		}
+		for _, typ := range types {
+			if jsonType(data, typ.(string)) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %v is not type %v", path, data, value)
+	case "anyOf", "allOf":
+		var errs []string
+		for _, sub := range value.([]interface{}) {
+			err := v.validate(sub.(map[string]interface{}), data, path)
+			if err == nil && key == "anyOf" {
+				return nil
+			}
+			if err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+		if len(errs) == 0 {
+			return nil
+		}
+		return fmt.Errorf("%s: %s failed: %s", path, key, strings.Join(errs, "; "))
+	case "not":
+		if v.validate(value.(map[string]interface{}), data, path) == nil {
+			return fmt.Errorf("%s: %v matches not", path, data)
+		}
+		return nil
+	case "const":
+		if !jsonEqual(value, data) {
+			return fmt.Errorf("%s: %v is not %v", path, data, value)
+		}
+		return nil
+	case "enum":
+		for _, x := range value.([]interface{}) {
+			if jsonEqual(x, data) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s: %v is not in %v", path, data, value)
+	case "minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum":
+		n, ok := data.(json.Number)
+		if !ok {
+			return nil
+		}
+		c := numberRat(n).Cmp(numberRat(value.(json.Number)))
+		valid := map[string]bool{"minimum": c >= 0, "maximum": c <= 0, "exclusiveMinimum": c > 0, "exclusiveMaximum": c < 0}[key]
+		if !valid {
+			return fmt.Errorf("%s: %v violates %s %v", path, data, key, value)
+		}
+		return nil
+	case "minLength", "maxLength":
+		s, ok := data.(string)
+		if !ok {
+			return nil
+		}
+		return checkCount(path, key, utf8.RuneCountInString(s), value)
+	case "pattern":
+		s, ok := data.(string)
+		if !ok {
+			return nil
+		}
+		// Rewrites the unicode escapes of ECMA-262 to RE2.
+		pattern := regexp.MustCompile(`\\u\{?([0-9A-Fa-f]+)\}?`).ReplaceAllString(value.(string), `\x{$1}`)
+		if !regexp.MustCompile(pattern).MatchString(s) {
+			return fmt.Errorf("%s: %q does not match %s", path, s, value)
+		}
+		return nil
+	case "properties", "additionalProperties", "required", "propertyNames", "minProperties", "maxProperties":
+		obj, ok := data.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		return v.validateObjectKeyword(schema, key, value, obj, path)
+	case "items", "minItems", "maxItems", "uniqueItems":
+		list, ok := data.([]interface{})
+		if !ok {
+			return nil
+		}
+		switch key {
+		case "items":
+			for i, item := range list {
+				if err := v.validate(value.(map[string]interface{}), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+					return err
+				}
+			}
+		case "uniqueItems":
+			for i := range list {
+				for j := i + 1; j < len(list); j++ {
+					if value.(bool) && jsonEqual(list[i], list[j]) {
+						return fmt.Errorf("%s: items are not unique", path)
+					}
+				}
+			}
+		default:
+			return checkCount(path, key, len(list), value)
+		}
+		return nil
+	}
+	v.t.Fatalf("unsupported keyword %q", key)
+	return nil
+}
+
+func (v *schemaValidator) validateObjectKeyword(schema map[string]interface{}, key string, value interface{}, obj map[string]interface{}, path string) error {
+	properties, _ := schema["properties"].(map[string]interface{})
+	switch key {
+	case "properties":
+		for k, sub := range properties {
+			if x, ok := obj[k]; ok {
+				if err := v.validate(sub.(map[string]interface{}), x, path+"."+k); err != nil {
+					return err
+				}
+			}
+		}
+	case "additionalProperties":
+		for k, x := range obj {
+			if _, ok := properties[k]; ok {
+				continue
+			}
+			if b, ok := value.(bool); ok {
+				if !b {
+					return fmt.Errorf("%s: unknown property %q", path, k)
+				}
+				continue
+			}
+			if err := v.validate(value.(map[string]interface{}), x, path+"."+k); err != nil {
+				return err
+			}
+		}
+	case "required":
+		for _, k := range value.([]interface{}) {
+			if _, ok := obj[k.(string)]; !ok {
+				return fmt.Errorf("%s: missing property %q", path, k)
+			}
+		}
+	case "propertyNames":
+		for k := range obj {
+			if err := v.validate(value.(map[string]interface{}), k, path+"."+k); err != nil {
+				return err
+			}
+		}
+	default:
+		return checkCount(path, key, len(obj), value)
+	}
+	return nil
+}
+
+func checkCount(path string, key string, n int, value interface{}) error {
+	limit, _ := value.(json.Number).Int64()
+	if strings.HasPrefix(key, "min") && int64(n) < limit || strings.HasPrefix(key, "max") && int64(n) > limit {
+		return fmt.Errorf("%s: %d violates %s %d", path, n, key, limit)
+	}
+	return nil
+}
+
+func jsonType(data interface{}, typ string) bool {
+	switch x := data.(type) {
+	case nil:
+		return typ == "null"
+	case bool:
+		return typ == "boolean"
+	case string:
+		return typ == "string"
+	case json.Number:
+		return typ == "number" || typ == "integer" && numberRat(x).IsInt()
+	case []interface{}:
+		return typ == "array"
+	case map[string]interface{}:
+		return typ == "object"
+	}
+	return false
+}
+
+func jsonEqual(a, b interface{}) bool {
+	x, ok1 := a.(json.Number)
+	y, ok2 := b.(json.Number)
+	if ok1 && ok2 {
+		return numberRat(x).Cmp(numberRat(y)) == 0
+	}
+	if ok1 || ok2 {
+		return false
+	}
+	switch x := a.(type) {
+	case []interface{}:
+		y, ok := b.([]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !jsonEqual(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]interface{}:
+		y, ok := b.(map[string]interface{})
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for k := range x {
+			if !jsonEqual(x[k], y[k]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+func numberRat(n json.Number) *big.Rat {
+	r, ok := new(big.Rat).SetString(n.String())
+	if !ok {
+		panic("invalid number " + n.String())
+	}
+	return r
+}
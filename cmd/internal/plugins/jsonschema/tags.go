@@ -0,0 +1,454 @@
+package jsonschema
+
+import (
+	"math"
+	"regexp"
+
+	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+)
+
+// The accepted strings of strconv.ParseBool, used by string tag `boolean`.
+var booleanStrings = []string{"1", "t", "T", "TRUE", "true", "True", "0", "f", "F", "FALSE", "false", "False"}
+
+// loadFieldTags returns the validator tags of the field. The tags that with `check_if`
+// are ignored because the condition can't be described by the schema.
+func loadFieldTags(field *protogen.Field) *pbvalidator.TagOptions {
+	options, _ := proto.GetExtension(field.Desc.Options(), pbvalidator.E_Field).(*pbvalidator.ValidOptions)
+	if options == nil || options.CheckIf != nil {
+		return nil
+	}
+	return options.Tags
+}
+
+func loadOneofNotNull(oneof *protogen.Oneof) bool {
+	options, _ := proto.GetExtension(oneof.Desc.Options(), pbvalidator.E_Oneof).(*pbvalidator.ValidOptions)
+	if options == nil || options.CheckIf != nil {
+		return false
+	}
+	return options.Tags.GetOneof().GetNotNull()
+}
+
+// setExcluded sets the values that not allowed.
+func setExcluded(s *object, values []interface{}) {
+	switch len(values) {
+	case 0:
+	case 1:
+		s.set("not", newObject().set("const", values[0]))
+	default:
+		s.set("not", newObject().set("enum", values))
+	}
+}
+
+// setLowerBound sets the keyword key such as "minimum" if the value is tighter than the existing one.
+func setLowerBound(s *object, key string, v int64) {
+	if x, ok := s.get(key); ok && x.(int64) >= v {
+		return
+	}
+	s.set(key, v)
+}
+
+// setUpperBound sets the keyword key such as "maximum" if the value is tighter than the existing one.
+func setUpperBound(s *object, key string, v int64) {
+	if x, ok := s.get(key); ok && x.(int64) <= v {
+		return
+	}
+	s.set(key, v)
+}
+
+func setUintMinimum(s *object, v uint64) {
+	if x, ok := s.get("minimum"); ok && x.(uint64) >= v {
+		return
+	}
+	s.set("minimum", v)
+}
+
+func setUintMaximum(s *object, v uint64) {
+	if x, ok := s.get("maximum"); ok && x.(uint64) <= v {
+		return
+	}
+	s.set("maximum", v)
+}
+
+// applyLengthTags sets the length constraints with the keywords minKey and maxKey, the tighter
+// bound is kept if both gt and gte (or lt and lte) are specified. The negative bounds are ignored.
+func applyLengthTags(s *object, minKey, maxKey string, eq, gt, gte, lt, lte *int64) {
+	if eq != nil && *eq >= 0 {
+		setLowerBound(s, minKey, *eq)
+		setUpperBound(s, maxKey, *eq)
+	}
+	if gt != nil && *gt >= 0 && *gt < math.MaxInt64 {
+		setLowerBound(s, minKey, *gt+1)
+	}
+	if gte != nil && *gte >= 0 {
+		setLowerBound(s, minKey, *gte)
+	}
+	if lt != nil && *lt > 0 {
+		setUpperBound(s, maxKey, *lt-1)
+	}
+	if lte != nil && *lte >= 0 {
+		setUpperBound(s, maxKey, *lte)
+	}
+}
+
+func applyIntTags(s *object, tags *pbvalidator.IntTags) {
+	if tags == nil {
+		return
+	}
+	if tags.Eq != nil {
+		s.set("const", *tags.Eq)
+	}
+	// The integer bounds are inclusive, the exclusive keywords are used only if the bound overflows.
+	if tags.Gt != nil {
+		if *tags.Gt < math.MaxInt64 {
+			setLowerBound(s, "minimum", *tags.Gt+1)
+		} else {
+			s.set("exclusiveMinimum", *tags.Gt)
+		}
+	}
+	if tags.Gte != nil {
+		setLowerBound(s, "minimum", *tags.Gte)
+	}
+	if tags.Lt != nil {
+		if *tags.Lt > math.MinInt64 {
+			setUpperBound(s, "maximum", *tags.Lt-1)
+		} else {
+			s.set("exclusiveMaximum", *tags.Lt)
+		}
+	}
+	if tags.Lte != nil {
+		setUpperBound(s, "maximum", *tags.Lte)
+	}
+	if len(tags.In) != 0 {
+		s.set("enum", tags.In)
+	}
+
+	excluded := make([]interface{}, 0, len(tags.NotIn)+1)
+	if tags.Ne != nil {
+		excluded = append(excluded, *tags.Ne)
+	}
+	for _, v := range tags.NotIn {
+		excluded = append(excluded, v)
+	}
+	setExcluded(s, excluded)
+}
+
+func applyUintTags(s *object, tags *pbvalidator.UintTags) {
+	if tags == nil {
+		return
+	}
+	if tags.Eq != nil {
+		s.set("const", *tags.Eq)
+	}
+	if tags.Gt != nil {
+		if *tags.Gt < math.MaxUint64 {
+			setUintMinimum(s, *tags.Gt+1)
+		} else {
+			s.set("exclusiveMinimum", *tags.Gt)
+		}
+	}
+	if tags.Gte != nil {
+		setUintMinimum(s, *tags.Gte)
+	}
+	if tags.Lt != nil {
+		if *tags.Lt > 0 {
+			setUintMaximum(s, *tags.Lt-1)
+		} else {
+			s.set("exclusiveMaximum", *tags.Lt)
+		}
+	}
+	if tags.Lte != nil {
+		setUintMaximum(s, *tags.Lte)
+	}
+	if len(tags.In) != 0 {
+		s.set("enum", tags.In)
+	}
+
+	excluded := make([]interface{}, 0, len(tags.NotIn)+1)
+	if tags.Ne != nil {
+		excluded = append(excluded, *tags.Ne)
+	}
+	for _, v := range tags.NotIn {
+		excluded = append(excluded, v)
+	}
+	setExcluded(s, excluded)
+}
+
+func applyFloatTags(s *object, tags *pbvalidator.FloatTags) {
+	if tags == nil {
+		return
+	}
+	if tags.Eq != nil {
+		s.set("const", *tags.Eq)
+	}
+	if tags.Gt != nil {
+		s.set("exclusiveMinimum", *tags.Gt)
+	}
+	if tags.Gte != nil {
+		s.set("minimum", *tags.Gte)
+	}
+	if tags.Lt != nil {
+		s.set("exclusiveMaximum", *tags.Lt)
+	}
+	if tags.Lte != nil {
+		s.set("maximum", *tags.Lte)
+	}
+	if len(tags.In) != 0 {
+		s.set("enum", tags.In)
+	}
+
+	excluded := make([]interface{}, 0, len(tags.NotIn)+1)
+	if tags.Ne != nil {
+		excluded = append(excluded, *tags.Ne)
+	}
+	for _, v := range tags.NotIn {
+		excluded = append(excluded, v)
+	}
+	setExcluded(s, excluded)
+}
+
+// nonFiniteAllowed returns the strings of NaN, +Inf and -Inf in names that allowed by the validator tags.
+// The tags that not described by the schema are ignored, e.g. range, multiple_of and decimal_places.
+func nonFiniteAllowed(names []string, tags *pbvalidator.FloatTags) []string {
+	if tags.GetFinite() {
+		return nil
+	}
+	values := []float64{math.NaN(), math.Inf(1), math.Inf(-1)}
+	allowed := make([]string, 0, len(names))
+	for i, name := range names {
+		if floatAllowed(values[i], tags) {
+			allowed = append(allowed, name)
+		}
+	}
+	return allowed
+}
+
+// floatAllowed reports whether the float value is allowed by the validator tags.
+func floatAllowed(v float64, tags *pbvalidator.FloatTags) bool {
+	if tags == nil {
+		return true
+	}
+	switch {
+	case tags.Eq != nil && v != *tags.Eq:
+		return false
+	case tags.Ne != nil && v == *tags.Ne:
+		return false
+	case tags.Gt != nil && !(v > *tags.Gt):
+		return false
+	case tags.Gte != nil && !(v >= *tags.Gte):
+		return false
+	case tags.Lt != nil && !(v < *tags.Lt):
+		return false
+	case tags.Lte != nil && !(v <= *tags.Lte):
+		return false
+	}
+	if len(tags.In) != 0 && !containsFloat64(tags.In, v) {
+		return false
+	}
+	if containsFloat64(tags.NotIn, v) {
+		return false
+	}
+	return true
+}
+
+func containsFloat64(a []float64, v float64) bool {
+	for _, x := range a {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// enumNumberAllowed reports whether the enum number is allowed by the validator tags.
+func enumNumberAllowed(n int32, tags *pbvalidator.EnumTags) bool {
+	if tags == nil {
+		return true
+	}
+	switch {
+	case tags.Eq != nil && n != *tags.Eq:
+		return false
+	case tags.Ne != nil && n == *tags.Ne:
+		return false
+	case tags.Gt != nil && n <= *tags.Gt:
+		return false
+	case tags.Gte != nil && n < *tags.Gte:
+		return false
+	case tags.Lt != nil && n >= *tags.Lt:
+		return false
+	case tags.Lte != nil && n > *tags.Lte:
+		return false
+	}
+	if len(tags.In) != 0 && !containsInt32(tags.In, n) {
+		return false
+	}
+	if containsInt32(tags.NotIn, n) {
+		return false
+	}
+	return true
+}
+
+func containsInt32(a []int32, n int32) bool {
+	for _, x := range a {
+		if x == n {
+			return true
+		}
+	}
+	return false
+}
+
+// applyStringTags translates the string tags to schema keywords. The tags that can't be
+// described by the schema are ignored, e.g. byte_len, lt, gt and datetime.
+func applyStringTags(s *object, tags *pbvalidator.StringTags) {
+	if tags == nil {
+		return
+	}
+	if tags.Eq != nil {
+		s.set("const", *tags.Eq)
+	}
+	if len(tags.In) != 0 {
+		s.set("enum", tags.In)
+	}
+	excluded := make([]interface{}, 0, len(tags.NotIn)+1)
+	if tags.Ne != nil {
+		excluded = append(excluded, *tags.Ne)
+	}
+	for _, v := range tags.NotIn {
+		excluded = append(excluded, v)
+	}
+
+	// Lengths. Both the validator and the schema count the length in characters.
+	applyLengthTags(s, "minLength", "maxLength", tags.CharLenEq, tags.CharLenGt, tags.CharLenGte, tags.CharLenLt, tags.CharLenLte)
+
+	// Patterns.
+	patterns := make([]string, 0)
+	notPatterns := make([]string, 0)
+	if tags.Regex != nil {
+		// The regex in RE2 syntax is translated to ECMA-262, it's ignored if invalid.
+		if pattern, ok := ecmaPattern(*tags.Regex); ok {
+			patterns = append(patterns, pattern)
+		}
+	}
+	if tags.Prefix != nil {
+		patterns = append(patterns, "^"+regexp.QuoteMeta(*tags.Prefix))
+	}
+	if tags.Suffix != nil {
+		patterns = append(patterns, regexp.QuoteMeta(*tags.Suffix)+"$")
+	}
+	if tags.Contains != nil {
+		patterns = append(patterns, regexp.QuoteMeta(*tags.Contains))
+	}
+	if tags.NoPrefix != nil {
+		notPatterns = append(notPatterns, "^"+regexp.QuoteMeta(*tags.NoPrefix))
+	}
+	if tags.NoSuffix != nil {
+		notPatterns = append(notPatterns, regexp.QuoteMeta(*tags.NoSuffix)+"$")
+	}
+	if tags.NotContains != nil {
+		notPatterns = append(notPatterns, regexp.QuoteMeta(*tags.NotContains))
+	}
+	if tags.GetAscii() {
+		patterns = append(patterns, `^[\x00-\x7F]*$`)
+	}
+	if tags.GetPrintAscii() {
+		patterns = append(patterns, `^[^\x00-\x20]*$`)
+	}
+	if tags.GetAlpha() {
+		patterns = append(patterns, "^[a-zA-Z]*$")
+	}
+	if tags.GetNumber() {
+		patterns = append(patterns, "^[0-9]*$")
+	}
+	if tags.GetAlphaNumber() {
+		patterns = append(patterns, "^[a-zA-Z0-9]*$")
+	}
+	if tags.GetHexadecimal() {
+		patterns = append(patterns, "^(0[xX])?[0-9a-fA-F]+$")
+	}
+	if tags.GetBoolean() {
+		s.set("enum", booleanStrings)
+	}
+
+	// Formats.
+	formats := make([]string, 0)
+	switch {
+	case tags.GetIp(), tags.GetIpAddr():
+		formats = append(formats, "ipv4", "ipv6")
+	case tags.GetIpv4(), tags.GetIp4Addr():
+		formats = append(formats, "ipv4")
+	case tags.GetIpv6(), tags.GetIp6Addr():
+		formats = append(formats, "ipv6")
+	}
+	if tags.GetHostname() || tags.GetHostnameRfc1123() || tags.GetFqdn() {
+		formats = append(formats, "hostname")
+	}
+	if tags.GetUri() || tags.GetUrl() {
+		formats = append(formats, "uri")
+	}
+	if tags.GetEmail() {
+		formats = append(formats, "email")
+	}
+	if tags.GetUuid() || tags.GetUuid1() || tags.GetUuid3() || tags.GetUuid4() || tags.GetUuid5() {
+		formats = append(formats, "uuid")
+	}
+	if tags.GetJson() {
+		s.set("contentMediaType", "application/json")
+	}
+	if tags.GetBase64() {
+		s.set("contentEncoding", "base64")
+	}
+	if tags.GetBase64Url() {
+		s.set("contentEncoding", "base64url")
+	}
+
+	if len(formats) == 2 && formats[0] == "ipv4" && formats[1] == "ipv6" {
+		// Either ipv4 or ipv6.
+		s.set("anyOf", []*object{newObject().set("format", "ipv4"), newObject().set("format", "ipv6")})
+		formats = formats[2:]
+	}
+
+	// The schema can only have one of each keyword, the rest are placed in "allOf".
+	allOf := make([]*object, 0)
+	for i, pattern := range patterns {
+		if i == 0 {
+			s.set("pattern", pattern)
+		} else {
+			allOf = append(allOf, newObject().set("pattern", pattern))
+		}
+	}
+	for i, format := range formats {
+		if i == 0 {
+			s.set("format", format)
+		} else {
+			allOf = append(allOf, newObject().set("format", format))
+		}
+	}
+	for _, pattern := range notPatterns {
+		allOf = append(allOf, newObject().set("not", newObject().set("pattern", pattern)))
+	}
+	if len(allOf) != 0 {
+		s.set("allOf", allOf)
+	}
+	setExcluded(s, excluded)
+}
+
+func applyRepeatedTags(s *object, field *protogen.Field, tags *pbvalidator.RepeatedTags) {
+	if tags == nil {
+		return
+	}
+	applyLengthTags(s, "minItems", "maxItems", tags.LenEq, tags.LenGt, tags.LenGte, tags.LenLt, tags.LenLte)
+	// The unique of message items are compared by pointer in validator.
+	if tags.GetUnique() && field.Message == nil {
+		s.set("uniqueItems", true)
+	}
+}
+
+// applyMapTags sets the length constraints with the keywords minKey and maxKey, they're
+// different for the map encoded as object and array.
+func applyMapTags(s *object, tags *pbvalidator.MapTags, minKey, maxKey string) {
+	if tags == nil {
+		return
+	}
+	applyLengthTags(s, minKey, maxKey, tags.LenEq, tags.LenGt, tags.LenGte, tags.LenLt, tags.LenLte)
+}
@@ -0,0 +1,208 @@
+package jsonschema
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/yu31/protoc-plugin/cmd/internal/plugins/gojson"
+	"github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (b *builder) fieldSchema(info *gojson.FieldInfo) *object {
+	field := info.Field
+	tags := loadFieldTags(field)
+
+	switch {
+	case field.Desc.IsMap():
+		return b.mapSchema(info, tags.GetMap())
+	case field.Desc.IsList():
+		return b.listSchema(info, tags.GetRepeated())
+	}
+
+	s := b.valueSchema(field, info, tags)
+	if info.Codec != nil {
+		return s
+	}
+	switch field.Desc.Kind() {
+	case protoreflect.MessageKind:
+		// The nil message is encoded as null if not omitempty.
+		if !info.Omitempty && !tags.GetMessage().GetNotNull() {
+			s = newObject().set("anyOf", []*object{s, newObject().set("type", "null")})
+		}
+	case protoreflect.BytesKind:
+		// The nil bytes is encoded as null if not omitempty.
+		if !info.Omitempty {
+			s.set("type", []string{"string", "null"})
+		}
+	}
+	return s
+}
+
+func (b *builder) listSchema(info *gojson.FieldInfo, tags *pbvalidator.RepeatedTags) *object {
+	field := info.Field
+
+	s := newObject()
+	if info.Omitempty || tags.GetNotNull() {
+		s.set("type", "array")
+	} else {
+		s.set("type", []string{"array", "null"})
+	}
+	s.set("items", b.valueSchema(field, info, tags.GetItem()))
+	applyRepeatedTags(s, field, tags)
+	return s
+}
+
+func (b *builder) mapSchema(info *gojson.FieldInfo, tags *pbvalidator.MapTags) *object {
+	field := info.Field
+	keyField := field.Message.Fields[0]
+	valueField := field.Message.Fields[1]
+
+	if info.MapAsPairs {
+		return b.mapPairsSchema(info, tags)
+	}
+
+	s := newObject()
+	if info.Omitempty || tags.GetNotNull() {
+		s.set("type", "object")
+	} else {
+		s.set("type", []string{"object", "null"})
+	}
+
+	keySchema := newObject()
+	switch keyField.Desc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		keySchema.set("pattern", "^-?[0-9]+$")
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		keySchema.set("pattern", "^[0-9]+$")
+	case protoreflect.BoolKind:
+		keySchema.set("enum", []string{"true", "false"})
+	case protoreflect.StringKind:
+		applyStringTags(keySchema, tags.GetKey().GetString_())
+	}
+	if len(keySchema.keys) != 0 {
+		s.set("propertyNames", keySchema)
+	}
+
+	s.set("additionalProperties", b.valueSchema(valueField, info, tags.GetValue()))
+	applyMapTags(s, tags, "minProperties", "maxProperties")
+	return s
+}
+
+// mapPairsSchema returns the schema of map that encoded as an array of {"key": k, "value": v}.
+func (b *builder) mapPairsSchema(info *gojson.FieldInfo, tags *pbvalidator.MapTags) *object {
+	field := info.Field
+	keyField := field.Message.Fields[0]
+	valueField := field.Message.Fields[1]
+
+	s := newObject()
+	if info.Omitempty || tags.GetNotNull() {
+		s.set("type", "array")
+	} else {
+		s.set("type", []string{"array", "null"})
+	}
+
+	// The key is never encoded by the codec.
+	keySchema := b.valueSchema(keyField, &gojson.FieldInfo{}, tags.GetKey())
+
+	item := newObject()
+	item.set("type", "object")
+	item.set("properties", newObject().
+		set("key", keySchema).
+		set("value", b.valueSchema(valueField, info, tags.GetValue())))
+	item.set("required", []string{"key", "value"})
+	item.set("additionalProperties", false)
+	s.set("items", item)
+
+	applyMapTags(s, tags, "minItems", "maxItems")
+	return s
+}
+
+// valueSchema returns the schema of a singular value, the field is the map value field for map.
+func (b *builder) valueSchema(field *protogen.Field, info *gojson.FieldInfo, tags *pbvalidator.TagOptions) *object {
+	s := newObject()
+	if info.Codec != nil {
+		// The value is encoded by the custom codec, accept any value.
+		return s
+	}
+
+	switch field.Desc.Kind() {
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		s.set("type", "integer")
+		s.set("minimum", int64(math.MinInt32))
+		s.set("maximum", int64(math.MaxInt32))
+		applyIntTags(s, tags.GetInt())
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		s.set("type", "integer")
+		applyIntTags(s, tags.GetInt())
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		s.set("type", "integer")
+		s.set("minimum", uint64(0))
+		s.set("maximum", uint64(math.MaxUint32))
+		applyUintTags(s, tags.GetUint())
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		s.set("type", "integer")
+		s.set("minimum", uint64(0))
+		applyUintTags(s, tags.GetUint())
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		s.set("type", "number")
+		applyFloatTags(s, tags.GetFloat())
+		// The NaN and Infinity are encoded as the quoted strings.
+		if names := nonFiniteAllowed(info.NonFiniteStrings, tags.GetFloat()); len(names) != 0 {
+			s = newObject().set("anyOf", []*object{s, newObject().set("type", "string").set("enum", names)})
+		}
+	case protoreflect.BoolKind:
+		s.set("type", "boolean")
+		if boolTags := tags.GetBool(); boolTags != nil && boolTags.Eq != nil {
+			s.set("const", *boolTags.Eq)
+		}
+	case protoreflect.StringKind:
+		s.set("type", "string")
+		applyStringTags(s, tags.GetString_())
+	case protoreflect.BytesKind:
+		s.set("type", "string")
+		s.set("contentEncoding", "base64")
+	case protoreflect.EnumKind:
+		b.enumSchema(s, field.Enum, info, tags.GetEnum())
+	case protoreflect.MessageKind:
+		s.set("$ref", b.messageRef(field.Message))
+	default:
+		panic(fmt.Sprintf("jsonschema: unsupported kind of %s, field: %s", field.Desc.Kind().String(), field.Desc.FullName()))
+	}
+	return s
+}
+
+// enumSchema sets the enum values in json format that allowed by the validator tags.
+func (b *builder) enumSchema(s *object, enum *protogen.Enum, info *gojson.FieldInfo, tags *pbvalidator.EnumTags) {
+	names := make([]string, 0, len(enum.Values))
+	numbers := make([]int32, 0, len(enum.Values))
+	seen := make(map[protoreflect.EnumNumber]bool)
+
+	for i, value := range enum.Values {
+		// The alias values are encoded as the first name of the same number.
+		if seen[value.Desc.Number()] {
+			continue
+		}
+		seen[value.Desc.Number()] = true
+
+		number := int32(value.Desc.Number())
+		if !enumNumberAllowed(number, tags) {
+			continue
+		}
+		if info.UseEnumString {
+			names = append(names, info.EnumNames[i])
+		}
+		numbers = append(numbers, number)
+	}
+
+	if info.UseEnumString {
+		s.set("type", "string")
+		s.set("enum", names)
+	} else {
+		s.set("type", "integer")
+		s.set("enum", numbers)
+	}
+}
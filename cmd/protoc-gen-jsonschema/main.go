@@ -0,0 +1,10 @@
+package main
+
+import (
+	"github.com/yu31/protoc-plugin/cmd/internal/generator"
+	"github.com/yu31/protoc-plugin/cmd/internal/plugins/jsonschema"
+)
+
+func main() {
+	generator.Do(jsonschema.New())
+}
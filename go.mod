@@ -8,5 +8,5 @@ require (
 	github.com/stretchr/testify v1.7.0
 	github.com/yu31/cron-go v0.0.0-20230528152510-658c4ec5d72b
 	golang.org/x/text v0.3.3
-	google.golang.org/protobuf v1.27.1
+	google.golang.org/protobuf v1.32.0
 )
@@ -24,10 +24,14 @@ const (
 type NameStyle int32
 
 const (
-	NameStyle_NameStyleUnset NameStyle = 0
-	NameStyle_TextName       NameStyle = 1 // Protobuf's text name (field.Desc.TextName()). This is default.
-	NameStyle_GoName         NameStyle = 2 // The golang's field name. It is upper camel case.
-	NameStyle_JSONName       NameStyle = 3 // Protobuf's json name (field.Desc.JSONName()). It is lower camel case.
+	NameStyle_NameStyleUnset     NameStyle = 0
+	NameStyle_TextName           NameStyle = 1 // Protobuf's text name (field.Desc.TextName()). This is default.
+	NameStyle_GoName             NameStyle = 2 // The golang's field name. It is upper camel case.
+	NameStyle_JSONName           NameStyle = 3 // Protobuf's json name (field.Desc.JSONName()). It is lower camel case.
+	NameStyle_SnakeCase          NameStyle = 4 // Snake case converted from golang's field name. e.g. "user_id".
+	NameStyle_KebabCase          NameStyle = 5 // Kebab case converted from golang's field name. e.g. "user-id".
+	NameStyle_LowerCamelCase     NameStyle = 6 // Lower camel case converted from golang's field name. e.g. "userId".
+	NameStyle_ScreamingSnakeCase NameStyle = 7 // Upper snake case converted from golang's field name. e.g. "USER_ID".
 )
 
 // Enum value maps for NameStyle.
@@ -37,12 +41,20 @@ var (
 		1: "TextName",
 		2: "GoName",
 		3: "JSONName",
+		4: "SnakeCase",
+		5: "KebabCase",
+		6: "LowerCamelCase",
+		7: "ScreamingSnakeCase",
 	}
 	NameStyle_value = map[string]int32{
-		"NameStyleUnset": 0,
-		"TextName":       1,
-		"GoName":         2,
-		"JSONName":       3,
+		"NameStyleUnset":     0,
+		"TextName":           1,
+		"GoName":             2,
+		"JSONName":           3,
+		"SnakeCase":          4,
+		"KebabCase":          5,
+		"LowerCamelCase":     6,
+		"ScreamingSnakeCase": 7,
 	}
 )
 
@@ -73,6 +85,353 @@ func (NameStyle) EnumDescriptor() ([]byte, []int) {
 	return file_json_proto_rawDescGZIP(), []int{0}
 }
 
+// EnumValueStyle represents the enum value name in json format if use enum string.
+type EnumValueStyle int32
+
+const (
+	EnumValueStyle_EnumValueStyleUnset EnumValueStyle = 0
+	EnumValueStyle_EnumValueName       EnumValueStyle = 1 // The name of enum value in protobuf. This is default.
+	EnumValueStyle_EnumLowerCase       EnumValueStyle = 2 // e.g. "TASK_RUNNING" to "task_running".
+	EnumValueStyle_EnumUpperCase       EnumValueStyle = 3 // e.g. "task_running" to "TASK_RUNNING".
+	EnumValueStyle_EnumKebabCase       EnumValueStyle = 4 // e.g. "TASK_RUNNING" to "task-running".
+	EnumValueStyle_EnumLowerCamelCase  EnumValueStyle = 5 // e.g. "TASK_RUNNING" to "taskRunning".
+	EnumValueStyle_EnumUpperCamelCase  EnumValueStyle = 6 // e.g. "TASK_RUNNING" to "TaskRunning".
+)
+
+// Enum value maps for EnumValueStyle.
+var (
+	EnumValueStyle_name = map[int32]string{
+		0: "EnumValueStyleUnset",
+		1: "EnumValueName",
+		2: "EnumLowerCase",
+		3: "EnumUpperCase",
+		4: "EnumKebabCase",
+		5: "EnumLowerCamelCase",
+		6: "EnumUpperCamelCase",
+	}
+	EnumValueStyle_value = map[string]int32{
+		"EnumValueStyleUnset": 0,
+		"EnumValueName":       1,
+		"EnumLowerCase":       2,
+		"EnumUpperCase":       3,
+		"EnumKebabCase":       4,
+		"EnumLowerCamelCase":  5,
+		"EnumUpperCamelCase":  6,
+	}
+)
+
+func (x EnumValueStyle) Enum() *EnumValueStyle {
+	p := new(EnumValueStyle)
+	*p = x
+	return p
+}
+
+func (x EnumValueStyle) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EnumValueStyle) Descriptor() protoreflect.EnumDescriptor {
+	return file_json_proto_enumTypes[1].Descriptor()
+}
+
+func (EnumValueStyle) Type() protoreflect.EnumType {
+	return &file_json_proto_enumTypes[1]
+}
+
+func (x EnumValueStyle) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EnumValueStyle.Descriptor instead.
+func (EnumValueStyle) EnumDescriptor() ([]byte, []int) {
+	return file_json_proto_rawDescGZIP(), []int{1}
+}
+
+// UnmarshalMode represents how decoding(UnmarshalJSON) handles the existing elements
+// of repeated and map fields.
+type UnmarshalMode int32
+
+const (
+	UnmarshalMode_UnmarshalModeUnset UnmarshalMode = 0
+	// The slice is truncated and the map is reset before decoding, so the field holds
+	// only the elements in json.
+	UnmarshalMode_Replace UnmarshalMode = 1
+	// The elements in json are appended to the slice and added to the map.
+	// The existing value in map is replaced by the new one with the same key.
+	UnmarshalMode_MergeAppend UnmarshalMode = 2
+	// The elements in json overwrite the existing elements of slice by index, and the
+	// slice is truncated to the length of json array. The entries in json are added to the map.
+	// The existing message in slice and map is decoded in place. This is default.
+	UnmarshalMode_MergeOverwrite UnmarshalMode = 3
+)
+
+// Enum value maps for UnmarshalMode.
+var (
+	UnmarshalMode_name = map[int32]string{
+		0: "UnmarshalModeUnset",
+		1: "Replace",
+		2: "MergeAppend",
+		3: "MergeOverwrite",
+	}
+	UnmarshalMode_value = map[string]int32{
+		"UnmarshalModeUnset": 0,
+		"Replace":            1,
+		"MergeAppend":        2,
+		"MergeOverwrite":     3,
+	}
+)
+
+func (x UnmarshalMode) Enum() *UnmarshalMode {
+	p := new(UnmarshalMode)
+	*p = x
+	return p
+}
+
+func (x UnmarshalMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnmarshalMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_json_proto_enumTypes[2].Descriptor()
+}
+
+func (UnmarshalMode) Type() protoreflect.EnumType {
+	return &file_json_proto_enumTypes[2]
+}
+
+func (x UnmarshalMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnmarshalMode.Descriptor instead.
+func (UnmarshalMode) EnumDescriptor() ([]byte, []int) {
+	return file_json_proto_rawDescGZIP(), []int{2}
+}
+
+// UnknownEnumPolicy represents how decoding(UnmarshalJSON) handles the unknown enum value.
+type UnknownEnumPolicy int32
+
+const (
+	UnknownEnumPolicy_UnknownEnumPolicyUnset UnknownEnumPolicy = 0
+	UnknownEnumPolicy_UnknownEnumError       UnknownEnumPolicy = 1 // Returns an error. This is default.
+	UnknownEnumPolicy_UnknownEnumKeepNumber  UnknownEnumPolicy = 2 // Keeps the unknown enum number, e.g. 10 or "10". The unknown name still returns an error.
+	UnknownEnumPolicy_UnknownEnumToZero      UnknownEnumPolicy = 3 // Decodes the unknown enum number or name as the zero value.
+)
+
+// Enum value maps for UnknownEnumPolicy.
+var (
+	UnknownEnumPolicy_name = map[int32]string{
+		0: "UnknownEnumPolicyUnset",
+		1: "UnknownEnumError",
+		2: "UnknownEnumKeepNumber",
+		3: "UnknownEnumToZero",
+	}
+	UnknownEnumPolicy_value = map[string]int32{
+		"UnknownEnumPolicyUnset": 0,
+		"UnknownEnumError":       1,
+		"UnknownEnumKeepNumber":  2,
+		"UnknownEnumToZero":      3,
+	}
+)
+
+func (x UnknownEnumPolicy) Enum() *UnknownEnumPolicy {
+	p := new(UnknownEnumPolicy)
+	*p = x
+	return p
+}
+
+func (x UnknownEnumPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UnknownEnumPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_json_proto_enumTypes[3].Descriptor()
+}
+
+func (UnknownEnumPolicy) Type() protoreflect.EnumType {
+	return &file_json_proto_enumTypes[3]
+}
+
+func (x UnknownEnumPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UnknownEnumPolicy.Descriptor instead.
+func (UnknownEnumPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_json_proto_rawDescGZIP(), []int{3}
+}
+
+// FloatFormat represents how encoding(MarshalJSON) formats the finite float and double values.
+type FloatFormat int32
+
+const (
+	FloatFormat_FloatFormatUnset FloatFormat = 0
+	// Decimal notation without exponent, e.g. 1e21 to 1000000000000000000000. This is default.
+	FloatFormat_FloatDecimal FloatFormat = 1
+	// ES6 number-to-string conversion same as encoding/json, the exponent is used for the
+	// very large and small values, e.g. 1e+21 and 1e-7.
+	FloatFormat_FloatES6 FloatFormat = 2
+)
+
+// Enum value maps for FloatFormat.
+var (
+	FloatFormat_name = map[int32]string{
+		0: "FloatFormatUnset",
+		1: "FloatDecimal",
+		2: "FloatES6",
+	}
+	FloatFormat_value = map[string]int32{
+		"FloatFormatUnset": 0,
+		"FloatDecimal":     1,
+		"FloatES6":         2,
+	}
+)
+
+func (x FloatFormat) Enum() *FloatFormat {
+	p := new(FloatFormat)
+	*p = x
+	return p
+}
+
+func (x FloatFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FloatFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_json_proto_enumTypes[4].Descriptor()
+}
+
+func (FloatFormat) Type() protoreflect.EnumType {
+	return &file_json_proto_enumTypes[4]
+}
+
+func (x FloatFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FloatFormat.Descriptor instead.
+func (FloatFormat) EnumDescriptor() ([]byte, []int) {
+	return file_json_proto_rawDescGZIP(), []int{4}
+}
+
+// NonFiniteFloat represents how encoding(MarshalJSON) handles the NaN and Infinity values.
+// Decoding(UnmarshalJSON) always accepts all the quoted spellings below.
+type NonFiniteFloat int32
+
+const (
+	NonFiniteFloat_NonFiniteFloatUnset NonFiniteFloat = 0
+	NonFiniteFloat_NonFiniteGo         NonFiniteFloat = 1 // The quoted strings "NaN", "+Inf" and "-Inf". This is default.
+	NonFiniteFloat_NonFiniteProtoJSON  NonFiniteFloat = 2 // The quoted strings "NaN", "Infinity" and "-Infinity" same as protojson.
+	NonFiniteFloat_NonFiniteError      NonFiniteFloat = 3 // Returns an error.
+)
+
+// Enum value maps for NonFiniteFloat.
+var (
+	NonFiniteFloat_name = map[int32]string{
+		0: "NonFiniteFloatUnset",
+		1: "NonFiniteGo",
+		2: "NonFiniteProtoJSON",
+		3: "NonFiniteError",
+	}
+	NonFiniteFloat_value = map[string]int32{
+		"NonFiniteFloatUnset": 0,
+		"NonFiniteGo":         1,
+		"NonFiniteProtoJSON":  2,
+		"NonFiniteError":      3,
+	}
+)
+
+func (x NonFiniteFloat) Enum() *NonFiniteFloat {
+	p := new(NonFiniteFloat)
+	*p = x
+	return p
+}
+
+func (x NonFiniteFloat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NonFiniteFloat) Descriptor() protoreflect.EnumDescriptor {
+	return file_json_proto_enumTypes[5].Descriptor()
+}
+
+func (NonFiniteFloat) Type() protoreflect.EnumType {
+	return &file_json_proto_enumTypes[5]
+}
+
+func (x NonFiniteFloat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NonFiniteFloat.Descriptor instead.
+func (NonFiniteFloat) EnumDescriptor() ([]byte, []int) {
+	return file_json_proto_rawDescGZIP(), []int{5}
+}
+
+// ForeignMessagePolicy represents how the generator handles the field that refers to a message
+// from other file that has no methods generated by protoc-gen-gojson. e.g. the well-known types
+// in google.golang.org/protobuf, or the message in the file that ignored by option `ignore`.
+//
+// The file from other request is recognized as generated by protoc-gen-gojson only if it
+// imports proto/json.proto, otherwise its messages are handled as the foreign messages.
+type ForeignMessagePolicy int32
+
+const (
+	ForeignMessagePolicy_ForeignMessagePolicyUnset ForeignMessagePolicy = 0
+	// Prints a warning when generating code, the message is encoded and decoded by
+	// encoding/json at runtime. This is default. No warning for the well-known types.
+	ForeignMessagePolicy_ForeignMessageWarn ForeignMessagePolicy = 1
+	// Fails the code generation.
+	ForeignMessagePolicy_ForeignMessageError ForeignMessagePolicy = 2
+	// The message is encoded and decoded by protojson with UseProtoNames. The decoded message
+	// is merged into the existing one as UnmarshalMode, except the well-known types that are
+	// replaced. The nesting depth of the message is limited by the remaining MaxDepth.
+	ForeignMessagePolicy_ForeignMessageProtoJSON ForeignMessagePolicy = 3
+)
+
+// Enum value maps for ForeignMessagePolicy.
+var (
+	ForeignMessagePolicy_name = map[int32]string{
+		0: "ForeignMessagePolicyUnset",
+		1: "ForeignMessageWarn",
+		2: "ForeignMessageError",
+		3: "ForeignMessageProtoJSON",
+	}
+	ForeignMessagePolicy_value = map[string]int32{
+		"ForeignMessagePolicyUnset": 0,
+		"ForeignMessageWarn":        1,
+		"ForeignMessageError":       2,
+		"ForeignMessageProtoJSON":   3,
+	}
+)
+
+func (x ForeignMessagePolicy) Enum() *ForeignMessagePolicy {
+	p := new(ForeignMessagePolicy)
+	*p = x
+	return p
+}
+
+func (x ForeignMessagePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ForeignMessagePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_json_proto_enumTypes[6].Descriptor()
+}
+
+func (ForeignMessagePolicy) Type() protoreflect.EnumType {
+	return &file_json_proto_enumTypes[6]
+}
+
+func (x ForeignMessagePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ForeignMessagePolicy.Descriptor instead.
+func (ForeignMessagePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_json_proto_rawDescGZIP(), []int{6}
+}
+
 type SerializeOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -92,7 +451,45 @@ type SerializeOptions struct {
 	// disallow_unknown_fields causes the Decoder to return an error when the destination
 	// is a struct and the input contains object keys which do not match any
 	// non-ignored, exported fields in the destination.
+	// It only as the default value of UnmarshalJSON, use UnmarshalJSONWithOptions to
+	// change it at runtime.
 	DisallowUnknownFields *bool `protobuf:"varint,6,opt,name=disallow_unknown_fields,json=disallowUnknownFields,proto3,oneof" json:"disallow_unknown_fields,omitempty"`
+	// unmarshal_mode represents how decoding(UnmarshalJSON) handles the existing elements
+	// of repeated and map fields. Default is MergeOverwrite.
+	UnmarshalMode *UnmarshalMode `protobuf:"varint,7,opt,name=unmarshal_mode,json=unmarshalMode,proto3,enum=json.UnmarshalMode,oneof" json:"unmarshal_mode,omitempty"`
+	// key_template is used to decorate the json key generated by name_style. The
+	// placeholder "{name}" will be replaced with the key, e.g. "x_{name}_v1".
+	// It not applies to the key specified by option `json`.
+	KeyTemplate *string `protobuf:"bytes,8,opt,name=key_template,json=keyTemplate,proto3,oneof" json:"key_template,omitempty"`
+	// enum_value_style represents the enum value name in json format if use enum string.
+	EnumValueStyle *EnumValueStyle `protobuf:"varint,9,opt,name=enum_value_style,json=enumValueStyle,proto3,enum=json.EnumValueStyle,oneof" json:"enum_value_style,omitempty"`
+	// Whether trim the prefix of enum type name from the enum value name if use enum string.
+	// e.g. "TASK_STATUS_RUNNING" to "RUNNING" in enum TaskStatus.
+	TrimEnumPrefix *bool `protobuf:"varint,10,opt,name=trim_enum_prefix,json=trimEnumPrefix,proto3,oneof" json:"trim_enum_prefix,omitempty"`
+	// indent is used to format the output of encoding(MarshalJSON) like json.MarshalIndent,
+	// e.g. "  ". Default is empty which means compact output.
+	// It only as the default value of MarshalJSON, use MarshalJSONWithOptions to
+	// change it at runtime.
+	Indent *string `protobuf:"bytes,11,opt,name=indent,proto3,oneof" json:"indent,omitempty"`
+	// Whether escape the problematic HTML characters (<, > and &) inside JSON quoted
+	// strings in encoding(MarshalJSON). Default is true as same as encoding/json.
+	// It only as the default value of MarshalJSON, use MarshalJSONWithOptions to
+	// change it at runtime.
+	EscapeHtml *bool `protobuf:"varint,12,opt,name=escape_html,json=escapeHtml,proto3,oneof" json:"escape_html,omitempty"`
+	// If true, the map fields are encoded as an array of {"key": k, "value": v} objects
+	// instead of a json object, the key is encoded as a json value of its type, e.g.
+	// [{"key":1,"value":"a"}]. It's useful for the consumers that can't handle the
+	// numeric or boolean object keys.
+	MapAsPairs *bool `protobuf:"varint,13,opt,name=map_as_pairs,json=mapAsPairs,proto3,oneof" json:"map_as_pairs,omitempty"`
+	// float_format represents how encoding(MarshalJSON) formats the finite float and double values.
+	// Default is FloatDecimal.
+	FloatFormat *FloatFormat `protobuf:"varint,14,opt,name=float_format,json=floatFormat,proto3,enum=json.FloatFormat,oneof" json:"float_format,omitempty"`
+	// non_finite_float represents how encoding(MarshalJSON) handles the NaN and Infinity values.
+	// Default is NonFiniteGo.
+	NonFiniteFloat *NonFiniteFloat `protobuf:"varint,15,opt,name=non_finite_float,json=nonFiniteFloat,proto3,enum=json.NonFiniteFloat,oneof" json:"non_finite_float,omitempty"`
+	// foreign_message represents how the generator handles the field that refers to a message
+	// from other file that has no methods generated by protoc-gen-gojson. Default is ForeignMessageWarn.
+	ForeignMessage *ForeignMessagePolicy `protobuf:"varint,16,opt,name=foreign_message,json=foreignMessage,proto3,enum=json.ForeignMessagePolicy,oneof" json:"foreign_message,omitempty"`
 }
 
 func (x *SerializeOptions) Reset() {
@@ -169,6 +566,76 @@ func (x *SerializeOptions) GetDisallowUnknownFields() bool {
 	return false
 }
 
+func (x *SerializeOptions) GetUnmarshalMode() UnmarshalMode {
+	if x != nil && x.UnmarshalMode != nil {
+		return *x.UnmarshalMode
+	}
+	return UnmarshalMode_UnmarshalModeUnset
+}
+
+func (x *SerializeOptions) GetKeyTemplate() string {
+	if x != nil && x.KeyTemplate != nil {
+		return *x.KeyTemplate
+	}
+	return ""
+}
+
+func (x *SerializeOptions) GetEnumValueStyle() EnumValueStyle {
+	if x != nil && x.EnumValueStyle != nil {
+		return *x.EnumValueStyle
+	}
+	return EnumValueStyle_EnumValueStyleUnset
+}
+
+func (x *SerializeOptions) GetTrimEnumPrefix() bool {
+	if x != nil && x.TrimEnumPrefix != nil {
+		return *x.TrimEnumPrefix
+	}
+	return false
+}
+
+func (x *SerializeOptions) GetIndent() string {
+	if x != nil && x.Indent != nil {
+		return *x.Indent
+	}
+	return ""
+}
+
+func (x *SerializeOptions) GetEscapeHtml() bool {
+	if x != nil && x.EscapeHtml != nil {
+		return *x.EscapeHtml
+	}
+	return false
+}
+
+func (x *SerializeOptions) GetMapAsPairs() bool {
+	if x != nil && x.MapAsPairs != nil {
+		return *x.MapAsPairs
+	}
+	return false
+}
+
+func (x *SerializeOptions) GetFloatFormat() FloatFormat {
+	if x != nil && x.FloatFormat != nil {
+		return *x.FloatFormat
+	}
+	return FloatFormat_FloatFormatUnset
+}
+
+func (x *SerializeOptions) GetNonFiniteFloat() NonFiniteFloat {
+	if x != nil && x.NonFiniteFloat != nil {
+		return *x.NonFiniteFloat
+	}
+	return NonFiniteFloat_NonFiniteFloatUnset
+}
+
+func (x *SerializeOptions) GetForeignMessage() ForeignMessagePolicy {
+	if x != nil && x.ForeignMessage != nil {
+		return *x.ForeignMessage
+	}
+	return ForeignMessagePolicy_ForeignMessagePolicyUnset
+}
+
 type OneofOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -252,7 +719,21 @@ type EnumOptions struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Whether use string format for enum type. default use integer.
+	// The methods MarshalJSON, UnmarshalJSON, MarshalText and UnmarshalText are generated
+	// for the enum if it set in enum level; the UnmarshalJSON is omitted for the enum in proto2
+	// because the protoc-gen-go already generates it.
 	UseEnumString *bool `protobuf:"varint,1,opt,name=use_enum_string,json=useEnumString,proto3,oneof" json:"use_enum_string,omitempty"`
+	// enum_value_style represents the enum value name in json format if use enum string.
+	EnumValueStyle *EnumValueStyle `protobuf:"varint,2,opt,name=enum_value_style,json=enumValueStyle,proto3,enum=json.EnumValueStyle,oneof" json:"enum_value_style,omitempty"`
+	// Whether trim the prefix of enum type name from the enum value name if use enum string.
+	TrimEnumPrefix *bool `protobuf:"varint,3,opt,name=trim_enum_prefix,json=trimEnumPrefix,proto3,oneof" json:"trim_enum_prefix,omitempty"`
+	// unknown_enum_policy represents how decoding(UnmarshalJSON) handles the unknown enum value.
+	// Default is UnknownEnumError.
+	UnknownEnumPolicy *UnknownEnumPolicy `protobuf:"varint,4,opt,name=unknown_enum_policy,json=unknownEnumPolicy,proto3,enum=json.UnknownEnumPolicy,oneof" json:"unknown_enum_policy,omitempty"`
+	// If true, decoding(UnmarshalJSON) accepts both the enum number and the enum name
+	// (include aliases) whether use enum string or not, and the enum name is matched
+	// with case-insensitive.
+	LenientDecoding *bool `protobuf:"varint,5,opt,name=lenient_decoding,json=lenientDecoding,proto3,oneof" json:"lenient_decoding,omitempty"`
 }
 
 func (x *EnumOptions) Reset() {
@@ -294,6 +775,93 @@ func (x *EnumOptions) GetUseEnumString() bool {
 	return false
 }
 
+func (x *EnumOptions) GetEnumValueStyle() EnumValueStyle {
+	if x != nil && x.EnumValueStyle != nil {
+		return *x.EnumValueStyle
+	}
+	return EnumValueStyle_EnumValueStyleUnset
+}
+
+func (x *EnumOptions) GetTrimEnumPrefix() bool {
+	if x != nil && x.TrimEnumPrefix != nil {
+		return *x.TrimEnumPrefix
+	}
+	return false
+}
+
+func (x *EnumOptions) GetUnknownEnumPolicy() UnknownEnumPolicy {
+	if x != nil && x.UnknownEnumPolicy != nil {
+		return *x.UnknownEnumPolicy
+	}
+	return UnknownEnumPolicy_UnknownEnumPolicyUnset
+}
+
+func (x *EnumOptions) GetLenientDecoding() bool {
+	if x != nil && x.LenientDecoding != nil {
+		return *x.LenientDecoding
+	}
+	return false
+}
+
+type EnumValueOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The enum value name in json format if use enum string.
+	// It has a higher priority than enum_value_style and trim_enum_prefix.
+	Json *string `protobuf:"bytes,1,opt,name=json,proto3,oneof" json:"json,omitempty"`
+	// The alternative names of the enum value that accepted by decoding(UnmarshalJSON).
+	// They are never used by encoding(MarshalJSON).
+	Aliases []string `protobuf:"bytes,2,rep,name=aliases,proto3" json:"aliases,omitempty"`
+}
+
+func (x *EnumValueOptions) Reset() {
+	*x = EnumValueOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_json_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumValueOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumValueOptions) ProtoMessage() {}
+
+func (x *EnumValueOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_json_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumValueOptions.ProtoReflect.Descriptor instead.
+func (*EnumValueOptions) Descriptor() ([]byte, []int) {
+	return file_json_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnumValueOptions) GetJson() string {
+	if x != nil && x.Json != nil {
+		return *x.Json
+	}
+	return ""
+}
+
+func (x *EnumValueOptions) GetAliases() []string {
+	if x != nil {
+		return x.Aliases
+	}
+	return nil
+}
+
 type FieldOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -309,12 +877,43 @@ type FieldOptions struct {
 	Omitempty *bool `protobuf:"varint,3,opt,name=omitempty,proto3,oneof" json:"omitempty,omitempty"`
 	// Whether use string format for enum type. default use integer.
 	UseEnumString *bool `protobuf:"varint,4,opt,name=use_enum_string,json=useEnumString,proto3,oneof" json:"use_enum_string,omitempty"`
+	// If true, decoding(UnmarshalJSON) returns an error when the key of field is missing.
+	// It can be turned off at runtime by UnmarshalJSONWithOptions with `RequireFields: false`.
+	// Not supported for the field in oneof.
+	Required *bool `protobuf:"varint,5,opt,name=required,proto3,oneof" json:"required,omitempty"`
+	// codec specifies a Go type to encode and decode the value of field instead of
+	// the built-in way. The format is "<go import path>.<type name>",
+	// e.g. "github.com/example/codec.Cents".
+	//
+	// The new(Type) must have the methods below, where T is the Go type of the value:
+	// the element type for repeated field, the value type for map field, the non-pointer
+	// type for optional field and the pointer type for message field.
+	//   EncodeJSON(enc *jsonencoder.Encoder, v T) error
+	//   DecodeJSON(data []byte) (T, error)
+	// EncodeJSON must append exactly one JSON value by the methods of encoder. The data
+	// passed to DecodeJSON is the raw JSON value include `null`. For the scalar value, they are
+	// the interfaces jsonencoder.<Type>FieldCodec and jsondecoder.<Type>FieldCodec, e.g.
+	// jsonencoder.Int64FieldCodec.
+	// The omitempty still checks the value with the built-in way.
+	Codec *string `protobuf:"bytes,6,opt,name=codec,proto3,oneof" json:"codec,omitempty"`
+	// Same as SerializeOptions.map_as_pairs, only valid for the map field.
+	MapAsPairs *bool `protobuf:"varint,7,opt,name=map_as_pairs,json=mapAsPairs,proto3,oneof" json:"map_as_pairs,omitempty"`
+	// Same as SerializeOptions.float_format, only valid for the float and double field.
+	FloatFormat *FloatFormat `protobuf:"varint,8,opt,name=float_format,json=floatFormat,proto3,enum=json.FloatFormat,oneof" json:"float_format,omitempty"`
+	// Same as SerializeOptions.non_finite_float, only valid for the float and double field.
+	NonFiniteFloat *NonFiniteFloat `protobuf:"varint,9,opt,name=non_finite_float,json=nonFiniteFloat,proto3,enum=json.NonFiniteFloat,oneof" json:"non_finite_float,omitempty"`
+	// float_precision formats the finite value in decimal notation with exactly the number
+	// of digits after the decimal point, e.g. 2 for the money-like value 1.50. It has a higher
+	// priority than float_format. Only valid for the float and double field, and must be in [0, 17].
+	FloatPrecision *int32 `protobuf:"varint,10,opt,name=float_precision,json=floatPrecision,proto3,oneof" json:"float_precision,omitempty"`
+	// Same as SerializeOptions.foreign_message, only valid for the message field.
+	ForeignMessage *ForeignMessagePolicy `protobuf:"varint,11,opt,name=foreign_message,json=foreignMessage,proto3,enum=json.ForeignMessagePolicy,oneof" json:"foreign_message,omitempty"`
 }
 
 func (x *FieldOptions) Reset() {
 	*x = FieldOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_json_proto_msgTypes[3]
+		mi := &file_json_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -327,7 +926,7 @@ func (x *FieldOptions) String() string {
 func (*FieldOptions) ProtoMessage() {}
 
 func (x *FieldOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_json_proto_msgTypes[3]
+	mi := &file_json_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +939,7 @@ func (x *FieldOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
 func (*FieldOptions) Descriptor() ([]byte, []int) {
-	return file_json_proto_rawDescGZIP(), []int{3}
+	return file_json_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *FieldOptions) GetJson() string {
@@ -371,6 +970,55 @@ func (x *FieldOptions) GetUseEnumString() bool {
 	return false
 }
 
+func (x *FieldOptions) GetRequired() bool {
+	if x != nil && x.Required != nil {
+		return *x.Required
+	}
+	return false
+}
+
+func (x *FieldOptions) GetCodec() string {
+	if x != nil && x.Codec != nil {
+		return *x.Codec
+	}
+	return ""
+}
+
+func (x *FieldOptions) GetMapAsPairs() bool {
+	if x != nil && x.MapAsPairs != nil {
+		return *x.MapAsPairs
+	}
+	return false
+}
+
+func (x *FieldOptions) GetFloatFormat() FloatFormat {
+	if x != nil && x.FloatFormat != nil {
+		return *x.FloatFormat
+	}
+	return FloatFormat_FloatFormatUnset
+}
+
+func (x *FieldOptions) GetNonFiniteFloat() NonFiniteFloat {
+	if x != nil && x.NonFiniteFloat != nil {
+		return *x.NonFiniteFloat
+	}
+	return NonFiniteFloat_NonFiniteFloatUnset
+}
+
+func (x *FieldOptions) GetFloatPrecision() int32 {
+	if x != nil && x.FloatPrecision != nil {
+		return *x.FloatPrecision
+	}
+	return 0
+}
+
+func (x *FieldOptions) GetForeignMessage() ForeignMessagePolicy {
+	if x != nil && x.ForeignMessage != nil {
+		return *x.ForeignMessage
+	}
+	return ForeignMessagePolicy_ForeignMessagePolicyUnset
+}
+
 var file_json_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FileOptions)(nil),
@@ -412,6 +1060,14 @@ var file_json_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,8001,opt,name=enum",
 		Filename:      "json.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
+		ExtensionType: (*EnumValueOptions)(nil),
+		Field:         9001,
+		Name:          "json.enum_value",
+		Tag:           "bytes,9001,opt,name=enum_value",
+		Filename:      "json.proto",
+	},
 }
 
 // Extension fields to descriptorpb.FileOptions.
@@ -446,13 +1102,19 @@ var (
 	E_Enum = &file_json_proto_extTypes[4]
 )
 
+// Extension fields to descriptorpb.EnumValueOptions.
+var (
+	// optional json.EnumValueOptions enum_value = 9001;
+	E_EnumValue = &file_json_proto_extTypes[5]
+)
+
 var File_json_proto protoreflect.FileDescriptor
 
 var file_json_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x6a, 0x73,
 	0x6f, 0x6e, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x03, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x08, 0x0a, 0x10, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69,
 	0x7a, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x33, 0x0a, 0x0a, 0x6e, 0x61, 0x6d,
 	0x65, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
 	0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x48, 0x00,
@@ -470,78 +1132,230 @@ var file_json_proto_rawDesc = []byte{
 	0x6f, 0x77, 0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x48, 0x05, 0x52, 0x15, 0x64, 0x69, 0x73, 0x61, 0x6c,
 	0x6c, 0x6f, 0x77, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x79,
-	0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
-	0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f,
-	0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0xc7,
-	0x01, 0x0a, 0x0c, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x17, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f,
-	0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x68, 0x69, 0x64, 0x65,
-	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x03, 0x52, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4b, 0x65, 0x79,
-	0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x6d, 0x69, 0x74,
-	0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x4e, 0x0a, 0x0b, 0x45, 0x6e, 0x75, 0x6d,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x5f, 0x65,
-	0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x00, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75,
-	0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x65,
-	0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6a, 0x73, 0x6f,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x88,
-	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x01, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x21, 0x0a, 0x09, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x02, 0x52, 0x09, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x88,
-	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d, 0x75,
-	0x73, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42,
-	0x07, 0x0a, 0x05, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x67, 0x6e,
-	0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x2a, 0x47, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x79,
-	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x55,
-	0x6e, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x65, 0x78, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x02,
-	0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x03, 0x3a, 0x49,
-	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa1, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x73,
-	0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x3a, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x89, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a,
-	0x73, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x48, 0x0a,
-	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xf1, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a,
-	0x73, 0x6f, 0x6e, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x48, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x0e, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4d, 0x6f, 0x64, 0x65,
+	0x48, 0x06, 0x52, 0x0d, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4d, 0x6f, 0x64,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x6c, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x0b, 0x6b, 0x65,
+	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x10,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x48, 0x08, 0x52, 0x0e,
+	0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2d, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x0e, 0x74,
+	0x72, 0x69, 0x6d, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01,
+	0x12, 0x1b, 0x0a, 0x06, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x0a, 0x52, 0x06, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x0b, 0x52, 0x0a, 0x65, 0x73, 0x63, 0x61, 0x70, 0x65, 0x48, 0x74, 0x6d, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x6d, 0x61, 0x70, 0x5f, 0x61, 0x73, 0x5f, 0x70, 0x61,
+	0x69, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0c, 0x52, 0x0a, 0x6d, 0x61, 0x70,
+	0x41, 0x73, 0x50, 0x61, 0x69, 0x72, 0x73, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x11, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x48, 0x0d, 0x52, 0x0b, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x10, 0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x0e, 0x52, 0x0e, 0x6e, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69,
+	0x74, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0f, 0x66, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x46, 0x6f, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x48,
+	0x0f, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x73, 0x74,
+	0x79, 0x6c, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x5f, 0x6b, 0x65, 0x79, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+	0x70, 0x74, 0x79, 0x42, 0x1a, 0x0a, 0x18, 0x5f, 0x64, 0x69, 0x73, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x5f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x75, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x5f, 0x6d, 0x6f,
+	0x64, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x72, 0x69,
+	0x6d, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x09, 0x0a,
+	0x07, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x65, 0x73, 0x63,
+	0x61, 0x70, 0x65, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e,
+	0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x0c, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52,
+	0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52,
+	0x09, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
+	0x0e, 0x68, 0x69, 0x64, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x4b, 0x65, 0x79, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x68,
+	0x69, 0x64, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6b, 0x65, 0x79, 0x22, 0x97, 0x03,
+	0x0a, 0x0b, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a,
+	0x0f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x45, 0x6e, 0x75,
+	0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x10, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x48, 0x01, 0x52, 0x0e, 0x65, 0x6e,
+	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x2d, 0x0a, 0x10, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0e, 0x74, 0x72, 0x69,
+	0x6d, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x4c,
+	0x0a, 0x13, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6a, 0x73,
+	0x6f, 0x6e, 0x2e, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x48, 0x03, 0x52, 0x11, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45,
+	0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10,
+	0x6c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x48, 0x04, 0x52, 0x0f, 0x6c, 0x65, 0x6e, 0x69, 0x65, 0x6e,
+	0x74, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x73, 0x74, 0x79, 0x6c, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x72, 0x69, 0x6d, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x75,
+	0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6c, 0x65, 0x6e, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x64,
+	0x65, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x4e, 0x0a, 0x10, 0x45, 0x6e, 0x75, 0x6d, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6a,
+	0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x65, 0x73, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x9b, 0x05, 0x0a, 0x0c, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x06, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x02, 0x52, 0x09, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x48, 0x03, 0x52, 0x0d, 0x75, 0x73,
+	0x65, 0x45, 0x6e, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x04, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x19, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x6d, 0x61,
+	0x70, 0x5f, 0x61, 0x73, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x06, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x41, 0x73, 0x50, 0x61, 0x69, 0x72, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x39, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x48, 0x07, 0x52, 0x0b, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x10,
+	0x6e, 0x6f, 0x6e, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4e, 0x6f,
+	0x6e, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x48, 0x08, 0x52, 0x0e,
+	0x6e, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x2c, 0x0a, 0x0f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x09, 0x52, 0x0e, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
+	0x48, 0x0a, 0x0f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+	0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x48, 0x0a, 0x52, 0x0e, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x75, 0x73, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x0b, 0x0a, 0x09, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x61,
+	0x73, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x5f, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x6f, 0x6e,
+	0x5f, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x66, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2a, 0x91, 0x01, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x74,
+	0x79, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65,
+	0x55, 0x6e, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x65, 0x78, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x10,
+	0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x53, 0x4f, 0x4e, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x03, 0x12,
+	0x0d, 0x0a, 0x09, 0x53, 0x6e, 0x61, 0x6b, 0x65, 0x43, 0x61, 0x73, 0x65, 0x10, 0x04, 0x12, 0x0d,
+	0x0a, 0x09, 0x4b, 0x65, 0x62, 0x61, 0x62, 0x43, 0x61, 0x73, 0x65, 0x10, 0x05, 0x12, 0x12, 0x0a,
+	0x0e, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x10,
+	0x06, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x63, 0x72, 0x65, 0x61, 0x6d, 0x69, 0x6e, 0x67, 0x53, 0x6e,
+	0x61, 0x6b, 0x65, 0x43, 0x61, 0x73, 0x65, 0x10, 0x07, 0x2a, 0xa5, 0x01, 0x0a, 0x0e, 0x45, 0x6e,
+	0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x13,
+	0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x55, 0x6e,
+	0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d,
+	0x4c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x45,
+	0x6e, 0x75, 0x6d, 0x55, 0x70, 0x70, 0x65, 0x72, 0x43, 0x61, 0x73, 0x65, 0x10, 0x03, 0x12, 0x11,
+	0x0a, 0x0d, 0x45, 0x6e, 0x75, 0x6d, 0x4b, 0x65, 0x62, 0x61, 0x62, 0x43, 0x61, 0x73, 0x65, 0x10,
+	0x04, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x43, 0x61,
+	0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x10, 0x05, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x6e, 0x75,
+	0x6d, 0x55, 0x70, 0x70, 0x65, 0x72, 0x43, 0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x10,
+	0x06, 0x2a, 0x59, 0x0a, 0x0d, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x55, 0x6e, 0x6d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4d,
+	0x6f, 0x64, 0x65, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x10, 0x03, 0x2a, 0x77, 0x0a, 0x11,
+	0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1a, 0x0a, 0x16, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x75, 0x6d,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x14, 0x0a,
+	0x10, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x6e,
+	0x75, 0x6d, 0x4b, 0x65, 0x65, 0x70, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x10, 0x02, 0x12, 0x15,
+	0x0a, 0x11, 0x55, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x6f, 0x5a,
+	0x65, 0x72, 0x6f, 0x10, 0x03, 0x2a, 0x43, 0x0a, 0x0b, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x53, 0x36, 0x10, 0x02, 0x2a, 0x66, 0x0a, 0x0e, 0x4e, 0x6f,
+	0x6e, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x13,
+	0x4e, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x55, 0x6e,
+	0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x4e, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69,
+	0x74, 0x65, 0x47, 0x6f, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x6f, 0x6e, 0x46, 0x69, 0x6e,
+	0x69, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x12,
+	0x0a, 0x0e, 0x4e, 0x6f, 0x6e, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x10, 0x03, 0x2a, 0x83, 0x01, 0x0a, 0x14, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1d, 0x0a, 0x19, 0x46,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x6f,
+	0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x57, 0x61, 0x72, 0x6e,
+	0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x46,
+	0x6f, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x03, 0x3a, 0x49, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xa1,
+	0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x72,
+	0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x66,
+	0x69, 0x6c, 0x65, 0x3a, 0x52, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x89, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x53, 0x65,
+	0x72, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x48, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64,
 	0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4f, 0x6e,
-	0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x3a, 0x44, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xc1, 0x3e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
-	0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x42, 0x58, 0x0a, 0x1f, 0x69, 0x6f, 0x2e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x79, 0x75, 0x33, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
-	0x2e, 0x70, 0x62, 0x2e, 0x70, 0x62, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x06, 0x50, 0x42, 0x4a, 0x73,
-	0x6f, 0x6e, 0x50, 0x00, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x79, 0x75, 0x33, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x70, 0x6c, 0x75,
-	0x67, 0x69, 0x6e, 0x2f, 0x78, 0x67, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x62, 0x6a, 0x73, 0x6f,
-	0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0xf1, 0x2e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x3a, 0x48, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65,
+	0x6f, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xd9, 0x36, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3a, 0x44, 0x0a, 0x04, 0x65,
+	0x6e, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0xc1, 0x3e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x65, 0x6e, 0x75,
+	0x6d, 0x3a, 0x59, 0x0a, 0x0a, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xa9, 0x46, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f, 0x6e,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x58, 0x0a, 0x1f,
+	0x69, 0x6f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x79, 0x75, 0x33, 0x31, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x62, 0x6a, 0x73, 0x6f, 0x6e, 0x42,
+	0x06, 0x50, 0x42, 0x4a, 0x73, 0x6f, 0x6e, 0x50, 0x00, 0x5a, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x33, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x78, 0x67, 0x6f, 0x2f, 0x70, 0x62, 0x2f,
+	0x70, 0x62, 0x6a, 0x73, 0x6f, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -556,37 +1370,57 @@ func file_json_proto_rawDescGZIP() []byte {
 	return file_json_proto_rawDescData
 }
 
-var file_json_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_json_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_json_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_json_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_json_proto_goTypes = []interface{}{
-	(NameStyle)(0),                      // 0: json.NameStyle
-	(*SerializeOptions)(nil),            // 1: json.SerializeOptions
-	(*OneofOptions)(nil),                // 2: json.OneofOptions
-	(*EnumOptions)(nil),                 // 3: json.EnumOptions
-	(*FieldOptions)(nil),                // 4: json.FieldOptions
-	(*descriptorpb.FileOptions)(nil),    // 5: google.protobuf.FileOptions
-	(*descriptorpb.MessageOptions)(nil), // 6: google.protobuf.MessageOptions
-	(*descriptorpb.FieldOptions)(nil),   // 7: google.protobuf.FieldOptions
-	(*descriptorpb.OneofOptions)(nil),   // 8: google.protobuf.OneofOptions
-	(*descriptorpb.EnumOptions)(nil),    // 9: google.protobuf.EnumOptions
+	(NameStyle)(0),                        // 0: json.NameStyle
+	(EnumValueStyle)(0),                   // 1: json.EnumValueStyle
+	(UnmarshalMode)(0),                    // 2: json.UnmarshalMode
+	(UnknownEnumPolicy)(0),                // 3: json.UnknownEnumPolicy
+	(FloatFormat)(0),                      // 4: json.FloatFormat
+	(NonFiniteFloat)(0),                   // 5: json.NonFiniteFloat
+	(ForeignMessagePolicy)(0),             // 6: json.ForeignMessagePolicy
+	(*SerializeOptions)(nil),              // 7: json.SerializeOptions
+	(*OneofOptions)(nil),                  // 8: json.OneofOptions
+	(*EnumOptions)(nil),                   // 9: json.EnumOptions
+	(*EnumValueOptions)(nil),              // 10: json.EnumValueOptions
+	(*FieldOptions)(nil),                  // 11: json.FieldOptions
+	(*descriptorpb.FileOptions)(nil),      // 12: google.protobuf.FileOptions
+	(*descriptorpb.MessageOptions)(nil),   // 13: google.protobuf.MessageOptions
+	(*descriptorpb.FieldOptions)(nil),     // 14: google.protobuf.FieldOptions
+	(*descriptorpb.OneofOptions)(nil),     // 15: google.protobuf.OneofOptions
+	(*descriptorpb.EnumOptions)(nil),      // 16: google.protobuf.EnumOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 17: google.protobuf.EnumValueOptions
 }
 var file_json_proto_depIdxs = []int32{
 	0,  // 0: json.SerializeOptions.name_style:type_name -> json.NameStyle
-	5,  // 1: json.file:extendee -> google.protobuf.FileOptions
-	6,  // 2: json.message:extendee -> google.protobuf.MessageOptions
-	7,  // 3: json.field:extendee -> google.protobuf.FieldOptions
-	8,  // 4: json.oneof:extendee -> google.protobuf.OneofOptions
-	9,  // 5: json.enum:extendee -> google.protobuf.EnumOptions
-	1,  // 6: json.file:type_name -> json.SerializeOptions
-	1,  // 7: json.message:type_name -> json.SerializeOptions
-	4,  // 8: json.field:type_name -> json.FieldOptions
-	2,  // 9: json.oneof:type_name -> json.OneofOptions
-	3,  // 10: json.enum:type_name -> json.EnumOptions
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	6,  // [6:11] is the sub-list for extension type_name
-	1,  // [1:6] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	2,  // 1: json.SerializeOptions.unmarshal_mode:type_name -> json.UnmarshalMode
+	1,  // 2: json.SerializeOptions.enum_value_style:type_name -> json.EnumValueStyle
+	4,  // 3: json.SerializeOptions.float_format:type_name -> json.FloatFormat
+	5,  // 4: json.SerializeOptions.non_finite_float:type_name -> json.NonFiniteFloat
+	6,  // 5: json.SerializeOptions.foreign_message:type_name -> json.ForeignMessagePolicy
+	1,  // 6: json.EnumOptions.enum_value_style:type_name -> json.EnumValueStyle
+	3,  // 7: json.EnumOptions.unknown_enum_policy:type_name -> json.UnknownEnumPolicy
+	4,  // 8: json.FieldOptions.float_format:type_name -> json.FloatFormat
+	5,  // 9: json.FieldOptions.non_finite_float:type_name -> json.NonFiniteFloat
+	6,  // 10: json.FieldOptions.foreign_message:type_name -> json.ForeignMessagePolicy
+	12, // 11: json.file:extendee -> google.protobuf.FileOptions
+	13, // 12: json.message:extendee -> google.protobuf.MessageOptions
+	14, // 13: json.field:extendee -> google.protobuf.FieldOptions
+	15, // 14: json.oneof:extendee -> google.protobuf.OneofOptions
+	16, // 15: json.enum:extendee -> google.protobuf.EnumOptions
+	17, // 16: json.enum_value:extendee -> google.protobuf.EnumValueOptions
+	7,  // 17: json.file:type_name -> json.SerializeOptions
+	7,  // 18: json.message:type_name -> json.SerializeOptions
+	11, // 19: json.field:type_name -> json.FieldOptions
+	8,  // 20: json.oneof:type_name -> json.OneofOptions
+	9,  // 21: json.enum:type_name -> json.EnumOptions
+	10, // 22: json.enum_value:type_name -> json.EnumValueOptions
+	23, // [23:23] is the sub-list for method output_type
+	23, // [23:23] is the sub-list for method input_type
+	17, // [17:23] is the sub-list for extension type_name
+	11, // [11:17] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_json_proto_init() }
@@ -632,6 +1466,18 @@ func file_json_proto_init() {
 			}
 		}
 		file_json_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumValueOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_json_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FieldOptions); i {
 			case 0:
 				return &v.state
@@ -648,14 +1494,15 @@ func file_json_proto_init() {
 	file_json_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_json_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_json_proto_msgTypes[3].OneofWrappers = []interface{}{}
+	file_json_proto_msgTypes[4].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_json_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   4,
-			NumExtensions: 5,
+			NumEnums:      7,
+			NumMessages:   5,
+			NumExtensions: 6,
 			NumServices:   0,
 		},
 		GoTypes:           file_json_proto_goTypes,
@@ -10,6 +10,8 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +23,207 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// MessageOptions describe the cross-field constraints of message. They are checked after
+// the checks of all fields. The fields must be the plain fields (non-oneof) in message.
+//
+// A field is set if it's not the zero value, i.e. the optional field is not null, the string,
+// bytes, repeated and map is not empty, the message is not null.
+type MessageOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// compare ensures the relationship between the values of two fields, the operators are
+	// "<", "<=", ">", ">=", "==" and "!=". e.g. "start_time < end_time", "min <= max".
+	// The fields must be the same type of number, string, enum, bool, or the message of
+	// google.protobuf.Timestamp and google.protobuf.Duration. The rule is skipped if any one
+	// of the optional or message field is null.
+	Compare []string `protobuf:"bytes,1,rep,name=compare,proto3" json:"compare,omitempty"`
+	// at_least_one_of ensures at least one of the fields in group is set.
+	AtLeastOneOf []*FieldGroup `protobuf:"bytes,2,rep,name=at_least_one_of,json=atLeastOneOf,proto3" json:"at_least_one_of,omitempty"`
+	// exactly_one_of ensures exactly one of the fields in group is set.
+	ExactlyOneOf []*FieldGroup `protobuf:"bytes,3,rep,name=exactly_one_of,json=exactlyOneOf,proto3" json:"exactly_one_of,omitempty"`
+	// all_or_none_of ensures all of the fields in group are set or none of them is set.
+	AllOrNoneOf []*FieldGroup `protobuf:"bytes,4,rep,name=all_or_none_of,json=allOrNoneOf,proto3" json:"all_or_none_of,omitempty"`
+	// mutually_exclusive ensures the fields of at most one group are set.
+	MutuallyExclusive []*ExclusiveGroups `protobuf:"bytes,5,rep,name=mutually_exclusive,json=mutuallyExclusive,proto3" json:"mutually_exclusive,omitempty"`
+	// expr ensures the boolean expression is true, it's a small subset of CEL that type-checked
+	// against the message and compiled into go code. e.g.
+	// "size(this.items) <= this.max_items && this.name.startsWith(this.prefix)".
+	// See docs/govalidator.md for the syntax.
+	Expr []string `protobuf:"bytes,6,rep,name=expr,proto3" json:"expr,omitempty"`
+}
+
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validator_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageOptions) ProtoMessage() {}
+
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_validator_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_validator_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MessageOptions) GetCompare() []string {
+	if x != nil {
+		return x.Compare
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetAtLeastOneOf() []*FieldGroup {
+	if x != nil {
+		return x.AtLeastOneOf
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetExactlyOneOf() []*FieldGroup {
+	if x != nil {
+		return x.ExactlyOneOf
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetAllOrNoneOf() []*FieldGroup {
+	if x != nil {
+		return x.AllOrNoneOf
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetMutuallyExclusive() []*ExclusiveGroups {
+	if x != nil {
+		return x.MutuallyExclusive
+	}
+	return nil
+}
+
+func (x *MessageOptions) GetExpr() []string {
+	if x != nil {
+		return x.Expr
+	}
+	return nil
+}
+
+// FieldGroup is a group of field names.
+type FieldGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fields []string `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+}
+
+func (x *FieldGroup) Reset() {
+	*x = FieldGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validator_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldGroup) ProtoMessage() {}
+
+func (x *FieldGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_validator_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldGroup.ProtoReflect.Descriptor instead.
+func (*FieldGroup) Descriptor() ([]byte, []int) {
+	return file_validator_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FieldGroup) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// ExclusiveGroups is the groups of field that mutually exclusive.
+type ExclusiveGroups struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Groups []*FieldGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+}
+
+func (x *ExclusiveGroups) Reset() {
+	*x = ExclusiveGroups{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validator_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExclusiveGroups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExclusiveGroups) ProtoMessage() {}
+
+func (x *ExclusiveGroups) ProtoReflect() protoreflect.Message {
+	mi := &file_validator_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExclusiveGroups.ProtoReflect.Descriptor instead.
+func (*ExclusiveGroups) Descriptor() ([]byte, []int) {
+	return file_validator_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ExclusiveGroups) GetGroups() []*FieldGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
 type ValidOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -28,12 +231,23 @@ type ValidOptions struct {
 
 	CheckIf *CheckIf    `protobuf:"bytes,1,opt,name=check_if,json=checkIf,proto3" json:"check_if,omitempty"`
 	Tags    *TagOptions `protobuf:"bytes,2,opt,name=tags,proto3" json:"tags,omitempty"`
+	// message is the custom error message template for all tags of field instead of the generated one.
+	// The placeholders will be replaced with the data of error:
+	//   {field}: the name of field; {json_name}: the json name of field; {path}: the full path of field;
+	//   {value}: the value of field; {param}: the value of tag options; {tag}: the name of violated tag.
+	// The "{{" and "}}" are used for literal braces. e.g. "{field} must be between 1 and 65535".
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// error_code is a machine-readable code for the errors of field, it's returned by ValidateError.Code.
+	ErrorCode string `protobuf:"bytes,4,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	// messages is the custom error message template for the specified tag, the key is the name of tag,
+	// e.g. "int.gte". It takes precedence over the option message.
+	Messages map[string]string `protobuf:"bytes,5,rep,name=messages,proto3" json:"messages,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ValidOptions) Reset() {
 	*x = ValidOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[0]
+		mi := &file_validator_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -46,7 +260,7 @@ func (x *ValidOptions) String() string {
 func (*ValidOptions) ProtoMessage() {}
 
 func (x *ValidOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[0]
+	mi := &file_validator_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,7 +273,7 @@ func (x *ValidOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidOptions.ProtoReflect.Descriptor instead.
 func (*ValidOptions) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{0}
+	return file_validator_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ValidOptions) GetCheckIf() *CheckIf {
@@ -76,6 +290,27 @@ func (x *ValidOptions) GetTags() *TagOptions {
 	return nil
 }
 
+func (x *ValidOptions) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ValidOptions) GetErrorCode() string {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return ""
+}
+
+func (x *ValidOptions) GetMessages() map[string]string {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
 type CheckIf struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -88,7 +323,7 @@ type CheckIf struct {
 func (x *CheckIf) Reset() {
 	*x = CheckIf{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[1]
+		mi := &file_validator_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -101,7 +336,7 @@ func (x *CheckIf) String() string {
 func (*CheckIf) ProtoMessage() {}
 
 func (x *CheckIf) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[1]
+	mi := &file_validator_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,7 +349,7 @@ func (x *CheckIf) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckIf.ProtoReflect.Descriptor instead.
 func (*CheckIf) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{1}
+	return file_validator_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CheckIf) GetField() string {
@@ -150,13 +385,16 @@ type TagOptions struct {
 	//	*TagOptions_Message
 	//	*TagOptions_Repeated
 	//	*TagOptions_Map
+	//	*TagOptions_Timestamp
+	//	*TagOptions_Duration
+	//	*TagOptions_Any
 	Kind isTagOptions_Kind `protobuf_oneof:"kind"`
 }
 
 func (x *TagOptions) Reset() {
 	*x = TagOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[2]
+		mi := &file_validator_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -169,7 +407,7 @@ func (x *TagOptions) String() string {
 func (*TagOptions) ProtoMessage() {}
 
 func (x *TagOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[2]
+	mi := &file_validator_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -182,7 +420,7 @@ func (x *TagOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TagOptions.ProtoReflect.Descriptor instead.
 func (*TagOptions) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{2}
+	return file_validator_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *TagOptions) GetKind() isTagOptions_Kind {
@@ -269,6 +507,27 @@ func (x *TagOptions) GetMap() *MapTags {
 	return nil
 }
 
+func (x *TagOptions) GetTimestamp() *TimestampTags {
+	if x, ok := x.GetKind().(*TagOptions_Timestamp); ok {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *TagOptions) GetDuration() *DurationTags {
+	if x, ok := x.GetKind().(*TagOptions_Duration); ok {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *TagOptions) GetAny() *AnyTags {
+	if x, ok := x.GetKind().(*TagOptions_Any); ok {
+		return x.Any
+	}
+	return nil
+}
+
 type isTagOptions_Kind interface {
 	isTagOptions_Kind()
 }
@@ -320,6 +579,21 @@ type TagOptions_Map struct {
 	Map *MapTags `protobuf:"bytes,30,opt,name=map,proto3,oneof"`
 }
 
+type TagOptions_Timestamp struct {
+	// Well-known Types. The wrapper types (e.g. google.protobuf.Int64Value) use the tags of
+	// the type of its value, e.g. <int> for google.protobuf.Int64Value.
+	// For all the well-known types, the tags except not_null are skipped when the field is null.
+	Timestamp *TimestampTags `protobuf:"bytes,31,opt,name=timestamp,proto3,oneof"`
+}
+
+type TagOptions_Duration struct {
+	Duration *DurationTags `protobuf:"bytes,32,opt,name=duration,proto3,oneof"`
+}
+
+type TagOptions_Any struct {
+	Any *AnyTags `protobuf:"bytes,33,opt,name=any,proto3,oneof"`
+}
+
 func (*TagOptions_Oneof) isTagOptions_Kind() {}
 
 func (*TagOptions_Float) isTagOptions_Kind() {}
@@ -342,6 +616,12 @@ func (*TagOptions_Repeated) isTagOptions_Kind() {}
 
 func (*TagOptions_Map) isTagOptions_Kind() {}
 
+func (*TagOptions_Timestamp) isTagOptions_Kind() {}
+
+func (*TagOptions_Duration) isTagOptions_Kind() {}
+
+func (*TagOptions_Any) isTagOptions_Kind() {}
+
 // OneOfOptions describe the constraints applied to the values type of oneof.
 type OneOfTags struct {
 	state         protoimpl.MessageState
@@ -356,7 +636,7 @@ type OneOfTags struct {
 func (x *OneOfTags) Reset() {
 	*x = OneOfTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[3]
+		mi := &file_validator_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -369,7 +649,7 @@ func (x *OneOfTags) String() string {
 func (*OneOfTags) ProtoMessage() {}
 
 func (x *OneOfTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[3]
+	mi := &file_validator_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,7 +662,7 @@ func (x *OneOfTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OneOfTags.ProtoReflect.Descriptor instead.
 func (*OneOfTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{3}
+	return file_validator_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *OneOfTags) GetNotNull() bool {
@@ -393,26 +673,40 @@ func (x *OneOfTags) GetNotNull() bool {
 }
 
 // FloatOptions describe the constraints applied to the values type of
-// `float` and `double`.
+// `float` and `double`. The NaN doesn't satisfy any of the tags.
 type FloatTags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Eq    *float64  `protobuf:"fixed64,3,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
-	Ne    *float64  `protobuf:"fixed64,4,opt,name=ne,proto3,oneof" json:"ne,omitempty"`
-	Lt    *float64  `protobuf:"fixed64,5,opt,name=lt,proto3,oneof" json:"lt,omitempty"`
-	Gt    *float64  `protobuf:"fixed64,6,opt,name=gt,proto3,oneof" json:"gt,omitempty"`
-	Lte   *float64  `protobuf:"fixed64,7,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
-	Gte   *float64  `protobuf:"fixed64,8,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
-	In    []float64 `protobuf:"fixed64,9,rep,packed,name=in,proto3" json:"in,omitempty"`
-	NotIn []float64 `protobuf:"fixed64,10,rep,packed,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+	// not_null specifies that the wrapper must be set. Only valid for the wrapper types,
+	// e.g. google.protobuf.Int64Value; the other tags are skipped when the wrapper is null.
+	NotNull *bool     `protobuf:"varint,2,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
+	Eq      *float64  `protobuf:"fixed64,3,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	Ne      *float64  `protobuf:"fixed64,4,opt,name=ne,proto3,oneof" json:"ne,omitempty"`
+	Lt      *float64  `protobuf:"fixed64,5,opt,name=lt,proto3,oneof" json:"lt,omitempty"`
+	Gt      *float64  `protobuf:"fixed64,6,opt,name=gt,proto3,oneof" json:"gt,omitempty"`
+	Lte     *float64  `protobuf:"fixed64,7,opt,name=lte,proto3,oneof" json:"lte,omitempty"`
+	Gte     *float64  `protobuf:"fixed64,8,opt,name=gte,proto3,oneof" json:"gte,omitempty"`
+	In      []float64 `protobuf:"fixed64,9,rep,packed,name=in,proto3" json:"in,omitempty"`
+	NotIn   []float64 `protobuf:"fixed64,10,rep,packed,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+	// range specifies that this field must be in the specified interval, e.g. "[0, 1)".
+	// The "[" and "]" include the bound, the "(" and ")" exclude it, and the empty bound is unbounded,
+	// e.g. "(0, ]" for the positive values.
+	Range *string `protobuf:"bytes,11,opt,name=range,proto3,oneof" json:"range,omitempty"`
+	// multiple_of specifies that this field must be an integer multiple of the specified positive value.
+	// The small rounding error of floating point is tolerated, e.g. 0.3 is a multiple of 0.1.
+	MultipleOf *float64 `protobuf:"fixed64,12,opt,name=multiple_of,json=multipleOf,proto3,oneof" json:"multiple_of,omitempty"`
+	// finite specifies that this field must not be NaN or ±Inf.
+	Finite *bool `protobuf:"varint,13,opt,name=finite,proto3,oneof" json:"finite,omitempty"`
+	// decimal_places specifies that this field must have at most the specified number of decimal places.
+	DecimalPlaces *uint32 `protobuf:"varint,14,opt,name=decimal_places,json=decimalPlaces,proto3,oneof" json:"decimal_places,omitempty"`
 }
 
 func (x *FloatTags) Reset() {
 	*x = FloatTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[4]
+		mi := &file_validator_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -425,7 +719,7 @@ func (x *FloatTags) String() string {
 func (*FloatTags) ProtoMessage() {}
 
 func (x *FloatTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[4]
+	mi := &file_validator_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -438,7 +732,14 @@ func (x *FloatTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FloatTags.ProtoReflect.Descriptor instead.
 func (*FloatTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{4}
+	return file_validator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *FloatTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
 }
 
 func (x *FloatTags) GetEq() float64 {
@@ -497,6 +798,34 @@ func (x *FloatTags) GetNotIn() []float64 {
 	return nil
 }
 
+func (x *FloatTags) GetRange() string {
+	if x != nil && x.Range != nil {
+		return *x.Range
+	}
+	return ""
+}
+
+func (x *FloatTags) GetMultipleOf() float64 {
+	if x != nil && x.MultipleOf != nil {
+		return *x.MultipleOf
+	}
+	return 0
+}
+
+func (x *FloatTags) GetFinite() bool {
+	if x != nil && x.Finite != nil {
+		return *x.Finite
+	}
+	return false
+}
+
+func (x *FloatTags) GetDecimalPlaces() uint32 {
+	if x != nil && x.DecimalPlaces != nil {
+		return *x.DecimalPlaces
+	}
+	return 0
+}
+
 // IntOptions describe the constraints applied to the values type of
 // `int32`, `int64`, `sint32`, `sint64`, `sfixed32`, `sfixed64`.
 type IntTags struct {
@@ -504,6 +833,9 @@ type IntTags struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// not_null specifies that the wrapper must be set. Only valid for the wrapper types,
+	// e.g. google.protobuf.Int64Value; the other tags are skipped when the wrapper is null.
+	NotNull *bool `protobuf:"varint,2,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
 	// eq specifies that this field must be equal to the specified value.
 	Eq *int64 `protobuf:"varint,3,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
 	// ne specifies that this field must be not equal to the specified value.
@@ -520,12 +852,18 @@ type IntTags struct {
 	In []int64 `protobuf:"varint,9,rep,packed,name=in,proto3" json:"in,omitempty"`
 	// not_in specifies that this field must not be in the specified value lists.
 	NotIn []int64 `protobuf:"varint,10,rep,packed,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+	// range specifies that this field must be in the specified interval, e.g. "[1, 100]".
+	// The "[" and "]" include the bound, the "(" and ")" exclude it, and the empty bound is unbounded,
+	// e.g. "(0, ]" for the positive values.
+	Range *string `protobuf:"bytes,11,opt,name=range,proto3,oneof" json:"range,omitempty"`
+	// multiple_of specifies that this field must be an integer multiple of the specified non-zero value.
+	MultipleOf *int64 `protobuf:"varint,12,opt,name=multiple_of,json=multipleOf,proto3,oneof" json:"multiple_of,omitempty"`
 }
 
 func (x *IntTags) Reset() {
 	*x = IntTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[5]
+		mi := &file_validator_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -538,7 +876,7 @@ func (x *IntTags) String() string {
 func (*IntTags) ProtoMessage() {}
 
 func (x *IntTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[5]
+	mi := &file_validator_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -551,7 +889,14 @@ func (x *IntTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntTags.ProtoReflect.Descriptor instead.
 func (*IntTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{5}
+	return file_validator_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *IntTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
 }
 
 func (x *IntTags) GetEq() int64 {
@@ -610,6 +955,20 @@ func (x *IntTags) GetNotIn() []int64 {
 	return nil
 }
 
+func (x *IntTags) GetRange() string {
+	if x != nil && x.Range != nil {
+		return *x.Range
+	}
+	return ""
+}
+
+func (x *IntTags) GetMultipleOf() int64 {
+	if x != nil && x.MultipleOf != nil {
+		return *x.MultipleOf
+	}
+	return 0
+}
+
 // UintOptions describe the constraints applied to the values type of
 // `uint32`, `uint64`, `fixed32`, `fixed64`.
 type UintTags struct {
@@ -617,6 +976,9 @@ type UintTags struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// not_null specifies that the wrapper must be set. Only valid for the wrapper types,
+	// e.g. google.protobuf.Int64Value; the other tags are skipped when the wrapper is null.
+	NotNull *bool `protobuf:"varint,2,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
 	// eq specifies that this field must be equal to the specified value.
 	Eq *uint64 `protobuf:"varint,3,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
 	// ne specifies that this field must be not equal to the specified value.
@@ -633,12 +995,18 @@ type UintTags struct {
 	In []uint64 `protobuf:"varint,9,rep,packed,name=in,proto3" json:"in,omitempty"`
 	// not_in specifies that this field must not be in the specified value lists.
 	NotIn []uint64 `protobuf:"varint,10,rep,packed,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+	// range specifies that this field must be in the specified interval, e.g. "[1, 100]".
+	// The "[" and "]" include the bound, the "(" and ")" exclude it, and the empty bound is unbounded,
+	// e.g. "(0, ]" for the positive values.
+	Range *string `protobuf:"bytes,11,opt,name=range,proto3,oneof" json:"range,omitempty"`
+	// multiple_of specifies that this field must be an integer multiple of the specified non-zero value.
+	MultipleOf *uint64 `protobuf:"varint,12,opt,name=multiple_of,json=multipleOf,proto3,oneof" json:"multiple_of,omitempty"`
 }
 
 func (x *UintTags) Reset() {
 	*x = UintTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[6]
+		mi := &file_validator_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -651,7 +1019,7 @@ func (x *UintTags) String() string {
 func (*UintTags) ProtoMessage() {}
 
 func (x *UintTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[6]
+	mi := &file_validator_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +1032,14 @@ func (x *UintTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UintTags.ProtoReflect.Descriptor instead.
 func (*UintTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{6}
+	return file_validator_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UintTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
 }
 
 func (x *UintTags) GetEq() uint64 {
@@ -723,12 +1098,29 @@ func (x *UintTags) GetNotIn() []uint64 {
 	return nil
 }
 
-// StringOptions describe the constraints applied to the values type of `string`.
-type StringTags struct {
+func (x *UintTags) GetRange() string {
+	if x != nil && x.Range != nil {
+		return *x.Range
+	}
+	return ""
+}
+
+func (x *UintTags) GetMultipleOf() uint64 {
+	if x != nil && x.MultipleOf != nil {
+		return *x.MultipleOf
+	}
+	return 0
+}
+
+// StringOptions describe the constraints applied to the values type of `string`.
+type StringTags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// not_null specifies that the wrapper must be set. Only valid for the wrapper types,
+	// e.g. google.protobuf.Int64Value; the other tags are skipped when the wrapper is null.
+	NotNull *bool `protobuf:"varint,2,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
 	// eq specifies that this field must be equal to the specified value.
 	Eq *string `protobuf:"bytes,3,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
 	// ne specifies that this field must be not equal to the specified value.
@@ -904,7 +1296,7 @@ type StringTags struct {
 func (x *StringTags) Reset() {
 	*x = StringTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[7]
+		mi := &file_validator_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -917,7 +1309,7 @@ func (x *StringTags) String() string {
 func (*StringTags) ProtoMessage() {}
 
 func (x *StringTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[7]
+	mi := &file_validator_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -930,7 +1322,14 @@ func (x *StringTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StringTags.ProtoReflect.Descriptor instead.
 func (*StringTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{7}
+	return file_validator_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StringTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
 }
 
 func (x *StringTags) GetEq() string {
@@ -1492,6 +1891,9 @@ type BytesTags struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// not_null specifies that the wrapper must be set. Only valid for the wrapper types,
+	// e.g. google.protobuf.Int64Value; the other tags are skipped when the wrapper is null.
+	NotNull *bool `protobuf:"varint,2,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
 	// len_eq specifies that this field must be equal to the specified number of
 	LenEq *int64 `protobuf:"varint,3,opt,name=len_eq,json=lenEq,proto3,oneof" json:"len_eq,omitempty"`
 	// len_ne specifies that this field must not be equal to the specified number of
@@ -1504,12 +1906,35 @@ type BytesTags struct {
 	LenLte *int64 `protobuf:"varint,7,opt,name=len_lte,json=lenLte,proto3,oneof" json:"len_lte,omitempty"`
 	// len_gte specifies that this field must be greater than or equal to the specified number of
 	LenGte *int64 `protobuf:"varint,8,opt,name=len_gte,json=lenGte,proto3,oneof" json:"len_gte,omitempty"`
+	// eq specifies that this field must be equal to the specified value.
+	Eq []byte `protobuf:"bytes,10,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
+	// in specifies that this field must be in the specified value lists.
+	In [][]byte `protobuf:"bytes,11,rep,name=in,proto3" json:"in,omitempty"`
+	// not_in specifies that this field must not be in the specified value lists.
+	NotIn [][]byte `protobuf:"bytes,12,rep,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+	// prefix specifies that this field must be start with the specified prefix.
+	Prefix []byte `protobuf:"bytes,20,opt,name=prefix,proto3,oneof" json:"prefix,omitempty"`
+	// suffix specifies that this field must be end with the specified suffix.
+	Suffix []byte `protobuf:"bytes,21,opt,name=suffix,proto3,oneof" json:"suffix,omitempty"`
+	// contains specifies that this field must be contains the specified value.
+	Contains []byte `protobuf:"bytes,22,opt,name=contains,proto3,oneof" json:"contains,omitempty"`
+	// regex specifies that this field must be math a RE2-syntax regex.
+	Regex *string `protobuf:"bytes,23,opt,name=regex,proto3,oneof" json:"regex,omitempty"`
+	// ip specifies that the field must be a valid IP (v4 or v6) address in byte form,
+	// that is 4 or 16 bytes.
+	Ip *bool `protobuf:"varint,30,opt,name=ip,proto3,oneof" json:"ip,omitempty"`
+	// ipv4 specifies that the field must be a valid IPv4 address in byte form, that is 4 bytes.
+	Ipv4 *bool `protobuf:"varint,31,opt,name=ipv4,proto3,oneof" json:"ipv4,omitempty"`
+	// ipv6 specifies that the field must be a valid IPv6 address in byte form, that is 16 bytes.
+	Ipv6 *bool `protobuf:"varint,32,opt,name=ipv6,proto3,oneof" json:"ipv6,omitempty"`
+	// utf8 specifies that the field must be a valid UTF-8 encoded string.
+	Utf8 *bool `protobuf:"varint,40,opt,name=utf8,proto3,oneof" json:"utf8,omitempty"`
 }
 
 func (x *BytesTags) Reset() {
 	*x = BytesTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[8]
+		mi := &file_validator_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1522,7 +1947,7 @@ func (x *BytesTags) String() string {
 func (*BytesTags) ProtoMessage() {}
 
 func (x *BytesTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[8]
+	mi := &file_validator_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1535,7 +1960,14 @@ func (x *BytesTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BytesTags.ProtoReflect.Descriptor instead.
 func (*BytesTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{8}
+	return file_validator_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BytesTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
 }
 
 func (x *BytesTags) GetLenEq() int64 {
@@ -1580,11 +2012,91 @@ func (x *BytesTags) GetLenGte() int64 {
 	return 0
 }
 
+func (x *BytesTags) GetEq() []byte {
+	if x != nil {
+		return x.Eq
+	}
+	return nil
+}
+
+func (x *BytesTags) GetIn() [][]byte {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *BytesTags) GetNotIn() [][]byte {
+	if x != nil {
+		return x.NotIn
+	}
+	return nil
+}
+
+func (x *BytesTags) GetPrefix() []byte {
+	if x != nil {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *BytesTags) GetSuffix() []byte {
+	if x != nil {
+		return x.Suffix
+	}
+	return nil
+}
+
+func (x *BytesTags) GetContains() []byte {
+	if x != nil {
+		return x.Contains
+	}
+	return nil
+}
+
+func (x *BytesTags) GetRegex() string {
+	if x != nil && x.Regex != nil {
+		return *x.Regex
+	}
+	return ""
+}
+
+func (x *BytesTags) GetIp() bool {
+	if x != nil && x.Ip != nil {
+		return *x.Ip
+	}
+	return false
+}
+
+func (x *BytesTags) GetIpv4() bool {
+	if x != nil && x.Ipv4 != nil {
+		return *x.Ipv4
+	}
+	return false
+}
+
+func (x *BytesTags) GetIpv6() bool {
+	if x != nil && x.Ipv6 != nil {
+		return *x.Ipv6
+	}
+	return false
+}
+
+func (x *BytesTags) GetUtf8() bool {
+	if x != nil && x.Utf8 != nil {
+		return *x.Utf8
+	}
+	return false
+}
+
 type BoolTags struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// not_null specifies that the wrapper must be set. Only valid for the wrapper types,
+	// e.g. google.protobuf.Int64Value; the other tags are skipped when the wrapper is null.
+	NotNull *bool `protobuf:"varint,2,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
 	// eq specifies that this field must be exactly the specified value
 	Eq *bool `protobuf:"varint,3,opt,name=eq,proto3,oneof" json:"eq,omitempty"`
 }
@@ -1592,7 +2104,7 @@ type BoolTags struct {
 func (x *BoolTags) Reset() {
 	*x = BoolTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[9]
+		mi := &file_validator_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1605,7 +2117,7 @@ func (x *BoolTags) String() string {
 func (*BoolTags) ProtoMessage() {}
 
 func (x *BoolTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[9]
+	mi := &file_validator_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1618,7 +2130,14 @@ func (x *BoolTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BoolTags.ProtoReflect.Descriptor instead.
 func (*BoolTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{9}
+	return file_validator_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BoolTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
 }
 
 func (x *BoolTags) GetEq() bool {
@@ -1656,7 +2175,7 @@ type EnumTags struct {
 func (x *EnumTags) Reset() {
 	*x = EnumTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[10]
+		mi := &file_validator_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1669,7 +2188,7 @@ func (x *EnumTags) String() string {
 func (*EnumTags) ProtoMessage() {}
 
 func (x *EnumTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[10]
+	mi := &file_validator_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1682,7 +2201,7 @@ func (x *EnumTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnumTags.ProtoReflect.Descriptor instead.
 func (*EnumTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{10}
+	return file_validator_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *EnumTags) GetEq() int32 {
@@ -1762,7 +2281,7 @@ type MessageTags struct {
 func (x *MessageTags) Reset() {
 	*x = MessageTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[11]
+		mi := &file_validator_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1775,7 +2294,7 @@ func (x *MessageTags) String() string {
 func (*MessageTags) ProtoMessage() {}
 
 func (x *MessageTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[11]
+	mi := &file_validator_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1788,7 +2307,7 @@ func (x *MessageTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MessageTags.ProtoReflect.Descriptor instead.
 func (*MessageTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{11}
+	return file_validator_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MessageTags) GetNotNull() bool {
@@ -1836,7 +2355,7 @@ type RepeatedTags struct {
 func (x *RepeatedTags) Reset() {
 	*x = RepeatedTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[12]
+		mi := &file_validator_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1849,7 +2368,7 @@ func (x *RepeatedTags) String() string {
 func (*RepeatedTags) ProtoMessage() {}
 
 func (x *RepeatedTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[12]
+	mi := &file_validator_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1862,7 +2381,7 @@ func (x *RepeatedTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepeatedTags.ProtoReflect.Descriptor instead.
 func (*RepeatedTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{12}
+	return file_validator_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *RepeatedTags) GetNotNull() bool {
@@ -1958,7 +2477,7 @@ type MapTags struct {
 func (x *MapTags) Reset() {
 	*x = MapTags{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_validator_proto_msgTypes[13]
+		mi := &file_validator_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1971,7 +2490,7 @@ func (x *MapTags) String() string {
 func (*MapTags) ProtoMessage() {}
 
 func (x *MapTags) ProtoReflect() protoreflect.Message {
-	mi := &file_validator_proto_msgTypes[13]
+	mi := &file_validator_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1984,7 +2503,7 @@ func (x *MapTags) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MapTags.ProtoReflect.Descriptor instead.
 func (*MapTags) Descriptor() ([]byte, []int) {
-	return file_validator_proto_rawDescGZIP(), []int{13}
+	return file_validator_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MapTags) GetNotNull() bool {
@@ -2050,6 +2569,273 @@ func (x *MapTags) GetValue() *TagOptions {
 	return nil
 }
 
+// TimestampTags is the tags for google.protobuf.Timestamp.
+// The tags except not_null are skipped if the field is null.
+type TimestampTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// not_null specifies that this field must be set.
+	NotNull *bool `protobuf:"varint,1,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
+	// lt specifies that this field must be before the specified value.
+	Lt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=lt,proto3" json:"lt,omitempty"`
+	// gt specifies that this field must be after the specified value.
+	Gt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	// lte specifies that this field must be before or equal to the specified value.
+	Lte *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+	// gte specifies that this field must be after or equal to the specified value.
+	Gte *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=gte,proto3" json:"gte,omitempty"`
+	// lt_now specifies that this field must be before the current time when validating.
+	LtNow *bool `protobuf:"varint,6,opt,name=lt_now,json=ltNow,proto3,oneof" json:"lt_now,omitempty"`
+	// gt_now specifies that this field must be after the current time when validating.
+	GtNow *bool `protobuf:"varint,7,opt,name=gt_now,json=gtNow,proto3,oneof" json:"gt_now,omitempty"`
+	// within specifies that this field must be within the specified duration of the current time.
+	Within *durationpb.Duration `protobuf:"bytes,8,opt,name=within,proto3" json:"within,omitempty"`
+}
+
+func (x *TimestampTags) Reset() {
+	*x = TimestampTags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validator_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimestampTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimestampTags) ProtoMessage() {}
+
+func (x *TimestampTags) ProtoReflect() protoreflect.Message {
+	mi := &file_validator_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimestampTags.ProtoReflect.Descriptor instead.
+func (*TimestampTags) Descriptor() ([]byte, []int) {
+	return file_validator_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TimestampTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
+}
+
+func (x *TimestampTags) GetLt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *TimestampTags) GetGt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *TimestampTags) GetLte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+func (x *TimestampTags) GetGte() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+func (x *TimestampTags) GetLtNow() bool {
+	if x != nil && x.LtNow != nil {
+		return *x.LtNow
+	}
+	return false
+}
+
+func (x *TimestampTags) GetGtNow() bool {
+	if x != nil && x.GtNow != nil {
+		return *x.GtNow
+	}
+	return false
+}
+
+func (x *TimestampTags) GetWithin() *durationpb.Duration {
+	if x != nil {
+		return x.Within
+	}
+	return nil
+}
+
+// DurationTags is the tags for google.protobuf.Duration.
+// The tags except not_null are skipped if the field is null.
+type DurationTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// not_null specifies that this field must be set.
+	NotNull *bool `protobuf:"varint,1,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
+	// lt specifies that this field must be less than the specified value.
+	Lt *durationpb.Duration `protobuf:"bytes,2,opt,name=lt,proto3" json:"lt,omitempty"`
+	// gt specifies that this field must be greater than the specified value.
+	Gt *durationpb.Duration `protobuf:"bytes,3,opt,name=gt,proto3" json:"gt,omitempty"`
+	// lte specifies that this field must be less than or equal to the specified value.
+	Lte *durationpb.Duration `protobuf:"bytes,4,opt,name=lte,proto3" json:"lte,omitempty"`
+	// gte specifies that this field must be greater than or equal to the specified value.
+	Gte *durationpb.Duration `protobuf:"bytes,5,opt,name=gte,proto3" json:"gte,omitempty"`
+}
+
+func (x *DurationTags) Reset() {
+	*x = DurationTags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validator_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DurationTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DurationTags) ProtoMessage() {}
+
+func (x *DurationTags) ProtoReflect() protoreflect.Message {
+	mi := &file_validator_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DurationTags.ProtoReflect.Descriptor instead.
+func (*DurationTags) Descriptor() ([]byte, []int) {
+	return file_validator_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *DurationTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
+}
+
+func (x *DurationTags) GetLt() *durationpb.Duration {
+	if x != nil {
+		return x.Lt
+	}
+	return nil
+}
+
+func (x *DurationTags) GetGt() *durationpb.Duration {
+	if x != nil {
+		return x.Gt
+	}
+	return nil
+}
+
+func (x *DurationTags) GetLte() *durationpb.Duration {
+	if x != nil {
+		return x.Lte
+	}
+	return nil
+}
+
+func (x *DurationTags) GetGte() *durationpb.Duration {
+	if x != nil {
+		return x.Gte
+	}
+	return nil
+}
+
+// AnyTags is the tags for google.protobuf.Any.
+// The tags except not_null are skipped if the field is null.
+type AnyTags struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// not_null specifies that this field must be set.
+	NotNull *bool `protobuf:"varint,1,opt,name=not_null,json=notNull,proto3,oneof" json:"not_null,omitempty"`
+	// in specifies that the type url of this field must be in the specified value lists.
+	In []string `protobuf:"bytes,2,rep,name=in,proto3" json:"in,omitempty"`
+	// not_in specifies that the type url of this field must not be in the specified value lists.
+	NotIn []string `protobuf:"bytes,3,rep,name=not_in,json=notIn,proto3" json:"not_in,omitempty"`
+}
+
+func (x *AnyTags) Reset() {
+	*x = AnyTags{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_validator_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnyTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnyTags) ProtoMessage() {}
+
+func (x *AnyTags) ProtoReflect() protoreflect.Message {
+	mi := &file_validator_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnyTags.ProtoReflect.Descriptor instead.
+func (*AnyTags) Descriptor() ([]byte, []int) {
+	return file_validator_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AnyTags) GetNotNull() bool {
+	if x != nil && x.NotNull != nil {
+		return *x.NotNull
+	}
+	return false
+}
+
+func (x *AnyTags) GetIn() []string {
+	if x != nil {
+		return x.In
+	}
+	return nil
+}
+
+func (x *AnyTags) GetNotIn() []string {
+	if x != nil {
+		return x.NotIn
+	}
+	return nil
+}
+
 var file_validator_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
@@ -2067,6 +2853,14 @@ var file_validator_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,65031,opt,name=oneof",
 		Filename:      "validator.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: (*MessageOptions)(nil),
+		Field:         65032,
+		Name:          "validator.message",
+		Tag:           "bytes,65032,opt,name=message",
+		Filename:      "validator.proto",
+	},
 }
 
 // Extension fields to descriptorpb.FieldOptions.
@@ -2081,426 +2875,597 @@ var (
 	E_Oneof = &file_validator_proto_extTypes[1]
 )
 
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// optional validator.MessageOptions message = 65032;
+	E_Message = &file_validator_proto_extTypes[2]
+)
+
 var File_validator_proto protoreflect.FileDescriptor
 
 var file_validator_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x1a, 0x20, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x68,
-	0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d,
-	0x0a, 0x08, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x49, 0x66, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x12, 0x29, 0x0a,
-	0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x07, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x49, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61, 0x67,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04,
-	0x74, 0x61, 0x67, 0x73, 0x22, 0x8b, 0x04, 0x0a, 0x0a, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f,
-	0x6e, 0x65, 0x4f, 0x66, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12,
-	0x26, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73,
-	0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x04, 0x75, 0x69,
-	0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x04,
-	0x65, 0x6e, 0x75, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73, 0x48,
-	0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73,
-	0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x72,
-	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x61, 0x70, 0x54,
-	0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69,
-	0x6e, 0x64, 0x22, 0x38, 0x0a, 0x09, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x61, 0x67, 0x73, 0x12,
-	0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0xe0, 0x01, 0x0a,
-	0x09, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x12,
-	0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x02, 0x6e,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
-	0x48, 0x02, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x67, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x01, 0x48, 0x03, 0x52, 0x02, 0x67, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15,
-	0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x03, 0x6c,
-	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x01, 0x48, 0x05, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x01, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06,
-	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x6e, 0x6f,
-	0x74, 0x49, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6e,
-	0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67, 0x74, 0x42,
-	0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x74, 0x65, 0x22,
-	0xde, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x65,
-	0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01, 0x01,
-	0x12, 0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x02,
-	0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x02, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x67, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x02, 0x67, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x03,
-	0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a,
-	0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x6e,
-	0x6f, 0x74, 0x49, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67, 0x74,
-	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x74, 0x65,
-	0x22, 0xdf, 0x01, 0x0a, 0x08, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x0a,
-	0x02, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x02, 0x65, 0x71, 0x88,
-	0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x48, 0x01,
-	0x52, 0x02, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02,
-	0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x02, 0x67, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04,
-	0x52, 0x03, 0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x04, 0x52, 0x02, 0x69, 0x6e, 0x12,
-	0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x04, 0x52,
-	0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x67, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67,
-	0x74, 0x65, 0x22, 0x87, 0x1b, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67,
-	0x73, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x02, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x13, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x02,
-	0x67, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x04, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03,
-	0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x03, 0x67, 0x74, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68,
-	0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x06, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01, 0x01, 0x12,
-	0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4e,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e,
-	0x5f, 0x67, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x48, 0x08, 0x52, 0x09, 0x63, 0x68, 0x61,
-	0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61,
-	0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x48, 0x09,
-	0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25,
-	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x18,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x0a, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47,
-	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65,
-	0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0b, 0x52, 0x0a, 0x63,
-	0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b,
-	0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x0c, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01,
-	0x01, 0x12, 0x23, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65,
-	0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0d, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65,
-	0x6e, 0x4e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c,
-	0x65, 0x6e, 0x5f, 0x67, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0e, 0x52, 0x09, 0x62,
-	0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x62,
-	0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x0f, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x25, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65,
-	0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x48, 0x10, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65,
-	0x6e, 0x47, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f,
-	0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x48, 0x11, 0x52,
-	0x0a, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19,
-	0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x48, 0x12, 0x52,
-	0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x48, 0x13, 0x52, 0x06, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x14, 0x52, 0x08, 0x6e, 0x6f, 0x50,
-	0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66,
-	0x69, 0x78, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x15, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66,
-	0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66,
-	0x69, 0x78, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x16, 0x52, 0x08, 0x6e, 0x6f, 0x53, 0x75,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xc0, 0x02, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x12, 0x3c, 0x0a, 0x0f,
+	0x61, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x73, 0x74, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x66, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x61, 0x74,
+	0x4c, 0x65, 0x61, 0x73, 0x74, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x12, 0x3b, 0x0a, 0x0e, 0x65, 0x78,
+	0x61, 0x63, 0x74, 0x6c, 0x79, 0x5f, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x65, 0x78, 0x61, 0x63, 0x74,
+	0x6c, 0x79, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x12, 0x3a, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x5f, 0x6f,
+	0x72, 0x5f, 0x6e, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x6e,
+	0x65, 0x4f, 0x66, 0x12, 0x49, 0x0a, 0x12, 0x6d, 0x75, 0x74, 0x75, 0x61, 0x6c, 0x6c, 0x79, 0x5f,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x11, 0x6d, 0x75, 0x74,
+	0x75, 0x61, 0x6c, 0x6c, 0x79, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x65, 0x78, 0x70, 0x72, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x65, 0x78,
+	0x70, 0x72, 0x22, 0x24, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x22, 0x40, 0x0a, 0x0f, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2d, 0x0a, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22, 0xa1, 0x02, 0x0a, 0x0c, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x5f, 0x69, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
+	0x66, 0x52, 0x07, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x12, 0x29, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x41,
+	0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x4a,
+	0x0a, 0x07, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65,
+	0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12,
+	0x29, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0xa4, 0x05, 0x0a, 0x0a, 0x54,
+	0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00,
+	0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x2e, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x05,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x03, 0x69, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x49,
+	0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x03, 0x69, 0x6e, 0x74, 0x12, 0x29, 0x0a,
+	0x04, 0x75, 0x69, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x48, 0x00, 0x52, 0x04, 0x75, 0x69, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00,
+	0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x62, 0x6f, 0x6f, 0x6c, 0x18,
+	0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x04, 0x62, 0x6f,
+	0x6f, 0x6c, 0x12, 0x29, 0x0a, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x04, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x32, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08,
+	0x72, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x03, 0x6d, 0x61, 0x70, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x2e, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x03, 0x6d, 0x61, 0x70,
+	0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x61, 0x67, 0x73, 0x48, 0x00, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x26, 0x0a, 0x03, 0x61, 0x6e, 0x79, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x6e, 0x79, 0x54, 0x61,
+	0x67, 0x73, 0x48, 0x00, 0x52, 0x03, 0x61, 0x6e, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x22, 0x38, 0x0a, 0x09, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0xcf, 0x03, 0x0a, 0x09,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74,
+	0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e,
+	0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x48, 0x01, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x12, 0x13,
+	0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x48, 0x02, 0x52, 0x02, 0x6e, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x48,
+	0x03, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x04, 0x52, 0x02, 0x67, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x48, 0x05, 0x52, 0x03, 0x6c, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x01, 0x48, 0x06, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x01, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x49, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x07, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x48, 0x08, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66,
+	0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x09, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x2a, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x63,
+	0x65, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0a, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x67, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a,
+	0x04, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x22, 0xe6, 0x02,
+	0x0a, 0x07, 0x49, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74,
+	0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e,
+	0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x12, 0x13,
+	0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x02, 0x6e, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x03, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x02, 0x67, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a,
+	0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x03, 0x6c, 0x74,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x06, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x03, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x49, 0x6e, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x07, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x08, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c,
+	0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6e, 0x65, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67, 0x74, 0x42, 0x06, 0x0a, 0x04,
+	0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06,
+	0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x22, 0xe7, 0x02, 0x0a, 0x08, 0x55, 0x69, 0x6e, 0x74, 0x54,
+	0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48,
+	0x01, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x02, 0x52, 0x02, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a,
+	0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x48, 0x03, 0x52, 0x02, 0x6c, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x48, 0x04,
+	0x52, 0x02, 0x67, 0x74, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x04, 0x48, 0x05, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15,
+	0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x48, 0x06, 0x52, 0x03, 0x67,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x19, 0x0a, 0x05,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x05, 0x72,
+	0x61, 0x6e, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x48, 0x08, 0x52, 0x0a,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65,
+	0x71, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x67, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06,
+	0x0a, 0x04, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66,
+	0x22, 0xb4, 0x1b, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x13, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x02, 0x65,
+	0x71, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x02, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13,
+	0x0a, 0x02, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x02, 0x67, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x05, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72,
+	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x48, 0x07, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a,
+	0x0b, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x18, 0x15, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x08, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
+	0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x48, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x72, 0x4c,
+	0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x5f,
+	0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0a, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c,
+	0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x0b, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
+	0x6c, 0x74, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0c, 0x52, 0x0a, 0x63, 0x68, 0x61,
+	0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x62, 0x79,
+	0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x0d, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01, 0x01, 0x12,
+	0x23, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x0e, 0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4e,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
+	0x5f, 0x67, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x48, 0x0f, 0x52, 0x09, 0x62, 0x79, 0x74,
+	0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x48, 0x10,
+	0x52, 0x09, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x11, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x48, 0x12, 0x52, 0x0a, 0x62,
+	0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x48, 0x13, 0x52, 0x05, 0x72,
+	0x65, 0x67, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x48, 0x14, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x15, 0x52, 0x08, 0x6e, 0x6f, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x48, 0x16, 0x52, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x48, 0x17, 0x52, 0x08, 0x6e, 0x6f, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x18, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x19, 0x52, 0x0b,
+	0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x2f,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x1a, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x41, 0x6e, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x1b, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41,
+	0x6e, 0x79, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x75, 0x74, 0x66, 0x38, 0x18, 0x51, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x1c, 0x52, 0x04, 0x75, 0x74, 0x66, 0x38, 0x88, 0x01, 0x01, 0x12, 0x19,
+	0x0a, 0x05, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x47, 0x20, 0x01, 0x28, 0x08, 0x48, 0x1d, 0x52,
+	0x05, 0x61, 0x73, 0x63, 0x69, 0x69, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x48, 0x20, 0x01, 0x28, 0x08, 0x48, 0x1e,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x63, 0x69, 0x69, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x1f, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x21,
+	0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x20, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x21, 0x0a, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4b,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x21, 0x52, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x4c, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x22, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x23, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c,
+	0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4e, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x24, 0x52, 0x0b, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x65, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x25, 0x52, 0x02, 0x69, 0x70, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x70, 0x76,
+	0x34, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x48, 0x26, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x67, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x27, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x48, 0x28, 0x52, 0x06,
+	0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69, 0x70, 0x34,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x48, 0x29, 0x52, 0x07, 0x69,
+	0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69, 0x70, 0x36,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x2a, 0x52, 0x07, 0x69,
+	0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x64,
+	0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x2b, 0x52, 0x04, 0x63, 0x69, 0x64, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x18, 0x6c, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x2c, 0x52, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x88, 0x01, 0x01, 0x12,
+	0x1b, 0x0a, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x2d, 0x52, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08,
+	0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x2e,
+	0x52, 0x07, 0x74, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
+	0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x70, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x2f, 0x52, 0x08, 0x74, 0x63, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20,
+	0x0a, 0x09, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x71, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x30, 0x52, 0x08, 0x74, 0x63, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x1e, 0x0a, 0x08, 0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x72, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x31, 0x52, 0x07, 0x75, 0x64, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x20, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x73, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x32, 0x52, 0x08, 0x75, 0x64, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x74, 0x20, 0x01, 0x28, 0x08, 0x48, 0x33, 0x52, 0x08, 0x75, 0x64, 0x70, 0x36, 0x41, 0x64, 0x64,
+	0x72, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x6e, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x34, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x75, 0x20, 0x01, 0x28, 0x08, 0x48, 0x35,
+	0x52, 0x08, 0x75, 0x6e, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a,
+	0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x36, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2e,
+	0x0a, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63, 0x31, 0x31,
+	0x32, 0x33, 0x18, 0x77, 0x20, 0x01, 0x28, 0x08, 0x48, 0x37, 0x52, 0x0f, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x28,
+	0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x78, 0x20, 0x01, 0x28, 0x08, 0x48, 0x38, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x79, 0x20, 0x01, 0x28, 0x08, 0x48, 0x39, 0x52, 0x07, 0x64, 0x61,
+	0x74, 0x61, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x71, 0x64, 0x6e,
+	0x18, 0x7a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3a, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e, 0x88, 0x01,
+	0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3b,
+	0x52, 0x03, 0x75, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18,
+	0x7c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3c, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x24, 0x0a, 0x0b, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x7d,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x3d, 0x52, 0x0a, 0x75, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72,
+	0x6f, 0x6e, 0x18, 0x50, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3e, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x78,
+	0x43, 0x72, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3f, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x8d, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x40, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x16, 0x0a,
+	0x03, 0x6a, 0x77, 0x74, 0x18, 0x8e, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x41, 0x52, 0x03, 0x6a,
+	0x77, 0x74, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x8f, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x48, 0x42, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x88, 0x01, 0x01, 0x12,
+	0x27, 0x0a, 0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18,
+	0x90, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x43, 0x52, 0x0b, 0x68, 0x74, 0x6d, 0x6c, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65,
+	0x36, 0x34, 0x18, 0x91, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x44, 0x52, 0x06, 0x62, 0x61, 0x73,
+	0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x92, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x45, 0x52, 0x09, 0x62,
+	0x61, 0x73, 0x65, 0x36, 0x34, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0b, 0x68,
+	0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x93, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x46, 0x52, 0x0b, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x94, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x47, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x95, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x48, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18,
+	0x96, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x49, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x31, 0x18, 0x97, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x4a, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x31, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x05, 0x75, 0x75, 0x69, 0x64, 0x33, 0x18, 0x98, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x4b, 0x52,
+	0x05, 0x75, 0x75, 0x69, 0x64, 0x33, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x75, 0x75, 0x69,
+	0x64, 0x34, 0x18, 0x99, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x4c, 0x52, 0x05, 0x75, 0x75, 0x69,
+	0x64, 0x34, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x35, 0x18, 0x9a,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x4d, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x35, 0x88, 0x01,
+	0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x05,
+	0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c,
+	0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x73, 0x63, 0x69,
+	0x69, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69,
+	0x69, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x42, 0x0c, 0x0a,
+	0x0a, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f,
+	0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x61, 0x6c,
+	0x70, 0x68, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x07,
+	0x0a, 0x05, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x69, 0x64, 0x72,
+	0x76, 0x34, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
+	0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x63, 0x70,
+	0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x63, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x72, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x42,
+	0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x6c, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42,
+	0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a, 0x77, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x68, 0x74, 0x6d,
+	0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x31, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x33, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x34, 0x42, 0x08, 0x0a,
+	0x06, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x35, 0x22, 0x90, 0x05, 0x0a, 0x09, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75,
+	0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52,
+	0x05, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x5f, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x6e,
+	0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x07, 0x52,
+	0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
+	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x1b, 0x0a,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x08, 0x52,
+	0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x73, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x09, 0x52, 0x06, 0x73, 0x75,
 	0x66, 0x66, 0x69, 0x78, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x48, 0x17, 0x52, 0x08, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x18,
-	0x52, 0x0b, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x26, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79,
-	0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x19, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x73, 0x41, 0x6e, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x10, 0x6e, 0x6f, 0x74, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x18, 0x30, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x1a, 0x52, 0x0e, 0x6e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x73, 0x41, 0x6e, 0x79, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x75, 0x74, 0x66, 0x38, 0x18,
-	0x51, 0x20, 0x01, 0x28, 0x08, 0x48, 0x1b, 0x52, 0x04, 0x75, 0x74, 0x66, 0x38, 0x88, 0x01, 0x01,
-	0x12, 0x19, 0x0a, 0x05, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x47, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x1c, 0x52, 0x05, 0x61, 0x73, 0x63, 0x69, 0x69, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x70,
-	0x72, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x48, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x1d, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x63, 0x69, 0x69, 0x88, 0x01,
-	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x49, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x1e, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x88, 0x01, 0x01,
-	0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4a, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x1f, 0x52, 0x09, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65,
-	0x18, 0x4b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x20, 0x52, 0x09, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63,
-	0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18,
-	0x4c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x21, 0x52, 0x05, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x88, 0x01,
-	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4d, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x22, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26,
-	0x0a, 0x0c, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4e,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x23, 0x52, 0x0b, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x24, 0x52, 0x02, 0x69, 0x70, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x69,
-	0x70, 0x76, 0x34, 0x18, 0x66, 0x20, 0x01, 0x28, 0x08, 0x48, 0x25, 0x52, 0x04, 0x69, 0x70, 0x76,
-	0x34, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x67, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x26, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a,
-	0x07, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x08, 0x48, 0x27,
-	0x52, 0x06, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69,
-	0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x69, 0x20, 0x01, 0x28, 0x08, 0x48, 0x28, 0x52,
-	0x07, 0x69, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x69,
-	0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x29, 0x52,
-	0x07, 0x69, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63,
-	0x69, 0x64, 0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x2a, 0x52, 0x04, 0x63, 0x69, 0x64,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x18, 0x6c,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x2b, 0x52, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x88, 0x01,
-	0x01, 0x12, 0x1b, 0x0a, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x18, 0x6d, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x2c, 0x52, 0x06, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x88, 0x01, 0x01, 0x12, 0x1e,
-	0x0a, 0x08, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x2d, 0x52, 0x07, 0x74, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x20,
-	0x0a, 0x09, 0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x70, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x2e, 0x52, 0x08, 0x74, 0x63, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01,
-	0x12, 0x20, 0x0a, 0x09, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x71, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x2f, 0x52, 0x08, 0x74, 0x63, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x72,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x30, 0x52, 0x07, 0x75, 0x64, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88,
-	0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x73, 0x20, 0x01, 0x28, 0x08, 0x48, 0x31, 0x52, 0x08, 0x75, 0x64, 0x70, 0x34, 0x41, 0x64, 0x64,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x74, 0x20, 0x01, 0x28, 0x08, 0x48, 0x32, 0x52, 0x08, 0x75, 0x64, 0x70, 0x36, 0x41,
-	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6d, 0x61, 0x63, 0x18, 0x6e, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x33, 0x52, 0x03, 0x6d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a,
-	0x09, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x75, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x34, 0x52, 0x08, 0x75, 0x6e, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12,
-	0x1f, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28,
-	0x08, 0x48, 0x35, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x2e, 0x0a, 0x10, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63,
-	0x31, 0x31, 0x32, 0x33, 0x18, 0x77, 0x20, 0x01, 0x28, 0x08, 0x48, 0x36, 0x52, 0x0f, 0x68, 0x6f,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x88, 0x01, 0x01,
-	0x12, 0x28, 0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72,
-	0x74, 0x18, 0x78, 0x20, 0x01, 0x28, 0x08, 0x48, 0x37, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x6e,
-	0x61, 0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x64, 0x61,
-	0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x79, 0x20, 0x01, 0x28, 0x08, 0x48, 0x38, 0x52, 0x07,
-	0x64, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x66, 0x71,
-	0x64, 0x6e, 0x18, 0x7a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x39, 0x52, 0x04, 0x66, 0x71, 0x64, 0x6e,
-	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x08,
-	0x48, 0x3a, 0x52, 0x03, 0x75, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x75, 0x72,
-	0x6c, 0x18, 0x7c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3b, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x88, 0x01,
-	0x01, 0x12, 0x24, 0x0a, 0x0b, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
-	0x18, 0x7d, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3c, 0x52, 0x0a, 0x75, 0x72, 0x6c, 0x45, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x78, 0x5f,
-	0x63, 0x72, 0x6f, 0x6e, 0x18, 0x50, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3d, 0x52, 0x08, 0x75, 0x6e,
-	0x69, 0x78, 0x43, 0x72, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x3e, 0x52, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x8d, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x48, 0x3f, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12,
-	0x16, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x8e, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x40, 0x52,
-	0x03, 0x6a, 0x77, 0x74, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x18,
-	0x8f, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x41, 0x52, 0x04, 0x68, 0x74, 0x6d, 0x6c, 0x88, 0x01,
-	0x01, 0x12, 0x27, 0x0a, 0x0c, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x64, 0x18, 0x90, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x42, 0x52, 0x0b, 0x68, 0x74, 0x6d, 0x6c,
-	0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x06, 0x62, 0x61,
-	0x73, 0x65, 0x36, 0x34, 0x18, 0x91, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x43, 0x52, 0x06, 0x62,
-	0x61, 0x73, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65,
-	0x36, 0x34, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x92, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x44, 0x52,
-	0x09, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a,
-	0x0b, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x93, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x45, 0x52, 0x0b, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x94, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x46, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65,
-	0x74, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
-	0x6f, 0x6e, 0x65, 0x18, 0x95, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x47, 0x52, 0x08, 0x74, 0x69,
-	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x18, 0x0a, 0x04, 0x75, 0x75, 0x69,
-	0x64, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x48, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x31, 0x18, 0x97, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x49, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x1a, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x33, 0x18, 0x98, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x4a, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x33, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x75,
-	0x75, 0x69, 0x64, 0x34, 0x18, 0x99, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x4b, 0x52, 0x05, 0x75,
-	0x75, 0x69, 0x64, 0x34, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x05, 0x75, 0x75, 0x69, 0x64, 0x35,
-	0x18, 0x9a, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x4c, 0x52, 0x05, 0x75, 0x75, 0x69, 0x64, 0x35,
-	0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6e,
-	0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67, 0x74, 0x42,
-	0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x74, 0x65, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65,
-	0x71, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e,
-	0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
-	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c,
-	0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
-	0x74, 0x65, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6c, 0x74, 0x65, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x09, 0x0a,
-	0x07, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x5f,
+	0x69, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x0a, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x48, 0x0b, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78,
+	0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x0c, 0x52, 0x02, 0x69, 0x70, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x34,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0d, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x88, 0x01,
+	0x01, 0x12, 0x17, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x0e, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x75, 0x74,
+	0x66, 0x38, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x48, 0x0f, 0x52, 0x04, 0x75, 0x74, 0x66, 0x38,
+	0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c,
+	0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x42, 0x09, 0x0a, 0x07, 0x5f,
+	0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c,
+	0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x42, 0x0a, 0x0a, 0x08,
+	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e,
+	0x5f, 0x67, 0x74, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x09, 0x0a, 0x07, 0x5f,
 	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69,
-	0x78, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x42, 0x13,
-	0x0a, 0x11, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f,
-	0x61, 0x6e, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x42, 0x08, 0x0a, 0x06,
-	0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65,
-	0x61, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x69, 0x70, 0x76, 0x34, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x70,
-	0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x69, 0x64, 0x72,
-	0x76, 0x36, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42,
-	0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0c, 0x0a,
-	0x0a, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
-	0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x64, 0x70,
-	0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6d, 0x61, 0x63, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x68,
-	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x66, 0x71, 0x64, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x06, 0x0a, 0x04,
-	0x5f, 0x75, 0x72, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72,
-	0x6f, 0x6e, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x07, 0x0a, 0x05,
-	0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6a, 0x77, 0x74, 0x42, 0x07, 0x0a,
-	0x05, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f,
-	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x62, 0x61, 0x73, 0x65,
-	0x36, 0x34, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x75, 0x72,
-	0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61,
-	0x6c, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x31, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x33, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x34, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x35, 0x22, 0xfb, 0x01, 0x0a,
-	0x09, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65,
-	0x6e, 0x5f, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x65,
-	0x6e, 0x45, 0x71, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4e, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a,
-	0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03,
-	0x52, 0x05, 0x6c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65,
-	0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x06, 0x6c,
-	0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x5f,
-	0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e,
-	0x47, 0x74, 0x65, 0x88, 0x01, 0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65,
-	0x71, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x67, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x0a,
-	0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x22, 0x26, 0x0a, 0x08, 0x42, 0x6f,
-	0x6f, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f,
-	0x65, 0x71, 0x22, 0x8c, 0x02, 0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73, 0x12,
-	0x13, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x02, 0x65,
-	0x71, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x01, 0x52, 0x02, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x05, 0x48, 0x02, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13,
-	0x0a, 0x02, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x02, 0x67, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05,
-	0x48, 0x04, 0x52, 0x03, 0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x02, 0x69,
-	0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28,
-	0x05, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x65,
-	0x6e, 0x75, 0x6d, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x07, 0x69, 0x6e,
-	0x45, 0x6e, 0x75, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a,
-	0x03, 0x5f, 0x67, 0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04,
-	0x5f, 0x67, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
-	0x73, 0x22, 0x5c, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73,
-	0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01,
-	0x12, 0x17, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01,
-	0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x22,
-	0xfe, 0x02, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73,
-	0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01,
-	0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06,
-	0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x05,
-	0x6c, 0x65, 0x6e, 0x4e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4c,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x03, 0x48, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c,
-	0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48,
-	0x06, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06,
-	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x06,
-	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x04, 0x69, 0x74, 0x65,
-	0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04,
-	0x69, 0x74, 0x65, 0x6d, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c,
-	0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x42, 0x0a, 0x0a,
-	0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65,
-	0x6e, 0x5f, 0x67, 0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x22, 0xfc, 0x02, 0x0a, 0x07, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08,
-	0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
-	0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06,
-	0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05,
-	0x6c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4e,
-	0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x48, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07,
-	0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52,
-	0x06, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65,
-	0x6e, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x06, 0x6c,
-	0x65, 0x6e, 0x47, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x2b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x61, 0x67,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b,
-	0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f,
-	0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e,
-	0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07,
-	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6c, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x3a,
-	0x4e, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfc, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a,
-	0x4e, 0x0a, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x87, 0xfc, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x42,
-	0x67, 0x0a, 0x24, 0x69, 0x6f, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x79, 0x75, 0x33,
-	0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2e, 0x70, 0x62, 0x2e, 0x70, 0x62, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x0b, 0x50, 0x42, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x50, 0x00, 0x5a, 0x30, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x33, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x78, 0x67, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x62, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x78, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x70, 0x42,
+	0x07, 0x0a, 0x05, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x69, 0x70, 0x76,
+	0x36, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x22, 0x53, 0x0a, 0x08, 0x42, 0x6f,
+	0x6f, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75,
+	0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e,
+	0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x22,
+	0x8c, 0x02, 0x0a, 0x08, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73, 0x12, 0x13, 0x0a, 0x02,
+	0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x02, 0x65, 0x71, 0x88, 0x01,
+	0x01, 0x12, 0x13, 0x0a, 0x02, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x01, 0x52,
+	0x02, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x48, 0x02, 0x52, 0x02, 0x6c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x13, 0x0a, 0x02, 0x67,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x48, 0x03, 0x52, 0x02, 0x67, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x15, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x48, 0x04, 0x52,
+	0x03, 0x6c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x15, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x48, 0x05, 0x52, 0x03, 0x67, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x03, 0x28, 0x05, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15,
+	0x0a, 0x06, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x05, 0x52, 0x05,
+	0x6e, 0x6f, 0x74, 0x49, 0x6e, 0x12, 0x1e, 0x0a, 0x08, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x48, 0x06, 0x52, 0x07, 0x69, 0x6e, 0x45, 0x6e, 0x75,
+	0x6d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x65, 0x71, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x6e, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x6c, 0x74, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x67,
+	0x74, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x67, 0x74,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x22, 0x5c,
+	0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a,
+	0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a,
+	0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x04, 0x73,
+	0x6b, 0x69, 0x70, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e,
+	0x75, 0x6c, 0x6c, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x22, 0xfe, 0x02, 0x0a,
+	0x0c, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a,
+	0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48,
+	0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52,
+	0x05, 0x6c, 0x65, 0x6e, 0x45, 0x71, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x5f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x65, 0x6e,
+	0x4e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x04, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a,
+	0x07, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05,
+	0x52, 0x06, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c,
+	0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x06,
+	0x6c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x48, 0x07, 0x52, 0x06, 0x75, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x6e, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c,
+	0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
+	0x74, 0x65, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x22, 0xfc, 0x02,
+	0x0a, 0x07, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74,
+	0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e,
+	0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e,
+	0x5f, 0x65, 0x71, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x65, 0x6e,
+	0x45, 0x71, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x48, 0x02, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4e, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x65, 0x6e, 0x4c, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1a, 0x0a,
+	0x06, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x48, 0x04, 0x52,
+	0x05, 0x6c, 0x65, 0x6e, 0x47, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65, 0x6e,
+	0x5f, 0x6c, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x05, 0x52, 0x06, 0x6c, 0x65,
+	0x6e, 0x4c, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x06, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x47,
+	0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54,
+	0x61, 0x67, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2b,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x54, 0x61, 0x67, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e,
+	0x5f, 0x65, 0x71, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x42, 0x09,
+	0x0a, 0x07, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x67, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x22, 0xf1, 0x02, 0x0a,
+	0x0d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e,
+	0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x2a,
+	0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x67, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x03, 0x6c, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x67,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x06, 0x6c, 0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x01, 0x52, 0x05, 0x6c, 0x74, 0x4e, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x1a,
+	0x0a, 0x06, 0x67, 0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02,
+	0x52, 0x05, 0x67, 0x74, 0x4e, 0x6f, 0x77, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x6c,
+	0x74, 0x5f, 0x6e, 0x6f, 0x77, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x67, 0x74, 0x5f, 0x6e, 0x6f, 0x77,
+	0x22, 0xeb, 0x01, 0x0a, 0x0c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x29, 0x0a, 0x02, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x6c, 0x74, 0x12, 0x29, 0x0a, 0x02,
+	0x67, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x02, 0x67, 0x74, 0x12, 0x2b, 0x0a, 0x03, 0x6c, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x03, 0x6c, 0x74, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x67, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x74,
+	0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x22, 0x5d,
+	0x0a, 0x07, 0x41, 0x6e, 0x79, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x08, 0x6e, 0x6f, 0x74,
+	0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x6e,
+	0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x69, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x49, 0x6e,
+	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x3a, 0x4e, 0x0a,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xfc, 0xfb, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x3a, 0x4e, 0x0a,
+	0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x87, 0xfc, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x3a, 0x56, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x88, 0xfc, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x67, 0x0a, 0x24, 0x69, 0x6f, 0x2e, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x79, 0x75, 0x33, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2e, 0x70,
+	0x62, 0x2e, 0x70, 0x62, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x0b, 0x50,
+	0x42, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x50, 0x00, 0x5a, 0x30, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x33, 0x31, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x78, 0x67, 0x6f, 0x2f,
+	0x70, 0x62, 0x2f, 0x70, 0x62, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2515,52 +3480,82 @@ func file_validator_proto_rawDescGZIP() []byte {
 	return file_validator_proto_rawDescData
 }
 
-var file_validator_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_validator_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_validator_proto_goTypes = []interface{}{
-	(*ValidOptions)(nil),              // 0: validator.ValidOptions
-	(*CheckIf)(nil),                   // 1: validator.CheckIf
-	(*TagOptions)(nil),                // 2: validator.TagOptions
-	(*OneOfTags)(nil),                 // 3: validator.OneOfTags
-	(*FloatTags)(nil),                 // 4: validator.FloatTags
-	(*IntTags)(nil),                   // 5: validator.IntTags
-	(*UintTags)(nil),                  // 6: validator.UintTags
-	(*StringTags)(nil),                // 7: validator.StringTags
-	(*BytesTags)(nil),                 // 8: validator.BytesTags
-	(*BoolTags)(nil),                  // 9: validator.BoolTags
-	(*EnumTags)(nil),                  // 10: validator.EnumTags
-	(*MessageTags)(nil),               // 11: validator.MessageTags
-	(*RepeatedTags)(nil),              // 12: validator.RepeatedTags
-	(*MapTags)(nil),                   // 13: validator.MapTags
-	(*descriptorpb.FieldOptions)(nil), // 14: google.protobuf.FieldOptions
-	(*descriptorpb.OneofOptions)(nil), // 15: google.protobuf.OneofOptions
+	(*MessageOptions)(nil),              // 0: validator.MessageOptions
+	(*FieldGroup)(nil),                  // 1: validator.FieldGroup
+	(*ExclusiveGroups)(nil),             // 2: validator.ExclusiveGroups
+	(*ValidOptions)(nil),                // 3: validator.ValidOptions
+	(*CheckIf)(nil),                     // 4: validator.CheckIf
+	(*TagOptions)(nil),                  // 5: validator.TagOptions
+	(*OneOfTags)(nil),                   // 6: validator.OneOfTags
+	(*FloatTags)(nil),                   // 7: validator.FloatTags
+	(*IntTags)(nil),                     // 8: validator.IntTags
+	(*UintTags)(nil),                    // 9: validator.UintTags
+	(*StringTags)(nil),                  // 10: validator.StringTags
+	(*BytesTags)(nil),                   // 11: validator.BytesTags
+	(*BoolTags)(nil),                    // 12: validator.BoolTags
+	(*EnumTags)(nil),                    // 13: validator.EnumTags
+	(*MessageTags)(nil),                 // 14: validator.MessageTags
+	(*RepeatedTags)(nil),                // 15: validator.RepeatedTags
+	(*MapTags)(nil),                     // 16: validator.MapTags
+	(*TimestampTags)(nil),               // 17: validator.TimestampTags
+	(*DurationTags)(nil),                // 18: validator.DurationTags
+	(*AnyTags)(nil),                     // 19: validator.AnyTags
+	nil,                                 // 20: validator.ValidOptions.MessagesEntry
+	(*timestamppb.Timestamp)(nil),       // 21: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),         // 22: google.protobuf.Duration
+	(*descriptorpb.FieldOptions)(nil),   // 23: google.protobuf.FieldOptions
+	(*descriptorpb.OneofOptions)(nil),   // 24: google.protobuf.OneofOptions
+	(*descriptorpb.MessageOptions)(nil), // 25: google.protobuf.MessageOptions
 }
 var file_validator_proto_depIdxs = []int32{
-	1,  // 0: validator.ValidOptions.check_if:type_name -> validator.CheckIf
-	2,  // 1: validator.ValidOptions.tags:type_name -> validator.TagOptions
-	2,  // 2: validator.CheckIf.tags:type_name -> validator.TagOptions
-	3,  // 3: validator.TagOptions.oneof:type_name -> validator.OneOfTags
-	4,  // 4: validator.TagOptions.float:type_name -> validator.FloatTags
-	5,  // 5: validator.TagOptions.int:type_name -> validator.IntTags
-	6,  // 6: validator.TagOptions.uint:type_name -> validator.UintTags
-	7,  // 7: validator.TagOptions.string:type_name -> validator.StringTags
-	8,  // 8: validator.TagOptions.bytes:type_name -> validator.BytesTags
-	9,  // 9: validator.TagOptions.bool:type_name -> validator.BoolTags
-	10, // 10: validator.TagOptions.enum:type_name -> validator.EnumTags
-	11, // 11: validator.TagOptions.message:type_name -> validator.MessageTags
-	12, // 12: validator.TagOptions.repeated:type_name -> validator.RepeatedTags
-	13, // 13: validator.TagOptions.map:type_name -> validator.MapTags
-	2,  // 14: validator.RepeatedTags.item:type_name -> validator.TagOptions
-	2,  // 15: validator.MapTags.key:type_name -> validator.TagOptions
-	2,  // 16: validator.MapTags.value:type_name -> validator.TagOptions
-	14, // 17: validator.field:extendee -> google.protobuf.FieldOptions
-	15, // 18: validator.oneof:extendee -> google.protobuf.OneofOptions
-	0,  // 19: validator.field:type_name -> validator.ValidOptions
-	0,  // 20: validator.oneof:type_name -> validator.ValidOptions
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	19, // [19:21] is the sub-list for extension type_name
-	17, // [17:19] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	1,  // 0: validator.MessageOptions.at_least_one_of:type_name -> validator.FieldGroup
+	1,  // 1: validator.MessageOptions.exactly_one_of:type_name -> validator.FieldGroup
+	1,  // 2: validator.MessageOptions.all_or_none_of:type_name -> validator.FieldGroup
+	2,  // 3: validator.MessageOptions.mutually_exclusive:type_name -> validator.ExclusiveGroups
+	1,  // 4: validator.ExclusiveGroups.groups:type_name -> validator.FieldGroup
+	4,  // 5: validator.ValidOptions.check_if:type_name -> validator.CheckIf
+	5,  // 6: validator.ValidOptions.tags:type_name -> validator.TagOptions
+	20, // 7: validator.ValidOptions.messages:type_name -> validator.ValidOptions.MessagesEntry
+	5,  // 8: validator.CheckIf.tags:type_name -> validator.TagOptions
+	6,  // 9: validator.TagOptions.oneof:type_name -> validator.OneOfTags
+	7,  // 10: validator.TagOptions.float:type_name -> validator.FloatTags
+	8,  // 11: validator.TagOptions.int:type_name -> validator.IntTags
+	9,  // 12: validator.TagOptions.uint:type_name -> validator.UintTags
+	10, // 13: validator.TagOptions.string:type_name -> validator.StringTags
+	11, // 14: validator.TagOptions.bytes:type_name -> validator.BytesTags
+	12, // 15: validator.TagOptions.bool:type_name -> validator.BoolTags
+	13, // 16: validator.TagOptions.enum:type_name -> validator.EnumTags
+	14, // 17: validator.TagOptions.message:type_name -> validator.MessageTags
+	15, // 18: validator.TagOptions.repeated:type_name -> validator.RepeatedTags
+	16, // 19: validator.TagOptions.map:type_name -> validator.MapTags
+	17, // 20: validator.TagOptions.timestamp:type_name -> validator.TimestampTags
+	18, // 21: validator.TagOptions.duration:type_name -> validator.DurationTags
+	19, // 22: validator.TagOptions.any:type_name -> validator.AnyTags
+	5,  // 23: validator.RepeatedTags.item:type_name -> validator.TagOptions
+	5,  // 24: validator.MapTags.key:type_name -> validator.TagOptions
+	5,  // 25: validator.MapTags.value:type_name -> validator.TagOptions
+	21, // 26: validator.TimestampTags.lt:type_name -> google.protobuf.Timestamp
+	21, // 27: validator.TimestampTags.gt:type_name -> google.protobuf.Timestamp
+	21, // 28: validator.TimestampTags.lte:type_name -> google.protobuf.Timestamp
+	21, // 29: validator.TimestampTags.gte:type_name -> google.protobuf.Timestamp
+	22, // 30: validator.TimestampTags.within:type_name -> google.protobuf.Duration
+	22, // 31: validator.DurationTags.lt:type_name -> google.protobuf.Duration
+	22, // 32: validator.DurationTags.gt:type_name -> google.protobuf.Duration
+	22, // 33: validator.DurationTags.lte:type_name -> google.protobuf.Duration
+	22, // 34: validator.DurationTags.gte:type_name -> google.protobuf.Duration
+	23, // 35: validator.field:extendee -> google.protobuf.FieldOptions
+	24, // 36: validator.oneof:extendee -> google.protobuf.OneofOptions
+	25, // 37: validator.message:extendee -> google.protobuf.MessageOptions
+	3,  // 38: validator.field:type_name -> validator.ValidOptions
+	3,  // 39: validator.oneof:type_name -> validator.ValidOptions
+	0,  // 40: validator.message:type_name -> validator.MessageOptions
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	38, // [38:41] is the sub-list for extension type_name
+	35, // [35:38] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_validator_proto_init() }
@@ -2570,7 +3565,7 @@ func file_validator_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_validator_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidOptions); i {
+			switch v := v.(*MessageOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2582,7 +3577,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckIf); i {
+			switch v := v.(*FieldGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2594,7 +3589,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TagOptions); i {
+			switch v := v.(*ExclusiveGroups); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2606,7 +3601,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OneOfTags); i {
+			switch v := v.(*ValidOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2618,7 +3613,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FloatTags); i {
+			switch v := v.(*CheckIf); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2630,7 +3625,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*IntTags); i {
+			switch v := v.(*TagOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2642,7 +3637,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UintTags); i {
+			switch v := v.(*OneOfTags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2654,7 +3649,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StringTags); i {
+			switch v := v.(*FloatTags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2666,7 +3661,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BytesTags); i {
+			switch v := v.(*IntTags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2678,7 +3673,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolTags); i {
+			switch v := v.(*UintTags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2690,7 +3685,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnumTags); i {
+			switch v := v.(*StringTags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2702,7 +3697,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageTags); i {
+			switch v := v.(*BytesTags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2714,7 +3709,7 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RepeatedTags); i {
+			switch v := v.(*BoolTags); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2726,6 +3721,42 @@ func file_validator_proto_init() {
 			}
 		}
 		file_validator_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validator_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validator_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepeatedTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validator_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MapTags); i {
 			case 0:
 				return &v.state
@@ -2737,8 +3768,44 @@ func file_validator_proto_init() {
 				return nil
 			}
 		}
+		file_validator_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimestampTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validator_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DurationTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_validator_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnyTags); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_validator_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_validator_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*TagOptions_Oneof)(nil),
 		(*TagOptions_Float)(nil),
 		(*TagOptions_Int)(nil),
@@ -2750,10 +3817,10 @@ func file_validator_proto_init() {
 		(*TagOptions_Message)(nil),
 		(*TagOptions_Repeated)(nil),
 		(*TagOptions_Map)(nil),
+		(*TagOptions_Timestamp)(nil),
+		(*TagOptions_Duration)(nil),
+		(*TagOptions_Any)(nil),
 	}
-	file_validator_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_validator_proto_msgTypes[4].OneofWrappers = []interface{}{}
-	file_validator_proto_msgTypes[5].OneofWrappers = []interface{}{}
 	file_validator_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	file_validator_proto_msgTypes[7].OneofWrappers = []interface{}{}
 	file_validator_proto_msgTypes[8].OneofWrappers = []interface{}{}
@@ -2762,14 +3829,20 @@ func file_validator_proto_init() {
 	file_validator_proto_msgTypes[11].OneofWrappers = []interface{}{}
 	file_validator_proto_msgTypes[12].OneofWrappers = []interface{}{}
 	file_validator_proto_msgTypes[13].OneofWrappers = []interface{}{}
+	file_validator_proto_msgTypes[14].OneofWrappers = []interface{}{}
+	file_validator_proto_msgTypes[15].OneofWrappers = []interface{}{}
+	file_validator_proto_msgTypes[16].OneofWrappers = []interface{}{}
+	file_validator_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_validator_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_validator_proto_msgTypes[19].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_validator_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
-			NumExtensions: 2,
+			NumMessages:   21,
+			NumExtensions: 3,
 			NumServices:   0,
 		},
 		GoTypes:           file_validator_proto_goTypes,
@@ -0,0 +1,54 @@
+package jsondecoder
+
+// The FieldCodec interfaces are implemented by the type that specified by the field option codec
+// of protoc-gen-gojson, one for each Go type of the scalar value. The data passed to DecodeJSON is
+// the raw JSON value include `null`. The codec of the enum or message value has the same method
+// with the Go type of the enum or the pointer of message.
+//
+// A codec type can be checked at compile time, e.g.
+//   var _ jsondecoder.Int64FieldCodec = (*Cents)(nil)
+
+// Int32FieldCodec is the codec of the int32 value.
+type Int32FieldCodec interface {
+	DecodeJSON(data []byte) (int32, error)
+}
+
+// Int64FieldCodec is the codec of the int64 value.
+type Int64FieldCodec interface {
+	DecodeJSON(data []byte) (int64, error)
+}
+
+// Uint32FieldCodec is the codec of the uint32 value.
+type Uint32FieldCodec interface {
+	DecodeJSON(data []byte) (uint32, error)
+}
+
+// Uint64FieldCodec is the codec of the uint64 value.
+type Uint64FieldCodec interface {
+	DecodeJSON(data []byte) (uint64, error)
+}
+
+// Float32FieldCodec is the codec of the float32 value.
+type Float32FieldCodec interface {
+	DecodeJSON(data []byte) (float32, error)
+}
+
+// Float64FieldCodec is the codec of the float64 value.
+type Float64FieldCodec interface {
+	DecodeJSON(data []byte) (float64, error)
+}
+
+// BoolFieldCodec is the codec of the bool value.
+type BoolFieldCodec interface {
+	DecodeJSON(data []byte) (bool, error)
+}
+
+// StringFieldCodec is the codec of the string value.
+type StringFieldCodec interface {
+	DecodeJSON(data []byte) (string, error)
+}
+
+// BytesFieldCodec is the codec of the []byte value.
+type BytesFieldCodec interface {
+	DecodeJSON(data []byte) ([]byte, error)
+}
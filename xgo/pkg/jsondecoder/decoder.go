@@ -11,21 +11,13 @@ type Decoder struct {
 	data []byte
 	off  int // next read offset in data
 	scan scanner
+	opts Options
 
 	OpCode OpCode // last read result
 }
 
 func New(data []byte) (*Decoder, error) {
-	d := &Decoder{
-		data: data,
-		off:  0,
-	}
-	err := checkValid(d.data, &d.scan)
-	if err != nil {
-		return nil, err
-	}
-	d.scan.reset()
-	return d, nil
+	return NewWithOptions(data, Options{})
 }
 
 func (d *Decoder) ScanError() error {
@@ -55,6 +47,16 @@ func (d *Decoder) ReadItem() []byte {
 	return d.data[start:end]
 }
 
+// ReadNull reads the value and returns true if the current value is null,
+// otherwise the decoder is not changed.
+func (d *Decoder) ReadNull() bool {
+	if d.OpCode != ScanBeginLiteral || d.data[d.off-1] != 'n' {
+		return false
+	}
+	d.RescanLiteral()
+	return true
+}
+
 // ReadObjectKey Read key of object or map.
 func (d *Decoder) ReadObjectKey() string {
 	item := d.ReadItem()
@@ -141,6 +143,16 @@ func (d *Decoder) ScanWhile(op OpCode) {
 	d.OpCode = d.scan.eof()
 }
 
+// ScanBeginValue scans to the beginning of the next value unless the decoder is already
+// at the beginning of a value, e.g. the value of a field that decoded in place.
+func (d *Decoder) ScanBeginValue() {
+	switch d.OpCode {
+	case ScanBeginLiteral, ScanBeginObject, ScanBeginArray:
+		return
+	}
+	d.ScanWhile(ScanSkipSpace)
+}
+
 // Skip skip scans to the end of what was started.
 func (d *Decoder) Skip() {
 	s, data, i := &d.scan, d.data, d.off
@@ -2,6 +2,7 @@ package jsondecoder
 
 import (
 	"reflect"
+	"strconv"
 )
 
 // A SyntaxError is a description of a JSON syntax error.
@@ -14,6 +15,17 @@ func (e *SyntaxError) Error() string {
 	return e.msg
 }
 
+// A LimitError is returned when the JSON document exceeds a limit in Options.
+type LimitError struct {
+	Limit  string // the name of the field in Options, e.g. "MaxDepth"
+	Max    int    // the value of the limit
+	Offset int64  // error occurred after reading Offset bytes
+}
+
+func (e *LimitError) Error() string {
+	return "json: exceeded " + e.Limit + " " + strconv.Itoa(e.Max) + " at offset " + strconv.FormatInt(e.Offset, 10)
+}
+
 // An UnmarshalTypeError describes a JSON value that was
 // not appropriate for a value of a specific Go type.
 type UnmarshalTypeError struct {
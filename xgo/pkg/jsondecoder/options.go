@@ -0,0 +1,228 @@
+package jsondecoder
+
+import (
+	"strings"
+)
+
+// Options controls the behavior of a single call of UnmarshalJSONWithOptions
+// that generated by protoc-gen-gojson. The zero value is the most lenient mode.
+//
+// The same Options is passed down to all nested messages that generated by protoc-gen-gojson.
+type Options struct {
+	// DisallowUnknownFields causes the decoder to return an error when the input
+	// contains object keys which do not match any non-ignored fields in the message.
+	DisallowUnknownFields bool
+
+	// CaseInsensitiveKeys allows object keys to match the json key of field with case-insensitive.
+	// An exact match is always preferred.
+	CaseInsensitiveKeys bool
+
+	// RequireFields causes the decoder to return an error when the input is missing
+	// the key of a field that marked with `required`.
+	RequireFields bool
+
+	// MaxDepth limits the nesting depth of the whole JSON document, including nested messages.
+	// Zero means no limit except the built-in limit.
+	MaxDepth int
+
+	// MaxBytes limits the size of the JSON document. Zero means no limit.
+	MaxBytes int
+
+	// MaxStringLen limits the length in bytes of each string in the JSON document, including
+	// object keys. The length is counted in the encoded form without quotes. Zero means no limit.
+	MaxStringLen int
+
+	// MaxArrayLen limits the number of elements of each array in the JSON document, including
+	// the map fields encoded as key/value pairs. Zero means no limit.
+	MaxArrayLen int
+
+	// MaxMapEntries limits the number of key/value entries of each object in the JSON document,
+	// including the objects of messages. Zero means no limit.
+	MaxMapEntries int
+
+	// Present collects the paths of fields that present in the JSON document if it is not nil.
+	// The path is made of the json keys of fields, same as jsonencoder.FieldMask, e.g. "config.network.port".
+	// The fields in the elements of repeated and map fields are not collected.
+	Present *FieldPaths
+
+	// depth is the nesting depth of the value being decoded within the whole JSON document.
+	depth int
+
+	// pathPrefix is the path of the message being decoded, it's used by Present.
+	pathPrefix string
+}
+
+// Unmarshaler is the interface implemented by types that generated by protoc-gen-gojson.
+type Unmarshaler interface {
+	UnmarshalJSONWithOptions(b []byte, opts Options) error
+}
+
+// DecoderUnmarshaler is the interface implemented by types that generated by protoc-gen-gojson.
+// The UnmarshalJSONFrom decodes the next value of dec with the options of dec, so a Decoder that reset
+// by ResetWithOptions can be reused to decode multiple documents. It is also used to decode the nested
+// messages in place by UnmarshalNested and UnmarshalNestedField.
+type DecoderUnmarshaler interface {
+	UnmarshalJSONFrom(dec *Decoder) error
+}
+
+// NewWithOptions creates a Decoder with the given options.
+// A *LimitError is returned if the data exceeds the limits in options.
+func NewWithOptions(data []byte, opts Options) (*Decoder, error) {
+	d := &Decoder{}
+	if err := d.ResetWithOptions(data, opts); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ResetWithOptions resets the decoder to decode data with the given options,
+// the stacks of the underlying scanner are retained for reuse.
+// A *LimitError is returned if the data exceeds the limits in options.
+func (d *Decoder) ResetWithOptions(data []byte, opts Options) error {
+	if opts.MaxBytes > 0 && len(data) > opts.MaxBytes {
+		return &LimitError{Limit: "MaxBytes", Max: opts.MaxBytes, Offset: int64(opts.MaxBytes)}
+	}
+	d.data = data
+	d.off = 0
+	d.opts = opts
+	d.OpCode = ScanContinue
+	d.scan.bytes = 0
+	d.scan.stringStart = 0
+	d.scan.maxDepth = maxNestingDepth
+	d.scan.depthLimit = 0
+	if opts.MaxDepth > 0 && opts.MaxDepth-opts.depth < d.scan.maxDepth {
+		d.scan.maxDepth = opts.MaxDepth - opts.depth
+		d.scan.depthLimit = opts.MaxDepth
+	}
+	d.scan.maxStringLen = opts.MaxStringLen
+	d.scan.maxArrayLen = opts.MaxArrayLen
+	d.scan.maxMapEntries = opts.MaxMapEntries
+	err := checkValid(d.data, &d.scan)
+	if err != nil {
+		return err
+	}
+	d.scan.reset()
+	return nil
+}
+
+// Options returns the options of the decoder.
+func (d *Decoder) Options() Options {
+	return d.opts
+}
+
+// NestedOptions returns the options for decode the value that just read by ReadItem.
+// It is used to pass the options and the current nesting depth to the nested message.
+// The paths of the nested message are not collected, use NestedFieldOptions instead.
+func (d *Decoder) NestedOptions() Options {
+	opts := d.opts
+	opts.depth += len(d.scan.parseState)
+	opts.Present = nil
+	opts.pathPrefix = ""
+	return opts
+}
+
+// NestedFieldOptions is like NestedOptions but used to decode the nested message of field name.
+// The paths of the nested message are collected with the prefix of name.
+func (d *Decoder) NestedFieldOptions(name string) Options {
+	opts := d.opts
+	opts.depth += len(d.scan.parseState)
+	if opts.Present != nil {
+		opts.pathPrefix += name + "."
+	}
+	return opts
+}
+
+// NestedDepthLimit returns the nesting depth that remains for the value that just read by ReadItem
+// under Options.MaxDepth or the built-in limit. It is used to limit the recursion of the nested
+// message that decoded by other packages, e.g. the RecursionLimit of protojson. It's at least 1.
+func (d *Decoder) NestedDepthLimit() int {
+	depth := len(d.scan.parseState)
+	if d.OpCode == ScanEndObject || d.OpCode == ScanEndArray {
+		// The value is the last one in the object or array that already closed by the next byte.
+		depth++
+	}
+	n := d.scan.maxDepth - depth
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// UnmarshalNested decodes the value at the current position with um in place, so the data that
+// already scanned by the decoder is not copied and validated again. It's used to decode the nested
+// message in the elements of repeated and map fields, the paths of the nested message are not collected.
+func (d *Decoder) UnmarshalNested(um DecoderUnmarshaler) error {
+	opts := d.opts
+	d.opts.Present = nil
+	d.opts.pathPrefix = ""
+	err := um.UnmarshalJSONFrom(d)
+	d.opts = opts
+	return err
+}
+
+// UnmarshalNestedField is like UnmarshalNested but used to decode the nested message of field name.
+// The paths of the nested message are collected with the prefix of name.
+func (d *Decoder) UnmarshalNestedField(um DecoderUnmarshaler, name string) error {
+	opts := d.opts
+	if d.opts.Present != nil {
+		d.opts.pathPrefix += name + "."
+	}
+	err := um.UnmarshalJSONFrom(d)
+	d.opts = opts
+	return err
+}
+
+// MarkPresent records the field name is present in the JSON document if Options.Present is not nil.
+func (d *Decoder) MarkPresent(name string) {
+	if d.opts.Present == nil {
+		return
+	}
+	d.opts.Present.Paths = append(d.opts.Present.Paths, d.opts.pathPrefix+name)
+}
+
+// FieldPaths collects the paths of fields that present in the JSON document.
+type FieldPaths struct {
+	// Paths is all the paths in the order of appearance, include the path of message
+	// field and its nested fields.
+	Paths []string
+}
+
+// Leaves returns the paths without duplicates that are not the prefix of other paths, i.e.
+// a message field is present by the paths of its nested fields, or by itself if the value
+// is null or an empty object.
+func (fp *FieldPaths) Leaves() []string {
+	parents := make(map[string]bool)
+	for _, path := range fp.Paths {
+		for i := range path {
+			if path[i] == '.' {
+				parents[path[:i]] = true
+			}
+		}
+	}
+	leaves := make([]string, 0, len(fp.Paths))
+	for _, path := range fp.Paths {
+		if parents[path] {
+			continue
+		}
+		parents[path] = true // skip the duplicate path.
+		leaves = append(leaves, path)
+	}
+	return leaves
+}
+
+// MatchKey returns the key in keys that matches the objKey with case-insensitive.
+// The exact match is preferred, otherwise the first key that equal to objKey
+// under Unicode case-folding will be returned. The objKey will be returned if no key matches.
+func MatchKey(objKey string, keys []string) string {
+	for _, k := range keys {
+		if k == objKey {
+			return k
+		}
+	}
+	for _, k := range keys {
+		if strings.EqualFold(k, objKey) {
+			return k
+		}
+	}
+	return objKey
+}
@@ -0,0 +1,132 @@
+package jsondecoder
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWithOptions_Limits(t *testing.T) {
+	data := []byte(`{"k1":"abcd","k2":[1,2,3],"k3":{"a":1,"b":2}}`)
+
+	cases := []struct {
+		opts  Options
+		limit string
+	}{
+		{Options{}, ""},
+		{Options{MaxBytes: len(data), MaxDepth: 2, MaxStringLen: 4, MaxArrayLen: 3, MaxMapEntries: 3}, ""},
+		{Options{MaxBytes: len(data) - 1}, "MaxBytes"},
+		{Options{MaxDepth: 1}, "MaxDepth"},
+		{Options{MaxStringLen: 3}, "MaxStringLen"},
+		{Options{MaxArrayLen: 2}, "MaxArrayLen"},
+		{Options{MaxMapEntries: 2}, "MaxMapEntries"},
+	}
+	for _, c := range cases {
+		_, err := NewWithOptions(data, c.opts)
+		if c.limit == "" {
+			require.Nil(t, err, "%+v", c.opts)
+			continue
+		}
+		var limitErr *LimitError
+		require.True(t, errors.As(err, &limitErr), "%+v: %v", c.opts, err)
+		require.Equal(t, c.limit, limitErr.Limit)
+	}
+
+	// The escaped string is counted in the encoded form.
+	_, err := NewWithOptions([]byte(`["\n\n"]`), Options{MaxStringLen: 3})
+	require.Equal(t, "json: exceeded MaxStringLen 3 at offset 7", err.Error())
+
+	// The object keys are limited by MaxStringLen.
+	_, err = NewWithOptions([]byte(`{"abcd":1}`), Options{MaxStringLen: 3})
+	require.NotNil(t, err)
+
+	// The empty array and object.
+	_, err = NewWithOptions([]byte(`[[],{},[1]]`), Options{MaxArrayLen: 3, MaxMapEntries: 1})
+	require.Nil(t, err)
+
+	// The error is returned before scanning the whole input.
+	huge := []byte(`["` + strings.Repeat("a", 1<<20))
+	_, err = NewWithOptions(huge, Options{MaxStringLen: 10})
+	require.Equal(t, "json: exceeded MaxStringLen 10 at offset 13", err.Error())
+
+	// The built-in max depth.
+	_, err = NewWithOptions([]byte(strings.Repeat("[", maxNestingDepth+1)), Options{MaxDepth: maxNestingDepth + 1})
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+}
+
+func TestDecoder_ResetWithOptions(t *testing.T) {
+	d := &Decoder{}
+
+	// The error and limits of the previous data are not kept after reset.
+	err := d.ResetWithOptions([]byte(`{"k1":`), Options{})
+	var syntaxErr *SyntaxError
+	require.True(t, errors.As(err, &syntaxErr))
+	err = d.ResetWithOptions([]byte(`[[1]]`), Options{MaxDepth: 1})
+	var limitErr *LimitError
+	require.True(t, errors.As(err, &limitErr))
+
+	require.Nil(t, d.ResetWithOptions([]byte(`{"k1":[1,2]}`), Options{MaxDepth: 2}))
+	require.Equal(t, 2, d.Options().MaxDepth)
+	require.Nil(t, d.ScanError())
+	d.ScanWhile(ScanSkipSpace)
+	require.Equal(t, ScanBeginObject, d.OpCode)
+	require.False(t, d.ObjectBeforeReadKey())
+	require.Equal(t, "k1", d.ReadObjectKey())
+	d.ObjectBeforeReadValue()
+	require.Equal(t, "[1,2]", string(d.ReadItem()))
+	require.True(t, d.ObjectAfterReadValue())
+	require.Nil(t, d.ScanError())
+
+	require.Nil(t, d.ResetWithOptions([]byte(` "s1" `), Options{}))
+	d.ScanWhile(ScanSkipSpace)
+	require.Equal(t, `"s1"`, string(d.ReadItem()))
+
+	// The value at the beginning is not skipped by ScanBeginValue.
+	require.Nil(t, d.ResetWithOptions([]byte(` [null, 1]`), Options{}))
+	d.ScanBeginValue()
+	require.Equal(t, ScanBeginArray, d.OpCode)
+	d.ScanBeginValue()
+	require.Equal(t, ScanBeginArray, d.OpCode)
+	require.False(t, d.ArrayBeforeReadValue())
+	require.True(t, d.ReadNull())
+	require.False(t, d.ArrayAfterReadValue())
+	require.False(t, d.ArrayBeforeReadValue())
+	require.False(t, d.ReadNull())
+	require.Equal(t, "1", string(d.ReadItem()))
+	require.True(t, d.ArrayAfterReadValue())
+}
+
+func TestDecoder_NestedDepthLimit(t *testing.T) {
+	d := &Decoder{}
+
+	// The last value in the object.
+	require.Nil(t, d.ResetWithOptions([]byte(`{"k1":{"a":1}}`), Options{MaxDepth: 4}))
+	d.ScanWhile(ScanSkipSpace)
+	require.False(t, d.ObjectBeforeReadKey())
+	require.Equal(t, "k1", d.ReadObjectKey())
+	d.ObjectBeforeReadValue()
+	require.Equal(t, `{"a":1}`, string(d.ReadItem()))
+	require.Equal(t, 3, d.NestedDepthLimit())
+
+	// The value that followed by others.
+	require.Nil(t, d.ResetWithOptions([]byte(`[[1],2]`), Options{MaxDepth: 2}))
+	d.ScanWhile(ScanSkipSpace)
+	require.False(t, d.ArrayBeforeReadValue())
+	require.Equal(t, "[1]", string(d.ReadItem()))
+	require.Equal(t, 1, d.NestedDepthLimit())
+
+	// The built-in limit.
+	require.Nil(t, d.ResetWithOptions([]byte(`[1]`), Options{}))
+	d.ScanWhile(ScanSkipSpace)
+	require.False(t, d.ArrayBeforeReadValue())
+	require.Equal(t, "1", string(d.ReadItem()))
+	require.Equal(t, maxNestingDepth-1, d.NestedDepthLimit())
+}
+
+func TestFieldPaths_Leaves(t *testing.T) {
+	fp := &FieldPaths{Paths: []string{"a", "a.b", "a.b.c", "d", "e.f", "d", "a.g"}}
+	require.Equal(t, []string{"a.b.c", "d", "e.f", "a.g"}, fp.Leaves())
+}
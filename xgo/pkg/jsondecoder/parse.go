@@ -1,6 +1,7 @@
 package jsondecoder
 
 import (
+	"math"
 	"strconv"
 	"unicode"
 	"unicode/utf16"
@@ -8,7 +9,12 @@ import (
 	"unsafe"
 )
 
+// ParseFloat32 parses the json number, or the quoted strings of NaN and Infinity
+// that encoded by jsonencoder: "NaN", "Infinity", "-Infinity", "+Inf" and "-Inf".
 func ParseFloat32(b []byte) (float32, error) {
+	if v, ok := parseNonFinite(b); ok {
+		return float32(v), nil
+	}
 	v, err := strconv.ParseFloat(*(*string)(unsafe.Pointer(&b)), 32)
 	if err != nil {
 		return 0, err
@@ -16,10 +22,27 @@ func ParseFloat32(b []byte) (float32, error) {
 	return float32(v), nil
 }
 
+// ParseFloat64 parses the json number, or the quoted strings of NaN and Infinity
+// that encoded by jsonencoder: "NaN", "Infinity", "-Infinity", "+Inf" and "-Inf".
 func ParseFloat64(b []byte) (float64, error) {
+	if v, ok := parseNonFinite(b); ok {
+		return v, nil
+	}
 	return strconv.ParseFloat(*(*string)(unsafe.Pointer(&b)), 64)
 }
 
+func parseNonFinite(b []byte) (float64, bool) {
+	switch string(b) {
+	case `"NaN"`:
+		return math.NaN(), true
+	case `"Infinity"`, `"+Inf"`:
+		return math.Inf(1), true
+	case `"-Infinity"`, `"-Inf"`:
+		return math.Inf(-1), true
+	}
+	return 0, false
+}
+
 func ParseInt32(b []byte) (int32, error) {
 	v, err := strconv.ParseInt(*(*string)(unsafe.Pointer(&b)), 10, 32)
 	if err != nil {
@@ -28,8 +51,9 @@ func ParseInt32(b []byte) (int32, error) {
 	return int32(v), nil
 }
 
+// ParseInt64 parses the value in the full range of int64.
 func ParseInt64(b []byte) (int64, error) {
-	return strconv.ParseInt(*(*string)(unsafe.Pointer(&b)), 10, 32)
+	return strconv.ParseInt(*(*string)(unsafe.Pointer(&b)), 10, 64)
 }
 
 func ParseUint32(b []byte) (uint32, error) {
@@ -44,10 +68,23 @@ func ParseUint64(b []byte) (uint64, error) {
 	return strconv.ParseUint(*(*string)(unsafe.Pointer(&b)), 10, 64)
 }
 
+// ParseBool parses the bool value by strconv.ParseBool, so the 1 and 0 are also accepted.
 func ParseBool(b []byte) (bool, error) {
 	return strconv.ParseBool(*(*string)(unsafe.Pointer(&b)))
 }
 
+// ParseBoolKey accepts only the json literal true and false.
+// It is used to parse the bool map key that encoded as key/value pairs.
+func ParseBoolKey(b []byte) (bool, error) {
+	switch string(b) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, &strconv.NumError{Func: "ParseBool", Num: string(b), Err: strconv.ErrSyntax}
+}
+
 // UnquoteString converts a quoted JSON string literal s into an actual string t.
 // The rules are different than for Go, so cannot use strconv.Unquote.
 func UnquoteString(b []byte) (s string, ok bool) {
@@ -0,0 +1,53 @@
+package jsondecoder
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// The bitSize of ParseInt64 was 32 before, so the value out of int32 range is rejected.
+func TestParseInt64(t *testing.T) {
+	for _, v := range []int64{0, -1, math.MaxInt32 + 1, math.MinInt32 - 1, math.MaxInt64, math.MinInt64} {
+		x, err := ParseInt64([]byte(strconv.FormatInt(v, 10)))
+		require.Nil(t, err, v)
+		require.Equal(t, v, x)
+	}
+
+	for _, s := range []string{"9223372036854775808", "-9223372036854775809", "1.5", "", "1e3"} {
+		_, err := ParseInt64([]byte(s))
+		require.NotNil(t, err, s)
+	}
+}
+
+// The ParseBool is used for the bool values, it accepts the same input as strconv.ParseBool.
+func TestParseBool(t *testing.T) {
+	for s, v := range map[string]bool{"true": true, "false": false, "1": true, "0": false} {
+		x, err := ParseBool([]byte(s))
+		require.Nil(t, err, s)
+		require.Equal(t, v, x, s)
+	}
+
+	for _, s := range []string{"2", "null", `"true"`, ""} {
+		_, err := ParseBool([]byte(s))
+		require.NotNil(t, err, s)
+	}
+}
+
+// The ParseBoolKey is used for the bool map keys, only the json literal true and false are accepted.
+func TestParseBoolKey(t *testing.T) {
+	x, err := ParseBoolKey([]byte("true"))
+	require.Nil(t, err)
+	require.True(t, x)
+	x, err = ParseBoolKey([]byte("false"))
+	require.Nil(t, err)
+	require.False(t, x)
+
+	for _, s := range []string{"1", "0", "t", "f", "T", "F", "TRUE", "FALSE", "True", "False", `"true"`, ""} {
+		_, err := ParseBoolKey([]byte(s))
+		require.NotNil(t, err, s)
+		require.Equal(t, "strconv.ParseBool: parsing "+strconv.Quote(s)+": invalid syntax", err.Error())
+	}
+}
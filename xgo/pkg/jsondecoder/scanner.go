@@ -96,6 +96,22 @@ type scanner struct {
 	// Error that happened, if any.
 	err error
 
+	// The max nesting depth that allowed, it never greater than maxNestingDepth.
+	maxDepth int
+	// The MaxDepth in Options that limits maxDepth, zero if maxDepth is the built-in limit.
+	depthLimit int
+
+	// The limits from Options, zero means no limit.
+	maxStringLen  int
+	maxArrayLen   int
+	maxMapEntries int
+
+	// Stack of the number of elements that have read, parallel to parseState.
+	counts []int
+
+	// The value of bytes when the current string began.
+	stringStart int64
+
 	// total bytes consumed, updated by decoder.Decode (and deliberately
 	// not set to zero by scan.reset)
 	bytes int64
@@ -106,6 +122,7 @@ type scanner struct {
 func (s *scanner) reset() {
 	s.step = stateBeginValue
 	s.parseState = s.parseState[0:0]
+	s.counts = s.counts[0:0]
 	s.err = nil
 	s.endTop = false
 }
@@ -130,20 +147,36 @@ func (s *scanner) eof() OpCode {
 }
 
 // pushParseState pushes a new parse state p onto the parse stack.
-// an error state is returned if maxNestingDepth was exceeded, otherwise successState is returned.
+// an error state is returned if maxDepth was exceeded, otherwise successState is returned.
 func (s *scanner) pushParseState(c byte, parseState ParsePhase, successState OpCode) OpCode {
 	s.parseState = append(s.parseState, parseState)
-	if len(s.parseState) <= maxNestingDepth {
+	s.counts = append(s.counts, 0)
+	if len(s.parseState) <= s.maxDepth {
 		return successState
 	}
+	if s.depthLimit > 0 {
+		return s.limitError("MaxDepth", s.depthLimit)
+	}
 	return s.error(c, "exceeded max depth")
 }
 
+// countElement counts the next element of the array or object at the top of the parse stack.
+// an error state is returned if the limit max was exceeded, otherwise successState is returned.
+func (s *scanner) countElement(limit string, max int, successState OpCode) OpCode {
+	n := len(s.counts) - 1
+	s.counts[n]++
+	if max > 0 && s.counts[n] >= max {
+		return s.limitError(limit, max)
+	}
+	return successState
+}
+
 // popParseState pops a parse state (already obtained) off the stack
 // and updates s.step accordingly.
 func (s *scanner) popParseState() {
 	n := len(s.parseState) - 1
 	s.parseState = s.parseState[0:n]
+	s.counts = s.counts[0:n]
 	if n == 0 {
 		s.step = stateEndTop
 		s.endTop = true
@@ -177,6 +210,7 @@ func stateBeginValue(s *scanner, c byte) OpCode {
 		return s.pushParseState(c, parseArrayValue, ScanBeginArray)
 	case '"':
 		s.step = stateInString
+		s.stringStart = s.bytes
 		return ScanBeginLiteral
 	case '-':
 		s.step = stateNeg
@@ -221,6 +255,7 @@ func stateBeginString(s *scanner, c byte) OpCode {
 	}
 	if c == '"' {
 		s.step = stateInString
+		s.stringStart = s.bytes
 		return ScanBeginLiteral
 	}
 	return s.error(c, "looking for beginning of object key string")
@@ -253,7 +288,7 @@ func stateEndValue(s *scanner, c byte) OpCode {
 		if c == ',' {
 			s.parseState[n-1] = parseObjectKey
 			s.step = stateBeginString
-			return ScanObjectValue
+			return s.countElement("MaxMapEntries", s.maxMapEntries, ScanObjectValue)
 		}
 		if c == '}' {
 			s.popParseState()
@@ -263,7 +298,7 @@ func stateEndValue(s *scanner, c byte) OpCode {
 	case parseArrayValue:
 		if c == ',' {
 			s.step = stateBeginValue
-			return ScanArrayValue
+			return s.countElement("MaxArrayLen", s.maxArrayLen, ScanArrayValue)
 		}
 		if c == ']' {
 			s.popParseState()
@@ -287,6 +322,14 @@ func stateEndTop(s *scanner, c byte) OpCode {
 
 // stateInString is the state after reading `"`.
 func stateInString(s *scanner, c byte) OpCode {
+	if s.maxStringLen > 0 {
+		// n is the length of the content before c, the escape sequences are not checked
+		// until the next byte in string.
+		n := s.bytes - s.stringStart - 1
+		if n > int64(s.maxStringLen) || (n == int64(s.maxStringLen) && c != '"') {
+			return s.limitError("MaxStringLen", s.maxStringLen)
+		}
+	}
 	if c == '"' {
 		s.step = stateEndValue
 		return ScanContinue
@@ -539,6 +582,13 @@ func stateError(s *scanner, c byte) OpCode {
 	return ScanError
 }
 
+// limitError records a LimitError and switches to the error state.
+func (s *scanner) limitError(limit string, max int) OpCode {
+	s.step = stateError
+	s.err = &LimitError{Limit: limit, Max: max, Offset: s.bytes}
+	return ScanError
+}
+
 // error records an error and switches to the error state.
 func (s *scanner) error(c byte, context string) OpCode {
 	s.step = stateError
@@ -0,0 +1,54 @@
+package jsonencoder
+
+// The FieldCodec interfaces are implemented by the type that specified by the field option codec
+// of protoc-gen-gojson, one for each Go type of the scalar value. EncodeJSON must append exactly
+// one JSON value by the methods of enc. The codec of the enum or message value has the same method
+// with the Go type of the enum or the pointer of message.
+//
+// A codec type can be checked at compile time, e.g.
+//   var _ jsonencoder.Int64FieldCodec = (*Cents)(nil)
+
+// Int32FieldCodec is the codec of the int32 value.
+type Int32FieldCodec interface {
+	EncodeJSON(enc *Encoder, v int32) error
+}
+
+// Int64FieldCodec is the codec of the int64 value.
+type Int64FieldCodec interface {
+	EncodeJSON(enc *Encoder, v int64) error
+}
+
+// Uint32FieldCodec is the codec of the uint32 value.
+type Uint32FieldCodec interface {
+	EncodeJSON(enc *Encoder, v uint32) error
+}
+
+// Uint64FieldCodec is the codec of the uint64 value.
+type Uint64FieldCodec interface {
+	EncodeJSON(enc *Encoder, v uint64) error
+}
+
+// Float32FieldCodec is the codec of the float32 value.
+type Float32FieldCodec interface {
+	EncodeJSON(enc *Encoder, v float32) error
+}
+
+// Float64FieldCodec is the codec of the float64 value.
+type Float64FieldCodec interface {
+	EncodeJSON(enc *Encoder, v float64) error
+}
+
+// BoolFieldCodec is the codec of the bool value.
+type BoolFieldCodec interface {
+	EncodeJSON(enc *Encoder, v bool) error
+}
+
+// StringFieldCodec is the codec of the string value.
+type StringFieldCodec interface {
+	EncodeJSON(enc *Encoder, v string) error
+}
+
+// BytesFieldCodec is the codec of the []byte value.
+type BytesFieldCodec interface {
+	EncodeJSON(enc *Encoder, v []byte) error
+}
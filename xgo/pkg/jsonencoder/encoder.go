@@ -1,22 +1,22 @@
 package jsonencoder
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
-	"math"
 	"strconv"
 )
 
 type Encoder struct {
-	escapeHTML bool
-	buf        []byte
+	buf  []byte
+	opts Options
 }
 
 // New return a Encoder.
 func New(bufLen int) *Encoder {
 	return &Encoder{
-		escapeHTML: true,
-		buf:        make([]byte, 0, bufLen),
+		buf:  make([]byte, 0, bufLen),
+		opts: Options{EscapeHTML: true},
 	}
 }
 
@@ -31,6 +31,14 @@ func (enc *Encoder) Bytes() []byte {
 	return enc.buf
 }
 
+// Output returns the encoded data, it is indented if Options.Prefix or Options.Indent is set.
+func (enc *Encoder) Output() ([]byte, error) {
+	if enc.opts.Prefix == "" && enc.opts.Indent == "" {
+		return enc.buf, nil
+	}
+	return enc.indent()
+}
+
 func (enc *Encoder) AppendObjectBegin() { enc.writeByte('{') }
 func (enc *Encoder) AppendObjectEnd()   { enc.writeByte('}') }
 
@@ -102,11 +110,31 @@ func (enc *Encoder) AppendUint64(v uint64) {
 
 func (enc *Encoder) AppendFloat32(v float32) {
 	enc.appendElementSeparator()
-	enc.appendFloat32(v)
+	_ = enc.appendFloat(float64(v), 32, FloatFormat{})
 }
 func (enc *Encoder) AppendFloat64(v float64) {
 	enc.appendElementSeparator()
-	enc.appendFloat64(v)
+	_ = enc.appendFloat(v, 64, FloatFormat{})
+}
+
+// AppendFloat32WithFormat appends the v that formatted by f.
+// An error is returned only if v is NaN or Infinity and f.NonFinite is NonFiniteError.
+func (enc *Encoder) AppendFloat32WithFormat(v float32, f FloatFormat) error {
+	enc.appendElementSeparator()
+	return enc.appendFloat(float64(v), 32, f)
+}
+
+// AppendFloat64WithFormat appends the v that formatted by f.
+// An error is returned only if v is NaN or Infinity and f.NonFinite is NonFiniteError.
+func (enc *Encoder) AppendFloat64WithFormat(v float64, f FloatFormat) error {
+	enc.appendElementSeparator()
+	return enc.appendFloat(v, 64, f)
+}
+
+// AppendRawJSON appends the v as a JSON value without validation.
+func (enc *Encoder) AppendRawJSON(v []byte) {
+	enc.appendElementSeparator()
+	enc.writeBytes(v)
 }
 
 func (enc *Encoder) AppendNil() {
@@ -134,33 +162,6 @@ func (enc *Encoder) appendElementSeparator() {
 	}
 }
 
-func (enc *Encoder) appendFloat64(v float64) {
-	switch {
-	case math.IsNaN(v):
-		enc.appendString(`"NaN"`)
-	case math.IsInf(v, 1):
-		enc.appendString(`"+Inf"`)
-	case math.IsInf(v, -1):
-		enc.appendString(`"-Inf"`)
-	default:
-		enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 64)
-	}
-}
-
-func (enc *Encoder) appendFloat32(x float32) {
-	v := float64(x)
-	switch {
-	case math.IsNaN(v):
-		enc.appendString(`"NaN"`)
-	case math.IsInf(v, 1):
-		enc.appendString(`"+Inf"`)
-	case math.IsInf(v, -1):
-		enc.appendString(`"-Inf"`)
-	default:
-		enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, 32)
-	}
-}
-
 func (enc *Encoder) appendInterface(i interface{}) error {
 	var err error
 	var b []byte
@@ -169,10 +170,18 @@ func (enc *Encoder) appendInterface(i interface{}) error {
 	case nil:
 		enc.writeString("null")
 		return nil
+	case EncoderMarshaler:
+		return v.MarshalJSONTo(enc)
+	case Marshaler:
+		b, err = v.MarshalJSONWithOptions(enc.NestedOptions())
 	case json.Marshaler:
 		b, err = v.MarshalJSON()
 	default:
-		b, err = json.Marshal(i)
+		if enc.opts.EscapeHTML {
+			b, err = json.Marshal(i)
+		} else {
+			b, err = marshalNoEscapeHTML(i)
+		}
 		//panic(fmt.Errorf("not support type: %v", v))
 	}
 	if err != nil {
@@ -193,3 +202,14 @@ func (enc *Encoder) writeBytes(v []byte) {
 func (enc *Encoder) writeString(v string) {
 	enc.buf = append(enc.buf, v...)
 }
+
+func marshalNoEscapeHTML(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err := e.Encode(v); err != nil {
+		return nil, err
+	}
+	// Removes the newline that added by Encode.
+	return bytes.TrimSuffix(buf.Bytes(), []byte{'\n'}), nil
+}
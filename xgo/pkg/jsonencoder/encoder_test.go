@@ -2,6 +2,7 @@ package jsonencoder
 
 import (
 	"encoding/json"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -31,3 +32,78 @@ func TestEncoder_Bytes(t *testing.T) {
 	//require.Equal(t, m["int64"], float64(64))
 
 }
+
+func TestEncoder_Options(t *testing.T) {
+	enc := NewWithOptions(0, Options{Indent: "  ", EscapeHTML: false})
+	enc.AppendObjectBegin()
+	enc.AppendObjectKey("k1")
+	enc.AppendString("<v1>")
+	enc.AppendObjectEnd()
+
+	b, err := enc.Output()
+	require.Nil(t, err)
+	require.Equal(t, "{\n  \"k1\": \"<v1>\"\n}", string(b))
+	require.Equal(t, Options{EscapeHTML: false}, enc.NestedOptions())
+
+	enc = New(0)
+	enc.AppendObjectBegin()
+	enc.AppendObjectKey("k1")
+	enc.AppendString("<v1>")
+	enc.AppendObjectEnd()
+
+	b, err = enc.Output()
+	require.Nil(t, err)
+	require.Equal(t, `{"k1":"\u003cv1\u003e"}`, string(b))
+
+	// The EscapeHTML is changed by ResetWithOptions, include the value encoded by encoding/json.
+	enc.ResetWithOptions(Options{EscapeHTML: false})
+	enc.AppendString("<v1>")
+	require.Nil(t, enc.AppendInterface(map[string]string{"k1": "<v2>"}))
+	require.Equal(t, `"<v1>",{"k1":"<v2>"}`, string(enc.Bytes()))
+}
+
+func TestEncoder_AppendFloat(t *testing.T) {
+	enc := New(0)
+	enc.AppendListBegin()
+	enc.AppendFloat64(1e21)
+	enc.AppendFloat64(math.NaN())
+	enc.AppendFloat32(float32(math.Inf(-1)))
+	require.Nil(t, enc.AppendFloat64WithFormat(1e21, FloatFormat{Mode: FloatES6}))
+	require.Nil(t, enc.AppendFloat32WithFormat(1e-9, FloatFormat{Mode: FloatES6}))
+	require.Nil(t, enc.AppendFloat64WithFormat(1.005, FloatFormat{Mode: FloatFixed, Precision: 2}))
+	require.Nil(t, enc.AppendFloat64WithFormat(math.Inf(1), FloatFormat{NonFinite: NonFiniteProtoJSON}))
+	enc.AppendListEnd()
+	require.Equal(t, `[1000000000000000000000,"NaN","-Inf",1e+21,1e-9,1.00,"Infinity"]`, string(enc.Bytes()))
+	require.True(t, json.Valid(enc.Bytes()))
+
+	err := enc.AppendFloat64WithFormat(math.Inf(-1), FloatFormat{NonFinite: NonFiniteError})
+	require.NotNil(t, err)
+	require.Equal(t, "json: unsupported value: -Inf", err.Error())
+}
+
+func TestNewFieldMask(t *testing.T) {
+	require.Nil(t, NewFieldMask(nil))
+	require.True(t, FieldMask(nil).Has("a"))
+	require.Nil(t, FieldMask(nil).Sub("a"))
+
+	mask := NewFieldMask([]string{"a.b.c", "a.d", "e", "e.f", "g.h", "g"})
+	require.Equal(t, FieldMask{
+		"a": {"b": {"c": nil}, "d": nil},
+		"e": nil,
+		"g": nil,
+	}, mask)
+	require.True(t, mask.Has("x", "a"))
+	require.False(t, mask.Has("b", "c"))
+	require.Equal(t, FieldMask{"c": nil}, mask.Sub("a").Sub("b"))
+	require.Nil(t, mask.Sub("e"))
+	require.Equal(t, []string{"a", "e", "g"}, mask.Names())
+}
+
+func TestCheckFieldMask(t *testing.T) {
+	require.Nil(t, CheckFieldMask("a", nil, nil))
+	require.Nil(t, CheckFieldMask("a", FieldMask{}, nil))
+
+	err := CheckFieldMask("a", FieldMask{"c": nil, "b": nil}, nil)
+	require.Equal(t, &FieldMaskError{Path: "a.b"}, err)
+	require.Equal(t, `json: unknown path "a.b" in field mask`, err.Error())
+}
@@ -0,0 +1,136 @@
+package jsonencoder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldMask is the tree of the field paths that selects the fields to encode.
+// Each key is the json key of a field under the NameStyle of message, and the value is the
+// mask of the nested message. A nil value selects the whole field.
+//
+// A nil FieldMask selects all fields.
+type FieldMask map[string]FieldMask
+
+// NewFieldMask builds a FieldMask from the paths, e.g. "config.network.port".
+// A path selects the whole field if it is the prefix of other paths.
+// A nil FieldMask is returned if paths is empty.
+func NewFieldMask(paths []string) FieldMask {
+	if len(paths) == 0 {
+		return nil
+	}
+	mask := make(FieldMask)
+	for _, path := range paths {
+		m := mask
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			sub, ok := m[name]
+			if ok && sub == nil {
+				// The whole field has been selected.
+				break
+			}
+			if i == len(names)-1 {
+				m[name] = nil
+				break
+			}
+			if !ok {
+				sub = make(FieldMask)
+				m[name] = sub
+			}
+			m = sub
+		}
+	}
+	return mask
+}
+
+// Has reports whether the field with any of the names is selected.
+func (m FieldMask) Has(names ...string) bool {
+	if m == nil {
+		return true
+	}
+	for _, name := range names {
+		if _, ok := m[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Sub returns the mask of the nested message of the field with any of the names.
+// A nil FieldMask is returned if the whole field is selected.
+func (m FieldMask) Sub(names ...string) FieldMask {
+	for _, name := range names {
+		if sub, ok := m[name]; ok {
+			return sub
+		}
+	}
+	return nil
+}
+
+// Names returns the names in mask in sorted order.
+func (m FieldMask) Names() []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// FieldMaskError is returned if a path in FieldMask selects no field.
+type FieldMaskError struct {
+	Path string
+}
+
+func (e *FieldMaskError) Error() string {
+	return fmt.Sprintf("json: unknown path %q in field mask", e.Path)
+}
+
+// FieldMaskChecker is the interface implemented by types that generated by protoc-gen-gojson.
+type FieldMaskChecker interface {
+	// CheckJSONFieldMask returns a *FieldMaskError if any path in mask selects no field.
+	CheckJSONFieldMask(mask FieldMask) error
+}
+
+// CheckFieldMask checks the mask of the nested message of field name. The v is the value of
+// field, the nil pointer of its message type is enough; it's nil if the field is not a message.
+// The sub must be nil if v is not a FieldMaskChecker.
+func CheckFieldMask(name string, sub FieldMask, v interface{}) error {
+	if len(sub) == 0 {
+		return nil
+	}
+	c, ok := v.(FieldMaskChecker)
+	if !ok {
+		return &FieldMaskError{Path: name + "." + sub.Names()[0]}
+	}
+	err := c.CheckJSONFieldMask(sub)
+	if e, ok := err.(*FieldMaskError); ok {
+		return &FieldMaskError{Path: name + "." + e.Path}
+	}
+	return err
+}
+
+// FieldsMarshaler is the interface implemented by types that generated by protoc-gen-gojson.
+type FieldsMarshaler interface {
+	MarshalJSONFieldsWithOptions(mask FieldMask, opts Options) ([]byte, error)
+}
+
+// AppendInterfaceFields is like AppendInterface but only encodes the fields selected by mask.
+// An error is returned if the mask is not nil and v is not a FieldsMarshaler.
+func (enc *Encoder) AppendInterfaceFields(v interface{}, mask FieldMask) error {
+	if mask == nil {
+		return enc.AppendInterface(v)
+	}
+	m, ok := v.(FieldsMarshaler)
+	if !ok {
+		return fmt.Errorf("json: field mask is not supported by type %T", v)
+	}
+	b, err := m.MarshalJSONFieldsWithOptions(mask, enc.NestedOptions())
+	if err != nil {
+		return err
+	}
+	enc.appendElementSeparator()
+	enc.writeBytes(b)
+	return nil
+}
@@ -0,0 +1,104 @@
+package jsonencoder
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// FloatMode represents the notation of the finite float values.
+type FloatMode int8
+
+const (
+	// FloatDecimal formats the value in decimal notation without exponent, e.g. 1e21 to
+	// 1000000000000000000000. It uses the minimal digits that represent the value exactly.
+	FloatDecimal FloatMode = iota
+	// FloatES6 formats the value like ES6 number-to-string conversion same as encoding/json,
+	// the exponent is used if the absolute value is less than 1e-6 or not less than 1e21.
+	FloatES6
+	// FloatFixed formats the value in decimal notation with exactly FloatFormat.Precision
+	// digits after the decimal point, e.g. 1.50 with precision 2.
+	FloatFixed
+)
+
+// NonFinite represents how to handle the NaN and Infinity values.
+type NonFinite int8
+
+const (
+	// NonFiniteGo encodes the values as the quoted strings "NaN", "+Inf" and "-Inf".
+	NonFiniteGo NonFinite = iota
+	// NonFiniteProtoJSON encodes the values as the quoted strings "NaN", "Infinity" and "-Infinity"
+	// same as protojson.
+	NonFiniteProtoJSON
+	// NonFiniteError returns a *json.UnsupportedValueError same as encoding/json.
+	NonFiniteError
+)
+
+// FloatFormat controls how the float values are formatted.
+// The zero value formats in FloatDecimal with NonFiniteGo.
+type FloatFormat struct {
+	Mode      FloatMode
+	Precision int // the number of digits after the decimal point, only used by FloatFixed.
+	NonFinite NonFinite
+}
+
+func (enc *Encoder) appendFloat(v float64, bits int, f FloatFormat) error {
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return enc.appendNonFinite(v, bits, f.NonFinite)
+	}
+
+	switch f.Mode {
+	case FloatES6:
+		// Convert as if by ES6 number to string conversion.
+		// This matches most other JSON generators.
+		// Like fmt %g, but the exponent cutoffs are different
+		// and exponents themselves are not padded to two digits.
+		format := byte('f')
+		if abs := math.Abs(v); abs != 0 {
+			if bits == 64 && (abs < 1e-6 || abs >= 1e21) || bits == 32 && (float32(abs) < 1e-6 || float32(abs) >= 1e21) {
+				format = 'e'
+			}
+		}
+		enc.buf = strconv.AppendFloat(enc.buf, v, format, -1, bits)
+		if format == 'e' {
+			// clean up e-09 to e-9
+			n := len(enc.buf)
+			if n >= 4 && enc.buf[n-4] == 'e' && enc.buf[n-3] == '-' && enc.buf[n-2] == '0' {
+				enc.buf[n-2] = enc.buf[n-1]
+				enc.buf = enc.buf[:n-1]
+			}
+		}
+	case FloatFixed:
+		enc.buf = strconv.AppendFloat(enc.buf, v, 'f', f.Precision, bits)
+	default:
+		enc.buf = strconv.AppendFloat(enc.buf, v, 'f', -1, bits)
+	}
+	return nil
+}
+
+func (enc *Encoder) appendNonFinite(v float64, bits int, policy NonFinite) error {
+	switch policy {
+	case NonFiniteError:
+		return &json.UnsupportedValueError{Value: reflect.ValueOf(v), Str: strconv.FormatFloat(v, 'g', -1, bits)}
+	case NonFiniteProtoJSON:
+		switch {
+		case math.IsNaN(v):
+			enc.writeString(`"NaN"`)
+		case math.IsInf(v, 1):
+			enc.writeString(`"Infinity"`)
+		default:
+			enc.writeString(`"-Infinity"`)
+		}
+	default:
+		switch {
+		case math.IsNaN(v):
+			enc.writeString(`"NaN"`)
+		case math.IsInf(v, 1):
+			enc.writeString(`"+Inf"`)
+		default:
+			enc.writeString(`"-Inf"`)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package jsonencoder
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Options controls the behavior of a single call of MarshalJSONWithOptions
+// that generated by protoc-gen-gojson.
+//
+// The same Options except Prefix and Indent is passed down to all nested messages
+// that generated by protoc-gen-gojson, the whole output is indented once at the end.
+type Options struct {
+	// Prefix and Indent format the output like json.MarshalIndent.
+	// The output is compact if both of them are empty.
+	Prefix string
+	Indent string
+
+	// EscapeHTML specifies whether problematic HTML characters (<, > and &)
+	// should be escaped inside JSON quoted strings.
+	EscapeHTML bool
+}
+
+// Marshaler is the interface implemented by types that generated by protoc-gen-gojson.
+type Marshaler interface {
+	MarshalJSONWithOptions(opts Options) ([]byte, error)
+}
+
+// EncoderMarshaler is the interface implemented by types that generated by protoc-gen-gojson.
+// The MarshalJSONTo appends the JSON encoding into enc with the options of enc, so the buffer
+// of enc is reused instead of allocating a new encoder.
+type EncoderMarshaler interface {
+	MarshalJSONTo(enc *Encoder) error
+}
+
+// NewWithOptions creates a Encoder with the given options.
+func NewWithOptions(bufLen int, opts Options) *Encoder {
+	return &Encoder{
+		buf:  make([]byte, 0, bufLen),
+		opts: opts,
+	}
+}
+
+// ResetWithOptions resets the encoder to be empty with the given options,
+// the underlying buffer is retained for reuse.
+func (enc *Encoder) ResetWithOptions(opts Options) {
+	enc.buf = enc.buf[:0]
+	enc.opts = opts
+}
+
+// NestedOptions returns the options for encode the nested message.
+// The indentation is applied only by the outermost message.
+func (enc *Encoder) NestedOptions() Options {
+	opts := enc.opts
+	opts.Prefix = ""
+	opts.Indent = ""
+	return opts
+}
+
+// indent formats the buf by Options.Prefix and Options.Indent.
+func (enc *Encoder) indent() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Grow(len(enc.buf) * 2)
+	if err := json.Indent(&buf, enc.buf, enc.opts.Prefix, enc.opts.Indent); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -13,7 +13,7 @@ func (enc *Encoder) appendString(s string) {
 	start := 0
 	for i := 0; i < len(s); {
 		if b := s[i]; b < utf8.RuneSelf {
-			if htmlSafeSet[b] || (!enc.escapeHTML && safeSet[b]) {
+			if htmlSafeSet[b] || (!enc.opts.EscapeHTML && safeSet[b]) {
 				i++
 				continue
 			}
@@ -0,0 +1,6 @@
+// Package jsonlines reads and writes the streams of messages in JSON Lines
+// (also known as NDJSON) format, one JSON value per line.
+//
+// It works with the messages that generated by protoc-gen-gojson, and falls back to
+// the json.Marshaler, json.Unmarshaler and encoding/json for other values.
+package jsonlines
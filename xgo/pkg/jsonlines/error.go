@@ -0,0 +1,19 @@
+package jsonlines
+
+import (
+	"strconv"
+)
+
+// A LineError records the error of a line and the line number, the line number starts from 1.
+type LineError struct {
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return "jsonlines: line " + strconv.Itoa(e.Line) + ": " + e.Err.Error()
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
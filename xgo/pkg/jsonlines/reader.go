@@ -0,0 +1,157 @@
+package jsonlines
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
+)
+
+// chunkSize is the minimum size of the chunk that the lines are copied into.
+const chunkSize = 4096
+
+// Reader reads the values from JSON Lines, one value per line. The blank lines are ignored.
+//
+// The value is decoded by the method UnmarshalJSONFrom that generated by protoc-gen-gojson,
+// or the method UnmarshalJSON, or encoding/json. The limits in jsondecoder.Options is applied
+// to each line, the MaxBytes limits the length of a line. The same jsondecoder.Decoder is reset
+// and reused for every line.
+//
+// The strings in the decoded message may refer to the bytes of line, so the bytes of a decoded line
+// are never overwritten. The lines are copied into a shared chunk that is reused until it is full,
+// instead of allocating a new slice for every line.
+type Reader struct {
+	// SkipBadLines causes the Reader skip the lines that can't be decoded instead of
+	// returning an error. The errors of skipped lines are collected and can be got by Errors.
+	SkipBadLines bool
+
+	r      *bufio.Reader
+	opts   jsondecoder.Options
+	dec    jsondecoder.Decoder
+	buf    []byte
+	chunk  []byte
+	line   int
+	errors []*LineError
+}
+
+// NewReader returns a new Reader that reads from r.
+func NewReader(r io.Reader) *Reader {
+	return NewReaderWithOptions(r, jsondecoder.Options{})
+}
+
+// NewReaderWithOptions returns a new Reader that reads from r with the options.
+func NewReaderWithOptions(r io.Reader, opts jsondecoder.Options) *Reader {
+	return &Reader{
+		r:    bufio.NewReader(r),
+		opts: opts,
+	}
+}
+
+// Line returns the line number of the last line that has been read.
+func (r *Reader) Line() int {
+	return r.line
+}
+
+// Errors returns the errors of lines that skipped when the SkipBadLines is true.
+func (r *Reader) Errors() []*LineError {
+	return r.errors
+}
+
+// Read reads the next non-blank line and decodes it into v.
+// The io.EOF is returned if no more lines. The error is a *LineError if the line can't be decoded.
+func (r *Reader) Read(v interface{}) error {
+	for {
+		line, err := r.readLine()
+		if err != nil {
+			if _, ok := err.(*LineError); ok && r.SkipBadLines {
+				r.errors = append(r.errors, err.(*LineError))
+				continue
+			}
+			return err
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		if err = r.unmarshal(r.copyLine(line), v); err != nil {
+			lineErr := &LineError{Line: r.line, Err: err}
+			if r.SkipBadLines {
+				r.errors = append(r.errors, lineErr)
+				continue
+			}
+			return lineErr
+		}
+		return nil
+	}
+}
+
+func (r *Reader) unmarshal(data []byte, v interface{}) error {
+	switch x := v.(type) {
+	case jsondecoder.DecoderUnmarshaler:
+		if err := r.dec.ResetWithOptions(data, r.opts); err != nil {
+			return err
+		}
+		return x.UnmarshalJSONFrom(&r.dec)
+	case jsondecoder.Unmarshaler:
+		return x.UnmarshalJSONWithOptions(data, r.opts)
+	case json.Unmarshaler:
+		return x.UnmarshalJSON(data)
+	default:
+		return json.Unmarshal(data, v)
+	}
+}
+
+// copyLine copies the line into the free space of chunk, a new chunk is allocated if the
+// free space is not enough. The bytes in chunk that have been returned are never overwritten.
+func (r *Reader) copyLine(line []byte) []byte {
+	if cap(r.chunk)-len(r.chunk) < len(line) {
+		size := chunkSize
+		if len(line) > size {
+			size = len(line)
+		}
+		r.chunk = make([]byte, 0, size)
+	}
+	start := len(r.chunk)
+	r.chunk = append(r.chunk, line...)
+	return r.chunk[start:len(r.chunk):len(r.chunk)]
+}
+
+// readLine reads a line without the end of line into the reused buffer.
+// The rest of the line is discarded if the line exceeds the MaxBytes.
+func (r *Reader) readLine() ([]byte, error) {
+	r.buf = r.buf[:0]
+	exceeded := false
+	for {
+		b, err := r.r.ReadSlice('\n')
+		if len(b) == 0 && err == io.EOF && len(r.buf) == 0 && !exceeded {
+			return nil, io.EOF
+		}
+		if !exceeded {
+			r.buf = append(r.buf, b...)
+			if max := r.opts.MaxBytes; max > 0 && len(bytes.TrimRight(r.buf, "\r\n")) > max {
+				exceeded = true
+				r.buf = r.buf[:0]
+			}
+		}
+		if err == bufio.ErrBufferFull {
+			continue
+		}
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		r.line++
+		if exceeded {
+			max := r.opts.MaxBytes
+			return nil, &LineError{Line: r.line, Err: &jsondecoder.LimitError{Limit: "MaxBytes", Max: max, Offset: int64(max)}}
+		}
+		if n := len(r.buf); n > 0 && r.buf[n-1] == '\n' {
+			r.buf = r.buf[:n-1]
+			if n := len(r.buf); n > 0 && r.buf[n-1] == '\r' {
+				r.buf = r.buf[:n-1]
+			}
+		}
+		return r.buf, nil
+	}
+}
@@ -0,0 +1,105 @@
+package jsonlines
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder"
+)
+
+var encoderPool = sync.Pool{
+	New: func() interface{} {
+		return jsonencoder.New(1024)
+	},
+}
+
+// Writer writes the values as JSON Lines, one value per line.
+//
+// The value is encoded by the method MarshalJSONTo that generated by protoc-gen-gojson,
+// or the method MarshalJSON, or encoding/json. The same pooled jsonencoder.Encoder is reused for
+// every line until the Writer is closed, the generated messages are encoded into it directly.
+//
+// As returned by NewWriter, a Writer writes the lines to a buffered writer, the Flush or
+// Close must be called to ensure the data has been written to the underlying io.Writer.
+type Writer struct {
+	w    *bufio.Writer
+	enc  *jsonencoder.Encoder
+	opts jsonencoder.Options
+	line int
+}
+
+// NewWriter returns a new Writer that writes to w with HTML escaping.
+func NewWriter(w io.Writer) *Writer {
+	return NewWriterWithOptions(w, jsonencoder.Options{EscapeHTML: true})
+}
+
+// NewWriterWithOptions returns a new Writer that writes to w with the options.
+// The Prefix and Indent in options are ignored because a line must be compact.
+func NewWriterWithOptions(w io.Writer, opts jsonencoder.Options) *Writer {
+	opts.Prefix = ""
+	opts.Indent = ""
+	return &Writer{
+		w:    bufio.NewWriter(w),
+		enc:  encoderPool.Get().(*jsonencoder.Encoder),
+		opts: opts,
+	}
+}
+
+// Line returns the number of lines that have been written.
+func (w *Writer) Line() int {
+	return w.line
+}
+
+// Write writes the value v as a single line. The error is a *LineError if v can't be encoded,
+// nothing is written in this case.
+func (w *Writer) Write(v interface{}) error {
+	w.enc.ResetWithOptions(w.opts)
+	var err error
+	if m, ok := v.(jsonencoder.EncoderMarshaler); ok {
+		err = m.MarshalJSONTo(w.enc)
+	} else {
+		err = w.enc.AppendInterface(v)
+	}
+	if err != nil {
+		return &LineError{Line: w.line + 1, Err: err}
+	}
+
+	b := w.enc.Bytes()
+	if bytes.IndexByte(b, '\n') != -1 {
+		// The method MarshalJSON of user may output the indented json.
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, b); err != nil {
+			return &LineError{Line: w.line + 1, Err: err}
+		}
+		b = buf.Bytes()
+	}
+
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	if err := w.w.WriteByte('\n'); err != nil {
+		return err
+	}
+	w.line++
+	return nil
+}
+
+// Flush writes any buffered data to the underlying io.Writer.
+func (w *Writer) Flush() error {
+	return w.w.Flush()
+}
+
+// Close flushes the buffered data and puts the encoder back to the pool.
+// The Writer can't be used after closed.
+func (w *Writer) Close() error {
+	if w.enc == nil {
+		return nil
+	}
+	err := w.Flush()
+	encoderPool.Put(w.enc)
+	w.enc = nil
+	return err
+}
@@ -0,0 +1,26 @@
+package protovalidator
+
+import (
+	"encoding/hex"
+	"net"
+)
+
+// BytesToString returns the bytes in hexadecimal that shown in error message.
+func BytesToString(b []byte) string {
+	return hex.EncodeToString(b)
+}
+
+// BytesIsIP is the validation function for validating if the field's value is a v4 or v6 IP address in byte form.
+func BytesIsIP(b []byte) bool {
+	return len(b) == net.IPv4len || len(b) == net.IPv6len
+}
+
+// BytesIsIPv4 is the validation function for validating if the field's value is a v4 IP address in byte form.
+func BytesIsIPv4(b []byte) bool {
+	return len(b) == net.IPv4len
+}
+
+// BytesIsIPv6 is the validation function for validating if the field's value is a v6 IP address in byte form.
+func BytesIsIPv6(b []byte) bool {
+	return len(b) == net.IPv6len
+}
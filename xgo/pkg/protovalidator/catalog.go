@@ -0,0 +1,119 @@
+package protovalidator
+
+import (
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+)
+
+// The keys of subject in MessageCatalog. The subject describes the field or the item of field
+// that violates the tag, it's referenced by the placeholder {subject} in the template of tag.
+const (
+	SubjectField     = "subject.field"
+	SubjectListItem  = "subject.list_item"
+	SubjectMapKey    = "subject.map_key"
+	SubjectMapValue  = "subject.map_value"
+	SubjectMessage   = "subject.message"
+	subjectKeyPrefix = "subject."
+)
+
+// DefaultCatalog is the MessageCatalog used by ValidateError.Localize.
+var DefaultCatalog = NewMessageCatalog()
+
+// MessageCatalog holds the message templates for each language. The templates of a language
+// are keyed by the tag name (e.g. TagStringEmail), the subject (e.g. SubjectField) or the error
+// code that specified by the option error_code. The syntax of template see CheckTemplate.
+//
+// The English templates are built from the messages of BuildErrorReason. A template that not found
+// in the matched language falls back to English.
+type MessageCatalog struct {
+	mu        sync.RWMutex
+	languages []language.Tag
+	messages  []map[string]string
+	matcher   language.Matcher
+}
+
+// NewMessageCatalog creates a MessageCatalog with the English templates.
+func NewMessageCatalog() *MessageCatalog {
+	c := &MessageCatalog{}
+	c.languages = []language.Tag{language.English}
+	c.messages = []map[string]string{englishMessages()}
+	c.matcher = language.NewMatcher(c.languages)
+	return c
+}
+
+// Set adds the templates for the language lang, the existing templates with the same key are replaced.
+// An error is returned if any template is invalid.
+func (c *MessageCatalog) Set(lang language.Tag, messages map[string]string) error {
+	for _, template := range messages {
+		if err := CheckTemplate(template); err != nil {
+			return err
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, tag := range c.languages {
+		if tag == lang {
+			for k, v := range messages {
+				c.messages[i][k] = v
+			}
+			return nil
+		}
+	}
+
+	x := make(map[string]string, len(messages))
+	for k, v := range messages {
+		x[k] = v
+	}
+	c.languages = append(c.languages, lang)
+	c.messages = append(c.messages, x)
+	c.matcher = language.NewMatcher(c.languages)
+	return nil
+}
+
+// Lookup returns the template of key for the language that best matches lang, e.g. "zh-CN" or "fr".
+// The English template is returned if not found in the matched language.
+func (c *MessageCatalog) Lookup(lang string, key string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if lang != "" && len(c.languages) > 1 {
+		if tag, err := language.Parse(lang); err == nil {
+			_, index, confidence := c.matcher.Match(tag)
+			if confidence != language.No {
+				if template, ok := c.messages[index][key]; ok {
+					return template, true
+				}
+			}
+		}
+	}
+	template, ok := c.messages[0][key]
+	return template, ok
+}
+
+// englishMessages builds the English templates from the messages of BuildErrorReason.
+func englishMessages() map[string]string {
+	messages := map[string]string{
+		SubjectField:    "field '{field}'",
+		SubjectListItem: "array item where in field '{field}'",
+		SubjectMapKey:   "map key where in field '{field}'",
+		SubjectMapValue: "map value where in field '{field}'",
+		SubjectMessage:  "the message",
+	}
+	for tag, format := range tagFormatMap {
+		messages[tag] = formatToTemplate(format)
+	}
+	return messages
+}
+
+// formatToTemplate converts the format of BuildErrorReason to template. The first verb is the
+// subject and the second one is the value of tag.
+func formatToTemplate(format string) string {
+	template := strings.NewReplacer("{", "{{", "}", "}}").Replace(format)
+	template = strings.Replace(template, "%s", "{"+PlaceholderSubject+"}", 1)
+	template = strings.NewReplacer("%v", "{"+PlaceholderParam+"}", "%s", "{"+PlaceholderParam+"}").Replace(template)
+	return template
+}
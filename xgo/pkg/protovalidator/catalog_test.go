@@ -0,0 +1,71 @@
+package protovalidator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestMessageCatalog_English(t *testing.T) {
+	field := &FieldDesc{Message: "example.Config", Struct: "Config", Name: "port", JSONName: "port"}
+
+	subjects := map[string]string{
+		SubjectField:    "field 'port'",
+		SubjectListItem: "array item where in field 'port'",
+		SubjectMapKey:   "map key where in field 'port'",
+		SubjectMapValue: "map value where in field 'port'",
+	}
+
+	// The English templates must be same as the messages of BuildErrorReason.
+	for tag, format := range tagFormatMap {
+		for subject, fieldDesc := range subjects {
+			tagInfo := &TagInfo{Tag: tag}
+			if strings.Count(format, "%") > 1 {
+				tagInfo.Value = "{x}"
+			}
+			e := TagError2(field, "", subject, "", tag, BuildExpectedValue(tagInfo))
+			require.Equal(t, BuildErrorReason(tagInfo, fieldDesc), e.Reason(), tag)
+		}
+	}
+}
+
+func TestMessageCatalog_Localize(t *testing.T) {
+	c := NewMessageCatalog()
+	require.Nil(t, c.Set(language.Chinese, map[string]string{
+		SubjectField: "字段 '{field}'",
+		TagIntGt:     "{subject} 的值必须大于 '{param}'",
+		"PORT_ZH":    "端口必须在 1 到 65535 之间",
+	}))
+	require.Nil(t, c.Set(language.French, map[string]string{
+		TagIntGt: "la valeur de {subject} doit être supérieure à '{param}'",
+	}))
+	require.NotNil(t, c.Set(language.German, map[string]string{TagIntGt: "{fild}"}))
+
+	field := &FieldDesc{Message: "example.Config", Struct: "Config", Name: "port", JSONName: "port"}
+	e := TagError1(field, "", SubjectField, "", TagIntGt, "0", "-1")
+
+	require.Equal(t, "字段 'port' 的值必须大于 '0'", e.LocalizeWith(c, "zh"))
+	require.Equal(t, "字段 'port' 的值必须大于 '0'", e.LocalizeWith(c, "zh-CN"))
+	require.Equal(t, "字段 'port' 的值必须大于 '0'", e.LocalizeWith(c, "zh-Hans-CN"))
+	// The subject falls back to English.
+	require.Equal(t, "la valeur de field 'port' doit être supérieure à '0'", e.LocalizeWith(c, "fr-CA"))
+	// Unknown or invalid language falls back to English.
+	require.Equal(t, "the value of field 'port' must be greater than '0'", e.LocalizeWith(c, "ja"))
+	require.Equal(t, "the value of field 'port' must be greater than '0'", e.LocalizeWith(c, "!!"))
+	require.Equal(t, "the value of field 'port' must be greater than '0'", e.LocalizeWith(c, ""))
+
+	// The template of tag not found in language falls back to English.
+	e2 := TagError1(field, "", SubjectField, "", TagIntLt, "10", "11")
+	require.Equal(t, "the value of 字段 'port' must be less than '10'", e2.LocalizeWith(c, "zh"))
+
+	// The error code is looked up first.
+	e.WithCustom("PORT_ZH", "{field} must be between 1 and 65535")
+	require.Equal(t, "端口必须在 1 到 65535 之间", e.LocalizeWith(c, "zh"))
+	require.Equal(t, "port must be between 1 and 65535", e.LocalizeWith(c, "fr"))
+
+	// The Error is always in English.
+	require.Equal(t, "ValidateError: <Config>: port must be between 1 and 65535", e.Error())
+	require.Equal(t, "ValidateError: <Config>: the value of field 'port' must be less than '10' and you provide '11'", e2.Error())
+}
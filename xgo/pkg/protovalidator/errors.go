@@ -6,28 +6,209 @@ import (
 
 const errorId = "ValidateError"
 const withValueMsg = " and you provide "
+const pathMsg = " at '"
+
+// FieldDesc describes a field of message that be validated. It's generated by protoc-gen-govalidator
+// for each field or oneof.
+type FieldDesc struct {
+	// The full name of message in protobuf, e.g. "example.Config".
+	Message string
+
+	// The name of struct in Go, e.g. "Config".
+	Struct string
+
+	// The name of field or oneof in protobuf.
+	Name string
+
+	// The json name of field. It's the name of oneof for oneof.
+	JSONName string
+}
 
 type ValidateError struct {
-	//// The validator tag name.
-	//Tag string
-	//
-	//// The value that you expected.
-	//ExpectedValue string
-	//
-	//// The field's value will return in message. It may be "" when no need display.
-	//FieldValue string
+	field FieldDesc
+
+	// The full path of field.
+	path string
+
+	// Whether the field is in a nested message, the path is shown in message if true.
+	nested bool
+
+	// The validator tag name.
+	tag string
+
+	// The value that you expected.
+	expectedValue string
+
+	// The field's value will return in message. It may be "" when no need display.
+	fieldValue string
+
+	// Whether the fieldValue is shown in message.
+	withValue bool
+
+	// The key of subject in MessageCatalog, e.g. SubjectListItem.
+	subject string
 
-	message string
+	// The reason that built by BuildErrorReason, it's only used by FieldError1 and FieldError2.
+	reason string
+
+	// The custom error code and message template by the options of field.
+	code     string
+	template string
 }
 
 func (e *ValidateError) Error() string {
-	return e.message
+	if e.template != "" || !e.withValue {
+		return buildMessage2(e.field.Struct, e.messagePath(), e.Reason())
+	}
+	return buildMessage1(e.field.Struct, e.messagePath(), e.Reason(), e.fieldValue)
+}
+
+// MessageName returns the full name of message in protobuf, e.g. "example.Config".
+func (e *ValidateError) MessageName() string {
+	return e.field.Message
+}
+
+// Field returns the name of field in protobuf.
+func (e *ValidateError) Field() string {
+	return e.field.Name
+}
+
+// JSONName returns the json name of field.
+func (e *ValidateError) JSONName() string {
+	return e.field.JSONName
+}
+
+// Path returns the full path of field from the message that be validated,
+// e.g. "order.items[2].sku" or `labels["env"]`.
+func (e *ValidateError) Path() string {
+	return e.path
+}
+
+// Tag returns the violated tag, e.g. TagStringEmail.
+func (e *ValidateError) Tag() string {
+	return e.tag
+}
+
+// ExpectedValue returns the value of tag options, e.g. "3" for TagStringByteLenEq.
+// It is "" if the tag has no value, e.g. TagStringEmail.
+func (e *ValidateError) ExpectedValue() string {
+	return e.expectedValue
+}
+
+// FieldValue returns the value of field that shown in message. It is "" if not shown,
+// e.g. for TagMessageNotNull. It's the length for the tags of length.
+func (e *ValidateError) FieldValue() string {
+	return e.fieldValue
+}
+
+// Code returns the error code that specified by the option error_code of field.
+func (e *ValidateError) Code() string {
+	return e.code
+}
+
+// Reason returns the reason of error in English without the prefix, i.e. the message rendered from
+// the custom message template of field if specified, otherwise the same as BuildErrorReason.
+func (e *ValidateError) Reason() string {
+	return e.LocalizeWith(DefaultCatalog, "")
+}
+
+// Localize returns the reason of error in the language lang, e.g. "zh-CN", by the DefaultCatalog.
+func (e *ValidateError) Localize(lang string) string {
+	return e.LocalizeWith(DefaultCatalog, lang)
+}
+
+// LocalizeWith returns the reason of error in the language lang by the catalog. The template is
+// looked up by the error code first, then the custom message template of field is used if specified,
+// otherwise the template of tag is used.
+func (e *ValidateError) LocalizeWith(c *MessageCatalog, lang string) string {
+	if e.tag == "" {
+		return e.reason
+	}
+	if e.code != "" {
+		if template, ok := c.Lookup(lang, e.code); ok {
+			return renderTemplate(template, e, c, lang, true)
+		}
+	}
+	if e.template != "" {
+		return renderTemplate(e.template, e, c, lang, true)
+	}
+	template, _ := c.Lookup(lang, e.tag)
+	return renderTemplate(template, e, c, lang, true)
+}
+
+// WithCustom sets the custom error code and message template that specified by the options of field.
+func (e *ValidateError) WithCustom(code string, template string) *ValidateError {
+	e.code = code
+	e.template = template
+	return e
+}
+
+// messagePath returns the full path that shown in message. The path is shown only
+// if the field is in a nested message, the message is unchanged for the message being validated.
+func (e *ValidateError) messagePath() string {
+	if !e.nested {
+		return ""
+	}
+	return e.path
 }
 
 func FieldError1(structName string, reason string, value string) error {
+	e := &ValidateError{
+		field:      FieldDesc{Struct: structName},
+		fieldValue: value,
+		withValue:  true,
+		reason:     reason,
+	}
+	return e
+}
+
+func FieldError2(structName string, reason string) error {
+	e := &ValidateError{
+		field:  FieldDesc{Struct: structName},
+		reason: reason,
+	}
+	return e
+}
+
+// TagError1 is like FieldError1 but records the field, the violated tag and the expected value.
+// The path is the path of message that the field belongs to, it's "" for the message being validated.
+// The subject is the key of subject in MessageCatalog, e.g. SubjectListItem.
+// The suffix is the index or key of the item in field, e.g. "[2]", it's "" for the field self.
+// The reason is rendered from the template of tag in MessageCatalog.
+func TagError1(field *FieldDesc, path string, subject string, suffix string, tag string, expectedValue string, value string) *ValidateError {
+	e := &ValidateError{
+		field:         *field,
+		path:          JoinPath(path, field.Name) + suffix,
+		nested:        path != "",
+		tag:           tag,
+		expectedValue: expectedValue,
+		fieldValue:    value,
+		withValue:     true,
+		subject:       subject,
+	}
+	return e
+}
+
+// TagError2 is like FieldError2 but records the field, the violated tag and the expected value.
+func TagError2(field *FieldDesc, path string, subject string, suffix string, tag string, expectedValue string) *ValidateError {
+	e := &ValidateError{
+		field:         *field,
+		path:          JoinPath(path, field.Name) + suffix,
+		nested:        path != "",
+		tag:           tag,
+		expectedValue: expectedValue,
+		subject:       subject,
+	}
+	return e
+}
+
+func buildMessage1(structName string, path string, reason string, value string) string {
 	//message := fmt.Sprintf("ValidateError: <%s>: %s and you provide '%+v'", structName, reason, value)
 
 	strLen := len(errorId) + len(structName) + 6 + len(reason) + len(withValueMsg) + 2 + len(value)
+	if path != "" {
+		strLen += len(pathMsg) + 1 + len(path)
+	}
 
 	var s strings.Builder
 	s.Grow(strLen)
@@ -37,6 +218,7 @@ func FieldError1(structName string, reason string, value string) error {
 	s.WriteString("<")
 	s.WriteString(structName)
 	s.WriteString(">")
+	writePath(&s, path)
 	s.WriteString(": ")
 	s.WriteString(reason)
 	s.WriteString(withValueMsg)
@@ -44,18 +226,16 @@ func FieldError1(structName string, reason string, value string) error {
 	s.WriteString(value)
 	s.WriteString("'")
 
-	message := s.String()
-
-	e := &ValidateError{
-		message: message,
-	}
-	return e
+	return s.String()
 }
 
-func FieldError2(structName string, reason string) error {
+func buildMessage2(structName string, path string, reason string) string {
 	//message := fmt.Sprintf("ValidateError: <%s>: %s", structName, reason)
 
 	strLen := len(errorId) + len(structName) + 6 + len(reason)
+	if path != "" {
+		strLen += len(pathMsg) + 1 + len(path)
+	}
 
 	var s strings.Builder
 	s.Grow(strLen)
@@ -65,12 +245,70 @@ func FieldError2(structName string, reason string) error {
 	s.WriteString("<")
 	s.WriteString(structName)
 	s.WriteString(">")
+	writePath(&s, path)
 	s.WriteString(": ")
 	s.WriteString(reason)
 
-	message := s.String()
-	e := &ValidateError{
-		message: message,
+	return s.String()
+}
+
+// writePath writes the path like " at 'servers[0].ip'" if path is not empty.
+func writePath(s *strings.Builder, path string) {
+	if path == "" {
+		return
 	}
-	return e
+	s.WriteString(pathMsg)
+	s.WriteString(path)
+	s.WriteString("'")
+}
+
+// ValidationErrors is a list of errors returned by the method ValidateAll that generated
+// by protoc-gen-govalidator. It contains all the violations of the message and its nested messages.
+type ValidationErrors []error
+
+func (errs ValidationErrors) Error() string {
+	var s strings.Builder
+	for i, err := range errs {
+		if i != 0 {
+			s.WriteString("; ")
+		}
+		s.WriteString(err.Error())
+	}
+	return s.String()
+}
+
+// Unwrap returns the errors in list.
+func (errs ValidationErrors) Unwrap() []error {
+	return errs
+}
+
+// ByField returns the errors that caused by the field. The name is the field name in protobuf.
+func (errs ValidationErrors) ByField(name string) ValidationErrors {
+	return errs.filter(func(e *ValidateError) bool { return e.field.Name == name })
+}
+
+// ByTag returns the errors that caused by the tag, e.g. TagStringEmail.
+func (errs ValidationErrors) ByTag(tag string) ValidationErrors {
+	return errs.filter(func(e *ValidateError) bool { return e.tag == tag })
+}
+
+func (errs ValidationErrors) filter(fn func(e *ValidateError) bool) ValidationErrors {
+	var x ValidationErrors
+	for _, err := range errs {
+		if e, ok := err.(*ValidateError); ok && fn(e) {
+			x = append(x, err)
+		}
+	}
+	return x
+}
+
+// AppendError appends err to errs. The elements is appended if err is a ValidationErrors.
+func AppendError(errs ValidationErrors, err error) ValidationErrors {
+	if err == nil {
+		return errs
+	}
+	if x, ok := err.(ValidationErrors); ok {
+		return append(errs, x...)
+	}
+	return append(errs, err)
 }
@@ -0,0 +1,42 @@
+package protovalidator
+
+import (
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CountSet returns the number of true in values. It's used to check the cross-field
+// constraints in message options.
+func CountSet(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+	return n
+}
+
+// CompareTimestamp returns -1 if a is before b, 1 if a is after b, otherwise 0.
+func CompareTimestamp(a, b *timestamppb.Timestamp) int {
+	return compareSecondsNanos(a.GetSeconds(), int64(a.GetNanos()), b.GetSeconds(), int64(b.GetNanos()))
+}
+
+// CompareDuration returns -1 if a is shorter than b, 1 if a is longer than b, otherwise 0.
+func CompareDuration(a, b *durationpb.Duration) int {
+	return compareSecondsNanos(a.GetSeconds(), int64(a.GetNanos()), b.GetSeconds(), int64(b.GetNanos()))
+}
+
+func compareSecondsNanos(s1, n1, s2, n2 int64) int {
+	switch {
+	case s1 < s2:
+		return -1
+	case s1 > s2:
+		return 1
+	case n1 < n2:
+		return -1
+	case n1 > n2:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,42 @@
+package protovalidator
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// FloatIsFinite is the validation function for validating if the field's value is neither NaN nor ±Inf.
+func FloatIsFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+// FloatHasDecimalPlaces is the validation function for validating if the field's value has at most n
+// decimal places. The bitSize is 32 for float and 64 for double, the value is counted in its shortest
+// representation of that precision, e.g. float32(0.1) has 1 decimal place.
+func FloatHasDecimalPlaces(v float64, bitSize int, n uint32) bool {
+	if !FloatIsFinite(v) {
+		return false
+	}
+	s := strconv.FormatFloat(v, 'f', -1, bitSize)
+	i := strings.IndexByte(s, '.')
+	if i == -1 {
+		return true
+	}
+	return len(s)-i-1 <= int(n)
+}
+
+// FloatIsMultipleOf is the validation function for validating if the field's value is an integer multiple
+// of m. The bitSize is 32 for float and 64 for double, it decides the tolerance of rounding error so that
+// the 0.3 is treated as a multiple of 0.1.
+func FloatIsMultipleOf(v float64, bitSize int, m float64) bool {
+	if !FloatIsFinite(v) || !FloatIsFinite(m) || m == 0 {
+		return false
+	}
+	epsilon := 0x1p-52
+	if bitSize == 32 {
+		epsilon = 0x1p-23
+	}
+	q := v / m
+	return math.Abs(q-math.Round(q)) <= 4*epsilon*math.Max(math.Abs(q), 1)
+}
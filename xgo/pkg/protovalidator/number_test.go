@@ -0,0 +1,44 @@
+package protovalidator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloatIsFinite(t *testing.T) {
+	require.True(t, FloatIsFinite(0))
+	require.True(t, FloatIsFinite(-math.MaxFloat64))
+	require.False(t, FloatIsFinite(math.NaN()))
+	require.False(t, FloatIsFinite(math.Inf(1)))
+	require.False(t, FloatIsFinite(math.Inf(-1)))
+}
+
+func TestFloatHasDecimalPlaces(t *testing.T) {
+	require.True(t, FloatHasDecimalPlaces(12, 64, 0))
+	require.True(t, FloatHasDecimalPlaces(12.5, 64, 1))
+	require.False(t, FloatHasDecimalPlaces(12.55, 64, 1))
+	require.True(t, FloatHasDecimalPlaces(-0.01, 64, 2))
+	require.False(t, FloatHasDecimalPlaces(1e-7, 64, 6))
+	require.True(t, FloatHasDecimalPlaces(1e21, 64, 0))
+	require.True(t, FloatHasDecimalPlaces(float64(float32(0.1)), 32, 1))
+	require.False(t, FloatHasDecimalPlaces(float64(float32(0.1)), 64, 1))
+	require.False(t, FloatHasDecimalPlaces(math.NaN(), 64, 2))
+	require.False(t, FloatHasDecimalPlaces(math.Inf(1), 64, 2))
+}
+
+func TestFloatIsMultipleOf(t *testing.T) {
+	require.True(t, FloatIsMultipleOf(0, 64, 0.1))
+	require.True(t, FloatIsMultipleOf(0.3, 64, 0.1))
+	require.True(t, FloatIsMultipleOf(-0.3, 64, 0.1))
+	require.True(t, FloatIsMultipleOf(19.99, 64, 0.01))
+	require.True(t, FloatIsMultipleOf(7.5, 64, 2.5))
+	require.False(t, FloatIsMultipleOf(0.35, 64, 0.1))
+	require.False(t, FloatIsMultipleOf(7, 64, 2.5))
+	require.True(t, FloatIsMultipleOf(float64(float32(0.3)), 32, 0.1))
+	require.False(t, FloatIsMultipleOf(float64(float32(0.35)), 32, 0.1))
+	require.False(t, FloatIsMultipleOf(math.NaN(), 64, 0.1))
+	require.False(t, FloatIsMultipleOf(math.Inf(1), 64, 0.1))
+	require.False(t, FloatIsMultipleOf(1, 64, 0))
+}
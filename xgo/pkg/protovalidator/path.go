@@ -0,0 +1,28 @@
+package protovalidator
+
+import (
+	"strconv"
+)
+
+// JoinPath returns the path of field name in the message of path.
+// The path is returned if name is "", it's used for the message self.
+func JoinPath(path string, name string) string {
+	if name == "" {
+		return path
+	}
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// IndexSuffix returns the suffix of path for the item of list at index i, e.g. "[2]".
+func IndexSuffix(i int) string {
+	return "[" + strconv.Itoa(i) + "]"
+}
+
+// KeySuffix returns the suffix of path for the item of map with key, e.g. `["env"]`.
+// The key must be formatted by the caller, the string key should be quoted.
+func KeySuffix(key string) string {
+	return "[" + key + "]"
+}
@@ -24,6 +24,11 @@ const (
 	TagFloatLte   = "float.lte"
 	TagFloatIn    = "float.in"
 	TagFloatNotIn = "float.not_in"
+
+	TagFloatRange         = "float.range"
+	TagFloatMultipleOf    = "float.multiple_of"
+	TagFloatFinite        = "float.finite"
+	TagFloatDecimalPlaces = "float.decimal_places"
 )
 
 // tag const for int.
@@ -36,6 +41,9 @@ const (
 	TagIntLte   = "int.lte"
 	TagIntIn    = "int.in"
 	TagIntNotIn = "int.not_in"
+
+	TagIntRange      = "int.range"
+	TagIntMultipleOf = "int.multiple_of"
 )
 
 // tag const for uint.
@@ -48,6 +56,9 @@ const (
 	TagUintLte   = "uint.lte"
 	TagUintIn    = "uint.in"
 	TagUintNotIn = "uint.not_in"
+
+	TagUintRange      = "uint.range"
+	TagUintMultipleOf = "uint.multiple_of"
 )
 
 // tag const for string.
@@ -149,6 +160,21 @@ const (
 	TagBytesLenLt  = "bytes.len_lt"
 	TagBytesLenGte = "bytes.len_gte"
 	TagBytesLenLte = "bytes.len_lte"
+
+	TagBytesEq    = "bytes.eq"
+	TagBytesIn    = "bytes.in"
+	TagBytesNotIn = "bytes.not_in"
+
+	TagBytesPrefix   = "bytes.prefix"
+	TagBytesSuffix   = "bytes.suffix"
+	TagBytesContains = "bytes.contains"
+	TagBytesRegex    = "bytes.regex"
+
+	TagBytesIp   = "bytes.ip"
+	TagBytesIpv4 = "bytes.ipv4"
+	TagBytesIpv6 = "bytes.ipv6"
+
+	TagBytesUtf8 = "bytes.utf8"
 )
 
 // tag const for bool.
@@ -175,6 +201,41 @@ const (
 	TagMessageSkip    = "message.skip"
 )
 
+// tag const for the cross-field constraints in message options.
+const (
+	TagMessageCompare           = "message.compare"
+	TagMessageAtLeastOneOf      = "message.at_least_one_of"
+	TagMessageExactlyOneOf      = "message.exactly_one_of"
+	TagMessageAllOrNoneOf       = "message.all_or_none_of"
+	TagMessageMutuallyExclusive = "message.mutually_exclusive"
+	TagMessageExpr              = "message.expr"
+)
+
+// tag const for google.protobuf.Timestamp.
+const (
+	TagTimestampLt     = "timestamp.lt"
+	TagTimestampGt     = "timestamp.gt"
+	TagTimestampLte    = "timestamp.lte"
+	TagTimestampGte    = "timestamp.gte"
+	TagTimestampLtNow  = "timestamp.lt_now"
+	TagTimestampGtNow  = "timestamp.gt_now"
+	TagTimestampWithin = "timestamp.within"
+)
+
+// tag const for google.protobuf.Duration.
+const (
+	TagDurationLt  = "duration.lt"
+	TagDurationGt  = "duration.gt"
+	TagDurationLte = "duration.lte"
+	TagDurationGte = "duration.gte"
+)
+
+// tag const for google.protobuf.Any.
+const (
+	TagAnyIn    = "any.in"
+	TagAnyNotIn = "any.not_in"
+)
+
 // tag const for repeated.
 const (
 	TagRepeatedNotNull = "repeated.not_null"
@@ -216,6 +277,11 @@ var tagFormatMap = map[string]string{
 	TagFloatIn:    "the value of %s must be one of in '%v'",
 	TagFloatNotIn: "the value of %s must be not one of in '%v'",
 
+	TagFloatRange:         "the value of %s must be in range '%v'",
+	TagFloatMultipleOf:    "the value of %s must be a multiple of '%v'",
+	TagFloatFinite:        "the value of %s must be a finite number",
+	TagFloatDecimalPlaces: "the value of %s must have at most '%v' decimal places",
+
 	// error message for type int.
 	TagIntEq:    "the value of %s must be equal to '%v'",
 	TagIntNe:    "the value of %s must be not equal to '%v'",
@@ -226,6 +292,9 @@ var tagFormatMap = map[string]string{
 	TagIntIn:    "the value of %s must be one of '%v'",
 	TagIntNotIn: "the value of %s must be not one of in '%v'",
 
+	TagIntRange:      "the value of %s must be in range '%v'",
+	TagIntMultipleOf: "the value of %s must be a multiple of '%v'",
+
 	// error message for type uint.
 	TagUintEq:    "the value of %s must be equal to '%v'",
 	TagUintNe:    "the value of %s must be not equal to '%v'",
@@ -236,6 +305,9 @@ var tagFormatMap = map[string]string{
 	TagUintIn:    "the value of %s must be one of '%v'",
 	TagUintNotIn: "the value of %s must be not one of in '%v'",
 
+	TagUintRange:      "the value of %s must be in range '%v'",
+	TagUintMultipleOf: "the value of %s must be a multiple of '%v'",
+
 	// error message for type string.
 	TagStringEq:    "the value of %s must be equal to '%v'",
 	TagStringNe:    "the value of %s must be not equal to '%v'",
@@ -332,6 +404,21 @@ var tagFormatMap = map[string]string{
 	TagBytesLenGte: "the length of %s must be greater than or equal to '%v'",
 	TagBytesLenLte: "the length of %s must be less than or equal to '%v'",
 
+	TagBytesEq:    "the value of %s must be equal to '%v'",
+	TagBytesIn:    "the value of %s must be one of '%v'",
+	TagBytesNotIn: "the value of %s must be not one of in '%v'",
+
+	TagBytesPrefix:   "the value of %s must start with bytes '%v'",
+	TagBytesSuffix:   "the value of %s must end with bytes '%v'",
+	TagBytesContains: "the value of %s must contains bytes '%v'",
+	TagBytesRegex:    "the value of %s must match regular expression '%s'",
+
+	TagBytesIp:   "the value of %s must be a valid IP address in 4 or 16 bytes",
+	TagBytesIpv4: "the value of %s must be a valid IPv4 address in 4 bytes",
+	TagBytesIpv6: "the value of %s must be a valid IPv6 address in 16 bytes",
+
+	TagBytesUtf8: "the value of %s must be a valid UTF-8 encoded string",
+
 	// error message for type bool.
 	TagBoolEq: "the value of %s must be equal to '%v'",
 
@@ -349,6 +436,33 @@ var tagFormatMap = map[string]string{
 	// error message for type message.
 	TagMessageNotNull: "the value of %s cannot be null",
 
+	// error message for the cross-field constraints in message options.
+	TagMessageCompare:           "%s must satisfy '%v'",
+	TagMessageAtLeastOneOf:      "%s must have at least one of the fields '%v' set",
+	TagMessageExactlyOneOf:      "%s must have exactly one of the fields '%v' set",
+	TagMessageAllOrNoneOf:       "%s must have all or none of the fields '%v' set",
+	TagMessageMutuallyExclusive: "%s must have the fields of at most one group in '%v' set",
+	TagMessageExpr:              "%s must satisfy the expression '%v'",
+
+	// error message for type google.protobuf.Timestamp.
+	TagTimestampLt:     "the value of %s must be before '%v'",
+	TagTimestampGt:     "the value of %s must be after '%v'",
+	TagTimestampLte:    "the value of %s must be before or equal to '%v'",
+	TagTimestampGte:    "the value of %s must be after or equal to '%v'",
+	TagTimestampLtNow:  "the value of %s must be before now",
+	TagTimestampGtNow:  "the value of %s must be after now",
+	TagTimestampWithin: "the value of %s must be within '%v' of now",
+
+	// error message for type google.protobuf.Duration.
+	TagDurationLt:  "the value of %s must be less than '%v'",
+	TagDurationGt:  "the value of %s must be greater than '%v'",
+	TagDurationLte: "the value of %s must be less than or equal to '%v'",
+	TagDurationGte: "the value of %s must be greater than or equal to '%v'",
+
+	// error message for type google.protobuf.Any.
+	TagAnyIn:    "the type url of %s must be one of '%v'",
+	TagAnyNotIn: "the type url of %s must be not one of '%v'",
+
 	// error message for type repeated.
 	TagRepeatedNotNull: "the value of %s cannot be null",
 	TagRepeatedLenEq:   "the length of %s must be equal to '%v'",
@@ -383,3 +497,11 @@ func BuildErrorReason(tagInfo *TagInfo, fieldDesc string) string {
 	}
 	return reason
 }
+
+// BuildExpectedValue returns the tag value in string that shown in error message.
+func BuildExpectedValue(tagInfo *TagInfo) string {
+	if tagInfo.Value == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", tagInfo.Value)
+}
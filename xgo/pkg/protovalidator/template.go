@@ -0,0 +1,110 @@
+package protovalidator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The placeholders in the custom message template.
+const (
+	PlaceholderField    = "field"     // The name of field in protobuf.
+	PlaceholderJSONName = "json_name" // The json name of field.
+	PlaceholderPath     = "path"      // The full path of field.
+	PlaceholderValue    = "value"     // The value of field.
+	PlaceholderParam    = "param"     // The value of tag options.
+	PlaceholderTag      = "tag"       // The name of violated tag.
+	PlaceholderSubject  = "subject"   // The description of field or item, e.g. "field 'port'".
+)
+
+var placeholders = map[string]func(e *ValidateError) string{
+	PlaceholderField:    (*ValidateError).Field,
+	PlaceholderJSONName: (*ValidateError).JSONName,
+	PlaceholderPath:     (*ValidateError).Path,
+	PlaceholderValue:    (*ValidateError).FieldValue,
+	PlaceholderParam:    (*ValidateError).ExpectedValue,
+	PlaceholderTag:      (*ValidateError).Tag,
+}
+
+// CheckTemplate checks the syntax and placeholders of the custom message template. It's used by
+// protoc-gen-govalidator to reject the invalid template at generation time.
+//
+// The placeholder is in the form of "{name}", e.g. "{field} must be greater than {param}".
+// The "{{" and "}}" are used for literal braces.
+func CheckTemplate(template string) error {
+	return walkTemplate(template, func(s string) {}, func(name string) error {
+		if _, ok := placeholders[name]; !ok && name != PlaceholderSubject {
+			return fmt.Errorf("unknown placeholder {%s}", name)
+		}
+		return nil
+	})
+}
+
+// IsTag reports whether the tag is a defined tag, e.g. TagStringEmail.
+func IsTag(tag string) bool {
+	_, ok := tagFormatMap[tag]
+	return ok
+}
+
+// RenderTemplate renders the custom message template with the data of error in English.
+// The unknown placeholders are kept as is.
+func RenderTemplate(template string, e *ValidateError) string {
+	return renderTemplate(template, e, DefaultCatalog, "", true)
+}
+
+// renderTemplate renders the template, the placeholder {subject} is rendered with the
+// template of subject in catalog for lang if withSubject is true.
+func renderTemplate(template string, e *ValidateError, c *MessageCatalog, lang string, withSubject bool) string {
+	var s strings.Builder
+	s.Grow(len(template))
+	_ = walkTemplate(template, func(x string) { s.WriteString(x) }, func(name string) error {
+		if fn, ok := placeholders[name]; ok {
+			s.WriteString(fn(e))
+			return nil
+		}
+		if name == PlaceholderSubject {
+			if withSubject {
+				subject, _ := c.Lookup(lang, e.subject)
+				s.WriteString(renderTemplate(subject, e, c, lang, false))
+			}
+			return nil
+		}
+		s.WriteString("{" + name + "}")
+		return nil
+	})
+	return s.String()
+}
+
+// walkTemplate parses the template and calls text for the literal text and placeholder for each placeholder.
+func walkTemplate(template string, text func(s string), placeholder func(name string) error) error {
+	for i := 0; i < len(template); {
+		c := template[i]
+		switch {
+		case (c == '{' || c == '}') && i+1 < len(template) && template[i+1] == c:
+			text(template[i : i+1])
+			i += 2
+		case c == '{':
+			end := strings.IndexByte(template[i+1:], '}')
+			if end == -1 {
+				return fmt.Errorf("unclosed placeholder at offset %d", i)
+			}
+			name := template[i+1 : i+1+end]
+			if strings.ContainsAny(name, "{ ") || name == "" {
+				return fmt.Errorf("invalid placeholder {%s} at offset %d", name, i)
+			}
+			if err := placeholder(name); err != nil {
+				return err
+			}
+			i += end + 2
+		case c == '}':
+			return fmt.Errorf("unexpected '}' at offset %d, use '}}' for literal brace", i)
+		default:
+			j := strings.IndexAny(template[i:], "{}")
+			if j == -1 {
+				j = len(template) - i
+			}
+			text(template[i : i+j])
+			i += j
+		}
+	}
+	return nil
+}
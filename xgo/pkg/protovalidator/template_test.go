@@ -0,0 +1,44 @@
+package protovalidator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckTemplate(t *testing.T) {
+	valid := []string{
+		"",
+		"no placeholders",
+		"{field} must be between 1 and 65535",
+		"{json_name} {path} {value} {param} {tag} {subject}",
+		"{{literal}} and {{{field}}}",
+	}
+	for _, s := range valid {
+		require.Nil(t, CheckTemplate(s), s)
+	}
+
+	invalid := map[string]string{
+		"{fild} is invalid": "unknown placeholder {fild}",
+		"{field is invalid": "unclosed placeholder at offset 0",
+		"{} is invalid":     "invalid placeholder {} at offset 0",
+		"{field name}":      "invalid placeholder {field name} at offset 0",
+		"field} is invalid": "unexpected '}' at offset 5, use '}}' for literal brace",
+	}
+	for s, msg := range invalid {
+		err := CheckTemplate(s)
+		require.NotNil(t, err, s)
+		require.Equal(t, msg, err.Error(), s)
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	field := &FieldDesc{Message: "example.Config", Struct: "Config", Name: "max_port", JSONName: "maxPort"}
+	e := TagError1(field, "config", SubjectField, "", TagIntLte, "65535", "70000")
+
+	require.Equal(t, "max_port maxPort config.max_port 70000 65535 int.lte",
+		RenderTemplate("{field} {json_name} {path} {value} {param} {tag}", e))
+	require.Equal(t, "{max_port} {field}", RenderTemplate("{{{field}}} {{field}}", e))
+	require.Equal(t, "{unknown}", RenderTemplate("{unknown}", e))
+	require.Equal(t, "field 'max_port'", RenderTemplate("{subject}", e))
+}
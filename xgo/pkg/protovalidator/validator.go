@@ -5,6 +5,11 @@ type Validator interface {
 	Validate() error
 }
 
+// AllValidator is a interface that allows a message to be validated with all violations.
+type AllValidator interface {
+	ValidateAll() error
+}
+
 // InvokeValidatorIfExists for invoke the Validate method if a interface is a Validator.
 func InvokeValidatorIfExists(candidate interface{}) error {
 	if candidate == nil {
@@ -15,3 +20,30 @@ func InvokeValidatorIfExists(candidate interface{}) error {
 	}
 	return nil
 }
+
+// pathValidator is the interface implemented by messages that generated by protoc-gen-govalidator.
+// The path is passed to the nested messages to build the full path of field in ValidateError.
+type pathValidator interface {
+	XXX_ValidateWithPath(path string, all bool) error
+}
+
+// InvokeNestedValidator for invoke the validate method of nested message in the field of path.
+// The XXX_ValidateWithPath is preferred, otherwise the method ValidateAll is invoked if all is true,
+// or the method Validate is invoked if exists.
+func InvokeNestedValidator(candidate interface{}, path string, all bool) error {
+	if candidate == nil {
+		return nil
+	}
+	if validator, ok := candidate.(pathValidator); ok {
+		return validator.XXX_ValidateWithPath(path, all)
+	}
+	if all {
+		if validator, ok := candidate.(AllValidator); ok {
+			return validator.ValidateAll()
+		}
+	}
+	if validator, ok := candidate.(Validator); ok {
+		return validator.Validate()
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package protovalidator
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CompareTimestampTo returns -1 if t is before the time of seconds and nanos, 1 if t is after it, otherwise 0.
+func CompareTimestampTo(t *timestamppb.Timestamp, seconds int64, nanos int32) int {
+	return compareSecondsNanos(t.GetSeconds(), int64(t.GetNanos()), seconds, int64(nanos))
+}
+
+// CompareTimestampNow returns -1 if t is before the current time, 1 if t is after it, otherwise 0.
+func CompareTimestampNow(t *timestamppb.Timestamp) int {
+	now := time.Now()
+	return compareSecondsNanos(t.GetSeconds(), int64(t.GetNanos()), now.Unix(), int64(now.Nanosecond()))
+}
+
+// TimestampWithin reports whether the distance between t and the current time is less than
+// or equal to the duration of seconds and nanos.
+func TimestampWithin(t *timestamppb.Timestamp, seconds int64, nanos int32) bool {
+	now := time.Now()
+	s, n := t.GetSeconds()-now.Unix(), int64(t.GetNanos())-int64(now.Nanosecond())
+	if n < 0 {
+		s, n = s-1, n+int64(time.Second)
+	}
+	// The absolute value of the distance.
+	if s < 0 {
+		if n == 0 {
+			s = -s
+		} else {
+			s, n = -s-1, int64(time.Second)-n
+		}
+	}
+	return compareSecondsNanos(s, n, seconds, int64(nanos)) <= 0
+}
+
+// CompareDurationTo returns -1 if d is shorter than the duration of seconds and nanos,
+// 1 if d is longer than it, otherwise 0.
+func CompareDurationTo(d *durationpb.Duration, seconds int64, nanos int32) int {
+	return compareSecondsNanos(d.GetSeconds(), int64(d.GetNanos()), seconds, int64(nanos))
+}
+
+// TimestampToString returns the timestamp in RFC 3339 that shown in error message.
+func TimestampToString(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return nilStr
+	}
+	return t.AsTime().Format(time.RFC3339Nano)
+}
+
+// DurationToString returns the duration in the format of time.Duration that shown in error message.
+func DurationToString(d *durationpb.Duration) string {
+	if d == nil {
+		return nilStr
+	}
+	return d.AsDuration().String()
+}
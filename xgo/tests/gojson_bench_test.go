@@ -2,9 +2,12 @@ package tests
 
 import (
 	"encoding/json"
+	"strconv"
+	"strings"
 	"testing"
 
 	jsoniter "github.com/json-iterator/go"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
 	"github.com/yu31/protoc-plugin/xgo/tests/gojsontest"
 )
 
@@ -158,3 +161,20 @@ func Benchmark_JSONIter_Unmarshal_2(b *testing.B) {
 	})
 
 }
+
+// The nested messages are decoded in place, so the throughput does not drop with the depth.
+func Benchmark_GoJSON_Unmarshal_Deep(b *testing.B) {
+	for _, depth := range []int{10, 100, 1000, 5000} {
+		data := []byte(strings.Repeat(`{"t_string":"s1","t_child":`, depth) + `{"t_string":"s2"}` + strings.Repeat("}", depth))
+		b.Run(strconv.Itoa(depth), func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				var model gojsontest.UnmarshalOptions1
+				if err := model.UnmarshalJSONWithOptions(data, jsondecoder.Options{}); err != nil {
+					b.Fatal("gojson unmarshal error:", err)
+				}
+			}
+		})
+	}
+}
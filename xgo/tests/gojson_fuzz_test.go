@@ -0,0 +1,151 @@
+//go:build go1.18
+// +build go1.18
+
+package tests
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
+	"github.com/yu31/protoc-plugin/xgo/tests/gojsontest"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// fuzzSeeds is the seeds shared by the fuzz tests. The inputs found by fuzzing are saved
+// in testdata/fuzz/<FuzzName> and run as the seeds by go test.
+var fuzzSeeds = [][]byte{
+	[]byte(``),
+	[]byte(`null`),
+	[]byte(`{}`),
+	[]byte(`[]`),
+	[]byte(`{"a":1,"b":[true,false,null],"c":{"d":"é😀"}}`),
+	[]byte(` { "type_int32" : -1 , "type_string1" : "a\"b" } `),
+	[]byte(`{"array_int32":[1,2,3],"map_int32_string":{"1":"a"},"type_embed_message":{"age1":"x"}}`),
+	[]byte(`{"m_int32":{"2147483647":"a"},"m_uint64":{"18446744073709551615":"b"},"m_bool":{"true":"c"}}`),
+	[]byte(`{"m_int64":[{"key":-9223372036854775808,"value":"a"}],"m_bool":[{"value":{"t_string":"b"},"key":false}]}`),
+	[]byte(`{"oneof_type1":{"oneof1_string":"x"}}`),
+	[]byte(`{"t_level":"b","t_kind":1}`),
+	[]byte(`{"type_double1":1e308,"type_float":3.4e38,"type_bytes":"AAEC"}`),
+	[]byte(`{"a":{"b":{"c":{"d":[[[[]]]]}}}}`),
+	[]byte(jsonStringModel2),
+}
+
+// fuzzMessages is the messages to fuzz, the protojson is true if the json format of message
+// is same as protojson with UseProtoNames.
+var fuzzMessages = []struct {
+	new       func() fuzzMessage
+	protojson bool
+}{
+	{func() fuzzMessage { return &gojsontest.Model1{} }, false},
+	{func() fuzzMessage { return &gojsontest.Model2{} }, true},
+	{func() fuzzMessage { return &gojsontest.Model3{} }, true},
+	{func() fuzzMessage { return &gojsontest.OptionalModel1{} }, false},
+	{func() fuzzMessage { return &gojsontest.UnmarshalOneofNotHide{} }, false},
+	{func() fuzzMessage { return &gojsontest.UnmarshalOneofHide{} }, false},
+	{func() fuzzMessage { return &gojsontest.EnumValueAlias1{} }, false},
+	{func() fuzzMessage { return &gojsontest.MapKeys1{} }, true},
+	{func() fuzzMessage { return &gojsontest.MapPairs1{} }, false},
+}
+
+type fuzzMessage interface {
+	proto.Message
+	MarshalJSON() ([]byte, error)
+	UnmarshalJSON(b []byte) error
+}
+
+// fuzzReadObject reads the top-level value by the decoder in the same way as the generated code.
+// The items is nil if the value is not an object.
+func fuzzReadObject(d *jsondecoder.Decoder) (items map[string][]byte, value []byte) {
+	d.ScanWhile(jsondecoder.ScanSkipSpace)
+	if d.OpCode != jsondecoder.ScanBeginObject {
+		return nil, d.ReadItem()
+	}
+	items = make(map[string][]byte)
+	for {
+		if d.ObjectBeforeReadKey() {
+			break
+		}
+		key := d.ReadObjectKey()
+		d.ObjectBeforeReadValue()
+		items[key] = d.ReadItem()
+		if d.ObjectAfterReadValue() {
+			break
+		}
+	}
+	return items, nil
+}
+
+func FuzzJSONDecoder(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		d, err := jsondecoder.New(data)
+		require.Equal(t, json.Valid(data), err == nil, "data: %q, err: %v", data, err)
+		if err != nil {
+			return
+		}
+
+		items, value := fuzzReadObject(d)
+		require.Nil(t, d.ScanError())
+
+		if items == nil {
+			require.Equal(t, string(bytes.TrimSpace(data)), string(value))
+			return
+		}
+		var object map[string]json.RawMessage
+		require.Nil(t, json.Unmarshal(data, &object))
+		require.Equal(t, len(object), len(items))
+		for k, v := range object {
+			require.Contains(t, items, k)
+			require.Equal(t, string(v), string(items[k]), "key: %q", k)
+		}
+	})
+}
+
+func FuzzGoJSONUnmarshal(f *testing.F) {
+	for _, seed := range fuzzSeeds {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		valid := json.Valid(data)
+
+		for _, m := range fuzzMessages {
+			x := m.new()
+			err := x.UnmarshalJSON(data)
+			if !valid {
+				require.NotNil(t, err, "data: %q", data)
+			}
+			if err != nil {
+				continue
+			}
+			name := x.ProtoReflect().Descriptor().FullName()
+
+			// Round-trip stability.
+			b, err := x.MarshalJSON()
+			require.Nil(t, err, "message: %s, data: %q", name, data)
+			require.True(t, json.Valid(b), "message: %s, data: %q, output: %q", name, data, b)
+
+			y := m.new()
+			require.Nil(t, y.UnmarshalJSON(b), "message: %s, data: %q, output: %q", name, data, b)
+			require.True(t, proto.Equal(x, y), "message: %s, data: %q, output: %q", name, data, b)
+
+			b2, err := y.MarshalJSON()
+			require.Nil(t, err)
+			require.Equal(t, len(b), len(b2), "message: %s, data: %q", name, data)
+
+			// Agreement with protojson.
+			if !m.protojson {
+				continue
+			}
+			z := m.new()
+			err = protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(b, z)
+			require.Nil(t, err, "message: %s, data: %q, output: %q", name, data, b)
+			require.True(t, proto.Equal(x, z), "message: %s, data: %q, output: %q", name, data, b)
+		}
+	})
+}
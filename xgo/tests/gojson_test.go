@@ -3,15 +3,24 @@ package tests
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"reflect"
+	"strings"
 	"testing"
 	"unsafe"
 
 	"github.com/stretchr/testify/require"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder"
 	"github.com/yu31/protoc-plugin/xgo/tests/gojsonexternal"
 	"github.com/yu31/protoc-plugin/xgo/tests/gojsontest"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var pMarshal = &protojson.MarshalOptions{
@@ -3988,3 +3997,1176 @@ func Test_GoJSON_OptionalModel2(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, data1, data2)
 }
+
+func Test_GoJSON_UnmarshalOptions1_Required(t *testing.T) {
+	b1 := []byte(`{"t_string":"s1","array_string":["a1"],"map_string":{"k1":"v1"}}`)
+	data1 := &gojsontest.UnmarshalOptions1{}
+	err := data1.UnmarshalJSON(b1)
+	require.Nil(t, err)
+	require.Equal(t, "s1", data1.TString)
+	require.Equal(t, []string{"a1"}, data1.ArrayString)
+	require.Equal(t, map[string]string{"k1": "v1"}, data1.MapString)
+
+	// Explicit null is treated as present.
+	b2 := []byte(`{"t_string":"s1","array_string":null,"map_string":null}`)
+	data2 := &gojsontest.UnmarshalOptions1{}
+	err = data2.UnmarshalJSON(b2)
+	require.Nil(t, err)
+
+	b3 := []byte(`{"t_string":"s1","array_string":["a1"]}`)
+	data3 := &gojsontest.UnmarshalOptions1{}
+	err = data3.UnmarshalJSON(b3)
+	require.NotNil(t, err)
+	require.Equal(t, `json: missing required field "map_string"`, err.Error())
+
+	// The required check can be disabled at runtime.
+	data4 := &gojsontest.UnmarshalOptions1{}
+	err = data4.UnmarshalJSONWithOptions(b3, jsondecoder.Options{RequireFields: false})
+	require.Nil(t, err)
+	require.Equal(t, "s1", data4.TString)
+
+	// The required check in nested message.
+	b5 := []byte(`{"t_string":"s1","array_string":[],"map_string":{},"TConfig":{"port":80}}`)
+	data5 := &gojsontest.UnmarshalOptions1{}
+	err = data5.UnmarshalJSON(b5)
+	require.NotNil(t, err)
+	require.Equal(t, `json: missing required field "ip"`, err.Error())
+
+	data6 := &gojsontest.UnmarshalOptions1{}
+	err = data6.UnmarshalJSONWithOptions(b5, jsondecoder.Options{})
+	require.Nil(t, err)
+	require.Equal(t, int32(80), data6.TConfig.Port)
+}
+
+func Test_GoJSON_UnmarshalOptions1_DisallowUnknownFields(t *testing.T) {
+	b1 := []byte(`{"t_string":"s1","t_unknown":"u1","TConfig":{"ip":"127.0.0.1","p1":1},"Oneof1":{"one1_unknown":1}}`)
+
+	data1 := &gojsontest.UnmarshalOptions1{}
+	err := data1.UnmarshalJSONWithOptions(b1, jsondecoder.Options{})
+	require.Nil(t, err)
+	require.Equal(t, "s1", data1.TString)
+	require.Equal(t, "127.0.0.1", data1.TConfig.Ip)
+
+	data2 := &gojsontest.UnmarshalOptions1{}
+	err = data2.UnmarshalJSONWithOptions(b1, jsondecoder.Options{DisallowUnknownFields: true})
+	require.NotNil(t, err)
+	require.Equal(t, `json: unknown field "t_unknown"`, err.Error())
+
+	// The options is passed down to the nested message.
+	b3 := []byte(`{"t_string":"s1","TConfig":{"ip":"127.0.0.1","p1":1}}`)
+	data3 := &gojsontest.UnmarshalOptions1{}
+	err = data3.UnmarshalJSONWithOptions(b3, jsondecoder.Options{DisallowUnknownFields: true})
+	require.NotNil(t, err)
+	require.Equal(t, `json: unknown field "p1"`, err.Error())
+
+	b4 := []byte(`{"t_string":"s1","Oneof1":{"one1_unknown":1}}`)
+	data4 := &gojsontest.UnmarshalOptions1{}
+	err = data4.UnmarshalJSONWithOptions(b4, jsondecoder.Options{DisallowUnknownFields: true})
+	require.NotNil(t, err)
+	require.Equal(t, `json: unknown oneof field "one1_unknown"`, err.Error())
+}
+
+func Test_GoJSON_UnmarshalOptions1_CaseInsensitiveKeys(t *testing.T) {
+	b1 := []byte(`{"T_STRING":"s1","t_Int32":1,"tconfig":{"IP":"127.0.0.1"},"oneof1":{"ONE1_INT32":2}}`)
+
+	data1 := &gojsontest.UnmarshalOptions1{}
+	err := data1.UnmarshalJSONWithOptions(b1, jsondecoder.Options{})
+	require.Nil(t, err)
+	require.Equal(t, "", data1.TString)
+	require.Nil(t, data1.TConfig)
+
+	data2 := &gojsontest.UnmarshalOptions1{}
+	err = data2.UnmarshalJSONWithOptions(b1, jsondecoder.Options{CaseInsensitiveKeys: true})
+	require.Nil(t, err)
+	require.Equal(t, "s1", data2.TString)
+	require.Equal(t, int32(1), data2.TInt32)
+	require.Equal(t, "127.0.0.1", data2.TConfig.Ip)
+	require.Equal(t, &gojsontest.UnmarshalOptions1_One1Int32{One1Int32: 2}, data2.Oneof1)
+
+	// The exact match is preferred.
+	b3 := []byte(`{"T_STRING":"s1","t_string":"s2"}`)
+	data3 := &gojsontest.UnmarshalOptions1{}
+	err = data3.UnmarshalJSONWithOptions(b3, jsondecoder.Options{CaseInsensitiveKeys: true})
+	require.Nil(t, err)
+	require.Equal(t, "s2", data3.TString)
+}
+
+func Test_GoJSON_UnmarshalOptions1_Limits(t *testing.T) {
+	b1 := []byte(`{"t_string":"s1","t_child":{"t_string":"s2","t_child":{"t_string":"s3"}}}`)
+
+	data1 := &gojsontest.UnmarshalOptions1{}
+	err := data1.UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxBytes: len(b1)})
+	require.Nil(t, err)
+	require.Equal(t, "s3", data1.TChild.TChild.TString)
+
+	data2 := &gojsontest.UnmarshalOptions1{}
+	err = data2.UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxBytes: len(b1) - 1})
+	require.NotNil(t, err)
+
+	data3 := &gojsontest.UnmarshalOptions1{}
+	err = data3.UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxDepth: 3})
+	require.Nil(t, err)
+	require.Equal(t, "s3", data3.TChild.TChild.TString)
+
+	// The depth is tracked through nested messages.
+	data4 := &gojsontest.UnmarshalOptions1{}
+	err = data4.UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxDepth: 2})
+	require.NotNil(t, err)
+
+	var limitErr *jsondecoder.LimitError
+	for _, c := range []struct {
+		opts  jsondecoder.Options
+		limit string
+	}{
+		{jsondecoder.Options{MaxBytes: len(b1) - 1}, "MaxBytes"},
+		{jsondecoder.Options{MaxDepth: 2}, "MaxDepth"},
+		{jsondecoder.Options{MaxStringLen: 7}, "MaxStringLen"},
+		{jsondecoder.Options{MaxMapEntries: 1}, "MaxMapEntries"},
+	} {
+		err = (&gojsontest.UnmarshalOptions1{}).UnmarshalJSONWithOptions(b1, c.opts)
+		require.True(t, errors.As(err, &limitErr), "%+v: %v", c.opts, err)
+		require.Equal(t, c.limit, limitErr.Limit)
+	}
+	err = (&gojsontest.UnmarshalOptions1{}).UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxStringLen: 8, MaxMapEntries: 2})
+	require.Nil(t, err)
+
+	// The limit MaxArrayLen.
+	b2 := []byte(`{"array_int32":[1,2,3]}`)
+	err = (&gojsontest.UnmarshalMode1{}).UnmarshalJSONWithOptions(b2, jsondecoder.Options{MaxArrayLen: 3})
+	require.Nil(t, err)
+	err = (&gojsontest.UnmarshalMode1{}).UnmarshalJSONWithOptions(b2, jsondecoder.Options{MaxArrayLen: 2})
+	require.Equal(t, "json: exceeded MaxArrayLen 2 at offset 20", err.Error())
+}
+
+func Test_GoJSON_UnmarshalOptions1_Deep(t *testing.T) {
+	// The nested messages are decoded in place with the limits of the whole document.
+	depth := 5000
+	b1 := []byte(strings.Repeat(`{"t_child":`, depth) + `{"t_string":"s1","TConfig":{"ip":"127.0.0.1"}}` + strings.Repeat("}", depth))
+
+	data1 := &gojsontest.UnmarshalOptions1{}
+	err := data1.UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxDepth: depth + 2})
+	require.Nil(t, err)
+	child := data1
+	for i := 0; i < depth; i++ {
+		child = child.TChild
+	}
+	require.Equal(t, "s1", child.TString)
+	require.Equal(t, "127.0.0.1", child.TConfig.Ip)
+	require.Nil(t, child.TChild)
+
+	err = (&gojsontest.UnmarshalOptions1{}).UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxDepth: depth + 1})
+	var limitErr *jsondecoder.LimitError
+	require.True(t, errors.As(err, &limitErr), "%v", err)
+	require.Equal(t, "MaxDepth", limitErr.Limit)
+
+	// The paths are collected through the nested messages.
+	present := &jsondecoder.FieldPaths{}
+	b2 := []byte(`{"t_child":{"t_child":{"t_string":"s1"},"t_int32":1},"t_string":"s2"}`)
+	err = (&gojsontest.UnmarshalOptions1{}).UnmarshalJSONWithOptions(b2, jsondecoder.Options{Present: present})
+	require.Nil(t, err)
+	require.Equal(t, []string{"t_child.t_child.t_string", "t_child.t_int32", "t_string"}, present.Leaves())
+}
+
+func Test_GoJSON_UnmarshalMode1_Replace(t *testing.T) {
+	b := []byte(`{"array_int32":[1,2],"array_config":[{"ip":"a1"}],"map_int32":{"k1":1},"map_config":{"k1":{"ip":"m1"}}}`)
+
+	aConfig1 := &gojsontest.UnmarshalMode1_Config{Ip: "10.1", Port: 1001}
+	mConfig1 := &gojsontest.UnmarshalMode1_Config{Ip: "10.2", Port: 1002}
+	arrayInt32 := []int32{7, 8, 9}
+	data1 := &gojsontest.UnmarshalMode1{
+		ArrayInt32:  arrayInt32,
+		ArrayConfig: []*gojsontest.UnmarshalMode1_Config{aConfig1},
+		MapInt32:    map[string]int32{"k1": 10, "k2": 20},
+		MapConfig:   map[string]*gojsontest.UnmarshalMode1_Config{"k1": mConfig1, "k2": {}},
+	}
+
+	err := data1.UnmarshalJSON(b)
+	require.Nil(t, err)
+	require.Equal(t, []int32{1, 2}, data1.ArrayInt32)
+	require.Equal(t, (*reflect.SliceHeader)(unsafe.Pointer(&data1.ArrayInt32)).Data, (*reflect.SliceHeader)(unsafe.Pointer(&arrayInt32)).Data)
+	require.Equal(t, []*gojsontest.UnmarshalMode1_Config{{Ip: "a1"}}, data1.ArrayConfig)
+	require.NotEqual(t, unsafe.Pointer(data1.ArrayConfig[0]), unsafe.Pointer(aConfig1))
+	require.Equal(t, map[string]int32{"k1": 1}, data1.MapInt32)
+	require.Equal(t, map[string]*gojsontest.UnmarshalMode1_Config{"k1": {Ip: "m1"}}, data1.MapConfig)
+	require.Equal(t, &gojsontest.UnmarshalMode1_Config{Ip: "10.1", Port: 1001}, aConfig1)
+	require.Equal(t, &gojsontest.UnmarshalMode1_Config{Ip: "10.2", Port: 1002}, mConfig1)
+
+	// Reuse the same object.
+	err = data1.UnmarshalJSON([]byte(`{"array_int32":[3],"map_int32":{"k2":2}}`))
+	require.Nil(t, err)
+	require.Equal(t, []int32{3}, data1.ArrayInt32)
+	require.Equal(t, map[string]int32{"k2": 2}, data1.MapInt32)
+	require.Equal(t, []*gojsontest.UnmarshalMode1_Config{{Ip: "a1"}}, data1.ArrayConfig)
+}
+
+func Test_GoJSON_UnmarshalMode2_MergeAppend(t *testing.T) {
+	b := []byte(`{"array_int32":[1,2],"array_config":[{"ip":"a1"}],"map_int32":{"k1":1},"map_config":{"k1":{"ip":"m1"}}}`)
+
+	aConfig1 := &gojsontest.UnmarshalMode2_Config{Ip: "10.1", Port: 1001}
+	mConfig1 := &gojsontest.UnmarshalMode2_Config{Ip: "10.2", Port: 1002}
+	data1 := &gojsontest.UnmarshalMode2{
+		ArrayInt32:  []int32{7, 8, 9},
+		ArrayConfig: []*gojsontest.UnmarshalMode2_Config{aConfig1},
+		MapInt32:    map[string]int32{"k1": 10, "k2": 20},
+		MapConfig:   map[string]*gojsontest.UnmarshalMode2_Config{"k1": mConfig1, "k2": {}},
+	}
+
+	err := data1.UnmarshalJSON(b)
+	require.Nil(t, err)
+	require.Equal(t, []int32{7, 8, 9, 1, 2}, data1.ArrayInt32)
+	require.Equal(t, []*gojsontest.UnmarshalMode2_Config{{Ip: "10.1", Port: 1001}, {Ip: "a1"}}, data1.ArrayConfig)
+	require.Equal(t, unsafe.Pointer(data1.ArrayConfig[0]), unsafe.Pointer(aConfig1))
+	require.Equal(t, map[string]int32{"k1": 1, "k2": 20}, data1.MapInt32)
+	require.Equal(t, map[string]*gojsontest.UnmarshalMode2_Config{"k1": {Ip: "m1"}, "k2": {}}, data1.MapConfig)
+	require.Equal(t, &gojsontest.UnmarshalMode2_Config{Ip: "10.2", Port: 1002}, mConfig1)
+}
+
+func Test_GoJSON_UnmarshalMode3_MergeOverwrite(t *testing.T) {
+	b := []byte(`{"array_int32":[1,2],"array_config":[{"ip":"a1"}],"map_int32":{"k1":1},"map_config":{"k1":{"ip":"m1"}}}`)
+
+	aConfig1 := &gojsontest.UnmarshalMode3_Config{Ip: "10.1", Port: 1001}
+	aConfig2 := &gojsontest.UnmarshalMode3_Config{Ip: "10.3", Port: 1003}
+	mConfig1 := &gojsontest.UnmarshalMode3_Config{Ip: "10.2", Port: 1002}
+	data1 := &gojsontest.UnmarshalMode3{
+		ArrayInt32:  []int32{7, 8, 9},
+		ArrayConfig: []*gojsontest.UnmarshalMode3_Config{aConfig1, aConfig2},
+		MapInt32:    map[string]int32{"k1": 10, "k2": 20},
+		MapConfig:   map[string]*gojsontest.UnmarshalMode3_Config{"k1": mConfig1, "k2": {}},
+	}
+
+	err := data1.UnmarshalJSON(b)
+	require.Nil(t, err)
+	require.Equal(t, []int32{1, 2}, data1.ArrayInt32)
+	require.Equal(t, []*gojsontest.UnmarshalMode3_Config{{Ip: "a1", Port: 1001}}, data1.ArrayConfig)
+	require.Equal(t, unsafe.Pointer(data1.ArrayConfig[0]), unsafe.Pointer(aConfig1))
+	require.Equal(t, map[string]int32{"k1": 1, "k2": 20}, data1.MapInt32)
+	require.Equal(t, map[string]*gojsontest.UnmarshalMode3_Config{"k1": {Ip: "m1", Port: 1002}, "k2": {}}, data1.MapConfig)
+	require.Equal(t, unsafe.Pointer(data1.MapConfig["k1"]), unsafe.Pointer(mConfig1))
+}
+
+func Test_GoJSON_NameStyleSnakeCase(t *testing.T) {
+	data1 := &gojsontest.NameStyleSnakeCase{
+		NameStyle1: 1,
+		TString:    "s1",
+		TInt32:     3,
+		OneofStyle: &gojsontest.NameStyleSnakeCase_One1String{One1String: "o1"},
+	}
+
+	expected := []byte(`{"name_style1":1,"t_string":"s1","custom_int32":3,"oneof_style":{"one1_string":"o1"}}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(b1))
+
+	data2 := &gojsontest.NameStyleSnakeCase{}
+	err = data2.UnmarshalJSON(expected)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+}
+
+func Test_GoJSON_NameStyleKebabCase(t *testing.T) {
+	data1 := &gojsontest.NameStyleKebabCase{
+		NameStyle1: 1,
+		TString:    "s1",
+		TInt32:     3,
+		OneofStyle: &gojsontest.NameStyleKebabCase_One1String{One1String: "o1"},
+	}
+
+	expected := []byte(`{"name-style1":1,"t-string":"s1","custom_int32":3,"oneof-style":{"one1-string":"o1"}}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(b1))
+
+	data2 := &gojsontest.NameStyleKebabCase{}
+	err = data2.UnmarshalJSON(expected)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+}
+
+func Test_GoJSON_NameStyleLowerCamelCase(t *testing.T) {
+	data1 := &gojsontest.NameStyleLowerCamelCase{
+		NameStyle1: 1,
+		TString:    "s1",
+		TInt32:     3,
+		OneofStyle: &gojsontest.NameStyleLowerCamelCase_One1String{One1String: "o1"},
+	}
+
+	expected := []byte(`{"nameStyle1":1,"tString":"s1","custom_int32":3,"oneofStyle":{"one1String":"o1"}}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(b1))
+
+	data2 := &gojsontest.NameStyleLowerCamelCase{}
+	err = data2.UnmarshalJSON(expected)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+}
+
+func Test_GoJSON_NameStyleScreamingSnakeCase(t *testing.T) {
+	data1 := &gojsontest.NameStyleScreamingSnakeCase{
+		NameStyle1: 1,
+		TString:    "s1",
+		TInt32:     3,
+		OneofStyle: &gojsontest.NameStyleScreamingSnakeCase_One1String{One1String: "o1"},
+	}
+
+	expected := []byte(`{"NAME_STYLE1":1,"T_STRING":"s1","custom_int32":3,"ONEOF_STYLE":{"ONE1_STRING":"o1"}}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(b1))
+
+	data2 := &gojsontest.NameStyleScreamingSnakeCase{}
+	err = data2.UnmarshalJSON(expected)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+}
+
+func Test_GoJSON_KeyTemplate(t *testing.T) {
+	data1 := &gojsontest.KeyTemplate1{
+		TInt32:  1,
+		TString: "s1",
+		OneofT:  &gojsontest.KeyTemplate1_One1Int32{One1Int32: 2},
+	}
+
+	expected1 := []byte(`{"x_t_int32":1,"ts":"s1","x_OneofT":{"x_one1_int32":2}}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected1), string(b1))
+
+	data2 := &gojsontest.KeyTemplate1{}
+	err = data2.UnmarshalJSON(expected1)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+
+	data3 := &gojsontest.KeyTemplate2{TInt32: 1, TString: "s1"}
+
+	expected3 := []byte(`{"tInt32V1":1,"tStringV1":"s1"}`)
+
+	b3, err := data3.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected3), string(b3))
+
+	data4 := &gojsontest.KeyTemplate2{}
+	err = data4.UnmarshalJSON(expected3)
+	require.Nil(t, err)
+	require.Equal(t, data3, data4)
+
+	// The keys that need to be escaped.
+	data5 := &gojsontest.KeyTemplate3{
+		TInt32: 1,
+		TChild: &gojsontest.KeyTemplate3{TInt32: 2},
+		OneofT: &gojsontest.KeyTemplate3_One1Int32{One1Int32: 3},
+	}
+
+	expected5 := []byte(`{"\"t_int32\\%v":1,"\"t_child\\%v":{"\"t_int32\\%v":2,"\"t_child\\%v":null,"\"OneofT\\%v":null},"\"OneofT\\%v":{"\"one1_int32\\%v":3}}`)
+
+	b5, err := data5.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected5), string(b5))
+
+	data6 := &gojsontest.KeyTemplate3{}
+	mask6, err := data6.UnmarshalJSONFields(expected5)
+	require.Nil(t, err)
+	require.Equal(t, data5, data6)
+	require.Equal(t, []string{`"t_int32\%v`, `"t_child\%v."t_int32\%v`, `"t_child\%v."t_child\%v`, `"one1_int32\%v`}, mask6.Paths)
+
+	data7 := &gojsontest.KeyTemplate3{}
+	err = data7.UnmarshalJSONWithOptions([]byte(`{"\"T_INT32\\%V":1}`), jsondecoder.Options{CaseInsensitiveKeys: true})
+	require.Nil(t, err)
+	require.Equal(t, int32(1), data7.TInt32)
+
+	err = (&gojsontest.KeyTemplate3{}).UnmarshalJSON([]byte(`{}`))
+	require.NotNil(t, err)
+	require.Equal(t, `json: missing required field "\"t_int32\\%v"`, err.Error())
+}
+
+func Test_GoJSON_EnumValueStyle1(t *testing.T) {
+	status := gojsontest.EnumValueStyle1_TASK_STATUS_STOPPED
+	data1 := &gojsontest.EnumValueStyle1{
+		TStatus:     gojsontest.EnumValueStyle1_TASK_STATUS_RUNNING,
+		TStatusOpt:  &status,
+		AStatus:     []gojsontest.EnumValueStyle1_TaskStatus{gojsontest.EnumValueStyle1_TASK_STATUS_UNSPECIFIED, gojsontest.EnumValueStyle1_TASK_STATUS_RUNNING},
+		MStatus:     map[string]gojsontest.EnumValueStyle1_TaskStatus{"k1": gojsontest.EnumValueStyle1_TASK_STATUS_STOPPED},
+		OneofStatus: &gojsontest.EnumValueStyle1_One1Status{One1Status: gojsontest.EnumValueStyle1_TASK_STATUS_RUNNING},
+	}
+
+	expected := []byte(`{"t_status":"running","t_status_opt":"stopped","a_status":["unspecified","running"],"m_status":{"k1":"stopped"},"OneofStatus":{"one1_status":"running"}}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(b1))
+
+	data2 := &gojsontest.EnumValueStyle1{}
+	err = data2.UnmarshalJSON(expected)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+
+	// The name of enum value in protobuf is not accepted.
+	data3 := &gojsontest.EnumValueStyle1{}
+	err = data3.UnmarshalJSON([]byte(`{"t_status":"TASK_STATUS_RUNNING"}`))
+	require.NotNil(t, err)
+	require.Equal(t, `json: unknown enum value "TASK_STATUS_RUNNING" in field t_status`, err.Error())
+
+	// The unknown enum number is encoded as number string.
+	data4 := &gojsontest.EnumValueStyle1{TStatus: 10, TStatusOpt: &status}
+	b4, err := data4.MarshalJSON()
+	require.Nil(t, err)
+	require.Contains(t, string(b4), `"t_status":"10"`)
+}
+
+func Test_GoJSON_EnumValueStyle2(t *testing.T) {
+	data1 := &gojsontest.EnumValueStyle2{
+		TPhase:  gojsontest.EnumValueStyle2_PHASE_TWO_DONE,
+		TColor:  gojsontest.EnumValueStyle2_COLOR_LIGHT_BLUE,
+		TStatus: gojsontest.EnumValueStyle1_TASK_STATUS_STOPPED,
+		APhase:  []gojsontest.EnumValueStyle2_Phase{gojsontest.EnumValueStyle2_PHASE_ONE},
+	}
+
+	expected := []byte(`{"t_phase":"phaseTwoDone","t_color":"color-light-blue","t_status":"stopped","a_phase":["phaseOne"]}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(b1))
+
+	data2 := &gojsontest.EnumValueStyle2{}
+	err = data2.UnmarshalJSON(expected)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+}
+
+func Test_GoJSON_EnumValueAlias1_Marshal(t *testing.T) {
+	data1 := &gojsontest.EnumValueAlias1{
+		TLevel: gojsontest.EnumValueAlias1_LEVEL_LOW,
+		TMode:  gojsontest.EnumValueAlias1_MODE_WRITE,
+		TKind:  gojsontest.EnumValueAlias1_KIND_B,
+		ALevel: []gojsontest.EnumValueAlias1_Level{gojsontest.EnumValueAlias1_LEVEL_UNSPECIFIED, gojsontest.EnumValueAlias1_LEVEL_HIGH},
+		MMode:  map[string]gojsontest.EnumValueAlias1_Mode{"k1": gojsontest.EnumValueAlias1_MODE_READ},
+	}
+
+	expected := []byte(`{"t_level":"low","t_mode":1,"t_kind":"b","a_level":["LEVEL_UNSPECIFIED","high"],"m_mode":{"k1":0}}`)
+
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(expected), string(b1))
+
+	data2 := &gojsontest.EnumValueAlias1{}
+	err = data2.UnmarshalJSON(expected)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+
+	// The unknown enum number is encoded as number string.
+	data3 := &gojsontest.EnumValueAlias1{TLevel: 10}
+	b3, err := data3.MarshalJSON()
+	require.Nil(t, err)
+	require.Contains(t, string(b3), `"t_level":"10"`)
+}
+
+func Test_GoJSON_EnumValueAlias2(t *testing.T) {
+	// The names that need to be escaped.
+	data1 := &gojsontest.EnumValueAlias2{
+		TSign: gojsontest.EnumValueAlias2_SIGN_TAB,
+		ASign: []gojsontest.EnumValueAlias2_Sign{gojsontest.EnumValueAlias2_SIGN_QUOTE},
+	}
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, `{"t_sign":"t\tb","a_sign":["\"q\""]}`, string(b1))
+
+	data2 := &gojsontest.EnumValueAlias2{}
+	require.Nil(t, data2.UnmarshalJSON(b1))
+	require.Equal(t, data1, data2)
+
+	data3 := &gojsontest.EnumValueAlias2{}
+	require.Nil(t, data3.UnmarshalJSON([]byte(`{"t_sign":"A\\B","a_sign":["T\tB",1]}`)))
+	require.Equal(t, gojsontest.EnumValueAlias2_SIGN_QUOTE, data3.TSign)
+	require.Equal(t, []gojsontest.EnumValueAlias2_Sign{1, 1}, data3.ASign)
+}
+
+func Test_GoJSON_EnumValueAlias1_Unmarshal(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Data     []byte
+		Expected *gojsontest.EnumValueAlias1
+	}{
+		{"alias 1", []byte(`{"t_level":"l"}`), &gojsontest.EnumValueAlias1{TLevel: gojsontest.EnumValueAlias1_LEVEL_LOW}},
+		{"alias 2", []byte(`{"t_level":"minor"}`), &gojsontest.EnumValueAlias1{TLevel: gojsontest.EnumValueAlias1_LEVEL_LOW}},
+		{"alias 3", []byte(`{"a_level":["h","high","low"]}`), &gojsontest.EnumValueAlias1{ALevel: []gojsontest.EnumValueAlias1_Level{2, 2, 1}}},
+		{"unknown to zero 1", []byte(`{"t_level":"xxx"}`), &gojsontest.EnumValueAlias1{TLevel: gojsontest.EnumValueAlias1_LEVEL_UNSPECIFIED}},
+		{"unknown to zero 2", []byte(`{"t_level":"LOW"}`), &gojsontest.EnumValueAlias1{TLevel: gojsontest.EnumValueAlias1_LEVEL_UNSPECIFIED}},
+		{"lenient number", []byte(`{"t_mode":1}`), &gojsontest.EnumValueAlias1{TMode: gojsontest.EnumValueAlias1_MODE_WRITE}},
+		{"lenient name", []byte(`{"t_mode":"MODE_WRITE"}`), &gojsontest.EnumValueAlias1{TMode: gojsontest.EnumValueAlias1_MODE_WRITE}},
+		{"lenient case", []byte(`{"t_mode":"Mode_Write"}`), &gojsontest.EnumValueAlias1{TMode: gojsontest.EnumValueAlias1_MODE_WRITE}},
+		{"lenient alias", []byte(`{"t_mode":"R"}`), &gojsontest.EnumValueAlias1{TMode: gojsontest.EnumValueAlias1_MODE_READ}},
+		{"keep number 1", []byte(`{"t_mode":10}`), &gojsontest.EnumValueAlias1{TMode: 10}},
+		{"keep number 2", []byte(`{"t_mode":"10"}`), &gojsontest.EnumValueAlias1{TMode: 10}},
+		{"keep number 3", []byte(`{"m_mode":{"k1":"r","k2":3}}`), &gojsontest.EnumValueAlias1{MMode: map[string]gojsontest.EnumValueAlias1_Mode{"k1": 0, "k2": 3}}},
+		{"lenient string mode 1", []byte(`{"t_kind":1}`), &gojsontest.EnumValueAlias1{TKind: gojsontest.EnumValueAlias1_KIND_B}},
+		{"lenient string mode 2", []byte(`{"t_kind":"B"}`), &gojsontest.EnumValueAlias1{TKind: gojsontest.EnumValueAlias1_KIND_B}},
+	}
+
+	for _, c := range cases {
+		data := &gojsontest.EnumValueAlias1{}
+		err := data.UnmarshalJSON(c.Data)
+		require.Nil(t, err, c.Name)
+		require.Equal(t, c.Expected, data, c.Name)
+	}
+
+	errCases := []struct {
+		Name string
+		Data []byte
+		Err  string
+	}{
+		{"number in string mode", []byte(`{"t_level":1}`), `json: cannot unmarshal 1 into field t_level of type EnumValueAlias1_Level`},
+		{"keep number unknown name", []byte(`{"t_mode":"xxx"}`), `json: unknown enum value "xxx" in field t_mode`},
+		{"unknown number", []byte(`{"t_kind":3}`), `json: unknown enum value 3 in field t_kind`},
+		{"unknown name", []byte(`{"t_kind":"KIND_A"}`), `json: unknown enum value "KIND_A" in field t_kind`},
+	}
+
+	for _, c := range errCases {
+		data := &gojsontest.EnumValueAlias1{}
+		err := data.UnmarshalJSON(c.Data)
+		require.NotNil(t, err, c.Name)
+		require.Equal(t, c.Err, err.Error(), c.Name)
+	}
+}
+
+func Test_GoJSON_MarshalIndent1(t *testing.T) {
+	data := &gojsontest.MarshalIndent1{
+		TString:  "<a&b>",
+		TMessage: &gojsontest.MarshalIndent2{TString: "<c>"},
+		AInt32:   []int32{1, 2},
+	}
+
+	// Use the default options of message.
+	b1, err := data.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, `{
+  "t_string": "<a&b>",
+  "t_message": {
+    "t_string": "<c>"
+  },
+  "a_int32": [
+    1,
+    2
+  ]
+}`, string(b1))
+
+	b2, err := data.MarshalJSONIndent(">", "\t")
+	require.Nil(t, err)
+	require.Equal(t, "{\n>\t\"t_string\": \"<a&b>\",\n>\t\"t_message\": {\n>\t\t\"t_string\": \"<c>\"\n>\t},\n>\t\"a_int32\": [\n>\t\t1,\n>\t\t2\n>\t]\n>}", string(b2))
+
+	// The options are passed down to the nested message.
+	b3, err := data.MarshalJSONWithOptions(jsonencoder.Options{EscapeHTML: true})
+	require.Nil(t, err)
+	require.Equal(t, `{"t_string":"\u003ca\u0026b\u003e","t_message":{"t_string":"\u003cc\u003e"},"a_int32":[1,2]}`, string(b3))
+
+	// The default options of nested message.
+	b4, err := data.TMessage.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, `{"t_string":"\u003cc\u003e"}`, string(b4))
+
+	b5, err := data.TMessage.MarshalJSONWithOptions(jsonencoder.Options{})
+	require.Nil(t, err)
+	require.Equal(t, `{"t_string":"<c>"}`, string(b5))
+
+	// Compatible with encoding/json, it compacts and escapes the output.
+	b6, err := json.Marshal(data)
+	require.Nil(t, err)
+	require.Equal(t, string(b3), string(b6))
+
+	data2 := &gojsontest.MarshalIndent1{}
+	require.Nil(t, data2.UnmarshalJSON(b1))
+	require.Equal(t, data.TString, data2.TString)
+	require.Equal(t, data.TMessage.TString, data2.TMessage.TString)
+}
+
+func Test_GoJSON_FieldCodec1(t *testing.T) {
+	centsOpt := int64(-5)
+	data1 := &gojsontest.FieldCodec1{
+		TCents:    1234,
+		TCentsOpt: &centsOpt,
+		TTime:     1700000000,
+		TTags:     "a,b,c",
+		ACents:    []int64{100, 1},
+		MCents:    map[string]int64{"k1": 250},
+		OnePrice:  &gojsontest.FieldCodec1_OCents{OCents: 99},
+	}
+
+	b, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"t_cents":"12.34","t_cents_opt":"-0.05","t_time":"2023-11-14T22:13:20Z","t_tags":["a","b","c"],`+
+			`"a_cents":["1.00","0.01"],"m_cents":{"k1":"2.50"},"one_price":{"o_cents":"0.99"}}`,
+		string(b),
+	)
+
+	data2 := &gojsontest.FieldCodec1{}
+	err = data2.UnmarshalJSON(b)
+	require.Nil(t, err)
+	require.Equal(t, data1, data2)
+
+	// The codec receives the null.
+	data3 := &gojsontest.FieldCodec1{}
+	err = data3.UnmarshalJSON([]byte(`{"t_time":null}`))
+	require.Nil(t, err)
+	require.Equal(t, int64(0), data3.TTime)
+
+	// The error of codec.
+	data4 := &gojsontest.FieldCodec1{}
+	err = data4.UnmarshalJSON([]byte(`{"a_cents":["1.5"]}`))
+	require.NotNil(t, err)
+	require.Equal(t, `json: cannot unmarshal "1.5" as array element into field a_cents of type []int64: cents must have two decimal places`, err.Error())
+
+	err = data4.UnmarshalJSON([]byte(`{"t_cents":12}`))
+	require.NotNil(t, err)
+	require.Equal(t, `json: cannot unmarshal 12 into field t_cents of type int64: cents must be a string`, err.Error())
+}
+
+func Test_GoJSON_EnumMethods(t *testing.T) {
+	type Model struct {
+		Color  gojsontest.Color            `json:"color"`
+		Colors map[string]gojsontest.Color `json:"colors"`
+		Kind   gojsontest.EnumValueAlias1_Kind
+	}
+
+	data1 := &Model{
+		Color:  gojsontest.Color_COLOR_RED,
+		Colors: map[string]gojsontest.Color{"k1": gojsontest.Color_COLOR_GREEN},
+		Kind:   gojsontest.EnumValueAlias1_KIND_B,
+	}
+	b, err := json.Marshal(data1)
+	require.Nil(t, err)
+	require.Equal(t, `{"color":"red","colors":{"k1":"green"},"Kind":"b"}`, string(b))
+
+	data2 := &Model{}
+	require.Nil(t, json.Unmarshal(b, data2))
+	require.Equal(t, data1, data2)
+
+	// The lenient decoding accepts number and case-insensitive name.
+	data3 := &Model{}
+	require.Nil(t, json.Unmarshal([]byte(`{"color":null,"Kind":1}`), data3))
+	require.Equal(t, gojsontest.Color_COLOR_UNSPECIFIED, data3.Color)
+	require.Equal(t, gojsontest.EnumValueAlias1_KIND_B, data3.Kind)
+	require.Nil(t, json.Unmarshal([]byte(`{"Kind":"B"}`), data3))
+	require.Equal(t, gojsontest.EnumValueAlias1_KIND_B, data3.Kind)
+
+	err = json.Unmarshal([]byte(`{"color":"blue"}`), data3)
+	require.NotNil(t, err)
+	require.Equal(t, `json: unknown enum value "blue" of type Color`, err.Error())
+
+	err = json.Unmarshal([]byte(`{"color":1}`), data3)
+	require.NotNil(t, err)
+	require.Equal(t, `json: cannot unmarshal 1 into value of type Color`, err.Error())
+
+	// Text.
+	text, err := gojsontest.Color_COLOR_GREEN.MarshalText()
+	require.Nil(t, err)
+	require.Equal(t, "green", string(text))
+	text, err = gojsontest.Color(9).MarshalText()
+	require.Nil(t, err)
+	require.Equal(t, "9", string(text))
+
+	var c gojsontest.Color
+	require.Nil(t, c.UnmarshalText([]byte("red")))
+	require.Equal(t, gojsontest.Color_COLOR_RED, c)
+	require.NotNil(t, c.UnmarshalText([]byte("RED")))
+}
+
+func Test_GoJSON_EnumMethodsProto2(t *testing.T) {
+	text, err := gojsontest.Proto2Color_PROTO2_COLOR_RED.MarshalText()
+	require.Nil(t, err)
+	require.Equal(t, "red", string(text))
+
+	b, err := gojsontest.Proto2Color_PROTO2_COLOR_RED.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, `"red"`, string(b))
+
+	var c gojsontest.Proto2Color
+	require.Nil(t, c.UnmarshalText([]byte("red")))
+	require.Equal(t, gojsontest.Proto2Color_PROTO2_COLOR_RED, c)
+	err = c.UnmarshalText([]byte("blue"))
+	require.NotNil(t, err)
+	require.Equal(t, `json: unknown enum value "blue" of type Proto2Color`, err.Error())
+
+	// The UnmarshalJSON is generated by protoc-gen-go that accepts the name in proto.
+	require.Nil(t, c.UnmarshalJSON([]byte(`"PROTO2_COLOR_UNSPECIFIED"`)))
+	require.Equal(t, gojsontest.Proto2Color_PROTO2_COLOR_UNSPECIFIED, c)
+
+	// The field of message uses the options of enum.
+	data := &gojsontest.Proto2Message1{TColor: gojsontest.Proto2Color_PROTO2_COLOR_RED.Enum()}
+	b, err = data.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, `{"t_color":"red"}`, string(b))
+	data2 := &gojsontest.Proto2Message1{}
+	require.Nil(t, data2.UnmarshalJSON(b))
+	require.Equal(t, gojsontest.Proto2Color_PROTO2_COLOR_RED, data2.GetTColor())
+}
+
+func Test_GoJSON_MapKeys1(t *testing.T) {
+	data1 := &gojsontest.MapKeys1{
+		MInt32:    map[int32]string{math.MinInt32: "a"},
+		MInt64:    map[int64]string{math.MinInt64: "a"},
+		MUint32:   map[uint32]string{math.MaxUint32: "a"},
+		MUint64:   map[uint64]string{math.MaxUint64: "a"},
+		MSint32:   map[int32]string{-1: "a"},
+		MSint64:   map[int64]string{math.MaxInt64: "a"},
+		MSfixed32: map[int32]string{math.MaxInt32: "a"},
+		MSfixed64: map[int64]string{-1: "a"},
+		MFixed32:  map[uint32]string{1: "a"},
+		MFixed64:  map[uint64]string{1 << 63: "a"},
+		MBool:     map[bool]string{true: "a"},
+		MString:   map[string]string{"": "a"},
+	}
+
+	b, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"m_int32":{"-2147483648":"a"},"m_int64":{"-9223372036854775808":"a"},"m_uint32":{"4294967295":"a"},`+
+			`"m_uint64":{"18446744073709551615":"a"},"m_sint32":{"-1":"a"},"m_sint64":{"9223372036854775807":"a"},`+
+			`"m_sfixed32":{"2147483647":"a"},"m_sfixed64":{"-1":"a"},"m_fixed32":{"1":"a"},`+
+			`"m_fixed64":{"9223372036854775808":"a"},"m_bool":{"true":"a"},"m_string":{"":"a"}}`,
+		string(b),
+	)
+
+	// Compatible with protojson.
+	b2, err := pMarshal.Marshal(data1)
+	require.Nil(t, err)
+	data2 := &gojsontest.MapKeys1{}
+	require.Nil(t, data2.UnmarshalJSON(b2))
+	require.Equal(t, data1.String(), data2.String())
+
+	data3 := &gojsontest.MapKeys1{}
+	require.Nil(t, data3.UnmarshalJSON(b))
+	require.Equal(t, data1.String(), data3.String())
+
+	// The strict range checks of key.
+	for _, c := range []struct{ input, err string }{
+		{`{"m_int32":{"2147483648":"a"}}`, `json: cannot unmarshal 2147483648 as map key into field m_int32 of type map[int32]string`},
+		{`{"m_int32":{"-2147483649":"a"}}`, `json: cannot unmarshal -2147483649 as map key into field m_int32 of type map[int32]string`},
+		{`{"m_uint32":{"4294967296":"a"}}`, `json: cannot unmarshal 4294967296 as map key into field m_uint32 of type map[uint32]string`},
+		{`{"m_uint32":{"-1":"a"}}`, `json: cannot unmarshal -1 as map key into field m_uint32 of type map[uint32]string`},
+		{`{"m_uint64":{"18446744073709551616":"a"}}`, `json: cannot unmarshal 18446744073709551616 as map key into field m_uint64 of type map[uint64]string`},
+		{`{"m_int64":{"1.5":"a"}}`, `json: cannot unmarshal 1.5 as map key into field m_int64 of type map[int64]string`},
+		{`{"m_bool":{"1":"a"}}`, `json: cannot unmarshal 1 as map key into field m_bool of type map[bool]string`},
+		{`{"m_bool":{"True":"a"}}`, `json: cannot unmarshal True as map key into field m_bool of type map[bool]string`},
+	} {
+		err = (&gojsontest.MapKeys1{}).UnmarshalJSON([]byte(c.input))
+		require.NotNil(t, err, c.input)
+		require.Equal(t, c.err, err.Error())
+	}
+}
+
+// The int64 is parsed in the full range, and the bool accepts only the json literal true and false.
+func Test_GoJSON_ParseScalar1(t *testing.T) {
+	data1 := &gojsontest.Model1{}
+	require.Nil(t, data1.UnmarshalJSON([]byte(`{"type_int64":2147483648,"type_sint64":-9223372036854775808}`)))
+	require.Equal(t, int64(2147483648), data1.TypeInt64)
+	require.Equal(t, int64(math.MinInt64), data1.TypeSint64)
+
+	data2 := &gojsontest.MapKeys1{}
+	require.Nil(t, data2.UnmarshalJSON([]byte(`{"m_int64":{"-9223372036854775808":"a"}}`)))
+	require.Equal(t, map[int64]string{math.MinInt64: "a"}, data2.MInt64)
+
+	// The bool values are parsed by strconv.ParseBool, so the 1 and 0 are accepted.
+	data3 := &gojsontest.Model1{}
+	require.Nil(t, data3.UnmarshalJSON([]byte(`{"type_bool1":1,"type_bool2":false}`)))
+	require.True(t, data3.TypeBool1)
+	require.False(t, data3.TypeBool2)
+	for _, input := range []string{`{"type_bool1":2}`, `{"type_bool1":"t"}`, `{"type_bool1":"true"}`, `{"type_bool1":TRUE}`} {
+		require.NotNil(t, (&gojsontest.Model1{}).UnmarshalJSON([]byte(input)), input)
+	}
+
+	// The bool map keys accept only true and false.
+	data4 := &gojsontest.MapPairs1{}
+	require.Nil(t, data4.UnmarshalJSON([]byte(`{"m_bool":[{"key":true,"value":null}]}`)))
+	require.Equal(t, map[bool]*gojsontest.MapPairs2{true: nil}, data4.MBool)
+	for _, input := range []string{`{"m_bool":[{"key":1,"value":null}]}`, `{"m_bool":[{"key":"true","value":null}]}`} {
+		require.NotNil(t, (&gojsontest.MapPairs1{}).UnmarshalJSON([]byte(input)), input)
+	}
+	for _, input := range []string{`{"m_bool":{"1":"a"}}`, `{"m_bool":{"TRUE":"a"}}`} {
+		require.NotNil(t, (&gojsontest.MapKeys1{}).UnmarshalJSON([]byte(input)), input)
+	}
+}
+
+func Test_GoJSON_MapPairs1(t *testing.T) {
+	data1 := &gojsontest.MapPairs1{
+		MInt64:  map[int64]string{math.MinInt64: "a"},
+		MUint64: map[uint64]int32{math.MaxUint64: 1},
+		MBool:   map[bool]*gojsontest.MapPairs2{false: {TString: "b"}},
+		MString: map[string]int32{"k": 2},
+		MUint32: map[uint32]string{7: "c"},
+	}
+
+	b, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"m_int64":[{"key":-9223372036854775808,"value":"a"}],"m_uint64":[{"key":18446744073709551615,"value":1}],`+
+			`"m_bool":[{"key":false,"value":{"t_string":"b"}}],"m_string":[{"key":"k","value":2}],`+
+			`"m_uint32":{"7":"c"}}`,
+		string(b),
+	)
+
+	data2 := &gojsontest.MapPairs1{}
+	require.Nil(t, data2.UnmarshalJSON(b))
+	require.Equal(t, data1.String(), data2.String())
+
+	// The order of key and value in entry is arbitrary.
+	data3 := &gojsontest.MapPairs1{}
+	require.Nil(t, data3.UnmarshalJSON([]byte(`{"m_int64":[{"value":"x", "key":5},{"key":6,"value":"y"}],"m_string":null}`)))
+	require.Equal(t, map[int64]string{5: "x", 6: "y"}, data3.MInt64)
+	require.Nil(t, data3.MString)
+
+	for _, c := range []struct{ input, err string }{
+		{`{"m_int64":{"1":"a"}}`, `json: cannot unmarshal {"1":"a"} as array into field m_int64 of type map[int64]string`},
+		{`{"m_int64":[1]}`, `json: cannot unmarshal 1 as map entry into field m_int64 of type map[int64]string`},
+		{`{"m_int64":[{"key":1}]}`, `json: map entry of field m_int64 must have both key and value`},
+		{`{"m_int64":[{"key":1,"value":"a","x":1}]}`, `json: unknown key "x" in map entry of field m_int64`},
+		{`{"m_int64":[{"key":"1","value":"a"}]}`, `json: cannot unmarshal "1" as map key into field m_int64 of type map[int64]string`},
+		{`{"m_bool":[{"key":1,"value":null}]}`, `json: cannot unmarshal 1 as map key into field m_bool of type map[bool]*MapPairs2`},
+		{`{"m_string":[{"key":null,"value":1}]}`, `json: cannot unmarshal null as map key into field m_string of type map[string]int32`},
+	} {
+		err = (&gojsontest.MapPairs1{}).UnmarshalJSON([]byte(c.input))
+		require.NotNil(t, err, c.input)
+		require.Equal(t, c.err, err.Error())
+	}
+}
+
+func Test_GoJSON_MapPairs3_MergeOverwrite(t *testing.T) {
+	config1 := &gojsontest.MapPairs3_Config{Ip: "10.1", Port: 1001}
+	config2 := &gojsontest.MapPairs3_Config{Ip: "10.2", Port: 1002}
+	data1 := &gojsontest.MapPairs3{
+		MConfig: map[string]*gojsontest.MapPairs3_Config{"k1": config1, "k2": config2, "k3": {}},
+	}
+
+	// The existing value is merged if the key is read before the value.
+	b1 := []byte(`{"m_config":[{"key":"k1","value":{"ip":"m1"}},{"value":{"ip":"m2"},"key":"k2"},{"key":"k3","value":null}]}`)
+	require.Nil(t, data1.UnmarshalJSON(b1))
+	require.Equal(t, &gojsontest.MapPairs3_Config{Ip: "m1", Port: 1001}, data1.MConfig["k1"])
+	require.Equal(t, unsafe.Pointer(config1), unsafe.Pointer(data1.MConfig["k1"]))
+	require.Equal(t, &gojsontest.MapPairs3_Config{Ip: "m2"}, data1.MConfig["k2"])
+	require.Nil(t, data1.MConfig["k3"])
+	require.Len(t, data1.MConfig, 3)
+
+	for _, c := range []struct{ input, err string }{
+		{`{"m_config":[{"key":"k1"}]}`, `json: map entry of field m_config must have both key and value`},
+		{`{"m_config":[{"value":{}}]}`, `json: map entry of field m_config must have both key and value`},
+		{`{"m_config":[{"key":"k1","value":1}]}`, `json: cannot unmarshal 1 into object`},
+		{`{"m_config":[{"key":1,"value":{}}]}`, `json: cannot unmarshal 1 as map key into field m_config of type map[string]*MapPairs3_Config`},
+	} {
+		err := (&gojsontest.MapPairs3{}).UnmarshalJSON([]byte(c.input))
+		require.NotNil(t, err, c.input)
+		require.Equal(t, c.err, err.Error())
+	}
+}
+
+func Test_GoJSON_FloatFormat1(t *testing.T) {
+	// The default format.
+	data1 := &gojsontest.FloatFormat1{
+		TDouble1: 1e21,
+		TFloat1:  float32(math.Inf(1)),
+		ADouble:  []float64{math.NaN(), math.Inf(-1), 1e-7},
+		MFloat:   map[string]float32{"k1": 1.5},
+		Oneof1:   &gojsontest.FloatFormat1_OneDouble{OneDouble: 0.1},
+	}
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"t_double1":1000000000000000000000,"t_float1":"+Inf","a_double":["NaN","-Inf",0.0000001],"m_float":{"k1":1.5},"one_double":0.1}`,
+		string(b1),
+	)
+
+	dataX := &gojsontest.FloatFormat1{}
+	require.Nil(t, dataX.UnmarshalJSON(b1))
+	require.Equal(t, 1e21, dataX.TDouble1)
+	require.True(t, math.IsInf(float64(dataX.TFloat1), 1))
+	require.True(t, math.IsNaN(dataX.ADouble[0]))
+	require.True(t, math.IsInf(dataX.ADouble[1], -1))
+	require.Equal(t, 1e-7, dataX.ADouble[2])
+
+	// Decoding accepts all the spellings of NaN and Infinity, and the exponent.
+	b2 := []byte(`{"t_double1":"-Infinity","t_float1":"Infinity","a_double":["+Inf","-Inf","NaN",1e+21,1E-7]}`)
+	data2 := &gojsontest.FloatFormat1{}
+	require.Nil(t, data2.UnmarshalJSON(b2))
+	require.True(t, math.IsInf(data2.TDouble1, -1))
+	require.True(t, math.IsInf(float64(data2.TFloat1), 1))
+	require.True(t, math.IsInf(data2.ADouble[0], 1))
+	require.True(t, math.IsInf(data2.ADouble[1], -1))
+	require.True(t, math.IsNaN(data2.ADouble[2]))
+	require.Equal(t, []float64{1e21, 1e-7}, data2.ADouble[3:])
+
+	for _, b := range []string{`{"t_double1":"inf"}`, `{"t_double1":"1.5"}`, `{"t_float1":"nan"}`} {
+		require.NotNil(t, (&gojsontest.FloatFormat1{}).UnmarshalJSON([]byte(b)), b)
+	}
+}
+
+func Test_GoJSON_FloatFormat2(t *testing.T) {
+	data1 := &gojsontest.FloatFormat2{
+		TDouble1: 1e21,
+		TFloat1:  float32(math.Inf(1)),
+		ADouble:  []float64{math.NaN(), math.Inf(-1), 1e-7, 123.456},
+		MFloat:   map[string]float32{"k1": 1e-9},
+		Oneof1:   &gojsontest.FloatFormat2_OneDouble{OneDouble: 0.1},
+		TDouble2: 1e21,
+		TMoney:   1.5,
+		TFloat2:  2.5,
+	}
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"t_double1":1e+21,"t_float1":"Infinity","a_double":["NaN","-Infinity",1e-7,123.456],"m_float":{"k1":1e-9},"one_double":0.1,"t_double2":1000000000000000000000,"t_money":1.50,"t_float2":2}`,
+		string(b1),
+	)
+
+	// Same as encoding/json for the finite values.
+	b2, err := json.Marshal([]float64{1e21, 1e-7, 123.456, 0.1})
+	require.Nil(t, err)
+	require.Equal(t, `[1e+21,1e-7,123.456,0.1]`, string(b2))
+
+	// Same as protojson for the non-finite values.
+	data3 := &gojsontest.FloatFormat2{TFloat1: float32(math.Inf(1)), ADouble: []float64{math.NaN(), math.Inf(-1)}}
+	b3, err := data3.MarshalJSON()
+	require.Nil(t, err)
+	b4, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(data3)
+	require.Nil(t, err)
+	data4 := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(b4, &data4))
+	data5 := map[string]interface{}{}
+	require.Nil(t, json.Unmarshal(b3, &data5))
+	require.Equal(t, data4["t_float1"], data5["t_float1"])
+	require.Equal(t, data4["a_double"], data5["a_double"])
+
+	dataX := &gojsontest.FloatFormat2{}
+	require.Nil(t, dataX.UnmarshalJSON(b1))
+	require.Equal(t, 1e21, dataX.TDouble1)
+	require.True(t, math.IsInf(float64(dataX.TFloat1), 1))
+	require.True(t, math.IsNaN(dataX.ADouble[0]))
+	require.Equal(t, float32(1e-9), dataX.MFloat["k1"])
+	require.Equal(t, 1.5, dataX.TMoney)
+	require.Equal(t, float32(2), dataX.TFloat2)
+
+	// Rejects the non-finite values.
+	data6 := &gojsontest.FloatFormat2{TDouble2: math.NaN()}
+	_, err = data6.MarshalJSON()
+	require.NotNil(t, err)
+	require.Equal(t, "json: unsupported value: NaN", err.Error())
+	var unsupportedErr *json.UnsupportedValueError
+	require.True(t, errors.As(err, &unsupportedErr))
+
+	data7 := &gojsontest.FloatFormat2{TFloat2: float32(math.Inf(-1))}
+	b7, err := data7.MarshalJSON()
+	require.Nil(t, err)
+	require.Contains(t, string(b7), `"t_float2":"-Inf"`)
+}
+
+func Test_GoJSON_ForeignMessage1(t *testing.T) {
+	value1, err := structpb.NewValue(map[string]interface{}{"k1": "v1", "k2": []interface{}{1.5, true, nil}})
+	require.Nil(t, err)
+	struct1, err := structpb.NewStruct(map[string]interface{}{"s1": "v1"})
+	require.Nil(t, err)
+
+	data1 := &gojsontest.ForeignMessage1{
+		TTimestamp: &timestamppb.Timestamp{Seconds: 1600000000, Nanos: 500000000},
+		TStruct:    struct1,
+		AValue:     []*structpb.Value{structpb.NewStringValue("a1"), structpb.NewNumberValue(2)},
+		MTimestamp: map[string]*timestamppb.Timestamp{"k1": {Seconds: 1}},
+		Oneof1:     &gojsontest.ForeignMessage1_OneValue{OneValue: value1},
+		TMessage:   &gojsontest.ForeignMessage2{TString: "s1"},
+	}
+	b1, err := data1.MarshalJSON()
+	require.Nil(t, err)
+
+	// The well-known types are encoded in the protojson format instead of encoding/json.
+	var m1 map[string]interface{}
+	require.Nil(t, json.Unmarshal(b1, &m1))
+	require.Equal(t, "2020-09-13T12:26:40.500Z", m1["t_timestamp"])
+	require.Equal(t, map[string]interface{}{"s1": "v1"}, m1["t_struct"])
+	require.Equal(t, []interface{}{"a1", float64(2)}, m1["a_value"])
+	require.Equal(t, map[string]interface{}{"k1": "1970-01-01T00:00:01Z"}, m1["m_timestamp"])
+	require.Equal(t, map[string]interface{}{"k1": "v1", "k2": []interface{}{1.5, true, nil}}, m1["one_value"])
+	require.Equal(t, map[string]interface{}{"t_string": "s1"}, m1["t_message"])
+
+	dataX := &gojsontest.ForeignMessage1{}
+	require.Nil(t, dataX.UnmarshalJSON(b1))
+	require.True(t, proto.Equal(data1, dataX))
+
+	// Same as protojson.
+	dataY := &gojsontest.ForeignMessage1{}
+	require.Nil(t, protojson.Unmarshal(b1, dataY))
+	require.True(t, proto.Equal(data1, dataY))
+
+	// The null is nil.
+	data2 := &gojsontest.ForeignMessage1{}
+	b2, err := data2.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, `{"t_timestamp":null,"t_struct":null,"a_value":null,"m_timestamp":null,"t_message":null}`, string(b2))
+	require.Nil(t, data2.UnmarshalJSON(b2))
+	require.Nil(t, data2.TTimestamp)
+
+	// The errors of protojson.
+	b3 := []byte(`{"t_message":{"t_string":"s1"},"m_timestamp":{"k1":{"seconds":1}}}`)
+	require.NotNil(t, (&gojsontest.ForeignMessage1{}).UnmarshalJSON(b3))
+	b4 := []byte(`{"t_timestamp":"x"}`)
+	require.NotNil(t, (&gojsontest.ForeignMessage1{}).UnmarshalJSON(b4))
+}
+
+func Test_GoJSON_ForeignMessage3_Merge(t *testing.T) {
+	message1 := &gojsonexternal.ExternalMessage2{Ip1: "a"}
+	element1 := &gojsonexternal.ExternalMessage2{Ip1: "a"}
+	entry1 := &gojsonexternal.ExternalMessage2{Ip1: "a"}
+	timestamp1 := &timestamppb.Timestamp{Seconds: 1, Nanos: 5}
+	data1 := &gojsontest.ForeignMessage3{
+		TMessage:   message1,
+		AMessage:   []*gojsonexternal.ExternalMessage2{element1},
+		MMessage:   map[string]*gojsonexternal.ExternalMessage2{"k1": entry1},
+		TTimestamp: timestamp1,
+	}
+
+	b1 := []byte(`{"t_message":{"ip2":"b"},"a_message":[{"ip2":"b"},{"ip1":"c"}],"m_message":{"k1":{"ip2":"b"},"k2":{"ip1":"c"}},"t_timestamp":"1970-01-01T00:00:02Z"}`)
+	require.Nil(t, data1.UnmarshalJSON(b1))
+
+	// The foreign messages are merged into the existing ones in place.
+	require.True(t, data1.TMessage == message1)
+	require.True(t, proto.Equal(&gojsonexternal.ExternalMessage2{Ip1: "a", Ip2: "b"}, data1.TMessage))
+	require.Equal(t, 2, len(data1.AMessage))
+	require.True(t, data1.AMessage[0] == element1)
+	require.True(t, proto.Equal(&gojsonexternal.ExternalMessage2{Ip1: "a", Ip2: "b"}, data1.AMessage[0]))
+	require.True(t, proto.Equal(&gojsonexternal.ExternalMessage2{Ip1: "c"}, data1.AMessage[1]))
+	require.Equal(t, 2, len(data1.MMessage))
+	require.True(t, data1.MMessage["k1"] == entry1)
+	require.True(t, proto.Equal(&gojsonexternal.ExternalMessage2{Ip1: "a", Ip2: "b"}, data1.MMessage["k1"]))
+	require.True(t, proto.Equal(&gojsonexternal.ExternalMessage2{Ip1: "c"}, data1.MMessage["k2"]))
+
+	// The well-known types are replaced.
+	require.True(t, proto.Equal(&timestamppb.Timestamp{Seconds: 2}, data1.TTimestamp))
+	require.True(t, proto.Equal(&timestamppb.Timestamp{Seconds: 1, Nanos: 5}, timestamp1))
+
+	// The null is nil.
+	require.Nil(t, data1.UnmarshalJSON([]byte(`{"t_message":null}`)))
+	require.Nil(t, data1.TMessage)
+}
+
+func Test_GoJSON_ForeignMessage3_MaxDepth(t *testing.T) {
+	// The recursion of protojson is limited by the remaining MaxDepth.
+	b1 := []byte(`{"t_struct":{"a":{"a":1}}}`)
+
+	data1 := &gojsontest.ForeignMessage3{}
+	err := data1.UnmarshalJSONWithOptions(b1, jsondecoder.Options{MaxDepth: 3})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "recursion")
+
+	data2 := &gojsontest.ForeignMessage3{}
+	require.Nil(t, data2.UnmarshalJSONWithOptions(b1, jsondecoder.Options{}))
+	require.Equal(t, map[string]interface{}{"a": map[string]interface{}{"a": float64(1)}}, data2.TStruct.AsMap())
+}
+
+func Test_GoJSON_FieldMask1_Marshal(t *testing.T) {
+	config := &gojsontest.FieldMask1_Config{
+		Ip:      "127.0.0.1",
+		Network: &gojsontest.FieldMask1_Network{Port: 80, Host: "h1"},
+	}
+	data := &gojsontest.FieldMask1{
+		TString: "s1",
+		TInt32:  1,
+		Config:  config,
+		AConfig: []*gojsontest.FieldMask1_Config{config},
+		MConfig: map[string]*gojsontest.FieldMask1_Config{"k1": config},
+		Oneof1:  &gojsontest.FieldMask1_OneConfig{OneConfig: config},
+		TChild:  &gojsontest.FieldMask1{TString: "s2", Config: config},
+	}
+
+	marshal := func(paths ...string) string {
+		b, err := data.MarshalJSONFields(&fieldmaskpb.FieldMask{Paths: paths})
+		require.Nil(t, err)
+		return string(b)
+	}
+
+	// The empty mask selects all fields.
+	b1, err := data.MarshalJSON()
+	require.Nil(t, err)
+	require.Equal(t, string(b1), marshal())
+
+	// The path is made of the json keys under the NameStyle.
+	require.Equal(t, `{"tString":"s1","count":1}`, marshal("tString", "count"))
+
+	// The unknown paths, the names of fields in protobuf are not accepted.
+	for _, c := range []struct{ path, unknown string }{
+		{"unknown", "unknown"},
+		{"t_string", "t_string"},
+		{"t_int32", "t_int32"},
+		{"Oneof1", "Oneof1"},
+		{"config.network.port.x", "config.network.port.x"},
+		{"config.network.host", "config.network.host"},
+		{"tString.x", "tString.x"},
+		{"oneof1.oneConfig", "oneof1.oneConfig"},
+		{"tChild.config.x", "tChild.config.x"},
+		{"aConfig.x", "aConfig.x"},
+		{"mConfig.network.x", "mConfig.network.x"},
+	} {
+		_, err := data.MarshalJSONFields(&fieldmaskpb.FieldMask{Paths: []string{"count", c.path}})
+		require.NotNil(t, err, c.path)
+		require.Equal(t, &jsonencoder.FieldMaskError{Path: c.unknown}, err, c.path)
+		require.Equal(t, `json: unknown path "`+c.unknown+`" in field mask`, err.Error())
+	}
+	require.Nil(t, data.CheckJSONFieldMask(jsonencoder.NewFieldMask([]string{"config.network.HOST", "oneConfig.ip", "oneof1"})))
+
+	// The nested paths.
+	require.Equal(t, `{"config":{"network":{"port":80}}}`, marshal("config.network.port"))
+	require.Equal(t, `{"config":{"network":{"HOST":"h1"}}}`, marshal("config.network.HOST"))
+	require.Equal(t, `{"config":{"ip":"127.0.0.1","network":{"port":80,"HOST":"h1"}}}`, marshal("config.ip", "config"))
+	require.Equal(t,
+		`{"tChild":{"tString":"s2","config":{"ip":"127.0.0.1"}}}`,
+		marshal("tChild.tString", "tChild.config.ip"),
+	)
+
+	// The nested paths apply to every element of repeated and map fields.
+	require.Equal(t, `{"aConfig":[{"ip":"127.0.0.1"}],"mConfig":{"k1":{"network":{"port":80}}}}`, marshal("aConfig.ip", "mConfig.network.port"))
+
+	// The oneof is selected by the key of oneof or its fields.
+	require.Equal(t, `{"oneof1":{"oneConfig":{"ip":"127.0.0.1","network":{"port":80,"HOST":"h1"}}}}`, marshal("oneof1"))
+	require.Equal(t, `{"oneof1":{"oneConfig":{"ip":"127.0.0.1"}}}`, marshal("oneConfig.ip"))
+	require.Equal(t, `{}`, marshal("oneString"))
+
+	// The mask of nested message is passed with options.
+	b2, err := data.MarshalJSONFieldsWithOptions(jsonencoder.NewFieldMask([]string{"config.network"}), jsonencoder.Options{Indent: " "})
+	require.Nil(t, err)
+	require.Equal(t, "{\n \"config\": {\n  \"network\": {\n   \"port\": 80,\n   \"HOST\": \"h1\"\n  }\n }\n}", string(b2))
+}
+
+func Test_GoJSON_FieldMask1_Unmarshal(t *testing.T) {
+	b1 := []byte(`{
+		"tString": "s1",
+		"count": 1,
+		"config": {"ip": "127.0.0.1", "network": {"port": 80, "HOST": "h1"}},
+		"aConfig": [{"ip": "127.0.0.2"}],
+		"mConfig": {"k1": {"ip": "127.0.0.3"}},
+		"oneof1": {"oneConfig": {"network": {}}},
+		"tChild": null,
+		"unknown": 1
+	}`)
+	data1 := &gojsontest.FieldMask1{}
+	mask1, err := data1.UnmarshalJSONFields(b1)
+	require.Nil(t, err)
+	require.Equal(t, []string{
+		"tString", "count", "config.ip", "config.network.port", "config.network.HOST",
+		"aConfig", "mConfig", "oneConfig.network", "tChild",
+	}, mask1.Paths)
+	require.Equal(t, "h1", data1.Config.Network.Host)
+
+	// The paths can be used to encode the same fields.
+	b2, err := data1.MarshalJSONFields(mask1)
+	require.Nil(t, err)
+	require.Equal(t,
+		`{"tString":"s1","count":1,"config":{"ip":"127.0.0.1","network":{"port":80,"HOST":"h1"}},"aConfig":[{"ip":"127.0.0.2","network":null}],"mConfig":{"k1":{"ip":"127.0.0.3","network":null}},"oneof1":{"oneConfig":{"network":{"port":0,"HOST":""}}},"tChild":null}`,
+		string(b2),
+	)
+
+	mask2, err := (&gojsontest.FieldMask1{}).UnmarshalJSONFields([]byte(`{"config":{}}`))
+	require.Nil(t, err)
+	require.Equal(t, []string{"config"}, mask2.Paths)
+
+	_, err = (&gojsontest.FieldMask1{}).UnmarshalJSONFields([]byte(`{"config":1}`))
+	require.NotNil(t, err)
+}
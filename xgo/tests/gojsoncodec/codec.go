@@ -0,0 +1,111 @@
+// Package gojsoncodec provides the codecs used by the option codec in tests.
+package gojsoncodec
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder"
+)
+
+var (
+	_ jsonencoder.Int64FieldCodec  = (*Cents)(nil)
+	_ jsondecoder.Int64FieldCodec  = (*Cents)(nil)
+	_ jsonencoder.Int64FieldCodec  = (*Timestamp)(nil)
+	_ jsondecoder.Int64FieldCodec  = (*Timestamp)(nil)
+	_ jsonencoder.StringFieldCodec = (*CommaList)(nil)
+	_ jsondecoder.StringFieldCodec = (*CommaList)(nil)
+)
+
+// Cents encodes the int64 cents as decimal string, e.g. 1234 to "12.34".
+type Cents struct{}
+
+func (Cents) EncodeJSON(enc *jsonencoder.Encoder, v int64) error {
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	enc.AppendString(fmt.Sprintf("%s%d.%02d", sign, v/100, v%100))
+	return nil
+}
+
+func (Cents) DecodeJSON(data []byte) (int64, error) {
+	s, ok := jsondecoder.UnquoteString(data)
+	if !ok {
+		return 0, fmt.Errorf("cents must be a string")
+	}
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimPrefix(s, "-")
+
+	parts := strings.SplitN(s, ".", 2)
+	units, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	var cents int64
+	if len(parts) == 2 {
+		if len(parts[1]) != 2 {
+			return 0, fmt.Errorf("cents must have two decimal places")
+		}
+		if cents, err = strconv.ParseInt(parts[1], 10, 64); err != nil {
+			return 0, err
+		}
+	}
+	v := units*100 + cents
+	if neg {
+		v = -v
+	}
+	return v, nil
+}
+
+// Timestamp encodes the epoch seconds as RFC 3339 string in UTC.
+type Timestamp struct{}
+
+func (Timestamp) EncodeJSON(enc *jsonencoder.Encoder, v int64) error {
+	enc.AppendString(time.Unix(v, 0).UTC().Format(time.RFC3339))
+	return nil
+}
+
+func (Timestamp) DecodeJSON(data []byte) (int64, error) {
+	if string(data) == "null" {
+		return 0, nil
+	}
+	s, ok := jsondecoder.UnquoteString(data)
+	if !ok {
+		return 0, fmt.Errorf("timestamp must be a string")
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, err
+	}
+	return t.Unix(), nil
+}
+
+// CommaList encodes the comma-separated string as string array.
+type CommaList struct{}
+
+func (CommaList) EncodeJSON(enc *jsonencoder.Encoder, v string) error {
+	items := make([]string, 0)
+	if v != "" {
+		items = strings.Split(v, ",")
+	}
+	b, err := json.Marshal(items)
+	if err != nil {
+		return err
+	}
+	enc.AppendRawJSON(b)
+	return nil
+}
+
+func (CommaList) DecodeJSON(data []byte) (string, error) {
+	var items []string
+	if err := json.Unmarshal(data, &items); err != nil {
+		return "", err
+	}
+	return strings.Join(items, ","), nil
+}
@@ -8,50 +8,167 @@ package gojsonexternal
 import (
 	errors "errors"
 	fmt "fmt"
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
 	jsondecoder "github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
 	jsonencoder "github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 )
 
 // MarshalJSON for implements interface json.Marshaler.
 func (this *ExternalMessage1) MarshalJSON() ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONIndent is like MarshalJSON but applies prefix and indent to format the output.
+func (this *ExternalMessage1) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Prefix:     prefix,
+		Indent:     indent,
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONFields is like MarshalJSON but only encodes the fields selected by mask,
+// all fields are encoded if mask is empty. The paths are made of the json keys, and an
+// error is returned if any path selects no field. See jsonencoder.FieldMask for details.
+func (this *ExternalMessage1) MarshalJSONFields(mask *fieldmaskpb.FieldMask) ([]byte, error) {
+	fieldMask := jsonencoder.NewFieldMask(mask.GetPaths())
+	if err := this.CheckJSONFieldMask(fieldMask); err != nil {
+		return nil, err
+	}
+	return this.MarshalJSONFieldsWithOptions(fieldMask, jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// CheckJSONFieldMask for implements jsonencoder.FieldMaskChecker.
+func (this *ExternalMessage1) CheckJSONFieldMask(mask jsonencoder.FieldMask) error {
+	for _, name := range mask.Names() {
+		var err error
+		switch name {
+		case "ip1":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "ip2":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "ip3":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		default:
+			err = &jsonencoder.FieldMaskError{Path: name}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSONWithOptions for implements jsonencoder.Marshaler.
+func (this *ExternalMessage1) MarshalJSONWithOptions(opts jsonencoder.Options) ([]byte, error) {
+	return this.MarshalJSONFieldsWithOptions(nil, opts)
+}
+
+// MarshalJSONFieldsWithOptions for implements jsonencoder.FieldsMarshaler.
+func (this *ExternalMessage1) MarshalJSONFieldsWithOptions(mask jsonencoder.FieldMask, opts jsonencoder.Options) ([]byte, error) {
 	if this == nil {
 		return []byte("null"), nil
 	}
-	var err error
+	encoder := jsonencoder.NewWithOptions(32, opts)
+	if err := this.marshalJSONFieldsTo(encoder, mask); err != nil {
+		return nil, err
+	}
+	return encoder.Output()
+}
+
+// MarshalJSONTo for implements jsonencoder.EncoderMarshaler.
+// It appends the JSON object into encoder with the options of encoder.
+func (this *ExternalMessage1) MarshalJSONTo(encoder *jsonencoder.Encoder) error {
+	return this.marshalJSONFieldsTo(encoder, nil)
+}
 
-	encoder := jsonencoder.New(32)
+// marshalJSONFieldsTo appends the fields selected by mask into encoder.
+func (this *ExternalMessage1) marshalJSONFieldsTo(encoder *jsonencoder.Encoder, mask jsonencoder.FieldMask) error {
+	if this == nil {
+		encoder.AppendNil()
+		return nil
+	}
+	var err error
 
 	// Add JSON end identifier
 	encoder.AppendObjectBegin()
 
-	// encode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip1 | kind: StringKind | GoName: Ip1 | omitempty: false | ignore: false
-	encoder.AppendObjectKey("ip1")
-	encoder.AppendString(this.Ip1)
-	// encode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip2 | kind: StringKind | GoName: Ip2 | omitempty: false | ignore: false
-	encoder.AppendObjectKey("ip2")
-	encoder.AppendString(this.Ip2)
-	// encode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip3 | kind: StringKind | GoName: Ip3 | omitempty: false | ignore: false
-	encoder.AppendObjectKey("ip3")
-	encoder.AppendString(this.Ip3)
+	if mask.Has("ip1") {
+		// encode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip1 | kind: StringKind | GoName: Ip1 | omitempty: false | ignore: false
+		encoder.AppendObjectKey("ip1")
+		encoder.AppendString(this.Ip1)
+	}
+	if mask.Has("ip2") {
+		// encode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip2 | kind: StringKind | GoName: Ip2 | omitempty: false | ignore: false
+		encoder.AppendObjectKey("ip2")
+		encoder.AppendString(this.Ip2)
+	}
+	if mask.Has("ip3") {
+		// encode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip3 | kind: StringKind | GoName: Ip3 | omitempty: false | ignore: false
+		encoder.AppendObjectKey("ip3")
+		encoder.AppendString(this.Ip3)
+	}
 
 	// Add JSON end identifier
 	encoder.AppendObjectEnd()
-	return encoder.Bytes(), err
+	return err
 }
 
 // UnmarshalJSON for implements json.Unmarshaler.
 func (this *ExternalMessage1) UnmarshalJSON(b []byte) error {
+	return this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+	})
+}
+
+// UnmarshalJSONFields is like UnmarshalJSON but also returns the paths of fields that present in b.
+// A message field is present by the paths of its nested fields, or by itself if the value is null or
+// an empty object. The fields in the elements of repeated and map fields are not reported.
+func (this *ExternalMessage1) UnmarshalJSONFields(b []byte) (*fieldmaskpb.FieldMask, error) {
+	present := &jsondecoder.FieldPaths{}
+	err := this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+		Present:               present,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: present.Leaves()}, nil
+}
+
+// UnmarshalJSONWithOptions for implements jsondecoder.Unmarshaler.
+func (this *ExternalMessage1) UnmarshalJSONWithOptions(b []byte, opts jsondecoder.Options) error {
 	if this == nil {
 		return errors.New("json: Unmarshal: github.com/yu31/protoc-plugin/xgo/tests/gojsonexternal.(*ExternalMessage1) is nil")
 	}
-
-	decoder, err := jsondecoder.New(b)
+	decoder, err := jsondecoder.NewWithOptions(b, opts)
 	if err != nil {
 		return err
 	}
+	return this.UnmarshalJSONFrom(decoder)
+}
+
+// UnmarshalJSONFrom for implements jsondecoder.DecoderUnmarshaler.
+// It decodes the next value of decoder with the options of decoder, the value is decoded in place
+// if the decoder is already at the beginning of it.
+func (this *ExternalMessage1) UnmarshalJSONFrom(decoder *jsondecoder.Decoder) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: github.com/yu31/protoc-plugin/xgo/tests/gojsonexternal.(*ExternalMessage1) is nil")
+	}
+	var err error
+	opts := decoder.Options()
 
 	// check null.
-	decoder.ScanWhile(jsondecoder.ScanSkipSpace)
+	decoder.ScanBeginValue()
 	if decoder.OpCode == jsondecoder.ScanBeginLiteral {
 		value := decoder.ReadItem()
 		if value[0] != 'n' {
@@ -60,7 +177,8 @@ func (this *ExternalMessage1) UnmarshalJSON(b []byte) error {
 		return nil // value is null
 	}
 	if decoder.OpCode != jsondecoder.ScanBeginObject {
-		panic(jsondecoder.PhasePanicMsg)
+		value := decoder.ReadItem()
+		return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
 	}
 
 	// Scan begin.
@@ -75,9 +193,17 @@ LOOP_OBJECT:
 
 		objKey := decoder.ReadObjectKey() // Read key
 		_ = objKey                        // avoid objKey not used
-		decoder.ObjectBeforeReadValue()   // Before read object value
-		switch {                          // process field with key.
+		if opts.CaseInsensitiveKeys {
+			objKey = jsondecoder.MatchKey(objKey, []string{
+				"ip1",
+				"ip2",
+				"ip3",
+			})
+		}
+		decoder.ObjectBeforeReadValue() // Before read object value
+		switch {                        // process field with key.
 		case objKey == "ip1":
+			decoder.MarkPresent("ip1")
 			// decode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip1 | kind: StringKind | GoName: Ip1
 			value := decoder.ReadItem()
 			var x string
@@ -90,6 +216,7 @@ LOOP_OBJECT:
 			}
 			this.Ip1 = x
 		case objKey == "ip2":
+			decoder.MarkPresent("ip2")
 			// decode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip2 | kind: StringKind | GoName: Ip2
 			value := decoder.ReadItem()
 			var x string
@@ -102,6 +229,7 @@ LOOP_OBJECT:
 			}
 			this.Ip2 = x
 		case objKey == "ip3":
+			decoder.MarkPresent("ip3")
 			// decode filed type of basic; | field: gojsonexternal.ExternalMessage1.ip3 | kind: StringKind | GoName: Ip3
 			value := decoder.ReadItem()
 			var x string
@@ -114,12 +242,16 @@ LOOP_OBJECT:
 			}
 			this.Ip3 = x
 		default:
+			if opts.DisallowUnknownFields {
+				return fmt.Errorf("json: unknown field %q", objKey)
+			}
 			_ = decoder.ReadItem() // discard unknown field
 		}
 		if decoder.ObjectAfterReadValue() { // After read object value
 			break LOOP_OBJECT
 		}
 	}
+	decoder.ScanNext()
 
 	if err = decoder.ScanError(); err != nil {
 		return err
@@ -7,6 +7,7 @@
 package gojsonexternal
 
 import (
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -144,6 +145,62 @@ func (x *ExternalMessage1) GetIp3() string {
 	return ""
 }
 
+// ExternalMessage2 has no methods of protoc-gen-gojson, it's handled as the foreign message.
+type ExternalMessage2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip1 string `protobuf:"bytes,1,opt,name=ip1,proto3" json:"ip1,omitempty"`
+	Ip2 string `protobuf:"bytes,2,opt,name=ip2,proto3" json:"ip2,omitempty"`
+}
+
+func (x *ExternalMessage2) Reset() {
+	*x = ExternalMessage2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_gojsonexternal_gojson_external_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExternalMessage2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalMessage2) ProtoMessage() {}
+
+func (x *ExternalMessage2) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_gojsonexternal_gojson_external_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExternalMessage2.ProtoReflect.Descriptor instead.
+func (*ExternalMessage2) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_gojsonexternal_gojson_external_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExternalMessage2) GetIp1() string {
+	if x != nil {
+		return x.Ip1
+	}
+	return ""
+}
+
+func (x *ExternalMessage2) GetIp2() string {
+	if x != nil {
+		return x.Ip2
+	}
+	return ""
+}
+
 var File_xgo_tests_gojsonexternal_gojson_external_proto protoreflect.FileDescriptor
 
 var file_xgo_tests_gojsonexternal_gojson_external_proto_rawDesc = []byte{
@@ -151,23 +208,28 @@ var file_xgo_tests_gojsonexternal_gojson_external_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x6f, 0x6a, 0x73, 0x6f,
 	0x6e, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x12, 0x0e, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x22, 0x48, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x69, 0x70, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x32, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x33, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x33, 0x2a, 0x6b, 0x0a, 0x0d, 0x45, 0x78,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x0a, 0x0a, 0x06, 0x4d,
-	0x6f, 0x6e, 0x64, 0x61, 0x79, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x75, 0x65, 0x73, 0x64,
-	0x61, 0x79, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x65, 0x64, 0x6e, 0x65, 0x73, 0x64, 0x61,
-	0x79, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x68, 0x75, 0x72, 0x73, 0x64, 0x61, 0x79, 0x10,
-	0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x72, 0x69, 0x64, 0x61, 0x79, 0x10, 0x04, 0x12, 0x0c, 0x0a,
-	0x08, 0x53, 0x61, 0x74, 0x75, 0x72, 0x64, 0x61, 0x79, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x53,
-	0x75, 0x6e, 0x64, 0x61, 0x79, 0x10, 0x06, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x33, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x78, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73,
-	0x74, 0x73, 0x2f, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x3b, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x1a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x48, 0x0a, 0x10, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x32, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x32, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70,
+	0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70, 0x33, 0x22, 0x3e, 0x0a, 0x10,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32,
+	0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69,
+	0x70, 0x31, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x70, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x69, 0x70, 0x32, 0x3a, 0x06, 0xca, 0xb8, 0x02, 0x02, 0x10, 0x01, 0x2a, 0x6b, 0x0a, 0x0d,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x0a, 0x0a,
+	0x06, 0x4d, 0x6f, 0x6e, 0x64, 0x61, 0x79, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x75, 0x65,
+	0x73, 0x64, 0x61, 0x79, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x57, 0x65, 0x64, 0x6e, 0x65, 0x73,
+	0x64, 0x61, 0x79, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x54, 0x68, 0x75, 0x72, 0x73, 0x64, 0x61,
+	0x79, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x72, 0x69, 0x64, 0x61, 0x79, 0x10, 0x04, 0x12,
+	0x0c, 0x0a, 0x08, 0x53, 0x61, 0x74, 0x75, 0x72, 0x64, 0x61, 0x79, 0x10, 0x05, 0x12, 0x0a, 0x0a,
+	0x06, 0x53, 0x75, 0x6e, 0x64, 0x61, 0x79, 0x10, 0x06, 0x42, 0x47, 0x5a, 0x45, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x75, 0x33, 0x31, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x78, 0x67, 0x6f, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x3b, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -183,10 +245,11 @@ func file_xgo_tests_gojsonexternal_gojson_external_proto_rawDescGZIP() []byte {
 }
 
 var file_xgo_tests_gojsonexternal_gojson_external_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_xgo_tests_gojsonexternal_gojson_external_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_xgo_tests_gojsonexternal_gojson_external_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_xgo_tests_gojsonexternal_gojson_external_proto_goTypes = []interface{}{
 	(ExternalEnum1)(0),       // 0: gojsonexternal.ExternalEnum1
 	(*ExternalMessage1)(nil), // 1: gojsonexternal.ExternalMessage1
+	(*ExternalMessage2)(nil), // 2: gojsonexternal.ExternalMessage2
 }
 var file_xgo_tests_gojsonexternal_gojson_external_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -214,6 +277,18 @@ func file_xgo_tests_gojsonexternal_gojson_external_proto_init() {
 				return nil
 			}
 		}
+		file_xgo_tests_gojsonexternal_gojson_external_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExternalMessage2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -221,7 +296,7 @@ func file_xgo_tests_gojsonexternal_gojson_external_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xgo_tests_gojsonexternal_gojson_external_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,858 @@
+// Code generated by protoc-gen-gojson. DO NOT EDIT.
+// versions:
+// 		protoc-gen-gojson 0.0.1
+// source: xgo/tests/gojsontest/gojson_key_template.proto
+
+package gojsontest
+
+import (
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	jsondecoder "github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
+	jsonencoder "github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// MarshalJSON for implements interface json.Marshaler.
+func (this *KeyTemplate1) MarshalJSON() ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONIndent is like MarshalJSON but applies prefix and indent to format the output.
+func (this *KeyTemplate1) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Prefix:     prefix,
+		Indent:     indent,
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONFields is like MarshalJSON but only encodes the fields selected by mask,
+// all fields are encoded if mask is empty. The paths are made of the json keys, and an
+// error is returned if any path selects no field. See jsonencoder.FieldMask for details.
+func (this *KeyTemplate1) MarshalJSONFields(mask *fieldmaskpb.FieldMask) ([]byte, error) {
+	fieldMask := jsonencoder.NewFieldMask(mask.GetPaths())
+	if err := this.CheckJSONFieldMask(fieldMask); err != nil {
+		return nil, err
+	}
+	return this.MarshalJSONFieldsWithOptions(fieldMask, jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// CheckJSONFieldMask for implements jsonencoder.FieldMaskChecker.
+func (this *KeyTemplate1) CheckJSONFieldMask(mask jsonencoder.FieldMask) error {
+	for _, name := range mask.Names() {
+		var err error
+		switch name {
+		case "x_t_int32":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "ts":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "x_OneofT":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "x_one1_int32":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		default:
+			err = &jsonencoder.FieldMaskError{Path: name}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSONWithOptions for implements jsonencoder.Marshaler.
+func (this *KeyTemplate1) MarshalJSONWithOptions(opts jsonencoder.Options) ([]byte, error) {
+	return this.MarshalJSONFieldsWithOptions(nil, opts)
+}
+
+// MarshalJSONFieldsWithOptions for implements jsonencoder.FieldsMarshaler.
+func (this *KeyTemplate1) MarshalJSONFieldsWithOptions(mask jsonencoder.FieldMask, opts jsonencoder.Options) ([]byte, error) {
+	if this == nil {
+		return []byte("null"), nil
+	}
+	encoder := jsonencoder.NewWithOptions(52, opts)
+	if err := this.marshalJSONFieldsTo(encoder, mask); err != nil {
+		return nil, err
+	}
+	return encoder.Output()
+}
+
+// MarshalJSONTo for implements jsonencoder.EncoderMarshaler.
+// It appends the JSON object into encoder with the options of encoder.
+func (this *KeyTemplate1) MarshalJSONTo(encoder *jsonencoder.Encoder) error {
+	return this.marshalJSONFieldsTo(encoder, nil)
+}
+
+// marshalJSONFieldsTo appends the fields selected by mask into encoder.
+func (this *KeyTemplate1) marshalJSONFieldsTo(encoder *jsonencoder.Encoder, mask jsonencoder.FieldMask) error {
+	if this == nil {
+		encoder.AppendNil()
+		return nil
+	}
+	var err error
+
+	// Add JSON end identifier
+	encoder.AppendObjectBegin()
+
+	if mask.Has("x_t_int32") {
+		// encode filed type of basic; | field: gojsontest.KeyTemplate1.t_int32 | kind: Int32Kind | GoName: TInt32 | omitempty: false | ignore: false
+		encoder.AppendObjectKey("x_t_int32")
+		encoder.AppendInt32(this.TInt32)
+	}
+	if mask.Has("ts") {
+		// encode filed type of basic; | field: gojsontest.KeyTemplate1.t_string | kind: StringKind | GoName: TString | omitempty: false | ignore: false
+		encoder.AppendObjectKey("ts")
+		encoder.AppendString(this.TString)
+	}
+	// Encode field type of oneof; | field: gojsontest.KeyTemplate1.OneofT | GoName: OneofT | omitempty: false | ignore: false
+	if mask.Has("x_OneofT", "x_one1_int32") {
+		if this.OneofT != nil {
+			switch v := this.OneofT.(type) {
+			case *KeyTemplate1_One1Int32:
+				if mask.Has("x_OneofT", "x_one1_int32") {
+					// encode filed type of basic; | field: gojsontest.KeyTemplate1.one1_int32 | kind: Int32Kind | GoName: One1Int32 | omitempty: false | ignore: false
+					encoder.AppendObjectKey("x_OneofT")
+					encoder.AppendObjectBegin()
+					encoder.AppendObjectKey("x_one1_int32")
+					encoder.AppendInt32(v.One1Int32)
+					encoder.AppendObjectEnd()
+				}
+			default:
+				return fmt.Errorf("invalid oneof field type: %v, jsonKey: %s, goName: OneofT, field: gojsontest.KeyTemplate1.OneofT", v, "x_OneofT")
+			}
+		} else {
+			encoder.AppendObjectKey("x_OneofT")
+			encoder.AppendNil()
+		}
+	}
+
+	// Add JSON end identifier
+	encoder.AppendObjectEnd()
+	return err
+}
+
+// UnmarshalJSON for implements json.Unmarshaler.
+func (this *KeyTemplate1) UnmarshalJSON(b []byte) error {
+	return this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+	})
+}
+
+// UnmarshalJSONFields is like UnmarshalJSON but also returns the paths of fields that present in b.
+// A message field is present by the paths of its nested fields, or by itself if the value is null or
+// an empty object. The fields in the elements of repeated and map fields are not reported.
+func (this *KeyTemplate1) UnmarshalJSONFields(b []byte) (*fieldmaskpb.FieldMask, error) {
+	present := &jsondecoder.FieldPaths{}
+	err := this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+		Present:               present,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: present.Leaves()}, nil
+}
+
+// UnmarshalJSONWithOptions for implements jsondecoder.Unmarshaler.
+func (this *KeyTemplate1) UnmarshalJSONWithOptions(b []byte, opts jsondecoder.Options) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*KeyTemplate1) is nil")
+	}
+	decoder, err := jsondecoder.NewWithOptions(b, opts)
+	if err != nil {
+		return err
+	}
+	return this.UnmarshalJSONFrom(decoder)
+}
+
+// UnmarshalJSONFrom for implements jsondecoder.DecoderUnmarshaler.
+// It decodes the next value of decoder with the options of decoder, the value is decoded in place
+// if the decoder is already at the beginning of it.
+func (this *KeyTemplate1) UnmarshalJSONFrom(decoder *jsondecoder.Decoder) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*KeyTemplate1) is nil")
+	}
+	var err error
+	opts := decoder.Options()
+	var oneofOneofTisStore bool
+
+	// check null.
+	decoder.ScanBeginValue()
+	if decoder.OpCode == jsondecoder.ScanBeginLiteral {
+		value := decoder.ReadItem()
+		if value[0] != 'n' {
+			return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+		}
+		return nil // value is null
+	}
+	if decoder.OpCode != jsondecoder.ScanBeginObject {
+		value := decoder.ReadItem()
+		return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+	}
+
+	// Scan begin.
+LOOP_OBJECT:
+	for {
+		if err = decoder.ScanError(); err != nil {
+			return err
+		}
+		if decoder.ObjectBeforeReadKey() { // before read object key
+			break LOOP_OBJECT
+		}
+
+		objKey := decoder.ReadObjectKey() // Read key
+		_ = objKey                        // avoid objKey not used
+		if opts.CaseInsensitiveKeys {
+			objKey = jsondecoder.MatchKey(objKey, []string{
+				"x_t_int32",
+				"ts",
+				"x_OneofT",
+			})
+		}
+		decoder.ObjectBeforeReadValue() // Before read object value
+		switch {                        // process field with key.
+		case objKey == "x_t_int32":
+			decoder.MarkPresent("x_t_int32")
+			// decode filed type of basic; | field: gojsontest.KeyTemplate1.t_int32 | kind: Int32Kind | GoName: TInt32
+			value := decoder.ReadItem()
+			x, err := jsondecoder.ParseInt32(value)
+			if err != nil {
+				return fmt.Errorf("json: cannot unmarshal %s into field %s of type int32", string(value), objKey)
+			}
+			this.TInt32 = x
+		case objKey == "ts":
+			decoder.MarkPresent("ts")
+			// decode filed type of basic; | field: gojsontest.KeyTemplate1.t_string | kind: StringKind | GoName: TString
+			value := decoder.ReadItem()
+			var x string
+			if value[0] != 'n' { // 'n' means null
+				var ok bool
+				x, ok = jsondecoder.UnquoteString(value)
+				if !ok {
+					return fmt.Errorf("json: cannot unmarshal %s into field %s of type string", string(value), objKey)
+				}
+			}
+			this.TString = x
+		case objKey == "x_OneofT":
+			// decode filed type of oneof; | field: gojsontest.KeyTemplate1.OneofT | GoName: OneofT
+			if decoder.OpCode == jsondecoder.ScanBeginLiteral {
+				value := decoder.ReadItem()
+				if value[0] != 'n' {
+					return fmt.Errorf("json: cannot unmarshal %s as oneof into field %s of type int32", string(value), objKey)
+				}
+			} else {
+				if decoder.OpCode != jsondecoder.ScanBeginObject {
+					value := decoder.ReadItem()
+					return fmt.Errorf("json: cannot unmarshal %s as oneof into field %s of type int32", string(value), objKey)
+				}
+			LOOP_ONEOF_OneofT:
+				for {
+					if decoder.ObjectBeforeReadKey() { // before read object key
+						break LOOP_ONEOF_OneofT
+					}
+					oneofKey := decoder.ReadObjectKey() // Read key
+					if opts.CaseInsensitiveKeys {
+						oneofKey = jsondecoder.MatchKey(oneofKey, []string{
+							"x_one1_int32",
+						})
+					}
+					decoder.ObjectBeforeReadValue() // Before read object value
+					switch {
+					case oneofKey == "x_one1_int32":
+						decoder.MarkPresent("x_one1_int32")
+						value := decoder.ReadItem()
+						x, err := jsondecoder.ParseInt32(value)
+						if err != nil {
+							return fmt.Errorf("json: cannot unmarshal %s into field %s of type int32", string(value), objKey)
+						}
+						if oneofOneofTisStore {
+							return fmt.Errorf("json: unmarshal: the field %s is type oneof, allow contains only one", objKey)
+						}
+						oneofOneofTisStore = true
+						ot := new(KeyTemplate1_One1Int32)
+						ot.One1Int32 = x
+						this.OneofT = ot
+					default:
+						if opts.DisallowUnknownFields {
+							return fmt.Errorf("json: unknown oneof field %q", oneofKey)
+						}
+						_ = decoder.ReadItem() // discard unknown field
+					}
+					if decoder.ObjectAfterReadValue() { // After read object value
+						break LOOP_ONEOF_OneofT
+					}
+				}
+				decoder.ScanNext()
+			}
+		default:
+			if opts.DisallowUnknownFields {
+				return fmt.Errorf("json: unknown field %q", objKey)
+			}
+			_ = decoder.ReadItem() // discard unknown field
+		}
+		if decoder.ObjectAfterReadValue() { // After read object value
+			break LOOP_OBJECT
+		}
+	}
+	decoder.ScanNext()
+
+	if err = decoder.ScanError(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON for implements interface json.Marshaler.
+func (this *KeyTemplate2) MarshalJSON() ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONIndent is like MarshalJSON but applies prefix and indent to format the output.
+func (this *KeyTemplate2) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Prefix:     prefix,
+		Indent:     indent,
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONFields is like MarshalJSON but only encodes the fields selected by mask,
+// all fields are encoded if mask is empty. The paths are made of the json keys, and an
+// error is returned if any path selects no field. See jsonencoder.FieldMask for details.
+func (this *KeyTemplate2) MarshalJSONFields(mask *fieldmaskpb.FieldMask) ([]byte, error) {
+	fieldMask := jsonencoder.NewFieldMask(mask.GetPaths())
+	if err := this.CheckJSONFieldMask(fieldMask); err != nil {
+		return nil, err
+	}
+	return this.MarshalJSONFieldsWithOptions(fieldMask, jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// CheckJSONFieldMask for implements jsonencoder.FieldMaskChecker.
+func (this *KeyTemplate2) CheckJSONFieldMask(mask jsonencoder.FieldMask) error {
+	for _, name := range mask.Names() {
+		var err error
+		switch name {
+		case "tInt32V1":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "tStringV1":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		default:
+			err = &jsonencoder.FieldMaskError{Path: name}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSONWithOptions for implements jsonencoder.Marshaler.
+func (this *KeyTemplate2) MarshalJSONWithOptions(opts jsonencoder.Options) ([]byte, error) {
+	return this.MarshalJSONFieldsWithOptions(nil, opts)
+}
+
+// MarshalJSONFieldsWithOptions for implements jsonencoder.FieldsMarshaler.
+func (this *KeyTemplate2) MarshalJSONFieldsWithOptions(mask jsonencoder.FieldMask, opts jsonencoder.Options) ([]byte, error) {
+	if this == nil {
+		return []byte("null"), nil
+	}
+	encoder := jsonencoder.NewWithOptions(44, opts)
+	if err := this.marshalJSONFieldsTo(encoder, mask); err != nil {
+		return nil, err
+	}
+	return encoder.Output()
+}
+
+// MarshalJSONTo for implements jsonencoder.EncoderMarshaler.
+// It appends the JSON object into encoder with the options of encoder.
+func (this *KeyTemplate2) MarshalJSONTo(encoder *jsonencoder.Encoder) error {
+	return this.marshalJSONFieldsTo(encoder, nil)
+}
+
+// marshalJSONFieldsTo appends the fields selected by mask into encoder.
+func (this *KeyTemplate2) marshalJSONFieldsTo(encoder *jsonencoder.Encoder, mask jsonencoder.FieldMask) error {
+	if this == nil {
+		encoder.AppendNil()
+		return nil
+	}
+	var err error
+
+	// Add JSON end identifier
+	encoder.AppendObjectBegin()
+
+	if mask.Has("tInt32V1") {
+		// encode filed type of basic; | field: gojsontest.KeyTemplate2.t_int32 | kind: Int32Kind | GoName: TInt32 | omitempty: false | ignore: false
+		encoder.AppendObjectKey("tInt32V1")
+		encoder.AppendInt32(this.TInt32)
+	}
+	if mask.Has("tStringV1") {
+		// encode filed type of basic; | field: gojsontest.KeyTemplate2.t_string | kind: StringKind | GoName: TString | omitempty: false | ignore: false
+		encoder.AppendObjectKey("tStringV1")
+		encoder.AppendString(this.TString)
+	}
+
+	// Add JSON end identifier
+	encoder.AppendObjectEnd()
+	return err
+}
+
+// UnmarshalJSON for implements json.Unmarshaler.
+func (this *KeyTemplate2) UnmarshalJSON(b []byte) error {
+	return this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+	})
+}
+
+// UnmarshalJSONFields is like UnmarshalJSON but also returns the paths of fields that present in b.
+// A message field is present by the paths of its nested fields, or by itself if the value is null or
+// an empty object. The fields in the elements of repeated and map fields are not reported.
+func (this *KeyTemplate2) UnmarshalJSONFields(b []byte) (*fieldmaskpb.FieldMask, error) {
+	present := &jsondecoder.FieldPaths{}
+	err := this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+		Present:               present,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: present.Leaves()}, nil
+}
+
+// UnmarshalJSONWithOptions for implements jsondecoder.Unmarshaler.
+func (this *KeyTemplate2) UnmarshalJSONWithOptions(b []byte, opts jsondecoder.Options) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*KeyTemplate2) is nil")
+	}
+	decoder, err := jsondecoder.NewWithOptions(b, opts)
+	if err != nil {
+		return err
+	}
+	return this.UnmarshalJSONFrom(decoder)
+}
+
+// UnmarshalJSONFrom for implements jsondecoder.DecoderUnmarshaler.
+// It decodes the next value of decoder with the options of decoder, the value is decoded in place
+// if the decoder is already at the beginning of it.
+func (this *KeyTemplate2) UnmarshalJSONFrom(decoder *jsondecoder.Decoder) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*KeyTemplate2) is nil")
+	}
+	var err error
+	opts := decoder.Options()
+
+	// check null.
+	decoder.ScanBeginValue()
+	if decoder.OpCode == jsondecoder.ScanBeginLiteral {
+		value := decoder.ReadItem()
+		if value[0] != 'n' {
+			return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+		}
+		return nil // value is null
+	}
+	if decoder.OpCode != jsondecoder.ScanBeginObject {
+		value := decoder.ReadItem()
+		return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+	}
+
+	// Scan begin.
+LOOP_OBJECT:
+	for {
+		if err = decoder.ScanError(); err != nil {
+			return err
+		}
+		if decoder.ObjectBeforeReadKey() { // before read object key
+			break LOOP_OBJECT
+		}
+
+		objKey := decoder.ReadObjectKey() // Read key
+		_ = objKey                        // avoid objKey not used
+		if opts.CaseInsensitiveKeys {
+			objKey = jsondecoder.MatchKey(objKey, []string{
+				"tInt32V1",
+				"tStringV1",
+			})
+		}
+		decoder.ObjectBeforeReadValue() // Before read object value
+		switch {                        // process field with key.
+		case objKey == "tInt32V1":
+			decoder.MarkPresent("tInt32V1")
+			// decode filed type of basic; | field: gojsontest.KeyTemplate2.t_int32 | kind: Int32Kind | GoName: TInt32
+			value := decoder.ReadItem()
+			x, err := jsondecoder.ParseInt32(value)
+			if err != nil {
+				return fmt.Errorf("json: cannot unmarshal %s into field %s of type int32", string(value), objKey)
+			}
+			this.TInt32 = x
+		case objKey == "tStringV1":
+			decoder.MarkPresent("tStringV1")
+			// decode filed type of basic; | field: gojsontest.KeyTemplate2.t_string | kind: StringKind | GoName: TString
+			value := decoder.ReadItem()
+			var x string
+			if value[0] != 'n' { // 'n' means null
+				var ok bool
+				x, ok = jsondecoder.UnquoteString(value)
+				if !ok {
+					return fmt.Errorf("json: cannot unmarshal %s into field %s of type string", string(value), objKey)
+				}
+			}
+			this.TString = x
+		default:
+			if opts.DisallowUnknownFields {
+				return fmt.Errorf("json: unknown field %q", objKey)
+			}
+			_ = decoder.ReadItem() // discard unknown field
+		}
+		if decoder.ObjectAfterReadValue() { // After read object value
+			break LOOP_OBJECT
+		}
+	}
+	decoder.ScanNext()
+
+	if err = decoder.ScanError(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalJSON for implements interface json.Marshaler.
+func (this *KeyTemplate3) MarshalJSON() ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONIndent is like MarshalJSON but applies prefix and indent to format the output.
+func (this *KeyTemplate3) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Prefix:     prefix,
+		Indent:     indent,
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONFields is like MarshalJSON but only encodes the fields selected by mask,
+// all fields are encoded if mask is empty. The paths are made of the json keys, and an
+// error is returned if any path selects no field. See jsonencoder.FieldMask for details.
+func (this *KeyTemplate3) MarshalJSONFields(mask *fieldmaskpb.FieldMask) ([]byte, error) {
+	fieldMask := jsonencoder.NewFieldMask(mask.GetPaths())
+	if err := this.CheckJSONFieldMask(fieldMask); err != nil {
+		return nil, err
+	}
+	return this.MarshalJSONFieldsWithOptions(fieldMask, jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// CheckJSONFieldMask for implements jsonencoder.FieldMaskChecker.
+func (this *KeyTemplate3) CheckJSONFieldMask(mask jsonencoder.FieldMask) error {
+	for _, name := range mask.Names() {
+		var err error
+		switch name {
+		case "\"t_int32\\%v":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "\"t_child\\%v":
+			err = jsonencoder.CheckFieldMask(name, mask[name], (*KeyTemplate3)(nil))
+		case "\"OneofT\\%v":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		case "\"one1_int32\\%v":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		default:
+			err = &jsonencoder.FieldMaskError{Path: name}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSONWithOptions for implements jsonencoder.Marshaler.
+func (this *KeyTemplate3) MarshalJSONWithOptions(opts jsonencoder.Options) ([]byte, error) {
+	return this.MarshalJSONFieldsWithOptions(nil, opts)
+}
+
+// MarshalJSONFieldsWithOptions for implements jsonencoder.FieldsMarshaler.
+func (this *KeyTemplate3) MarshalJSONFieldsWithOptions(mask jsonencoder.FieldMask, opts jsonencoder.Options) ([]byte, error) {
+	if this == nil {
+		return []byte("null"), nil
+	}
+	encoder := jsonencoder.NewWithOptions(78, opts)
+	if err := this.marshalJSONFieldsTo(encoder, mask); err != nil {
+		return nil, err
+	}
+	return encoder.Output()
+}
+
+// MarshalJSONTo for implements jsonencoder.EncoderMarshaler.
+// It appends the JSON object into encoder with the options of encoder.
+func (this *KeyTemplate3) MarshalJSONTo(encoder *jsonencoder.Encoder) error {
+	return this.marshalJSONFieldsTo(encoder, nil)
+}
+
+// marshalJSONFieldsTo appends the fields selected by mask into encoder.
+func (this *KeyTemplate3) marshalJSONFieldsTo(encoder *jsonencoder.Encoder, mask jsonencoder.FieldMask) error {
+	if this == nil {
+		encoder.AppendNil()
+		return nil
+	}
+	var err error
+
+	// Add JSON end identifier
+	encoder.AppendObjectBegin()
+
+	if mask.Has("\"t_int32\\%v") {
+		// encode filed type of basic; | field: gojsontest.KeyTemplate3.t_int32 | kind: Int32Kind | GoName: TInt32 | omitempty: false | ignore: false
+		encoder.AppendObjectKey("\"t_int32\\%v")
+		encoder.AppendInt32(this.TInt32)
+	}
+	if mask.Has("\"t_child\\%v") {
+		// encode filed type of basic; | field: gojsontest.KeyTemplate3.t_child | kind: MessageKind | GoName: TChild | omitempty: false | ignore: false
+		encoder.AppendObjectKey("\"t_child\\%v")
+		err = encoder.AppendInterfaceFields(this.TChild, mask.Sub("\"t_child\\%v"))
+		if err != nil {
+			return err
+		}
+	}
+	// Encode field type of oneof; | field: gojsontest.KeyTemplate3.OneofT | GoName: OneofT | omitempty: false | ignore: false
+	if mask.Has("\"OneofT\\%v", "\"one1_int32\\%v") {
+		if this.OneofT != nil {
+			switch v := this.OneofT.(type) {
+			case *KeyTemplate3_One1Int32:
+				if mask.Has("\"OneofT\\%v", "\"one1_int32\\%v") {
+					// encode filed type of basic; | field: gojsontest.KeyTemplate3.one1_int32 | kind: Int32Kind | GoName: One1Int32 | omitempty: false | ignore: false
+					encoder.AppendObjectKey("\"OneofT\\%v")
+					encoder.AppendObjectBegin()
+					encoder.AppendObjectKey("\"one1_int32\\%v")
+					encoder.AppendInt32(v.One1Int32)
+					encoder.AppendObjectEnd()
+				}
+			default:
+				return fmt.Errorf("invalid oneof field type: %v, jsonKey: %s, goName: OneofT, field: gojsontest.KeyTemplate3.OneofT", v, "\"OneofT\\%v")
+			}
+		} else {
+			encoder.AppendObjectKey("\"OneofT\\%v")
+			encoder.AppendNil()
+		}
+	}
+
+	// Add JSON end identifier
+	encoder.AppendObjectEnd()
+	return err
+}
+
+// UnmarshalJSON for implements json.Unmarshaler.
+func (this *KeyTemplate3) UnmarshalJSON(b []byte) error {
+	return this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+	})
+}
+
+// UnmarshalJSONFields is like UnmarshalJSON but also returns the paths of fields that present in b.
+// A message field is present by the paths of its nested fields, or by itself if the value is null or
+// an empty object. The fields in the elements of repeated and map fields are not reported.
+func (this *KeyTemplate3) UnmarshalJSONFields(b []byte) (*fieldmaskpb.FieldMask, error) {
+	present := &jsondecoder.FieldPaths{}
+	err := this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+		Present:               present,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: present.Leaves()}, nil
+}
+
+// UnmarshalJSONWithOptions for implements jsondecoder.Unmarshaler.
+func (this *KeyTemplate3) UnmarshalJSONWithOptions(b []byte, opts jsondecoder.Options) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*KeyTemplate3) is nil")
+	}
+	decoder, err := jsondecoder.NewWithOptions(b, opts)
+	if err != nil {
+		return err
+	}
+	return this.UnmarshalJSONFrom(decoder)
+}
+
+// UnmarshalJSONFrom for implements jsondecoder.DecoderUnmarshaler.
+// It decodes the next value of decoder with the options of decoder, the value is decoded in place
+// if the decoder is already at the beginning of it.
+func (this *KeyTemplate3) UnmarshalJSONFrom(decoder *jsondecoder.Decoder) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*KeyTemplate3) is nil")
+	}
+	var err error
+	opts := decoder.Options()
+	var oneofOneofTisStore bool
+	var requiredTInt32isStore bool
+
+	// check null.
+	decoder.ScanBeginValue()
+	if decoder.OpCode == jsondecoder.ScanBeginLiteral {
+		value := decoder.ReadItem()
+		if value[0] != 'n' {
+			return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+		}
+		return nil // value is null
+	}
+	if decoder.OpCode != jsondecoder.ScanBeginObject {
+		value := decoder.ReadItem()
+		return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+	}
+
+	// Scan begin.
+LOOP_OBJECT:
+	for {
+		if err = decoder.ScanError(); err != nil {
+			return err
+		}
+		if decoder.ObjectBeforeReadKey() { // before read object key
+			break LOOP_OBJECT
+		}
+
+		objKey := decoder.ReadObjectKey() // Read key
+		_ = objKey                        // avoid objKey not used
+		if opts.CaseInsensitiveKeys {
+			objKey = jsondecoder.MatchKey(objKey, []string{
+				"\"t_int32\\%v",
+				"\"t_child\\%v",
+				"\"OneofT\\%v",
+			})
+		}
+		decoder.ObjectBeforeReadValue() // Before read object value
+		switch {                        // process field with key.
+		case objKey == "\"t_int32\\%v":
+			decoder.MarkPresent("\"t_int32\\%v")
+			requiredTInt32isStore = true
+			// decode filed type of basic; | field: gojsontest.KeyTemplate3.t_int32 | kind: Int32Kind | GoName: TInt32
+			value := decoder.ReadItem()
+			x, err := jsondecoder.ParseInt32(value)
+			if err != nil {
+				return fmt.Errorf("json: cannot unmarshal %s into field %s of type int32", string(value), objKey)
+			}
+			this.TInt32 = x
+		case objKey == "\"t_child\\%v":
+			decoder.MarkPresent("\"t_child\\%v")
+			// decode filed type of basic; | field: gojsontest.KeyTemplate3.t_child | kind: MessageKind | GoName: TChild
+			var x *KeyTemplate3
+			if !decoder.ReadNull() {
+				if this.TChild == nil {
+					x = new(KeyTemplate3)
+				} else {
+					x = this.TChild
+				}
+				if um, ok := interface{}(x).(jsondecoder.DecoderUnmarshaler); ok {
+					err = decoder.UnmarshalNestedField(um, "\"t_child\\%v")
+				} else {
+					value := decoder.ReadItem()
+					if um, ok := interface{}(x).(jsondecoder.Unmarshaler); ok {
+						err = um.UnmarshalJSONWithOptions(value, decoder.NestedFieldOptions("\"t_child\\%v"))
+					} else if um, ok := interface{}(x).(json.Unmarshaler); ok {
+						err = um.UnmarshalJSON(value)
+					} else {
+						err = json.Unmarshal(value, x)
+					}
+				}
+				if err != nil {
+					return err
+				}
+			}
+			this.TChild = x
+		case objKey == "\"OneofT\\%v":
+			// decode filed type of oneof; | field: gojsontest.KeyTemplate3.OneofT | GoName: OneofT
+			if decoder.OpCode == jsondecoder.ScanBeginLiteral {
+				value := decoder.ReadItem()
+				if value[0] != 'n' {
+					return fmt.Errorf("json: cannot unmarshal %s as oneof into field %s of type int32", string(value), objKey)
+				}
+			} else {
+				if decoder.OpCode != jsondecoder.ScanBeginObject {
+					value := decoder.ReadItem()
+					return fmt.Errorf("json: cannot unmarshal %s as oneof into field %s of type int32", string(value), objKey)
+				}
+			LOOP_ONEOF_OneofT:
+				for {
+					if decoder.ObjectBeforeReadKey() { // before read object key
+						break LOOP_ONEOF_OneofT
+					}
+					oneofKey := decoder.ReadObjectKey() // Read key
+					if opts.CaseInsensitiveKeys {
+						oneofKey = jsondecoder.MatchKey(oneofKey, []string{
+							"\"one1_int32\\%v",
+						})
+					}
+					decoder.ObjectBeforeReadValue() // Before read object value
+					switch {
+					case oneofKey == "\"one1_int32\\%v":
+						decoder.MarkPresent("\"one1_int32\\%v")
+						value := decoder.ReadItem()
+						x, err := jsondecoder.ParseInt32(value)
+						if err != nil {
+							return fmt.Errorf("json: cannot unmarshal %s into field %s of type int32", string(value), objKey)
+						}
+						if oneofOneofTisStore {
+							return fmt.Errorf("json: unmarshal: the field %s is type oneof, allow contains only one", objKey)
+						}
+						oneofOneofTisStore = true
+						ot := new(KeyTemplate3_One1Int32)
+						ot.One1Int32 = x
+						this.OneofT = ot
+					default:
+						if opts.DisallowUnknownFields {
+							return fmt.Errorf("json: unknown oneof field %q", oneofKey)
+						}
+						_ = decoder.ReadItem() // discard unknown field
+					}
+					if decoder.ObjectAfterReadValue() { // After read object value
+						break LOOP_ONEOF_OneofT
+					}
+				}
+				decoder.ScanNext()
+			}
+		default:
+			if opts.DisallowUnknownFields {
+				return fmt.Errorf("json: unknown field %q", objKey)
+			}
+			_ = decoder.ReadItem() // discard unknown field
+		}
+		if decoder.ObjectAfterReadValue() { // After read object value
+			break LOOP_OBJECT
+		}
+	}
+	decoder.ScanNext()
+
+	if err = decoder.ScanError(); err != nil {
+		return err
+	}
+
+	// check required fields.
+	if opts.RequireFields {
+		if !requiredTInt32isStore {
+			return fmt.Errorf("json: missing required field %q", "\"t_int32\\%v")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,373 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: xgo/tests/gojsontest/gojson_key_template.proto
+
+package gojsontest
+
+import (
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type KeyTemplate1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TInt32  int32  `protobuf:"varint,1,opt,name=t_int32,json=tInt32,proto3" json:"t_int32,omitempty"`
+	TString string `protobuf:"bytes,2,opt,name=t_string,json=tString,proto3" json:"t_string,omitempty"`
+	// Types that are assignable to OneofT:
+	//	*KeyTemplate1_One1Int32
+	OneofT isKeyTemplate1_OneofT `protobuf_oneof:"OneofT"`
+}
+
+func (x *KeyTemplate1) Reset() {
+	*x = KeyTemplate1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyTemplate1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyTemplate1) ProtoMessage() {}
+
+func (x *KeyTemplate1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyTemplate1.ProtoReflect.Descriptor instead.
+func (*KeyTemplate1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KeyTemplate1) GetTInt32() int32 {
+	if x != nil {
+		return x.TInt32
+	}
+	return 0
+}
+
+func (x *KeyTemplate1) GetTString() string {
+	if x != nil {
+		return x.TString
+	}
+	return ""
+}
+
+func (m *KeyTemplate1) GetOneofT() isKeyTemplate1_OneofT {
+	if m != nil {
+		return m.OneofT
+	}
+	return nil
+}
+
+func (x *KeyTemplate1) GetOne1Int32() int32 {
+	if x, ok := x.GetOneofT().(*KeyTemplate1_One1Int32); ok {
+		return x.One1Int32
+	}
+	return 0
+}
+
+type isKeyTemplate1_OneofT interface {
+	isKeyTemplate1_OneofT()
+}
+
+type KeyTemplate1_One1Int32 struct {
+	One1Int32 int32 `protobuf:"varint,11,opt,name=one1_int32,json=one1Int32,proto3,oneof"`
+}
+
+func (*KeyTemplate1_One1Int32) isKeyTemplate1_OneofT() {}
+
+type KeyTemplate2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TInt32  int32  `protobuf:"varint,1,opt,name=t_int32,json=tInt32,proto3" json:"t_int32,omitempty"`
+	TString string `protobuf:"bytes,2,opt,name=t_string,json=tString,proto3" json:"t_string,omitempty"`
+}
+
+func (x *KeyTemplate2) Reset() {
+	*x = KeyTemplate2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyTemplate2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyTemplate2) ProtoMessage() {}
+
+func (x *KeyTemplate2) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyTemplate2.ProtoReflect.Descriptor instead.
+func (*KeyTemplate2) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KeyTemplate2) GetTInt32() int32 {
+	if x != nil {
+		return x.TInt32
+	}
+	return 0
+}
+
+func (x *KeyTemplate2) GetTString() string {
+	if x != nil {
+		return x.TString
+	}
+	return ""
+}
+
+type KeyTemplate3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TInt32 int32         `protobuf:"varint,1,opt,name=t_int32,json=tInt32,proto3" json:"t_int32,omitempty"`
+	TChild *KeyTemplate3 `protobuf:"bytes,2,opt,name=t_child,json=tChild,proto3" json:"t_child,omitempty"`
+	// Types that are assignable to OneofT:
+	//	*KeyTemplate3_One1Int32
+	OneofT isKeyTemplate3_OneofT `protobuf_oneof:"OneofT"`
+}
+
+func (x *KeyTemplate3) Reset() {
+	*x = KeyTemplate3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyTemplate3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyTemplate3) ProtoMessage() {}
+
+func (x *KeyTemplate3) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyTemplate3.ProtoReflect.Descriptor instead.
+func (*KeyTemplate3) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KeyTemplate3) GetTInt32() int32 {
+	if x != nil {
+		return x.TInt32
+	}
+	return 0
+}
+
+func (x *KeyTemplate3) GetTChild() *KeyTemplate3 {
+	if x != nil {
+		return x.TChild
+	}
+	return nil
+}
+
+func (m *KeyTemplate3) GetOneofT() isKeyTemplate3_OneofT {
+	if m != nil {
+		return m.OneofT
+	}
+	return nil
+}
+
+func (x *KeyTemplate3) GetOne1Int32() int32 {
+	if x, ok := x.GetOneofT().(*KeyTemplate3_One1Int32); ok {
+		return x.One1Int32
+	}
+	return 0
+}
+
+type isKeyTemplate3_OneofT interface {
+	isKeyTemplate3_OneofT()
+}
+
+type KeyTemplate3_One1Int32 struct {
+	One1Int32 int32 `protobuf:"varint,11,opt,name=one1_int32,json=one1Int32,proto3,oneof"`
+}
+
+func (*KeyTemplate3_One1Int32) isKeyTemplate3_OneofT() {}
+
+var File_xgo_tests_gojsontest_gojson_key_template_proto protoreflect.FileDescriptor
+
+var file_xgo_tests_gojsontest_gojson_key_template_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x78, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x6b, 0x65,
+	0x79, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0a, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x77,
+	0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x31, 0x12, 0x17,
+	0x0a, 0x07, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x23, 0x0a, 0x08, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0x8a, 0xf7, 0x02, 0x04, 0x0a,
+	0x02, 0x74, 0x73, 0x52, 0x07, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0a,
+	0x6f, 0x6e, 0x65, 0x31, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x09, 0x6f, 0x6e, 0x65, 0x31, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x42, 0x08, 0x0a,
+	0x06, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x54, 0x22, 0x54, 0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x32, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x3a, 0x10, 0xca, 0xb8, 0x02,
+	0x0c, 0x08, 0x06, 0x42, 0x08, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x56, 0x31, 0x22, 0x9f, 0x01,
+	0x0a, 0x0c, 0x4b, 0x65, 0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x33, 0x12, 0x1f,
+	0x0a, 0x07, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x06, 0x8a, 0xf7, 0x02, 0x02, 0x28, 0x01, 0x52, 0x06, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x31, 0x0a, 0x07, 0x74, 0x5f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x18, 0x2e, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4b, 0x65,
+	0x79, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x33, 0x52, 0x06, 0x74, 0x43, 0x68, 0x69,
+	0x6c, 0x64, 0x12, 0x1f, 0x0a, 0x0a, 0x6f, 0x6e, 0x65, 0x31, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x6e, 0x65, 0x31, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x3a, 0x10, 0xca, 0xb8, 0x02, 0x0c, 0x42, 0x0a, 0x22, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x5c, 0x25, 0x76, 0x42, 0x08, 0x0a, 0x06, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x54, 0x42,
+	0x20, 0x5a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x74,
+	0x65, 0x73, 0x74, 0x8a, 0xfa, 0x01, 0x0a, 0x42, 0x08, 0x78, 0x5f, 0x7b, 0x6e, 0x61, 0x6d, 0x65,
+	0x7d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescOnce sync.Once
+	file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescData = file_xgo_tests_gojsontest_gojson_key_template_proto_rawDesc
+)
+
+func file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescGZIP() []byte {
+	file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescOnce.Do(func() {
+		file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescData = protoimpl.X.CompressGZIP(file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescData)
+	})
+	return file_xgo_tests_gojsontest_gojson_key_template_proto_rawDescData
+}
+
+var file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_xgo_tests_gojsontest_gojson_key_template_proto_goTypes = []interface{}{
+	(*KeyTemplate1)(nil), // 0: gojsontest.KeyTemplate1
+	(*KeyTemplate2)(nil), // 1: gojsontest.KeyTemplate2
+	(*KeyTemplate3)(nil), // 2: gojsontest.KeyTemplate3
+}
+var file_xgo_tests_gojsontest_gojson_key_template_proto_depIdxs = []int32{
+	2, // 0: gojsontest.KeyTemplate3.t_child:type_name -> gojsontest.KeyTemplate3
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_xgo_tests_gojsontest_gojson_key_template_proto_init() }
+func file_xgo_tests_gojsontest_gojson_key_template_proto_init() {
+	if File_xgo_tests_gojsontest_gojson_key_template_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyTemplate1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyTemplate2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyTemplate3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*KeyTemplate1_One1Int32)(nil),
+	}
+	file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*KeyTemplate3_One1Int32)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xgo_tests_gojsontest_gojson_key_template_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_xgo_tests_gojsontest_gojson_key_template_proto_goTypes,
+		DependencyIndexes: file_xgo_tests_gojsontest_gojson_key_template_proto_depIdxs,
+		MessageInfos:      file_xgo_tests_gojsontest_gojson_key_template_proto_msgTypes,
+	}.Build()
+	File_xgo_tests_gojsontest_gojson_key_template_proto = out.File
+	file_xgo_tests_gojsontest_gojson_key_template_proto_rawDesc = nil
+	file_xgo_tests_gojsontest_gojson_key_template_proto_goTypes = nil
+	file_xgo_tests_gojsontest_gojson_key_template_proto_depIdxs = nil
+}
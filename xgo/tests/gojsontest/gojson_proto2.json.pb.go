@@ -0,0 +1,288 @@
+// Code generated by protoc-gen-gojson. DO NOT EDIT.
+// versions:
+// 		protoc-gen-gojson 0.0.1
+// source: xgo/tests/gojsontest/gojson_proto2.proto
+
+package gojsontest
+
+import (
+	errors "errors"
+	fmt "fmt"
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	jsondecoder "github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
+	jsonencoder "github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
+	strconv "strconv"
+)
+
+// MarshalJSON for implements interface json.Marshaler.
+func (this *Proto2Message1) MarshalJSON() ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONIndent is like MarshalJSON but applies prefix and indent to format the output.
+func (this *Proto2Message1) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	return this.MarshalJSONWithOptions(jsonencoder.Options{
+		Prefix:     prefix,
+		Indent:     indent,
+		EscapeHTML: true,
+	})
+}
+
+// MarshalJSONFields is like MarshalJSON but only encodes the fields selected by mask,
+// all fields are encoded if mask is empty. The paths are made of the json keys, and an
+// error is returned if any path selects no field. See jsonencoder.FieldMask for details.
+func (this *Proto2Message1) MarshalJSONFields(mask *fieldmaskpb.FieldMask) ([]byte, error) {
+	fieldMask := jsonencoder.NewFieldMask(mask.GetPaths())
+	if err := this.CheckJSONFieldMask(fieldMask); err != nil {
+		return nil, err
+	}
+	return this.MarshalJSONFieldsWithOptions(fieldMask, jsonencoder.Options{
+		Indent:     "",
+		EscapeHTML: true,
+	})
+}
+
+// CheckJSONFieldMask for implements jsonencoder.FieldMaskChecker.
+func (this *Proto2Message1) CheckJSONFieldMask(mask jsonencoder.FieldMask) error {
+	for _, name := range mask.Names() {
+		var err error
+		switch name {
+		case "t_color":
+			err = jsonencoder.CheckFieldMask(name, mask[name], nil)
+		default:
+			err = &jsonencoder.FieldMaskError{Path: name}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalJSONWithOptions for implements jsonencoder.Marshaler.
+func (this *Proto2Message1) MarshalJSONWithOptions(opts jsonencoder.Options) ([]byte, error) {
+	return this.MarshalJSONFieldsWithOptions(nil, opts)
+}
+
+// MarshalJSONFieldsWithOptions for implements jsonencoder.FieldsMarshaler.
+func (this *Proto2Message1) MarshalJSONFieldsWithOptions(mask jsonencoder.FieldMask, opts jsonencoder.Options) ([]byte, error) {
+	if this == nil {
+		return []byte("null"), nil
+	}
+	encoder := jsonencoder.NewWithOptions(20, opts)
+	if err := this.marshalJSONFieldsTo(encoder, mask); err != nil {
+		return nil, err
+	}
+	return encoder.Output()
+}
+
+// MarshalJSONTo for implements jsonencoder.EncoderMarshaler.
+// It appends the JSON object into encoder with the options of encoder.
+func (this *Proto2Message1) MarshalJSONTo(encoder *jsonencoder.Encoder) error {
+	return this.marshalJSONFieldsTo(encoder, nil)
+}
+
+// marshalJSONFieldsTo appends the fields selected by mask into encoder.
+func (this *Proto2Message1) marshalJSONFieldsTo(encoder *jsonencoder.Encoder, mask jsonencoder.FieldMask) error {
+	if this == nil {
+		encoder.AppendNil()
+		return nil
+	}
+	var err error
+
+	// Add JSON end identifier
+	encoder.AppendObjectBegin()
+
+	if mask.Has("t_color") {
+		// encode filed type of basic; | field: gojsontest.Proto2Message1.t_color | kind: EnumKind | GoName: TColor | omitempty: false | ignore: false
+		encoder.AppendObjectKey("t_color")
+		switch this.TColor.Number() {
+		case 0:
+			encoder.AppendString("unspecified")
+		case 1:
+			encoder.AppendString("red")
+		default:
+			encoder.AppendString(strconv.FormatInt(int64(this.TColor.Number()), 10))
+		}
+	}
+
+	// Add JSON end identifier
+	encoder.AppendObjectEnd()
+	return err
+}
+
+// UnmarshalJSON for implements json.Unmarshaler.
+func (this *Proto2Message1) UnmarshalJSON(b []byte) error {
+	return this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+	})
+}
+
+// UnmarshalJSONFields is like UnmarshalJSON but also returns the paths of fields that present in b.
+// A message field is present by the paths of its nested fields, or by itself if the value is null or
+// an empty object. The fields in the elements of repeated and map fields are not reported.
+func (this *Proto2Message1) UnmarshalJSONFields(b []byte) (*fieldmaskpb.FieldMask, error) {
+	present := &jsondecoder.FieldPaths{}
+	err := this.UnmarshalJSONWithOptions(b, jsondecoder.Options{
+		DisallowUnknownFields: false,
+		RequireFields:         true,
+		Present:               present,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &fieldmaskpb.FieldMask{Paths: present.Leaves()}, nil
+}
+
+// UnmarshalJSONWithOptions for implements jsondecoder.Unmarshaler.
+func (this *Proto2Message1) UnmarshalJSONWithOptions(b []byte, opts jsondecoder.Options) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*Proto2Message1) is nil")
+	}
+	decoder, err := jsondecoder.NewWithOptions(b, opts)
+	if err != nil {
+		return err
+	}
+	return this.UnmarshalJSONFrom(decoder)
+}
+
+// UnmarshalJSONFrom for implements jsondecoder.DecoderUnmarshaler.
+// It decodes the next value of decoder with the options of decoder, the value is decoded in place
+// if the decoder is already at the beginning of it.
+func (this *Proto2Message1) UnmarshalJSONFrom(decoder *jsondecoder.Decoder) error {
+	if this == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*Proto2Message1) is nil")
+	}
+	var err error
+	opts := decoder.Options()
+
+	// check null.
+	decoder.ScanBeginValue()
+	if decoder.OpCode == jsondecoder.ScanBeginLiteral {
+		value := decoder.ReadItem()
+		if value[0] != 'n' {
+			return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+		}
+		return nil // value is null
+	}
+	if decoder.OpCode != jsondecoder.ScanBeginObject {
+		value := decoder.ReadItem()
+		return fmt.Errorf("json: cannot unmarshal %s into object", string(value))
+	}
+
+	// Scan begin.
+LOOP_OBJECT:
+	for {
+		if err = decoder.ScanError(); err != nil {
+			return err
+		}
+		if decoder.ObjectBeforeReadKey() { // before read object key
+			break LOOP_OBJECT
+		}
+
+		objKey := decoder.ReadObjectKey() // Read key
+		_ = objKey                        // avoid objKey not used
+		if opts.CaseInsensitiveKeys {
+			objKey = jsondecoder.MatchKey(objKey, []string{
+				"t_color",
+			})
+		}
+		decoder.ObjectBeforeReadValue() // Before read object value
+		switch {                        // process field with key.
+		case objKey == "t_color":
+			decoder.MarkPresent("t_color")
+			// decode filed type of basic; | field: gojsontest.Proto2Message1.t_color | kind: EnumKind | GoName: TColor
+			value := decoder.ReadItem()
+			var x1 int32
+			ok := true
+			s, isString := jsondecoder.UnquoteString(value)
+			if !isString {
+				return fmt.Errorf("json: cannot unmarshal %s into field %s of type Proto2Color", string(value), objKey)
+			}
+			switch s {
+			case "unspecified":
+				x1 = 0
+			case "red":
+				x1 = 1
+			default:
+				ok = false
+			}
+			if !ok {
+				return fmt.Errorf("json: unknown enum value %s in field %s", string(value), objKey)
+			}
+			x := Proto2Color(x1)
+			this.TColor = &x
+		default:
+			if opts.DisallowUnknownFields {
+				return fmt.Errorf("json: unknown field %q", objKey)
+			}
+			_ = decoder.ReadItem() // discard unknown field
+		}
+		if decoder.ObjectAfterReadValue() { // After read object value
+			break LOOP_OBJECT
+		}
+	}
+	decoder.ScanNext()
+
+	if err = decoder.ScanError(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalText for implements interface encoding.TextMarshaler.
+func (x Proto2Color) MarshalText() ([]byte, error) {
+	switch x {
+	case 0:
+		return []byte("unspecified"), nil
+	case 1:
+		return []byte("red"), nil
+	default:
+		return []byte(strconv.FormatInt(int64(x), 10)), nil
+	}
+}
+
+// MarshalJSON for implements interface json.Marshaler.
+func (x Proto2Color) MarshalJSON() ([]byte, error) {
+	text, err := x.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	encoder := jsonencoder.New(len(text) + 2)
+	encoder.AppendString(string(text))
+	return encoder.Bytes(), nil
+}
+
+// UnmarshalText for implements interface encoding.TextUnmarshaler.
+func (x *Proto2Color) UnmarshalText(text []byte) error {
+	if x == nil {
+		return errors.New("json: Unmarshal: tests/gojsontest.(*Proto2Color) is nil")
+	}
+	encoder := jsonencoder.New(len(text) + 2)
+	encoder.AppendString(string(text))
+	value := encoder.Bytes()
+	var x1 int32
+	ok := true
+	s, isString := jsondecoder.UnquoteString(value)
+	if !isString {
+		return fmt.Errorf("json: cannot unmarshal %s into value of type Proto2Color", string(value))
+	}
+	switch s {
+	case "unspecified":
+		x1 = 0
+	case "red":
+		x1 = 1
+	default:
+		ok = false
+	}
+	if !ok {
+		return fmt.Errorf("json: unknown enum value %s of type Proto2Color", string(value))
+	}
+	*x = Proto2Color(x1)
+	return nil
+}
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: xgo/tests/gojsontest/gojson_proto2.proto
+
+package gojsontest
+
+import (
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Proto2Color for test the enum methods in proto2, the protoc-gen-go already
+// generates the method UnmarshalJSON for it.
+type Proto2Color int32
+
+const (
+	Proto2Color_PROTO2_COLOR_UNSPECIFIED Proto2Color = 0
+	Proto2Color_PROTO2_COLOR_RED         Proto2Color = 1
+)
+
+// Enum value maps for Proto2Color.
+var (
+	Proto2Color_name = map[int32]string{
+		0: "PROTO2_COLOR_UNSPECIFIED",
+		1: "PROTO2_COLOR_RED",
+	}
+	Proto2Color_value = map[string]int32{
+		"PROTO2_COLOR_UNSPECIFIED": 0,
+		"PROTO2_COLOR_RED":         1,
+	}
+)
+
+func (x Proto2Color) Enum() *Proto2Color {
+	p := new(Proto2Color)
+	*p = x
+	return p
+}
+
+func (x Proto2Color) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Proto2Color) Descriptor() protoreflect.EnumDescriptor {
+	return file_xgo_tests_gojsontest_gojson_proto2_proto_enumTypes[0].Descriptor()
+}
+
+func (Proto2Color) Type() protoreflect.EnumType {
+	return &file_xgo_tests_gojsontest_gojson_proto2_proto_enumTypes[0]
+}
+
+func (x Proto2Color) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Do not use.
+func (x *Proto2Color) UnmarshalJSON(b []byte) error {
+	num, err := protoimpl.X.UnmarshalJSONEnum(x.Descriptor(), b)
+	if err != nil {
+		return err
+	}
+	*x = Proto2Color(num)
+	return nil
+}
+
+// Deprecated: Use Proto2Color.Descriptor instead.
+func (Proto2Color) EnumDescriptor() ([]byte, []int) {
+	return file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescGZIP(), []int{0}
+}
+
+type Proto2Message1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TColor *Proto2Color `protobuf:"varint,1,opt,name=t_color,json=tColor,enum=gojsontest.Proto2Color" json:"t_color,omitempty"`
+}
+
+func (x *Proto2Message1) Reset() {
+	*x = Proto2Message1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_gojsontest_gojson_proto2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Proto2Message1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Proto2Message1) ProtoMessage() {}
+
+func (x *Proto2Message1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_gojsontest_gojson_proto2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Proto2Message1.ProtoReflect.Descriptor instead.
+func (*Proto2Message1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Proto2Message1) GetTColor() Proto2Color {
+	if x != nil && x.TColor != nil {
+		return *x.TColor
+	}
+	return Proto2Color_PROTO2_COLOR_UNSPECIFIED
+}
+
+var File_xgo_tests_gojsontest_gojson_proto2_proto protoreflect.FileDescriptor
+
+var file_xgo_tests_gojsontest_gojson_proto2_proto_rawDesc = []byte{
+	0x0a, 0x28, 0x78, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x67, 0x6f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6a, 0x73,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x42, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x32, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x07, 0x74, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x67, 0x6f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x06, 0x74, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x2a, 0x4d, 0x0a, 0x0b,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x18, 0x50,
+	0x52, 0x4f, 0x54, 0x4f, 0x32, 0x5f, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f,
+	0x54, 0x4f, 0x32, 0x5f, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x5f, 0x52, 0x45, 0x44, 0x10, 0x01, 0x1a,
+	0x0a, 0x8a, 0xf4, 0x03, 0x06, 0x08, 0x01, 0x10, 0x02, 0x18, 0x01, 0x42, 0x12, 0x5a, 0x10, 0x74,
+	0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x6a, 0x73, 0x6f, 0x6e, 0x74, 0x65, 0x73, 0x74,
+}
+
+var (
+	file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescOnce sync.Once
+	file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescData = file_xgo_tests_gojsontest_gojson_proto2_proto_rawDesc
+)
+
+func file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescGZIP() []byte {
+	file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescOnce.Do(func() {
+		file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescData = protoimpl.X.CompressGZIP(file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescData)
+	})
+	return file_xgo_tests_gojsontest_gojson_proto2_proto_rawDescData
+}
+
+var file_xgo_tests_gojsontest_gojson_proto2_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_xgo_tests_gojsontest_gojson_proto2_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_xgo_tests_gojsontest_gojson_proto2_proto_goTypes = []interface{}{
+	(Proto2Color)(0),       // 0: gojsontest.Proto2Color
+	(*Proto2Message1)(nil), // 1: gojsontest.Proto2Message1
+}
+var file_xgo_tests_gojsontest_gojson_proto2_proto_depIdxs = []int32{
+	0, // 0: gojsontest.Proto2Message1.t_color:type_name -> gojsontest.Proto2Color
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_xgo_tests_gojsontest_gojson_proto2_proto_init() }
+func file_xgo_tests_gojsontest_gojson_proto2_proto_init() {
+	if File_xgo_tests_gojsontest_gojson_proto2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xgo_tests_gojsontest_gojson_proto2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Proto2Message1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xgo_tests_gojsontest_gojson_proto2_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_xgo_tests_gojsontest_gojson_proto2_proto_goTypes,
+		DependencyIndexes: file_xgo_tests_gojsontest_gojson_proto2_proto_depIdxs,
+		EnumInfos:         file_xgo_tests_gojsontest_gojson_proto2_proto_enumTypes,
+		MessageInfos:      file_xgo_tests_gojsontest_gojson_proto2_proto_msgTypes,
+	}.Build()
+	File_xgo_tests_gojsontest_gojson_proto2_proto = out.File
+	file_xgo_tests_gojsontest_gojson_proto2_proto_rawDesc = nil
+	file_xgo_tests_gojsontest_gojson_proto2_proto_goTypes = nil
+	file_xgo_tests_gojsontest_gojson_proto2_proto_depIdxs = nil
+}
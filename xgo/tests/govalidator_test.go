@@ -4,15 +4,25 @@ import (
 	"bytes"
 	"fmt"
 	"io/ioutil"
+	"math"
+	"net"
 	"reflect"
 	"testing"
+	"time"
 	"unicode/utf8"
 
 	"github.com/stretchr/testify/require"
 	"github.com/yu31/protoc-plugin/xgo/pkg/protovalidator"
 	"github.com/yu31/protoc-plugin/xgo/tests/govalidatortest"
 	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/language"
 	"golang.org/x/text/transform"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type CaseDesc struct {
@@ -3239,6 +3249,308 @@ func Test_GoValidator_ValidBytesTags1(t *testing.T) {
 	}
 }
 
+func Test_GoValidator_ValidNumberTags1(t *testing.T) {
+	data := &govalidatortest.ValidNumberTags1{}
+	{
+		err := data.Validate()
+		require.NotNil(t, err)
+	}
+
+	cases := []*CaseDesc{
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagIntRange),
+			FieldDesc:  "field 't_int_range1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagIntRange, Value: "[1, 100)"},
+			FieldValue: func() interface{} { return data.TIntRange1 },
+			BeforeFunc: func() { data.TIntRange1 = 100 },
+			AfterFunc:  func() { data.TIntRange1 = 1 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagIntRange),
+			FieldDesc:  "field 't_int_range2'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagIntRange, Value: "(0, ]"},
+			FieldValue: func() interface{} { return data.TIntRange2 },
+			BeforeFunc: func() { x := int64(0); data.TIntRange2 = &x },
+			AfterFunc:  func() { x := int64(1); data.TIntRange2 = &x },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagIntMultipleOf),
+			FieldDesc:  "field 't_int_multiple_of1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagIntMultipleOf, Value: 5},
+			FieldValue: func() interface{} { return data.TIntMultipleOf1 },
+			BeforeFunc: func() { data.TIntMultipleOf1 = -7 },
+			AfterFunc:  func() { data.TIntMultipleOf1 = -15 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagUintRange),
+			FieldDesc:  "field 't_uint_range1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagUintRange, Value: "(10, 20]"},
+			FieldValue: func() interface{} { return data.TUintRange1 },
+			BeforeFunc: func() { data.TUintRange1 = 10 },
+			AfterFunc:  func() { data.TUintRange1 = 20 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagUintMultipleOf),
+			FieldDesc:  "field 't_uint_multiple_of1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagUintMultipleOf, Value: 1024},
+			FieldValue: func() interface{} { return data.TUintMultipleOf1 },
+			BeforeFunc: func() { x := uint64(1000); data.TUintMultipleOf1 = &x },
+			AfterFunc:  func() { x := uint64(4096); data.TUintMultipleOf1 = &x },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatRange),
+			FieldDesc:  "field 't_double_range1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatRange, Value: "[0, 1)"},
+			FieldValue: func() interface{} { return data.TDoubleRange1 },
+			BeforeFunc: func() { data.TDoubleRange1 = math.NaN() },
+			AfterFunc:  func() { data.TDoubleRange1 = 0.99 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatRange),
+			FieldDesc:  "field 't_float_range1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatRange, Value: "(-0.5, ]"},
+			FieldValue: func() interface{} { return data.TFloatRange1 },
+			BeforeFunc: func() { data.TFloatRange1 = -0.5 },
+			AfterFunc:  func() { data.TFloatRange1 = float32(math.Inf(1)) },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatMultipleOf),
+			FieldDesc:  "field 't_double_multiple_of1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatMultipleOf, Value: 0.05},
+			FieldValue: func() interface{} { return data.TDoubleMultipleOf1 },
+			BeforeFunc: func() { data.TDoubleMultipleOf1 = 0.33 },
+			AfterFunc:  func() { data.TDoubleMultipleOf1 = 0.35 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatMultipleOf),
+			FieldDesc:  "field 't_float_multiple_of1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatMultipleOf, Value: 0.1},
+			FieldValue: func() interface{} { return data.TFloatMultipleOf1 },
+			BeforeFunc: func() { x := float32(0.35); data.TFloatMultipleOf1 = &x },
+			AfterFunc:  func() { x := float32(0.3); data.TFloatMultipleOf1 = &x },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatFinite),
+			FieldDesc:  "field 't_double_finite1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatFinite, Value: nil},
+			FieldValue: func() interface{} { return data.TDoubleFinite1 },
+			BeforeFunc: func() { data.TDoubleFinite1 = math.Inf(-1) },
+			AfterFunc:  func() { data.TDoubleFinite1 = math.MaxFloat64 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatFinite),
+			FieldDesc:  "field 't_double_finite2'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatFinite, Value: nil},
+			FieldValue: func() interface{} { return data.TDoubleFinite2 },
+			BeforeFunc: func() { x := math.NaN(); data.TDoubleFinite2 = &x },
+			AfterFunc:  func() { x := 0.0; data.TDoubleFinite2 = &x },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatDecimalPlaces),
+			FieldDesc:  "field 't_double_decimal_places1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatDecimalPlaces, Value: 2},
+			FieldValue: func() interface{} { return data.TDoubleDecimalPlaces1 },
+			BeforeFunc: func() { data.TDoubleDecimalPlaces1 = 19.999 },
+			AfterFunc:  func() { data.TDoubleDecimalPlaces1 = 19.99 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatDecimalPlaces),
+			FieldDesc:  "field 't_float_decimal_places1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatDecimalPlaces, Value: 1},
+			FieldValue: func() interface{} { return data.TFloatDecimalPlaces1 },
+			BeforeFunc: func() { data.TFloatDecimalPlaces1 = 0.15 },
+			AfterFunc:  func() { data.TFloatDecimalPlaces1 = 0.1 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatNe),
+			FieldDesc:  "field 't_double_ne1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatNe, Value: 1},
+			FieldValue: func() interface{} { return data.TDoubleNe1 },
+			BeforeFunc: func() { data.TDoubleNe1 = math.NaN() },
+			AfterFunc:  func() { data.TDoubleNe1 = 2 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatNotIn),
+			FieldDesc:  "field 't_float_not_in1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatNotIn, Value: []float64{1, 2}},
+			FieldValue: func() interface{} { return data.TFloatNotIn1 },
+			BeforeFunc: func() { data.TFloatNotIn1 = float32(math.NaN()) },
+			AfterFunc:  func() { data.TFloatNotIn1 = 3 },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatNotIn),
+			FieldDesc:  "field 't_double_not_in1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatNotIn, Value: []float64{1, 2}},
+			FieldValue: func() interface{} { return data.TDoubleNotIn1 },
+			BeforeFunc: func() {},
+			AfterFunc:  func() { x := math.NaN(); data.TDoubleNotIn1 = &x },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatNotIn),
+			FieldDesc:  "field 't_double_not_in1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatNotIn, Value: []float64{1, 2}},
+			FieldValue: func() interface{} { return data.TDoubleNotIn1 },
+			BeforeFunc: func() {},
+			AfterFunc:  func() { x := 3.0; data.TDoubleNotIn1 = &x },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagIntRange),
+			FieldDesc:  "array item where in field 't_int_list1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagIntRange, Value: "[-1, 1]"},
+			FieldValue: func() interface{} { return "2" },
+			BeforeFunc: func() { data.TIntList1 = []int32{0, 2} },
+			AfterFunc:  func() { data.TIntList1 = []int32{-1, 0, 1} },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagFloatDecimalPlaces),
+			FieldDesc:  "field 't_double_wrapper1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagFloatDecimalPlaces, Value: 2},
+			FieldValue: func() interface{} { return "0.125" },
+			BeforeFunc: func() { data.TDoubleWrapper1 = wrapperspb.Double(0.125) },
+			AfterFunc:  func() { data.TDoubleWrapper1 = wrapperspb.Double(0.25) },
+		},
+	}
+
+	msgName := "ValidNumberTags1"
+	runCases(t, data, msgName, cases)
+
+	{
+		err := data.Validate()
+		require.Nil(t, err)
+	}
+}
+
+func Test_GoValidator_ValidBytesTags2(t *testing.T) {
+	data := &govalidatortest.ValidBytesTags2{}
+	{
+		err := data.Validate()
+		require.NotNil(t, err)
+	}
+
+	cases := []*CaseDesc{
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesEq),
+			FieldDesc:  "field 't_bytes_eq1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesEq, Value: "0102"},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesEq1) },
+			BeforeFunc: func() { data.TBytesEq1 = []byte{0x01} },
+			AfterFunc:  func() { data.TBytesEq1 = []byte{0x01, 0x02} },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesIn),
+			FieldDesc:  "field 't_bytes_in1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesIn, Value: []string{"01", "6162"}},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesIn1) },
+			BeforeFunc: func() { data.TBytesIn1 = []byte("a") },
+			AfterFunc:  func() { data.TBytesIn1 = []byte("ab") },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesNotIn),
+			FieldDesc:  "field 't_bytes_not_in1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesNotIn, Value: []string{"", "ff"}},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesNotIn1) },
+			BeforeFunc: func() { data.TBytesNotIn1 = []byte{0xff} },
+			AfterFunc:  func() { data.TBytesNotIn1 = []byte{0xfe} },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesPrefix),
+			FieldDesc:  "field 't_bytes_prefix1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesPrefix, Value: "89504e47"},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesPrefix1) },
+			BeforeFunc: func() { data.TBytesPrefix1 = []byte("PNG") },
+			AfterFunc:  func() { data.TBytesPrefix1 = []byte("\x89PNG\r\n") },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesSuffix),
+			FieldDesc:  "field 't_bytes_suffix1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesSuffix, Value: "0001"},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesSuffix1) },
+			BeforeFunc: func() { data.TBytesSuffix1 = []byte{0x00, 0x01, 0x02} },
+			AfterFunc:  func() { data.TBytesSuffix1 = []byte{0x02, 0x00, 0x01} },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesContains),
+			FieldDesc:  "field 't_bytes_contains1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesContains, Value: "2260"},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesContains1) },
+			BeforeFunc: func() { data.TBytesContains1 = []byte("`\"") },
+			AfterFunc:  func() { data.TBytesContains1 = []byte("a\"`b") },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesRegex),
+			FieldDesc:  "field 't_bytes_regex1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesRegex, Value: "^[a-f0-9]+$"},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesRegex1) },
+			BeforeFunc: func() { data.TBytesRegex1 = []byte("a1x") },
+			AfterFunc:  func() { data.TBytesRegex1 = []byte("a1f") },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesIp),
+			FieldDesc:  "field 't_bytes_ip1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesIp, Value: nil},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesIp1) },
+			BeforeFunc: func() { data.TBytesIp1 = []byte{127, 0, 1} },
+			AfterFunc:  func() { data.TBytesIp1 = net.ParseIP("::1") },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesIpv4),
+			FieldDesc:  "field 't_bytes_ipv4_1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesIpv4, Value: nil},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesIpv4_1) },
+			BeforeFunc: func() { data.TBytesIpv4_1 = net.ParseIP("127.0.0.1") },
+			AfterFunc:  func() { data.TBytesIpv4_1 = net.ParseIP("127.0.0.1").To4() },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesIpv6),
+			FieldDesc:  "field 't_bytes_ipv6_1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesIpv6, Value: nil},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesIpv6_1) },
+			BeforeFunc: func() { data.TBytesIpv6_1 = net.ParseIP("127.0.0.1").To4() },
+			AfterFunc:  func() { data.TBytesIpv6_1 = net.ParseIP("fe80::1") },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesUtf8),
+			FieldDesc:  "field 't_bytes_utf8_1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesUtf8, Value: nil},
+			FieldValue: func() interface{} { return protovalidator.BytesToString(data.TBytesUtf8_1) },
+			BeforeFunc: func() { data.TBytesUtf8_1 = []byte{0xe4, 0xbd} },
+			AfterFunc:  func() { data.TBytesUtf8_1 = []byte("你好") },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesIn),
+			FieldDesc:  "array item where in field 't_bytes_list1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesIn, Value: []string{"01", "02"}},
+			FieldValue: func() interface{} { return "03" },
+			BeforeFunc: func() { data.TBytesList1 = [][]byte{{0x01}, {0x03}} },
+			AfterFunc:  func() { data.TBytesList1 = [][]byte{{0x01}, {0x02}} },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesIpv4),
+			FieldDesc:  "map value where in field 't_bytes_map1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesIpv4, Value: nil},
+			FieldValue: func() interface{} { return "7f00" },
+			BeforeFunc: func() { data.TBytesMap1 = map[string][]byte{"k1": {127, 0}} },
+			AfterFunc:  func() { data.TBytesMap1 = map[string][]byte{"k1": {127, 0, 0, 1}} },
+		},
+		{
+			Name:       fmt.Sprintf("test tag <%s>", protovalidator.TagBytesPrefix),
+			FieldDesc:  "field 't_bytes_wrapper1'",
+			TagInfo:    &protovalidator.TagInfo{Tag: protovalidator.TagBytesPrefix, Value: "77"},
+			FieldValue: func() interface{} { return "7831" },
+			BeforeFunc: func() { data.TBytesWrapper1 = wrapperspb.Bytes([]byte("x1")) },
+			AfterFunc:  func() { data.TBytesWrapper1 = wrapperspb.Bytes([]byte("w1")) },
+		},
+	}
+
+	msgName := "ValidBytesTags2"
+	runCases(t, data, msgName, cases)
+
+	{
+		err := data.Validate()
+		require.Nil(t, err)
+	}
+}
+
 func Test_GoValidator_ValidRepeatedTagsGeneral1(t *testing.T) {
 	data := &govalidatortest.ValidRepeatedTagsGeneral1{}
 	{
@@ -8435,3 +8747,618 @@ func Test_GoValidator_CheckIfOptions5(t *testing.T) {
 		require.Nil(t, err)
 	}
 }
+
+func Test_GoValidator_ValidateAll1(t *testing.T) {
+	data := &govalidatortest.ValidateAll1{
+		Name:   "x",
+		Config: nil,
+		Items: []*govalidatortest.Config{
+			{Ip: "127.0.0.1", Port: 8080},
+			{Ip: "127.0.0.2", Port: 8081},
+			{Ip: "127.0.0.1", Port: 8080},
+		},
+		Labels: map[string]*govalidatortest.Config{
+			"env": {Ip: "127.0.0.2", Port: 8080},
+			"dev": {Ip: "127.0.0.1", Port: 8080},
+		},
+		Ports: []int32{1, 0, -1},
+	}
+
+	// The Validate returns the first error only.
+	err := data.Validate()
+	require.NotNil(t, err)
+	require.Equal(t, "ValidateError: <ValidateAll1>: the character length of field 'name' must be greater than or equal to '3' and you provide '1'", err.Error())
+
+	err = data.ValidateAll()
+	require.NotNil(t, err)
+	errs, ok := err.(protovalidator.ValidationErrors)
+	require.True(t, ok)
+	require.Equal(t, 10, len(errs))
+	require.Equal(t, len(errs), len(errs.Unwrap()))
+	require.Equal(t, err.Error(), errs.Error())
+
+	require.Equal(t, 2, len(errs.ByField("name")))
+	require.Equal(t, 1, len(errs.ByField("config")))
+	require.Equal(t, 1, len(errs.ByField("items")))
+	require.Equal(t, 1, len(errs.ByField("labels")))
+	require.Equal(t, 2, len(errs.ByField("ports")))
+	require.Equal(t, 2, len(errs.ByField("ip")))
+	require.Equal(t, 1, len(errs.ByField("port")))
+	require.Equal(t, 0, len(errs.ByField("unknown")))
+
+	require.Equal(t, 1, len(errs.ByTag(protovalidator.TagStringCharLenGte)))
+	require.Equal(t, 1, len(errs.ByTag(protovalidator.TagStringPrefix)))
+	require.Equal(t, 1, len(errs.ByTag(protovalidator.TagMessageNotNull)))
+	require.Equal(t, 1, len(errs.ByTag(protovalidator.TagRepeatedLenLte)))
+	require.Equal(t, 1, len(errs.ByTag(protovalidator.TagStringIn)))
+	require.Equal(t, 2, len(errs.ByTag(protovalidator.TagIntGt)))
+	require.Equal(t, 2, len(errs.ByTag(protovalidator.TagStringEq)))
+	require.Equal(t, 1, len(errs.ByTag(protovalidator.TagIntEq)))
+
+	// The errors are in the order of fields.
+	require.Equal(t, errs[0].Error(), data.Validate().Error())
+	require.Equal(t,
+		"ValidateError: <ValidateAll1>: the value of field 'config' cannot be null",
+		errs[2].Error(),
+	)
+	require.Equal(t,
+		"ValidateError: <ValidateAll1>: the value of array item where in field 'ports' must be greater than '0' and you provide '-1'",
+		errs[9].Error(),
+	)
+
+	data.Name = "n-abc"
+	data.Config = &govalidatortest.Config{Ip: "127.0.0.1", Port: 8080}
+	data.Items = data.Items[:1]
+	data.Labels = map[string]*govalidatortest.Config{"env": {Ip: "127.0.0.1", Port: 8080}}
+	data.Ports = []int32{1}
+	require.Nil(t, data.ValidateAll())
+	require.Nil(t, data.Validate())
+}
+
+func Test_GoValidator_ValidateError1(t *testing.T) {
+	data := &govalidatortest.ValidateError1{
+		UserEmail: "abc",
+		TagNames:  []string{"a", "c"},
+	}
+
+	err := data.ValidateAll()
+	require.NotNil(t, err)
+	errs := err.(protovalidator.ValidationErrors)
+	require.Equal(t, 4, len(errs))
+
+	e1 := errs[0].(*protovalidator.ValidateError)
+	require.Equal(t, "govalidatortest.ValidateError1", e1.MessageName())
+	require.Equal(t, "user_email", e1.Field())
+	require.Equal(t, "userEmail", e1.JSONName())
+	require.Equal(t, "user_email", e1.Path())
+	require.Equal(t, protovalidator.TagStringEmail, e1.Tag())
+	require.Equal(t, "", e1.ExpectedValue())
+	require.Equal(t, "abc", e1.FieldValue())
+
+	e2 := errs[1].(*protovalidator.ValidateError)
+	require.Equal(t, "tag_names", e2.Field())
+	require.Equal(t, "tagNames", e2.JSONName())
+	require.Equal(t, protovalidator.TagRepeatedLenLte, e2.Tag())
+	require.Equal(t, "1", e2.ExpectedValue())
+	require.Equal(t, "2", e2.FieldValue())
+
+	e3 := errs[2].(*protovalidator.ValidateError)
+	require.Equal(t, "tag_names", e3.Field())
+	require.Equal(t, protovalidator.TagStringIn, e3.Tag())
+	require.Equal(t, "[a b]", e3.ExpectedValue())
+	require.Equal(t, "c", e3.FieldValue())
+
+	e4 := errs[3].(*protovalidator.ValidateError)
+	require.Equal(t, "kind", e4.Field())
+	require.Equal(t, "kind", e4.JSONName())
+	require.Equal(t, protovalidator.TagOneOfNotNull, e4.Tag())
+	require.Equal(t, "", e4.ExpectedValue())
+	require.Equal(t, "", e4.FieldValue())
+	require.Equal(t, "ValidateError: <ValidateError1>: the value of field 'kind' cannot be null", e4.Error())
+}
+
+func Test_GoValidator_FieldPath1(t *testing.T) {
+	data := &govalidatortest.FieldPath1{
+		Order: &govalidatortest.FieldPath1_Order{
+			Items: []*govalidatortest.FieldPath1_Item{{Sku: "sku-1"}, {Sku: "sku-2"}, {Sku: "x3"}},
+			Notes: []string{"a", "abcd"},
+		},
+		Labels:  map[string]*govalidatortest.FieldPath1_Item{"env": {Sku: "x"}},
+		Indexes: map[int32]*govalidatortest.FieldPath1_Item{-1: {Sku: "sku-1"}},
+	}
+
+	err := data.Validate()
+	require.NotNil(t, err)
+	e := err.(*protovalidator.ValidateError)
+	require.Equal(t, "order.items[2].sku", e.Path())
+	require.Equal(t, "sku", e.Field())
+	require.Equal(t, "govalidatortest.FieldPath1.Item", e.MessageName())
+	require.Equal(t,
+		"ValidateError: <FieldPath1_Item> at 'order.items[2].sku': the value of field 'sku' must start with string 'sku-' and you provide 'x3'",
+		err.Error(),
+	)
+
+	err = data.ValidateAll()
+	require.NotNil(t, err)
+	errs := err.(protovalidator.ValidationErrors)
+	require.Equal(t, 4, len(errs))
+
+	var paths []string
+	for _, err := range errs {
+		paths = append(paths, err.(*protovalidator.ValidateError).Path())
+	}
+	require.Equal(t, []string{"order.items[2].sku", "order.notes[1]", `labels["env"].sku`, "indexes[-1]"}, paths)
+
+	require.Equal(t,
+		"ValidateError: <FieldPath1_Order> at 'order.notes[1]': the character length of array item where in field 'notes' must be less than or equal to '3' and you provide '4'",
+		errs[1].Error(),
+	)
+	require.Equal(t,
+		"ValidateError: <FieldPath1_Item> at 'labels[\"env\"].sku': the value of field 'sku' must start with string 'sku-' and you provide 'x'",
+		errs[2].Error(),
+	)
+	// The path is not shown in message for the fields of message being validated.
+	require.Equal(t,
+		"ValidateError: <FieldPath1>: the value of map key where in field 'indexes' must be greater than '0' and you provide '-1'",
+		errs[3].Error(),
+	)
+
+	// The path starts from the message being validated.
+	err = data.Order.Validate()
+	require.NotNil(t, err)
+	require.Equal(t, "items[2].sku", err.(*protovalidator.ValidateError).Path())
+}
+
+func Test_GoValidator_CustomMessage1(t *testing.T) {
+	data := &govalidatortest.CustomMessage1{
+		Port:     0,
+		UserName: "abc",
+		Emails:   []string{"a@b.com", "x"},
+	}
+
+	err := data.ValidateAll()
+	require.NotNil(t, err)
+	errs := err.(protovalidator.ValidationErrors)
+	require.Equal(t, 4, len(errs))
+
+	e1 := errs[0].(*protovalidator.ValidateError)
+	require.Equal(t, "INVALID_PORT", e1.Code())
+	require.Equal(t, protovalidator.TagIntGte, e1.Tag())
+	require.Equal(t, "port must be between 1 and 65535", e1.Reason())
+	require.Equal(t, "ValidateError: <CustomMessage1>: port must be between 1 and 65535", e1.Error())
+
+	e2 := errs[1].(*protovalidator.ValidateError)
+	require.Equal(t, "", e2.Code())
+	require.Equal(t, "userName must start with 'n-', got 'abc' (string.prefix)", e2.Reason())
+
+	e3 := errs[2].(*protovalidator.ValidateError)
+	require.Equal(t, "{emails[1]} is not a valid email", e3.Reason())
+
+	// Only the error code is specified.
+	e4 := errs[3].(*protovalidator.ValidateError)
+	require.Equal(t, "KIND_REQUIRED", e4.Code())
+	require.Equal(t, "the value of field 'kind' cannot be null", e4.Reason())
+	require.Equal(t, "ValidateError: <CustomMessage1>: the value of field 'kind' cannot be null", e4.Error())
+
+	data.Port = 70000
+	err = data.Validate()
+	require.NotNil(t, err)
+	require.Equal(t, "ValidateError: <CustomMessage1>: port must be between 1 and 65535", err.Error())
+	require.Equal(t, protovalidator.TagIntLte, err.(*protovalidator.ValidateError).Tag())
+}
+
+func Test_GoValidator_Localize(t *testing.T) {
+	require.Nil(t, protovalidator.DefaultCatalog.Set(language.Spanish, map[string]string{
+		protovalidator.SubjectListItem:     "elemento de '{field}'",
+		protovalidator.TagStringCharLenLte: "la longitud de {subject} debe ser como máximo {param}",
+	}))
+
+	data := &govalidatortest.FieldPath1{
+		Order: &govalidatortest.FieldPath1_Order{Notes: []string{"abcd"}},
+	}
+	err := data.Validate()
+	require.NotNil(t, err)
+
+	e := err.(*protovalidator.ValidateError)
+	require.Equal(t, "la longitud de elemento de 'notes' debe ser como máximo 3", e.Localize("es"))
+	require.Equal(t, "the character length of array item where in field 'notes' must be less than or equal to '3'", e.Localize("en"))
+	require.Equal(t, e.Reason(), e.Localize("de"))
+}
+
+func Test_GoValidator_MessageRules1(t *testing.T) {
+	// The valid message.
+	newData := func() *govalidatortest.MessageRules1 {
+		return &govalidatortest.MessageRules1{
+			StartTime: &timestamppb.Timestamp{Seconds: 100},
+			EndTime:   &timestamppb.Timestamp{Seconds: 100, Nanos: 1},
+			MinCount:  1,
+			MaxCount:  1,
+			Email:     "a@b.com",
+			ByName:    "name",
+		}
+	}
+	require.Nil(t, newData().ValidateAll())
+
+	cases := []struct {
+		Tag    string
+		Value  string
+		Modify func(data *govalidatortest.MessageRules1)
+	}{
+		{protovalidator.TagMessageCompare, "start_time < end_time", func(data *govalidatortest.MessageRules1) {
+			data.EndTime = &timestamppb.Timestamp{Seconds: 100}
+		}},
+		{protovalidator.TagMessageCompare, "min_count <= max_count", func(data *govalidatortest.MessageRules1) {
+			data.MinCount = 2
+		}},
+		{protovalidator.TagMessageCompare, "timeout != max_timeout", func(data *govalidatortest.MessageRules1) {
+			data.Timeout = &durationpb.Duration{Seconds: 1}
+			data.MaxTimeout = &durationpb.Duration{Seconds: 1}
+		}},
+		{protovalidator.TagMessageCompare, "opt_min <= opt_max", func(data *govalidatortest.MessageRules1) {
+			data.OptMin = proto.Int64(2)
+			data.OptMax = proto.Int64(1)
+		}},
+		{protovalidator.TagMessageAtLeastOneOf, "[email phone]", func(data *govalidatortest.MessageRules1) {
+			data.Email = ""
+		}},
+		{protovalidator.TagMessageExactlyOneOf, "[by_id by_name tags]", func(data *govalidatortest.MessageRules1) {
+			data.ByName = ""
+		}},
+		{protovalidator.TagMessageExactlyOneOf, "[by_id by_name tags]", func(data *govalidatortest.MessageRules1) {
+			data.Tags = []string{"t1"}
+		}},
+		{protovalidator.TagMessageAllOrNoneOf, "[username password]", func(data *govalidatortest.MessageRules1) {
+			data.Password = []byte("p")
+		}},
+		{protovalidator.TagMessageMutuallyExclusive, "[[cert key] [token]]", func(data *govalidatortest.MessageRules1) {
+			data.Key = []byte("k")
+			data.Token = "t"
+		}},
+	}
+	for _, c := range cases {
+		data := newData()
+		c.Modify(data)
+		err := data.Validate()
+		require.NotNil(t, err, c.Value)
+		e := err.(*protovalidator.ValidateError)
+		require.Equal(t, c.Tag, e.Tag(), c.Value)
+		require.Equal(t, c.Value, e.ExpectedValue())
+		require.Equal(t, "", e.Field())
+		require.Equal(t, "", e.Path())
+	}
+
+	// The rules are skipped if the field is null.
+	data := newData()
+	data.StartTime = nil
+	data.Timeout = &durationpb.Duration{Seconds: 1}
+	data.OptMin = proto.Int64(2)
+	data.Username = "u"
+	data.Password = []byte("p")
+	data.Cert = []byte("c")
+	data.Key = []byte("k")
+	require.Nil(t, data.Validate())
+
+	// The rules are checked after the checks of fields.
+	data = newData()
+	data.MinCount = -1
+	data.MaxCount = -2
+	data.Email = ""
+	data.Child = &govalidatortest.MessageRules1{Email: "x", ById: 1, MinCount: 3}
+	err := data.ValidateAll()
+	require.NotNil(t, err)
+	errs := err.(protovalidator.ValidationErrors)
+	require.Equal(t, 4, len(errs))
+	require.Equal(t, protovalidator.TagIntGte, errs[0].(*protovalidator.ValidateError).Tag())
+	require.Equal(t,
+		"ValidateError: <MessageRules1> at 'child': the message must satisfy 'min_count <= max_count'",
+		errs[1].Error(),
+	)
+	require.Equal(t, "child", errs[1].(*protovalidator.ValidateError).Path())
+	require.Equal(t,
+		"ValidateError: <MessageRules1>: the message must satisfy 'min_count <= max_count'",
+		errs[2].Error(),
+	)
+	require.Equal(t,
+		"ValidateError: <MessageRules1>: the message must have at least one of the fields '[email phone]' set",
+		errs[3].Error(),
+	)
+}
+
+func Test_GoValidator_ExprRules1(t *testing.T) {
+	// The valid message.
+	newData := func() *govalidatortest.ExprRules1 {
+		return &govalidatortest.ExprRules1{
+			Name:     "app-name",
+			Prefix:   "app-",
+			Items:    []*govalidatortest.ExprRules1_Item{{Sku: "s01", Price: 1.5}},
+			MaxItems: 2,
+			Status:   govalidatortest.ExprRules1_Active,
+			Level:    4,
+			By:       &govalidatortest.ExprRules1_ById{ById: 0},
+		}
+	}
+	require.Nil(t, newData().ValidateAll())
+
+	cases := []struct {
+		Value  string
+		Modify func(data *govalidatortest.ExprRules1)
+	}{
+		{"size(this.items) <= this.max_items && this.name.startsWith(this.prefix)", func(data *govalidatortest.ExprRules1) {
+			data.MaxItems = 0
+		}},
+		{"size(this.items) <= this.max_items && this.name.startsWith(this.prefix)", func(data *govalidatortest.ExprRules1) {
+			data.Name = "name"
+		}},
+		{"this.items.all(x, x.price > 0.0 && size(x.sku) >= 3)", func(data *govalidatortest.ExprRules1) {
+			data.Items = append(data.Items, &govalidatortest.ExprRules1_Item{Sku: "s02"})
+		}},
+		{"this.items.all(x, x.price > 0.0 && size(x.sku) >= 3)", func(data *govalidatortest.ExprRules1) {
+			data.Items[0].Sku = "中文"
+		}},
+		{"!has(this.start) || !has(this.end) || this.start < this.end", func(data *govalidatortest.ExprRules1) {
+			data.Start = &timestamppb.Timestamp{Seconds: 2}
+			data.End = &timestamppb.Timestamp{Seconds: 1}
+		}},
+		{"this.status in [1, 2] || this.tags.exists(t, t == 'draft')", func(data *govalidatortest.ExprRules1) {
+			data.Status = govalidatortest.ExprRules1_Unknown
+			data.Tags = []string{"a", "b"}
+		}},
+		{"this.level * 2 + 1 < 10", func(data *govalidatortest.ExprRules1) {
+			data.Level = 5
+		}},
+		{"this.code == '' || this.code.matches('^[A-Z]{3}$')", func(data *govalidatortest.ExprRules1) {
+			data.Code = "ABCD"
+		}},
+		{"!has(this.opt) || this.opt % 2 == 0", func(data *govalidatortest.ExprRules1) {
+			data.Opt = proto.Int64(-3)
+		}},
+		{"!('deprecated' in this.labels) && this.labels.all(k, this.labels_limit >= size(k))", func(data *govalidatortest.ExprRules1) {
+			data.LabelsLimit = 10
+			data.Labels = map[string]int32{"deprecated": 1}
+		}},
+		{"!('deprecated' in this.labels) && this.labels.all(k, this.labels_limit >= size(k))", func(data *govalidatortest.ExprRules1) {
+			data.LabelsLimit = 3
+			data.Labels = map[string]int32{"abc": 1, "abcd": 1}
+		}},
+		{"has(this.by_id) || has(this.by_name)", func(data *govalidatortest.ExprRules1) {
+			data.By = nil
+		}},
+	}
+	for _, c := range cases {
+		data := newData()
+		c.Modify(data)
+		err := data.Validate()
+		require.NotNil(t, err, c.Value)
+		e := err.(*protovalidator.ValidateError)
+		require.Equal(t, protovalidator.TagMessageExpr, e.Tag(), c.Value)
+		require.Equal(t, c.Value, e.ExpectedValue())
+		require.Equal(t, "", e.Field())
+	}
+
+	// The expressions that satisfied by other branches.
+	data := newData()
+	data.Status = govalidatortest.ExprRules1_Unknown
+	data.Tags = []string{"draft"}
+	data.Code = "ABC"
+	data.Opt = proto.Int64(-4)
+	data.Start = &timestamppb.Timestamp{Seconds: 1}
+	data.LabelsLimit = 3
+	data.Labels = map[string]int32{"abc": 1}
+	data.By = &govalidatortest.ExprRules1_ByName{ByName: ""}
+	require.Nil(t, data.Validate())
+
+	require.Equal(t,
+		"ValidateError: <ExprRules1>: the message must satisfy the expression 'this.level * 2 + 1 < 10'",
+		(&govalidatortest.ExprRules1{Status: govalidatortest.ExprRules1_Pending, Level: 5, By: &govalidatortest.ExprRules1_ById{}}).Validate().Error(),
+	)
+}
+
+func Test_GoValidator_ExprRules2(t *testing.T) {
+	data := &govalidatortest.ExprRules2{}
+	err := data.Validate()
+	require.NotNil(t, err)
+	require.Equal(t, "this.items.exists(x, true)", err.(*protovalidator.ValidateError).ExpectedValue())
+
+	data.Items = []string{"a"}
+	require.Nil(t, data.Validate())
+
+	data.Labels = map[string]int32{"a": 1, "b": 2, "c": 3}
+	err = data.Validate()
+	require.NotNil(t, err)
+	require.Equal(t, "this.labels.all(k, size(this.labels) < 3)", err.(*protovalidator.ValidateError).ExpectedValue())
+}
+
+func Test_GoValidator_ExprRules3(t *testing.T) {
+	expectedValues := func(err error) []string {
+		values := make([]string, 0)
+		for _, e := range err.(protovalidator.ValidationErrors) {
+			values = append(values, e.(*protovalidator.ValidateError).ExpectedValue())
+		}
+		return values
+	}
+
+	// The null timestamp and duration are the zero values.
+	data := &govalidatortest.ExprRules3{}
+	require.Nil(t, data.ValidateAll())
+
+	// The comparison and its negation agree when one is null, the has() skips the null.
+	data.Start = timestamppb.New(time.Unix(100, 0))
+	err := data.ValidateAll()
+	require.NotNil(t, err)
+	require.Equal(t, []string{"this.start <= this.end", "!(this.start > this.end)"}, expectedValues(err))
+
+	data.End = timestamppb.New(time.Unix(100, 0))
+	err = data.ValidateAll()
+	require.NotNil(t, err)
+	require.Equal(t, []string{"!has(this.start) || !has(this.end) || this.start < this.end"}, expectedValues(err))
+
+	data.End = timestamppb.New(time.Unix(101, 0))
+	require.Nil(t, data.ValidateAll())
+
+	// The all() and exists() agree when the compared one is null.
+	data.Timeouts = []*durationpb.Duration{durationpb.New(time.Second), nil}
+	err = data.ValidateAll()
+	require.NotNil(t, err)
+	require.Equal(t, []string{"this.timeouts.all(x, x <= this.max_timeout)", "!this.timeouts.exists(x, x > this.max_timeout)"}, expectedValues(err))
+
+	data.MaxTimeout = durationpb.New(time.Millisecond)
+	err = data.ValidateAll()
+	require.NotNil(t, err)
+	require.Equal(t, 2, len(expectedValues(err)))
+
+	data.MaxTimeout = durationpb.New(time.Second)
+	require.Nil(t, data.ValidateAll())
+}
+
+func Test_GoValidator_WellKnownTypes1(t *testing.T) {
+	now := time.Now()
+	newAny := func(m proto.Message) *anypb.Any {
+		x, err := anypb.New(m)
+		require.Nil(t, err)
+		return x
+	}
+
+	// The valid message.
+	newData := func() *govalidatortest.WellKnownTypes1 {
+		return &govalidatortest.WellKnownTypes1{
+			TsPast:   timestamppb.New(now.Add(-time.Minute)),
+			Count:    wrapperspb.Int64(1),
+			Port:     wrapperspb.UInt32(443),
+			Ratio:    wrapperspb.Double(0.5),
+			Name:     wrapperspb.String("n-a"),
+			Enabled:  wrapperspb.Bool(true),
+			Data:     wrapperspb.Bytes([]byte("abc")),
+			Levels:   map[string]*wrapperspb.Int32Value{"a": wrapperspb.Int32(1)},
+			Delays:   []*durationpb.Duration{durationpb.New(time.Second)},
+			Extra:    newAny(&durationpb.Duration{}),
+			TsRange:  &timestamppb.Timestamp{Seconds: 2000, Nanos: 500},
+			Timeout:  durationpb.New(time.Millisecond),
+			TsWithin: timestamppb.New(now.Add(-time.Hour + time.Minute)),
+			Detail:   newAny(&timestamppb.Timestamp{}),
+		}
+	}
+	require.Nil(t, newData().ValidateAll())
+
+	cases := []struct {
+		Tag        string
+		Field      string
+		Value      string
+		FieldValue string
+		Modify     func(data *govalidatortest.WellKnownTypes1)
+	}{
+		{protovalidator.TagTimestampGt, "ts_range", "1970-01-01T00:16:40Z", "1970-01-01T00:16:40Z", func(data *govalidatortest.WellKnownTypes1) {
+			data.TsRange = &timestamppb.Timestamp{Seconds: 1000}
+		}},
+		{protovalidator.TagTimestampLte, "ts_range", "1970-01-01T00:33:20.0000005Z", "1970-01-01T00:33:20.000000501Z", func(data *govalidatortest.WellKnownTypes1) {
+			data.TsRange = &timestamppb.Timestamp{Seconds: 2000, Nanos: 501}
+		}},
+		{protovalidator.TagMessageNotNull, "ts_past", "", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.TsPast = nil
+		}},
+		{protovalidator.TagTimestampLtNow, "ts_past", "", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.TsPast = timestamppb.New(now.Add(time.Minute))
+		}},
+		{protovalidator.TagTimestampGtNow, "ts_future", "", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.TsFuture = timestamppb.New(now.Add(-time.Minute))
+		}},
+		{protovalidator.TagTimestampWithin, "ts_within", "1h0m0s", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.TsWithin = timestamppb.New(now.Add(-time.Hour - time.Minute))
+		}},
+		{protovalidator.TagTimestampWithin, "ts_within", "1h0m0s", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.TsWithin = timestamppb.New(now.Add(time.Hour + time.Minute))
+		}},
+		{protovalidator.TagDurationGte, "timeout", "1ms", "999µs", func(data *govalidatortest.WellKnownTypes1) {
+			data.Timeout = durationpb.New(time.Millisecond - time.Microsecond)
+		}},
+		{protovalidator.TagDurationLt, "timeout", "1m0s", "1m0s", func(data *govalidatortest.WellKnownTypes1) {
+			data.Timeout = durationpb.New(time.Minute)
+		}},
+		{protovalidator.TagMessageNotNull, "delays", "", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.Delays = append(data.Delays, nil)
+		}},
+		{protovalidator.TagDurationGt, "delays", "0s", "-1s", func(data *govalidatortest.WellKnownTypes1) {
+			data.Delays = append(data.Delays, durationpb.New(-time.Second))
+		}},
+		{protovalidator.TagMessageNotNull, "count", "", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.Count = nil
+		}},
+		{protovalidator.TagIntGte, "count", "1", "0", func(data *govalidatortest.WellKnownTypes1) {
+			data.Count = wrapperspb.Int64(0)
+		}},
+		{protovalidator.TagIntLte, "count", "10", "11", func(data *govalidatortest.WellKnownTypes1) {
+			data.Count = wrapperspb.Int64(11)
+		}},
+		{protovalidator.TagUintIn, "port", "[80 443]", "8080", func(data *govalidatortest.WellKnownTypes1) {
+			data.Port = wrapperspb.UInt32(8080)
+		}},
+		{protovalidator.TagFloatLt, "ratio", "1", "1", func(data *govalidatortest.WellKnownTypes1) {
+			data.Ratio = wrapperspb.Double(1)
+		}},
+		{protovalidator.TagStringPrefix, "name", "n-", "a", func(data *govalidatortest.WellKnownTypes1) {
+			data.Name = wrapperspb.String("a")
+		}},
+		{protovalidator.TagStringNotIn, "name", "[n-x]", "n-x", func(data *govalidatortest.WellKnownTypes1) {
+			data.Name = wrapperspb.String("n-x")
+		}},
+		{protovalidator.TagBoolEq, "enabled", "true", "false", func(data *govalidatortest.WellKnownTypes1) {
+			data.Enabled = wrapperspb.Bool(false)
+		}},
+		{protovalidator.TagBytesLenLte, "data", "3", "4", func(data *govalidatortest.WellKnownTypes1) {
+			data.Data = wrapperspb.Bytes([]byte("abcd"))
+		}},
+		{protovalidator.TagIntGt, "levels", "0", "0", func(data *govalidatortest.WellKnownTypes1) {
+			data.Levels["b"] = wrapperspb.Int32(0)
+		}},
+		{protovalidator.TagAnyIn, "detail", "[type.googleapis.com/google.protobuf.Duration type.googleapis.com/google.protobuf.Timestamp]",
+			"type.googleapis.com/google.protobuf.Empty", func(data *govalidatortest.WellKnownTypes1) {
+				data.Detail = newAny(&emptypb.Empty{})
+			}},
+		{protovalidator.TagMessageNotNull, "extra", "", "", func(data *govalidatortest.WellKnownTypes1) {
+			data.Extra = nil
+		}},
+		{protovalidator.TagAnyNotIn, "extra", "[type.googleapis.com/google.protobuf.Empty]",
+			"type.googleapis.com/google.protobuf.Empty", func(data *govalidatortest.WellKnownTypes1) {
+				data.Extra = newAny(&emptypb.Empty{})
+			}},
+	}
+	for _, c := range cases {
+		data := newData()
+		c.Modify(data)
+		err := data.Validate()
+		require.NotNil(t, err, c.Tag)
+		e := err.(*protovalidator.ValidateError)
+		require.Equal(t, c.Tag, e.Tag(), c.Tag)
+		require.Equal(t, c.Field, e.Field(), c.Tag)
+		require.Equal(t, c.Value, e.ExpectedValue(), c.Tag)
+		if c.FieldValue != "" {
+			require.Equal(t, c.FieldValue, e.FieldValue(), c.Tag)
+		}
+	}
+
+	// The tags except not_null are skipped if the field of well-known types is null.
+	data := newData()
+	data.TsRange = nil
+	data.TsWithin = nil
+	data.Timeout = nil
+	data.Detail = nil
+	data.Port = nil
+	data.Ratio = nil
+	data.Name = nil
+	data.Enabled = nil
+	data.Data = nil
+	data.Levels["b"] = nil
+	require.Nil(t, data.ValidateAll())
+
+	require.Equal(t,
+		"ValidateError: <WellKnownTypes1>: the value of field 'name' must start with string 'n-' and you provide 'a'",
+		(func() error { data := newData(); data.Name = wrapperspb.String("a"); return data.Validate() })().Error(),
+	)
+	require.Equal(t,
+		"ValidateError: <WellKnownTypes1>: the value of field 'ts_range' must be before or equal to '1970-01-01T00:33:20.0000005Z' and you provide '1970-01-01T00:33:21Z'",
+		(func() error {
+			data := newData()
+			data.TsRange = &timestamppb.Timestamp{Seconds: 2001}
+			return data.Validate()
+		})().Error(),
+	)
+}
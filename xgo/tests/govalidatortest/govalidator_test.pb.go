@@ -10,6 +10,10 @@ import (
 	_ "github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -79,6 +83,55 @@ func (Enum1) EnumDescriptor() ([]byte, []int) {
 	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{0}
 }
 
+type ExprRules1_Status int32
+
+const (
+	ExprRules1_Unknown ExprRules1_Status = 0
+	ExprRules1_Active  ExprRules1_Status = 1
+	ExprRules1_Pending ExprRules1_Status = 2
+)
+
+// Enum value maps for ExprRules1_Status.
+var (
+	ExprRules1_Status_name = map[int32]string{
+		0: "Unknown",
+		1: "Active",
+		2: "Pending",
+	}
+	ExprRules1_Status_value = map[string]int32{
+		"Unknown": 0,
+		"Active":  1,
+		"Pending": 2,
+	}
+)
+
+func (x ExprRules1_Status) Enum() *ExprRules1_Status {
+	p := new(ExprRules1_Status)
+	*p = x
+	return p
+}
+
+func (x ExprRules1_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExprRules1_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_enumTypes[1].Descriptor()
+}
+
+func (ExprRules1_Status) Type() protoreflect.EnumType {
+	return &file_xgo_tests_govalidatortest_govalidator_test_proto_enumTypes[1]
+}
+
+func (x ExprRules1_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExprRules1_Status.Descriptor instead.
+func (ExprRules1_Status) EnumDescriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{39, 0}
+}
+
 type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -6141,6 +6194,342 @@ func (x *ValidBytesTags1) GetTBytesLenGte1() []byte {
 	return nil
 }
 
+// ValidNumberTags1 for test option tag range, multiple_of, finite and decimal_places of numbers.
+type ValidNumberTags1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TIntRange1            int32                   `protobuf:"varint,1,opt,name=t_int_range1,json=tIntRange1,proto3" json:"t_int_range1,omitempty"`
+	TIntRange2            *int64                  `protobuf:"varint,2,opt,name=t_int_range2,json=tIntRange2,proto3,oneof" json:"t_int_range2,omitempty"`
+	TIntMultipleOf1       int64                   `protobuf:"varint,3,opt,name=t_int_multiple_of1,json=tIntMultipleOf1,proto3" json:"t_int_multiple_of1,omitempty"`
+	TUintRange1           uint32                  `protobuf:"varint,4,opt,name=t_uint_range1,json=tUintRange1,proto3" json:"t_uint_range1,omitempty"`
+	TUintMultipleOf1      *uint64                 `protobuf:"varint,5,opt,name=t_uint_multiple_of1,json=tUintMultipleOf1,proto3,oneof" json:"t_uint_multiple_of1,omitempty"`
+	TDoubleRange1         float64                 `protobuf:"fixed64,10,opt,name=t_double_range1,json=tDoubleRange1,proto3" json:"t_double_range1,omitempty"`
+	TFloatRange1          float32                 `protobuf:"fixed32,11,opt,name=t_float_range1,json=tFloatRange1,proto3" json:"t_float_range1,omitempty"`
+	TDoubleMultipleOf1    float64                 `protobuf:"fixed64,12,opt,name=t_double_multiple_of1,json=tDoubleMultipleOf1,proto3" json:"t_double_multiple_of1,omitempty"`
+	TFloatMultipleOf1     *float32                `protobuf:"fixed32,13,opt,name=t_float_multiple_of1,json=tFloatMultipleOf1,proto3,oneof" json:"t_float_multiple_of1,omitempty"`
+	TDoubleFinite1        float64                 `protobuf:"fixed64,14,opt,name=t_double_finite1,json=tDoubleFinite1,proto3" json:"t_double_finite1,omitempty"`
+	TDoubleFinite2        *float64                `protobuf:"fixed64,15,opt,name=t_double_finite2,json=tDoubleFinite2,proto3,oneof" json:"t_double_finite2,omitempty"`
+	TDoubleDecimalPlaces1 float64                 `protobuf:"fixed64,16,opt,name=t_double_decimal_places1,json=tDoubleDecimalPlaces1,proto3" json:"t_double_decimal_places1,omitempty"`
+	TFloatDecimalPlaces1  float32                 `protobuf:"fixed32,17,opt,name=t_float_decimal_places1,json=tFloatDecimalPlaces1,proto3" json:"t_float_decimal_places1,omitempty"`
+	TDoubleNe1            float64                 `protobuf:"fixed64,18,opt,name=t_double_ne1,json=tDoubleNe1,proto3" json:"t_double_ne1,omitempty"`
+	TFloatNotIn1          float32                 `protobuf:"fixed32,19,opt,name=t_float_not_in1,json=tFloatNotIn1,proto3" json:"t_float_not_in1,omitempty"`
+	TDoubleNotIn1         *float64                `protobuf:"fixed64,20,opt,name=t_double_not_in1,json=tDoubleNotIn1,proto3,oneof" json:"t_double_not_in1,omitempty"`
+	TIntList1             []int32                 `protobuf:"varint,30,rep,packed,name=t_int_list1,json=tIntList1,proto3" json:"t_int_list1,omitempty"`
+	TDoubleWrapper1       *wrapperspb.DoubleValue `protobuf:"bytes,31,opt,name=t_double_wrapper1,json=tDoubleWrapper1,proto3" json:"t_double_wrapper1,omitempty"`
+}
+
+func (x *ValidNumberTags1) Reset() {
+	*x = ValidNumberTags1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidNumberTags1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidNumberTags1) ProtoMessage() {}
+
+func (x *ValidNumberTags1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidNumberTags1.ProtoReflect.Descriptor instead.
+func (*ValidNumberTags1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ValidNumberTags1) GetTIntRange1() int32 {
+	if x != nil {
+		return x.TIntRange1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTIntRange2() int64 {
+	if x != nil && x.TIntRange2 != nil {
+		return *x.TIntRange2
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTIntMultipleOf1() int64 {
+	if x != nil {
+		return x.TIntMultipleOf1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTUintRange1() uint32 {
+	if x != nil {
+		return x.TUintRange1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTUintMultipleOf1() uint64 {
+	if x != nil && x.TUintMultipleOf1 != nil {
+		return *x.TUintMultipleOf1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTDoubleRange1() float64 {
+	if x != nil {
+		return x.TDoubleRange1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTFloatRange1() float32 {
+	if x != nil {
+		return x.TFloatRange1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTDoubleMultipleOf1() float64 {
+	if x != nil {
+		return x.TDoubleMultipleOf1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTFloatMultipleOf1() float32 {
+	if x != nil && x.TFloatMultipleOf1 != nil {
+		return *x.TFloatMultipleOf1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTDoubleFinite1() float64 {
+	if x != nil {
+		return x.TDoubleFinite1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTDoubleFinite2() float64 {
+	if x != nil && x.TDoubleFinite2 != nil {
+		return *x.TDoubleFinite2
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTDoubleDecimalPlaces1() float64 {
+	if x != nil {
+		return x.TDoubleDecimalPlaces1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTFloatDecimalPlaces1() float32 {
+	if x != nil {
+		return x.TFloatDecimalPlaces1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTDoubleNe1() float64 {
+	if x != nil {
+		return x.TDoubleNe1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTFloatNotIn1() float32 {
+	if x != nil {
+		return x.TFloatNotIn1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTDoubleNotIn1() float64 {
+	if x != nil && x.TDoubleNotIn1 != nil {
+		return *x.TDoubleNotIn1
+	}
+	return 0
+}
+
+func (x *ValidNumberTags1) GetTIntList1() []int32 {
+	if x != nil {
+		return x.TIntList1
+	}
+	return nil
+}
+
+func (x *ValidNumberTags1) GetTDoubleWrapper1() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.TDoubleWrapper1
+	}
+	return nil
+}
+
+// ValidBytesTags2 for test option tag ValidBytes with the value of field.
+type ValidBytesTags2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TBytesEq1       []byte                 `protobuf:"bytes,1,opt,name=t_bytes_eq1,json=tBytesEq1,proto3" json:"t_bytes_eq1,omitempty"`
+	TBytesIn1       []byte                 `protobuf:"bytes,2,opt,name=t_bytes_in1,json=tBytesIn1,proto3" json:"t_bytes_in1,omitempty"`
+	TBytesNotIn1    []byte                 `protobuf:"bytes,3,opt,name=t_bytes_not_in1,json=tBytesNotIn1,proto3" json:"t_bytes_not_in1,omitempty"`
+	TBytesPrefix1   []byte                 `protobuf:"bytes,4,opt,name=t_bytes_prefix1,json=tBytesPrefix1,proto3" json:"t_bytes_prefix1,omitempty"`
+	TBytesSuffix1   []byte                 `protobuf:"bytes,5,opt,name=t_bytes_suffix1,json=tBytesSuffix1,proto3" json:"t_bytes_suffix1,omitempty"`
+	TBytesContains1 []byte                 `protobuf:"bytes,6,opt,name=t_bytes_contains1,json=tBytesContains1,proto3" json:"t_bytes_contains1,omitempty"`
+	TBytesRegex1    []byte                 `protobuf:"bytes,7,opt,name=t_bytes_regex1,json=tBytesRegex1,proto3" json:"t_bytes_regex1,omitempty"`
+	TBytesIp1       []byte                 `protobuf:"bytes,8,opt,name=t_bytes_ip1,json=tBytesIp1,proto3" json:"t_bytes_ip1,omitempty"`
+	TBytesIpv4_1    []byte                 `protobuf:"bytes,9,opt,name=t_bytes_ipv4_1,json=tBytesIpv41,proto3" json:"t_bytes_ipv4_1,omitempty"`
+	TBytesIpv6_1    []byte                 `protobuf:"bytes,10,opt,name=t_bytes_ipv6_1,json=tBytesIpv61,proto3" json:"t_bytes_ipv6_1,omitempty"`
+	TBytesUtf8_1    []byte                 `protobuf:"bytes,11,opt,name=t_bytes_utf8_1,json=tBytesUtf81,proto3" json:"t_bytes_utf8_1,omitempty"`
+	TBytesList1     [][]byte               `protobuf:"bytes,20,rep,name=t_bytes_list1,json=tBytesList1,proto3" json:"t_bytes_list1,omitempty"`
+	TBytesMap1      map[string][]byte      `protobuf:"bytes,21,rep,name=t_bytes_map1,json=tBytesMap1,proto3" json:"t_bytes_map1,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TBytesWrapper1  *wrapperspb.BytesValue `protobuf:"bytes,22,opt,name=t_bytes_wrapper1,json=tBytesWrapper1,proto3" json:"t_bytes_wrapper1,omitempty"`
+}
+
+func (x *ValidBytesTags2) Reset() {
+	*x = ValidBytesTags2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidBytesTags2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidBytesTags2) ProtoMessage() {}
+
+func (x *ValidBytesTags2) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidBytesTags2.ProtoReflect.Descriptor instead.
+func (*ValidBytesTags2) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ValidBytesTags2) GetTBytesEq1() []byte {
+	if x != nil {
+		return x.TBytesEq1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesIn1() []byte {
+	if x != nil {
+		return x.TBytesIn1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesNotIn1() []byte {
+	if x != nil {
+		return x.TBytesNotIn1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesPrefix1() []byte {
+	if x != nil {
+		return x.TBytesPrefix1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesSuffix1() []byte {
+	if x != nil {
+		return x.TBytesSuffix1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesContains1() []byte {
+	if x != nil {
+		return x.TBytesContains1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesRegex1() []byte {
+	if x != nil {
+		return x.TBytesRegex1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesIp1() []byte {
+	if x != nil {
+		return x.TBytesIp1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesIpv4_1() []byte {
+	if x != nil {
+		return x.TBytesIpv4_1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesIpv6_1() []byte {
+	if x != nil {
+		return x.TBytesIpv6_1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesUtf8_1() []byte {
+	if x != nil {
+		return x.TBytesUtf8_1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesList1() [][]byte {
+	if x != nil {
+		return x.TBytesList1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesMap1() map[string][]byte {
+	if x != nil {
+		return x.TBytesMap1
+	}
+	return nil
+}
+
+func (x *ValidBytesTags2) GetTBytesWrapper1() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.TBytesWrapper1
+	}
+	return nil
+}
+
 // ValidRepeatedTagsField1 for test option tag RepeatedTags.
 type ValidRepeatedTagsGeneral1 struct {
 	state         protoimpl.MessageState
@@ -6194,7 +6583,7 @@ type ValidRepeatedTagsGeneral1 struct {
 func (x *ValidRepeatedTagsGeneral1) Reset() {
 	*x = ValidRepeatedTagsGeneral1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[17]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6207,7 +6596,7 @@ func (x *ValidRepeatedTagsGeneral1) String() string {
 func (*ValidRepeatedTagsGeneral1) ProtoMessage() {}
 
 func (x *ValidRepeatedTagsGeneral1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[17]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6220,7 +6609,7 @@ func (x *ValidRepeatedTagsGeneral1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidRepeatedTagsGeneral1.ProtoReflect.Descriptor instead.
 func (*ValidRepeatedTagsGeneral1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{17}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ValidRepeatedTagsGeneral1) GetTList_101() []string {
@@ -6545,7 +6934,7 @@ type ValidRepeatedTagsItem1 struct {
 func (x *ValidRepeatedTagsItem1) Reset() {
 	*x = ValidRepeatedTagsItem1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[18]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6558,7 +6947,7 @@ func (x *ValidRepeatedTagsItem1) String() string {
 func (*ValidRepeatedTagsItem1) ProtoMessage() {}
 
 func (x *ValidRepeatedTagsItem1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[18]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6571,7 +6960,7 @@ func (x *ValidRepeatedTagsItem1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidRepeatedTagsItem1.ProtoReflect.Descriptor instead.
 func (*ValidRepeatedTagsItem1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{18}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ValidRepeatedTagsItem1) GetTListItemString() []string {
@@ -6727,7 +7116,7 @@ type ValidMapTagsGeneral1 struct {
 func (x *ValidMapTagsGeneral1) Reset() {
 	*x = ValidMapTagsGeneral1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[19]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6740,7 +7129,7 @@ func (x *ValidMapTagsGeneral1) String() string {
 func (*ValidMapTagsGeneral1) ProtoMessage() {}
 
 func (x *ValidMapTagsGeneral1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[19]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6753,7 +7142,7 @@ func (x *ValidMapTagsGeneral1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidMapTagsGeneral1.ProtoReflect.Descriptor instead.
 func (*ValidMapTagsGeneral1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{19}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ValidMapTagsGeneral1) GetTMap_101() map[string]string {
@@ -6939,7 +7328,7 @@ type ValidMapTagsKey1 struct {
 func (x *ValidMapTagsKey1) Reset() {
 	*x = ValidMapTagsKey1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[20]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -6952,7 +7341,7 @@ func (x *ValidMapTagsKey1) String() string {
 func (*ValidMapTagsKey1) ProtoMessage() {}
 
 func (x *ValidMapTagsKey1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[20]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6965,7 +7354,7 @@ func (x *ValidMapTagsKey1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidMapTagsKey1.ProtoReflect.Descriptor instead.
 func (*ValidMapTagsKey1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{20}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ValidMapTagsKey1) GetTMapKeyString() map[string]int32 {
@@ -7073,7 +7462,7 @@ type ValidMapTagsValue1 struct {
 func (x *ValidMapTagsValue1) Reset() {
 	*x = ValidMapTagsValue1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[21]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7086,7 +7475,7 @@ func (x *ValidMapTagsValue1) String() string {
 func (*ValidMapTagsValue1) ProtoMessage() {}
 
 func (x *ValidMapTagsValue1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[21]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7099,7 +7488,7 @@ func (x *ValidMapTagsValue1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidMapTagsValue1.ProtoReflect.Descriptor instead.
 func (*ValidMapTagsValue1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{21}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ValidMapTagsValue1) GetTMapValueString() map[string]string {
@@ -7315,7 +7704,7 @@ type ValidStringTagsGeneral1 struct {
 func (x *ValidStringTagsGeneral1) Reset() {
 	*x = ValidStringTagsGeneral1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[22]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -7328,7 +7717,7 @@ func (x *ValidStringTagsGeneral1) String() string {
 func (*ValidStringTagsGeneral1) ProtoMessage() {}
 
 func (x *ValidStringTagsGeneral1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[22]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7341,7 +7730,7 @@ func (x *ValidStringTagsGeneral1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidStringTagsGeneral1.ProtoReflect.Descriptor instead.
 func (*ValidStringTagsGeneral1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{22}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ValidStringTagsGeneral1) GetTString1() string {
@@ -8019,7 +8408,7 @@ type ValidStringTagsOptional1 struct {
 func (x *ValidStringTagsOptional1) Reset() {
 	*x = ValidStringTagsOptional1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[23]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8032,7 +8421,7 @@ func (x *ValidStringTagsOptional1) String() string {
 func (*ValidStringTagsOptional1) ProtoMessage() {}
 
 func (x *ValidStringTagsOptional1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[23]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8045,7 +8434,7 @@ func (x *ValidStringTagsOptional1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidStringTagsOptional1.ProtoReflect.Descriptor instead.
 func (*ValidStringTagsOptional1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{23}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ValidStringTagsOptional1) GetTString1() string {
@@ -8725,7 +9114,7 @@ type ValidStringTagsOneOf1 struct {
 func (x *ValidStringTagsOneOf1) Reset() {
 	*x = ValidStringTagsOneOf1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[24]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8738,7 +9127,7 @@ func (x *ValidStringTagsOneOf1) String() string {
 func (*ValidStringTagsOneOf1) ProtoMessage() {}
 
 func (x *ValidStringTagsOneOf1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[24]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8751,7 +9140,7 @@ func (x *ValidStringTagsOneOf1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidStringTagsOneOf1.ProtoReflect.Descriptor instead.
 func (*ValidStringTagsOneOf1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{24}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{26}
 }
 
 func (m *ValidStringTagsOneOf1) GetOneTyp1() isValidStringTagsOneOf1_OneTyp1 {
@@ -9868,7 +10257,7 @@ type ValidOptionsMultiCond1 struct {
 func (x *ValidOptionsMultiCond1) Reset() {
 	*x = ValidOptionsMultiCond1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[25]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9881,7 +10270,7 @@ func (x *ValidOptionsMultiCond1) String() string {
 func (*ValidOptionsMultiCond1) ProtoMessage() {}
 
 func (x *ValidOptionsMultiCond1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[25]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9894,7 +10283,7 @@ func (x *ValidOptionsMultiCond1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidOptionsMultiCond1.ProtoReflect.Descriptor instead.
 func (*ValidOptionsMultiCond1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{25}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ValidOptionsMultiCond1) GetTBasicString1() string {
@@ -9998,7 +10387,6 @@ func (*ValidOptionsMultiCond1_OneofString1) isValidOptionsMultiCond1_OneTyp1() {
 func (*ValidOptionsMultiCond1_OneofInt64) isValidOptionsMultiCond1_OneTyp1() {}
 
 // message for check if.
-//
 type CheckIfOptions1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -10018,7 +10406,7 @@ type CheckIfOptions1 struct {
 func (x *CheckIfOptions1) Reset() {
 	*x = CheckIfOptions1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[26]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10031,7 +10419,7 @@ func (x *CheckIfOptions1) String() string {
 func (*CheckIfOptions1) ProtoMessage() {}
 
 func (x *CheckIfOptions1) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[26]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10044,7 +10432,7 @@ func (x *CheckIfOptions1) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckIfOptions1.ProtoReflect.Descriptor instead.
 func (*CheckIfOptions1) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{26}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{28}
 }
 
 func (m *CheckIfOptions1) GetOneofType1() isCheckIfOptions1_OneofType1 {
@@ -10135,7 +10523,7 @@ type CheckIfOptions2 struct {
 func (x *CheckIfOptions2) Reset() {
 	*x = CheckIfOptions2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[27]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10148,7 +10536,7 @@ func (x *CheckIfOptions2) String() string {
 func (*CheckIfOptions2) ProtoMessage() {}
 
 func (x *CheckIfOptions2) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[27]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10161,7 +10549,7 @@ func (x *CheckIfOptions2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckIfOptions2.ProtoReflect.Descriptor instead.
 func (*CheckIfOptions2) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{27}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{29}
 }
 
 func (m *CheckIfOptions2) GetOneofType1() isCheckIfOptions2_OneofType1 {
@@ -10250,7 +10638,7 @@ type CheckIfOptions3 struct {
 func (x *CheckIfOptions3) Reset() {
 	*x = CheckIfOptions3{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[28]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10263,7 +10651,7 @@ func (x *CheckIfOptions3) String() string {
 func (*CheckIfOptions3) ProtoMessage() {}
 
 func (x *CheckIfOptions3) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[28]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10276,7 +10664,7 @@ func (x *CheckIfOptions3) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckIfOptions3.ProtoReflect.Descriptor instead.
 func (*CheckIfOptions3) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{28}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *CheckIfOptions3) GetSeedString1() string {
@@ -10350,7 +10738,7 @@ type CheckIfOptions4 struct {
 func (x *CheckIfOptions4) Reset() {
 	*x = CheckIfOptions4{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[29]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10363,7 +10751,7 @@ func (x *CheckIfOptions4) String() string {
 func (*CheckIfOptions4) ProtoMessage() {}
 
 func (x *CheckIfOptions4) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[29]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10376,7 +10764,7 @@ func (x *CheckIfOptions4) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckIfOptions4.ProtoReflect.Descriptor instead.
 func (*CheckIfOptions4) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{29}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{31}
 }
 
 func (m *CheckIfOptions4) GetOneofType1() isCheckIfOptions4_OneofType1 {
@@ -10465,7 +10853,7 @@ type CheckIfOptions5 struct {
 func (x *CheckIfOptions5) Reset() {
 	*x = CheckIfOptions5{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[30]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10478,7 +10866,7 @@ func (x *CheckIfOptions5) String() string {
 func (*CheckIfOptions5) ProtoMessage() {}
 
 func (x *CheckIfOptions5) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[30]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10491,7 +10879,7 @@ func (x *CheckIfOptions5) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckIfOptions5.ProtoReflect.Descriptor instead.
 func (*CheckIfOptions5) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{30}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *CheckIfOptions5) GetSeedString1() string {
@@ -10559,7 +10947,7 @@ type CheckIfOptions6 struct {
 func (x *CheckIfOptions6) Reset() {
 	*x = CheckIfOptions6{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[31]
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -10572,7 +10960,7 @@ func (x *CheckIfOptions6) String() string {
 func (*CheckIfOptions6) ProtoMessage() {}
 
 func (x *CheckIfOptions6) ProtoReflect() protoreflect.Message {
-	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[31]
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10585,7 +10973,7 @@ func (x *CheckIfOptions6) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckIfOptions6.ProtoReflect.Descriptor instead.
 func (*CheckIfOptions6) Descriptor() ([]byte, []int) {
-	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{31}
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *CheckIfOptions6) GetSeedMapString() map[string]string {
@@ -10609,4047 +10997,5686 @@ func (x *CheckIfOptions6) GetTMapString2() map[string]string {
 	return nil
 }
 
-var File_xgo_tests_govalidatortest_govalidator_test_proto protoreflect.FileDescriptor
+// ValidateAll1 for test the method ValidateAll.
+type ValidateAll1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_xgo_tests_govalidatortest_govalidator_test_proto_rawDesc = []byte{
-	0x0a, 0x30, 0x78, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x0f, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x06, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x24, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xc2, 0x01, 0x0b, 0x1a, 0x09, 0x31, 0x32, 0x37,
-	0x2e, 0x30, 0x2e, 0x30, 0x2e, 0x31, 0x52, 0x02, 0x69, 0x70, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xb2, 0x01, 0x03, 0x18, 0x90, 0x3f, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xdc, 0x02, 0x0a,
-	0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x61, 0x67, 0x73, 0x31,
-	0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x31, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x01, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x33, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x33, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x27, 0x0a, 0x0e, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x34, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x34, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x31, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x35, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0d,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x35, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x42, 0x1a, 0x0a,
-	0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x0b, 0xba, 0xe0,
-	0x1f, 0x07, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x42, 0x1a, 0x0a, 0x0b, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x0b, 0xba, 0xe0, 0x1f, 0x07, 0x12, 0x05,
-	0xa2, 0x01, 0x02, 0x08, 0x00, 0x42, 0x18, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x33, 0x12, 0x09, 0xba, 0xe0, 0x1f, 0x05, 0x12, 0x03, 0xa2, 0x01, 0x00, 0x42,
-	0x15, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x34, 0x12, 0x06,
-	0xba, 0xe0, 0x1f, 0x02, 0x12, 0x00, 0x42, 0x13, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x35, 0x12, 0x04, 0xba, 0xe0, 0x1f, 0x00, 0x22, 0xd4, 0x09, 0x0a, 0x16,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74,
-	0x31, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x02, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x52, 0x07,
-	0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x12, 0x21, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12,
-	0x00, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x5f,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x42, 0x04, 0xe2, 0xdf,
-	0x1f, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x12, 0x32, 0x0a, 0x0b, 0x74,
-	0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99,
-	0x99, 0x99, 0xf1, 0x3f, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x71, 0x31, 0x12,
-	0x32, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21,
-	0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74,
-	0x4e, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c,
-	0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
-	0xaa, 0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x52, 0x09, 0x74, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x31, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40,
-	0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74,
-	0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66,
-	0x66, 0x66, 0x66, 0x14, 0x40, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x65,
-	0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74, 0x65,
-	0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa,
-	0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x52, 0x0a, 0x74, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x47, 0x74, 0x65, 0x31, 0x12, 0x43, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf,
-	0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x4a, 0x18, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1,
-	0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0xf3, 0x3f, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4,
-	0x3f, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x4a, 0x0a, 0x0f,
-	0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a,
-	0x52, 0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99,
-	0x01, 0x40, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x02, 0x40, 0x52, 0x0c, 0x74, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x31, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x32, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
-	0xaa, 0x01, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x12, 0x23, 0x0a,
-	0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x33, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x18,
-	0x22, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x34, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
-	0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52,
-	0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74,
-	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc,
-	0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x65,
-	0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74,
-	0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa,
-	0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x52, 0x0a, 0x74, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x4c, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2,
-	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10,
-	0x40, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x31, 0x12, 0x36, 0x0a,
-	0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x2c,
-	0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39,
-	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x14, 0x40, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40,
-	0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x65, 0x31, 0x12, 0x45, 0x0a,
-	0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2e, 0x20,
-	0x01, 0x28, 0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x4a, 0x18,
-	0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0xf3, 0x3f,
-	0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x49, 0x6e, 0x31, 0x12, 0x4c, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x23,
-	0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc,
-	0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66, 0x66, 0x66, 0x66,
-	0x66, 0x02, 0x40, 0x52, 0x0d, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x49,
-	0x6e, 0x31, 0x22, 0xcd, 0x0d, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x1e,
-	0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
-	0x48, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x29,
-	0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x48, 0x01, 0x52, 0x07, 0x74,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x5f, 0x66,
-	0x6c, 0x6f, 0x61, 0x74, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x12, 0x00, 0x48, 0x02, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x88, 0x01,
-	0x01, 0x12, 0x24, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x02, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52, 0x07, 0x74, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x34, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f,
-	0x48, 0x04, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x37, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09,
-	0x21, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x48, 0x05, 0x52, 0x09, 0x74, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x5f, 0x66,
-	0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12,
-	0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc,
-	0x08, 0x40, 0x48, 0x06, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x31, 0x88,
-	0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74,
-	0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa,
-	0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x48, 0x07, 0x52, 0x09, 0x74,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74,
-	0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66,
-	0x66, 0x66, 0x66, 0x14, 0x40, 0x48, 0x08, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c,
-	0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40,
-	0x48, 0x09, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x31,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01,
-	0x1a, 0x4a, 0x18, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33,
-	0x33, 0xf3, 0x3f, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x0a, 0x52, 0x09, 0x74,
-	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x0f, 0x74,
-	0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52,
-	0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01,
-	0x40, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x02, 0x40, 0x48, 0x0b, 0x52, 0x0c, 0x74, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09,
-	0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x48,
-	0x0c, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2b,
-	0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x18, 0x20, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x48, 0x0d, 0x52, 0x08,
-	0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x09, 0x74,
-	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x0e, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x33, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x34, 0x18, 0x22, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x0f,
-	0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
-	0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x28, 0x20,
-	0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a,
-	0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x48, 0x10, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12,
-	0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc,
-	0x00, 0x40, 0x48, 0x11, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
-	0x6c, 0x74, 0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
-	0x0c, 0xaa, 0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x48, 0x12, 0x52,
-	0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39,
-	0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x2b,
-	0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31,
-	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x48, 0x13, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0d, 0x74, 0x5f, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x01,
-	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66, 0x66,
-	0x66, 0x66, 0x14, 0x40, 0x48, 0x14, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c,
-	0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2,
-	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18,
-	0x40, 0x48, 0x15, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x65, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x4a, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
-	0x69, 0x6e, 0x31, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12,
-	0x1d, 0xaa, 0x01, 0x1a, 0x4a, 0x18, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33,
-	0x33, 0x33, 0x33, 0x33, 0xf3, 0x3f, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x16,
-	0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x51, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
-	0x69, 0x6e, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12,
-	0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99,
-	0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x02, 0x40, 0x48, 0x17,
-	0x52, 0x0d, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88,
-	0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x42,
-	0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x42, 0x0b, 0x0a, 0x09,
-	0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66,
-	0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x66,
-	0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
-	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62,
-	0x6c, 0x65, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74,
-	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a,
-	0x11, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x22, 0x8e, 0x0a, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x1b, 0x0a, 0x08, 0x74,
-	0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52,
-	0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
-	0x12, 0x03, 0xaa, 0x01, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x32,
-	0x12, 0x23, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x02, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x33, 0x12, 0x21, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
-	0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52,
-	0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2,
-	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1,
-	0x3f, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x71, 0x31, 0x12, 0x34,
-	0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd,
-	0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61,
-	0x74, 0x4e, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f,
-	0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
-	0x0c, 0xaa, 0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x48, 0x00, 0x52,
-	0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x5f,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x42,
-	0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66,
-	0x66, 0x10, 0x40, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x31,
-	0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x31,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01,
-	0x09, 0x39, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x14, 0x40, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12,
-	0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
-	0x18, 0x40, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x65, 0x31,
-	0x12, 0x45, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a,
-	0x4a, 0x18, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
-	0xf3, 0x3f, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46,
-	0x6c, 0x6f, 0x61, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x4c, 0x0a, 0x0f, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
-	0x61, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc, 0xcc,
-	0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66, 0x66,
-	0x66, 0x66, 0x66, 0x02, 0x40, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4e,
-	0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1d, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x31, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x32, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa,
-	0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x12, 0x25,
-	0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x18, 0x21, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x33, 0x12, 0x23, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x34, 0x18, 0x22, 0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00,
-	0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f,
-	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x01,
-	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99,
-	0x99, 0x99, 0xf1, 0x3f, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45,
-	0x71, 0x31, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e,
-	0x65, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
-	0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x48, 0x00, 0x52, 0x0a,
-	0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f,
-	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x01,
-	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc,
-	0xcc, 0xcc, 0x08, 0x40, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c,
-	0x74, 0x31, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67,
-	0x74, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
-	0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x48, 0x00, 0x52, 0x0a,
-	0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x31, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x5f,
-	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66,
-	0x66, 0x66, 0x66, 0x14, 0x40, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x4c, 0x74, 0x65, 0x31, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
-	0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x48,
-	0x00, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x65, 0x31, 0x12, 0x47,
-	0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2e,
-	0x20, 0x01, 0x28, 0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x4a,
-	0x18, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0xf3,
-	0x3f, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x31, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75,
-	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28,
-	0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc,
-	0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66,
-	0x66, 0x66, 0x66, 0x66, 0x02, 0x40, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74,
-	0x79, 0x70, 0x31, 0x22, 0xe2, 0x19, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x74,
-	0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x1a, 0x0a, 0x09,
-	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
-	0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12,
-	0x22, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74,
-	0x33, 0x32, 0x33, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x34, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45,
-	0x71, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65,
-	0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x20, 0x02, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12,
-	0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28,
-	0x03, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2b, 0x0a, 0x0b,
-	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x09,
-	0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0a, 0x74, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0a, 0x74, 0x49, 0x6e,
-	0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf,
-	0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x09, 0x74, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03,
-	0x52, 0x0c, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1a,
-	0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xe2,
-	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x32, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x49,
-	0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07,
-	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x45, 0x71, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65,
-	0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31,
-	0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x28, 0x03, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2b,
-	0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04,
-	0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74,
-	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0a,
-	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0a, 0x74,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x09,
-	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x25, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01,
-	0x02, 0x03, 0x52, 0x0c, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31,
-	0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29,
-	0x20, 0x01, 0x28, 0x11, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x12, 0x27,
-	0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01,
-	0x28, 0x11, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x52, 0x08, 0x74,
-	0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x11, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x22, 0x0a,
-	0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28,
-	0x11, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x34, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71,
-	0x31, 0x18, 0x32, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31,
-	0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31,
-	0x18, 0x33, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x20, 0x02, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12,
-	0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18,
-	0x34, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x28, 0x03, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2d,
-	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x35,
-	0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30,
-	0x04, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a,
-	0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x36,
-	0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38,
-	0x05, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f,
-	0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18,
-	0x37, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x40, 0x06, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12,
-	0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x38, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05,
-	0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e,
-	0x31, 0x12, 0x37, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0d, 0x74, 0x53, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x12, 0x52, 0x08,
-	0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x12, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x32, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18,
-	0x3f, 0x20, 0x01, 0x28, 0x12, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74,
-	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x12, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
-	0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2d, 0x0a, 0x0c, 0x74,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28,
-	0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0a,
-	0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x12,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0a, 0x74,
-	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x12, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x53,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x49, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0a, 0x74, 0x53, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0b, 0x74, 0x53, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x12, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0b, 0x74, 0x53,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x12, 0x42,
-	0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52,
-	0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x37, 0x0a, 0x10, 0x74,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x4d, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05,
-	0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f,
-	0x74, 0x49, 0x6e, 0x31, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x5f, 0x31, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x12, 0x2b, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x52, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x32, 0x12, 0x28, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x33, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12,
-	0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x12, 0x26, 0x0a,
-	0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x54, 0x20,
-	0x01, 0x28, 0x0f, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x34, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0f,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0c, 0x74,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x5c, 0x20,
-	0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03,
-	0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x31,
-	0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31,
-	0x18, 0x5d, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x30, 0x04, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74,
-	0x31, 0x12, 0x33, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x6c, 0x74, 0x65, 0x31, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x5f, 0x20, 0x01, 0x28, 0x0f, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0d, 0x74, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0e, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x60, 0x20,
-	0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03,
-	0x01, 0x02, 0x03, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e,
-	0x31, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x61, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2,
-	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0f, 0x74,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x20,
-	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x65,
-	0x20, 0x01, 0x28, 0x10, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x31,
-	0x12, 0x2b, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32,
-	0x18, 0x66, 0x20, 0x01, 0x28, 0x10, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01,
-	0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x32, 0x12, 0x28, 0x0a,
-	0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x67, 0x20,
-	0x01, 0x28, 0x10, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x68, 0x20, 0x01, 0x28, 0x10, 0x42, 0x04, 0xe2,
-	0xdf, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x34, 0x12,
-	0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71,
-	0x31, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45,
-	0x71, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x5f, 0x6e, 0x65, 0x31, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x70, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x71, 0x20, 0x01, 0x28, 0x10,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0c, 0x74,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x33, 0x0a, 0x0f, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x72,
-	0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38,
-	0x05, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31,
-	0x12, 0x33, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67,
-	0x74, 0x65, 0x31, 0x18, 0x73, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x74, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2,
-	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0c, 0x74,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x3b, 0x0a, 0x12, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
-	0x31, 0x18, 0x75, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2,
-	0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x22, 0x9f, 0x26, 0x0a, 0x15, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x49, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x31, 0x12, 0x1f, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01,
-	0x00, 0x48, 0x01, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x88, 0x01, 0x01, 0x12,
-	0x27, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x02, 0x52, 0x07, 0x74, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
-	0x00, 0x48, 0x03, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12,
-	0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18,
-	0x01, 0x48, 0x04, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x20, 0x02, 0x48, 0x05, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c,
-	0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x06, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c,
-	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x07, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x08, 0x52, 0x0a, 0x74, 0x49,
-	0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74,
-	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x09,
-	0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x33, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a,
-	0x03, 0x01, 0x02, 0x03, 0x48, 0x0a, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e,
-	0x31, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2,
-	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x0b, 0x52,
-	0x0c, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x1f, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20,
-	0x01, 0x28, 0x03, 0x48, 0x0c, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x2a, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48,
-	0x0d, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a,
-	0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x0e, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74,
-	0x36, 0x34, 0x33, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48,
-	0x0f, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
-	0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48,
-	0x10, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f,
-	0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20,
-	0x02, 0x48, 0x11, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31,
-	0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x28, 0x03, 0x48, 0x12, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67,
-	0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x13, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x47,
-	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x14, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74,
-	0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x15, 0x52, 0x0a,
-	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a,
-	0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01,
-	0x02, 0x03, 0x48, 0x16, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x88,
-	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x17, 0x52, 0x0c, 0x74,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x21,
-	0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01,
-	0x28, 0x11, 0x48, 0x18, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18,
-	0x2a, 0x20, 0x01, 0x28, 0x11, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00,
-	0x48, 0x19, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x88, 0x01, 0x01, 0x12,
-	0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20,
-	0x01, 0x28, 0x11, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x1a, 0x52, 0x08, 0x74,
-	0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x11, 0x42, 0x04,
-	0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x1b, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34,
-	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x65, 0x71, 0x31, 0x18, 0x32, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x1c, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x1d, 0x52, 0x0a, 0x74, 0x53,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x34, 0x20, 0x01, 0x28,
-	0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x1e,
-	0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18,
-	0x35, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x30, 0x04, 0x48, 0x1f, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6c, 0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x20, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x73,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x11,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x21, 0x52,
-	0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x35, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x38, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05,
-	0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x22, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x11,
-	0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03,
-	0x48, 0x23, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e,
-	0x31, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x12, 0x48, 0x24, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x12, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
-	0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x25, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x33, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x12, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12,
-	0x00, 0x48, 0x26, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x88, 0x01, 0x01,
-	0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40,
-	0x20, 0x01, 0x28, 0x12, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x27, 0x52, 0x08, 0x74, 0x53,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28, 0x12, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x28, 0x52, 0x0a,
-	0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a,
-	0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20,
-	0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02,
-	0x48, 0x29, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74,
-	0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x28, 0x03, 0x48, 0x2a, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c,
-	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x49, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x2b, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x73,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x12,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x2c, 0x52,
-	0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x18, 0x4b, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x40, 0x06, 0x48, 0x2d, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74,
-	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x2e, 0x52, 0x0a, 0x74,
-	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x10,
-	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31,
-	0x18, 0x4d, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01,
-	0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x2f, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x74, 0x5f,
-	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0f,
-	0x48, 0x30, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x32, 0x18, 0x52, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2,
-	0x01, 0x00, 0x48, 0x31, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32,
-	0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x33, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12,
-	0x00, 0x48, 0x32, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x88,
-	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x34, 0x18, 0x54, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x33,
-	0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12,
-	0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71,
-	0x31, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x18, 0x01, 0x48, 0x34, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0f, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x35, 0x52, 0x0c,
-	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74,
-	0x31, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x28, 0x03, 0x48, 0x36, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x0f, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x37, 0x52, 0x0c,
-	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x38, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74,
-	0x65, 0x31, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x38, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0f, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x5f, 0x20, 0x01,
-	0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48,
-	0x39, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x60, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x3a, 0x52, 0x0c, 0x74,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x40,
-	0x0a, 0x12, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74,
-	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x61, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
-	0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x3b, 0x52, 0x0f, 0x74, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x25, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31,
-	0x18, 0x65, 0x20, 0x01, 0x28, 0x10, 0x48, 0x3c, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x31, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x66, 0x20, 0x01, 0x28, 0x10, 0x42, 0x09, 0xe2,
-	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x3d, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x67, 0x20, 0x01, 0x28, 0x10, 0x42,
-	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x3e, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x36, 0x34, 0x33, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x68, 0x20, 0x01, 0x28, 0x10, 0x42, 0x04,
-	0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x3f, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x40, 0x52, 0x0c, 0x74, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
-	0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x6f, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x20, 0x02, 0x48, 0x41, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e,
-	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x70, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x42, 0x52, 0x0c, 0x74, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
-	0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18,
-	0x71, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x30, 0x04, 0x48, 0x43, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47,
-	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x72, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x44, 0x52, 0x0d, 0x74,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x38, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74,
-	0x65, 0x31, 0x18, 0x73, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x45, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x74, 0x20, 0x01, 0x28,
-	0x10, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02,
-	0x03, 0x48, 0x46, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e,
-	0x31, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x75, 0x20, 0x01, 0x28, 0x10,
-	0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03,
-	0x48, 0x47, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74,
-	0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x5f, 0x32, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31,
-	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74,
-	0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32,
-	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x12,
-	0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x42,
-	0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74,
-	0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67,
-	0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e,
-	0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e,
-	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f,
-	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x11, 0x0a,
-	0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x6c, 0x74, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x11,
-	0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e,
-	0x31, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f,
-	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x42, 0x11, 0x0a, 0x0f,
-	0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e,
-	0x65, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
-	0x69, 0x6e, 0x31, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x22, 0xfc, 0x1a, 0x0a, 0x12, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66,
-	0x31, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x12,
-	0x27, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x00, 0x52,
-	0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x22,
-	0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x34, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71,
-	0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71,
-	0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31,
-	0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12,
-	0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30,
-	0x04, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x2f,
-	0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38,
-	0x05, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12,
-	0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x40, 0x06, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31,
-	0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05,
-	0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49,
-	0x6e, 0x31, 0x12, 0x37, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x74,
-	0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00,
-	0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x27, 0x0a, 0x09, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x32, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52,
-	0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
-	0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2d, 0x0a, 0x0b,
-	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00,
-	0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74,
-	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52,
-	0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09,
-	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x09, 0x74,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x74,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0a,
-	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48,
-	0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x37, 0x0a, 0x0f,
-	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x25, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05,
-	0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e,
-	0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x11, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x11, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12,
-	0x03, 0xb2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32,
-	0x12, 0x26, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b,
-	0x20, 0x01, 0x28, 0x11, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08,
-	0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x11, 0x42, 0x04, 0xe2, 0xdf,
-	0x1f, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x12, 0x2f,
-	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x32,
-	0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18,
-	0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12,
-	0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x33, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
-	0x20, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31,
-	0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31,
-	0x18, 0x34, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74,
-	0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74,
-	0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47,
-	0x74, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c,
-	0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00,
-	0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a, 0x10,
-	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31,
-	0x18, 0x39, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01,
-	0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x12, 0x48, 0x00, 0x52, 0x08, 0x74,
-	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x12, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
-	0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x32, 0x12, 0x26, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33,
-	0x18, 0x3f, 0x20, 0x01, 0x28, 0x12, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00,
-	0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x12, 0x42, 0x04,
-	0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34,
-	0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31,
-	0x18, 0x46, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71,
-	0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65,
-	0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
-	0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e,
-	0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c,
-	0x74, 0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x4c, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x67, 0x74, 0x31, 0x18, 0x49, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x47, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b, 0x74,
-	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f,
-	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x12,
-	0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03,
-	0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x39,
-	0x0a, 0x10, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0f, 0x48,
-	0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x12, 0x2d, 0x0a,
-	0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x52, 0x20,
-	0x01, 0x28, 0x0f, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x00,
-	0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32, 0x12, 0x2a, 0x0a, 0x0c,
-	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x53, 0x20, 0x01,
-	0x28, 0x0f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x12, 0x28, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x54, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x04,
-	0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x34, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x65, 0x71, 0x31, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0f, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0c,
-	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0e,
-	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x5c,
-	0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28,
-	0x03, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74,
-	0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x67, 0x74, 0x31, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x0f, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0d,
-	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x35, 0x0a,
-	0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x18, 0x5f, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x47, 0x74, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x60, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf,
-	0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0c,
-	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x3d, 0x0a, 0x12,
-	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x18, 0x61, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x74,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x65, 0x20, 0x01, 0x28,
-	0x10, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x31, 0x12,
-	0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x18,
-	0x66, 0x20, 0x01, 0x28, 0x10, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00,
-	0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x32, 0x12, 0x2a,
-	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x67,
-	0x20, 0x01, 0x28, 0x10, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0a,
-	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x12, 0x28, 0x0a, 0x0c, 0x74, 0x5f,
-	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x68, 0x20, 0x01, 0x28, 0x10,
-	0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x34, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x6f, 0x20, 0x01, 0x28,
-	0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00,
-	0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x33,
-	0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31,
-	0x18, 0x70, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
-	0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x4c, 0x74, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x71, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x72, 0x20, 0x01, 0x28,
-	0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00,
-	0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12,
-	0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74,
-	0x65, 0x31, 0x18, 0x73, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x74, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00,
-	0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x3d,
-	0x0a, 0x12, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74,
-	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x75, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
-	0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x42, 0x0a, 0x0a,
-	0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0xaf, 0x11, 0x0a, 0x15, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x31, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x31, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00,
-	0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f,
-	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33,
-	0x12, 0x22, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x34, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e,
-	0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c,
-	0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74,
-	0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74,
-	0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
-	0x01, 0x02, 0x30, 0x04, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31,
-	0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65,
-	0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
-	0x01, 0x02, 0x38, 0x05, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65,
-	0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74,
-	0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74,
-	0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69,
-	0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x49, 0x6e, 0x31, 0x12, 0x37, 0x0a, 0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0d,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1c, 0x0a,
-	0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x27, 0x0a, 0x0a, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x32, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00,
-	0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x5f,
-	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04,
-	0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2d,
-	0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18,
-	0x01, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a,
-	0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02,
-	0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c,
-	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x52,
-	0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0a,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f,
-	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0b,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x52,
-	0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c,
-	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01,
-	0x28, 0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01,
-	0x02, 0x03, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x37,
-	0x0a, 0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x07, 0x52, 0x09, 0x74, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x12, 0x29, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x07, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x32, 0x12, 0x26, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x07, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52,
-	0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x5f,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x07, 0x42,
-	0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34,
-	0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71,
-	0x31, 0x18, 0x32, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
-	0x01, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x71,
-	0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e,
-	0x65, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e,
-	0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x6c, 0x74, 0x31, 0x18, 0x34, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x4c, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x67, 0x74, 0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x47, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x07, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0c, 0x74, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28,
-	0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02,
-	0x03, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x39,
-	0x0a, 0x11, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
-	0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
-	0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0e, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x31, 0x12, 0x29, 0x0a, 0x0b, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x06, 0x42, 0x09,
-	0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x32, 0x12, 0x26, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x5f, 0x33, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x06, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12,
-	0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x12, 0x24, 0x0a, 0x0b,
-	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01, 0x28,
-	0x06, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x34, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
-	0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x45, 0x71, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x4e, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x49, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0c, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28,
-	0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0c,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0d,
-	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20,
-	0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03,
-	0x01, 0x02, 0x03, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31,
-	0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0e, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x22, 0xe0, 0x19, 0x0a, 0x16,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x21, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xe2,
-	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x01, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x32, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x12, 0x00, 0x48, 0x02, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x88,
-	0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52, 0x08,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48, 0x04,
-	0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
-	0x20, 0x02, 0x48, 0x05, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x06, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33,
-	0x32, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x07, 0x52, 0x0a, 0x74, 0x55,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48,
-	0x08, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74,
-	0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x09, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2,
-	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x0a, 0x52,
-	0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3c,
-	0x0a, 0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x0b, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a,
-	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04,
-	0x48, 0x0c, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x0d,
-	0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a,
-	0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x0e, 0x52, 0x08, 0x74, 0x55, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x33, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0xdf,
-	0x1f, 0x00, 0x48, 0x0f, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x88, 0x01,
-	0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71,
-	0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
-	0x01, 0x02, 0x18, 0x01, 0x48, 0x10, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45,
-	0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x11, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x12, 0x52, 0x0a,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a,
-	0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04,
-	0x48, 0x13, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74,
-	0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x14, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x15, 0x52, 0x0b, 0x74,
-	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
-	0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20,
-	0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03,
-	0x01, 0x02, 0x03, 0x48, 0x16, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e,
-	0x31, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x17,
-	0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88,
-	0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
-	0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x07, 0x48, 0x18, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x07, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x19, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x07, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x12, 0x00, 0x48, 0x1a, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x33, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x07, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48,
-	0x1b, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12,
-	0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31,
-	0x18, 0x32, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
-	0x02, 0x18, 0x01, 0x48, 0x1c, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45,
-	0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x1d, 0x52, 0x0b, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x34, 0x20, 0x01,
-	0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48,
-	0x1e, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67,
-	0x74, 0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x1f, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x07, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x20, 0x52, 0x0c,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65,
-	0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
-	0x01, 0x02, 0x40, 0x06, 0x48, 0x21, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x22,
-	0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x3e, 0x0a, 0x11, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x23, 0x52, 0x0e, 0x74,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x23, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18,
-	0x3d, 0x20, 0x01, 0x28, 0x06, 0x48, 0x24, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x06, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
-	0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x25, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x33, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x06, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x12, 0x00, 0x48, 0x26, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x88,
-	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
-	0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x06, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x27, 0x52,
-	0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
-	0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46,
-	0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18,
-	0x01, 0x48, 0x28, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x71, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x29, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x06,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x2a, 0x52,
-	0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31,
-	0x18, 0x49, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
-	0x02, 0x30, 0x04, 0x48, 0x2b, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47,
-	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x2c, 0x52, 0x0c, 0x74, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a,
-	0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18,
-	0x4b, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
-	0x40, 0x06, 0x48, 0x2d, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74,
-	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf,
-	0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x2e, 0x52, 0x0b,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3e,
-	0x0a, 0x11, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
-	0x69, 0x6e, 0x31, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
-	0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x2f, 0x52, 0x0e, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x42, 0x0d,
-	0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x42, 0x0d, 0x0a,
-	0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x42, 0x0d, 0x0a, 0x0b,
-	0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
-	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
-	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74,
-	0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x5f, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x75,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a,
-	0x11, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x32, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x33, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x34, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x65, 0x71, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x11, 0x0a,
-	0x0f, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69,
-	0x6e, 0x31, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x10,
-	0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31,
-	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c,
-	0x74, 0x65, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x22, 0x99,
-	0x12, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73,
-	0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
-	0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x32, 0x12, 0x26, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52,
-	0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0xe2,
-	0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x12,
-	0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
-	0x18, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31,
-	0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
-	0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65,
-	0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74,
-	0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
-	0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c,
-	0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67,
-	0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
-	0x47, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
-	0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x55,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x42,
-	0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48,
-	0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a,
-	0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
-	0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba,
-	0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x32, 0x12, 0x26, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x33, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48,
-	0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x34, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71,
-	0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
-	0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45,
-	0x71, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e,
-	0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x4e, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
-	0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x4c, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x55, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b,
-	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02,
-	0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12,
-	0x39, 0x0a, 0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
-	0x69, 0x6e, 0x31, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
-	0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x55, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x5f,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x07, 0x48,
-	0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x12, 0x2b, 0x0a, 0x0b,
-	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28,
-	0x07, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x00, 0x52, 0x09,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32, 0x12, 0x28, 0x0a, 0x0b, 0x74, 0x5f, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x07, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x33, 0x12, 0x26, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x07, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00,
-	0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x12, 0x31, 0x0a, 0x0d, 0x74,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x32, 0x20, 0x01,
-	0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48,
-	0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x31,
-	0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x33, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
-	0x20, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65,
-	0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c,
-	0x74, 0x31, 0x18, 0x34, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x4c, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
-	0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x07, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0c,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0e,
-	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37,
-	0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40,
-	0x06, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65,
-	0x31, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69,
-	0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x3b, 0x0a, 0x11, 0x74, 0x5f, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01,
-	0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01,
-	0x02, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x6f,
-	0x74, 0x49, 0x6e, 0x31, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
-	0x34, 0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x06, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
-	0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x32, 0x12, 0x28, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x5f, 0x33, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x06, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00,
-	0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x12, 0x26, 0x0a,
-	0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01,
-	0x28, 0x06, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x36, 0x34, 0x34, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x06, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0b,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20, 0x01,
-	0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48,
-	0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x31,
-	0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18,
-	0x49, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
-	0x30, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74,
-	0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c,
-	0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0c, 0x74,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0d, 0x74,
-	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01,
-	0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01,
-	0x02, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e,
-	0x31, 0x12, 0x3b, 0x0a, 0x11, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e,
-	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf,
-	0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0e,
-	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x42, 0x0a,
-	0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0x8a, 0x07, 0x0a, 0x0e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x42, 0x6f, 0x6f, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x31, 0x12, 0x27, 0x0a,
-	0x10, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f,
-	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x32, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c,
-	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xd2, 0x01, 0x00, 0x52, 0x0d, 0x74, 0x42, 0x6f,
-	0x6f, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x32, 0x12, 0x2f, 0x0a, 0x10, 0x74, 0x5f,
-	0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x33, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x08, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x0d, 0x74, 0x42,
-	0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x33, 0x12, 0x2d, 0x0a, 0x10, 0x74,
-	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x34, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x08, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x42, 0x6f,
-	0x6f, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x34, 0x12, 0x38, 0x0a, 0x12, 0x74, 0x5f,
-	0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x65, 0x71, 0x31,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xd2, 0x01,
-	0x02, 0x18, 0x01, 0x52, 0x0f, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x6c, 0x45, 0x71, 0x31, 0x12, 0x2e, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x48,
-	0x01, 0x52, 0x0e, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
-	0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xd2, 0x01, 0x00, 0x48, 0x02, 0x52, 0x0e, 0x74, 0x42,
-	0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x32, 0x88, 0x01, 0x01, 0x12,
-	0x36, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x12, 0x00, 0x48, 0x03, 0x52, 0x0e, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x33, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01,
-	0x28, 0x08, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x04, 0x52, 0x0e, 0x74, 0x42, 0x6f, 0x6f,
-	0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x34, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a,
-	0x13, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
-	0x5f, 0x65, 0x71, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x05, 0x52, 0x10, 0x74, 0x42, 0x6f, 0x6f, 0x6c,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x25,
-	0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x31,
-	0x18, 0x29, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f,
-	0x6e, 0x66, 0x6f, 0x66, 0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f,
-	0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0xe2,
-	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xd2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f,
-	0x6c, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x32, 0x12, 0x2d, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x6f, 0x6f,
-	0x6c, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x42,
-	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f, 0x6c,
-	0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x33, 0x12, 0x2b, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c,
-	0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x04,
-	0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x6e, 0x66,
-	0x6f, 0x66, 0x34, 0x12, 0x36, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x42,
-	0x6f, 0x6f, 0x6c, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x45, 0x71, 0x31, 0x42, 0x0a, 0x0a, 0x08, 0x6f,
-	0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x62, 0x6f,
-	0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x31, 0x42, 0x14, 0x0a,
-	0x12, 0x5f, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x5f, 0x32, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x33, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f,
-	0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x34, 0x42,
-	0x16, 0x0a, 0x14, 0x5f, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x5f, 0x65, 0x71, 0x31, 0x22, 0x84, 0x0b, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x13,
-	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x6c, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x52, 0x10, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x6c, 0x31, 0x12, 0x51, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
-	0x12, 0x03, 0xe2, 0x01, 0x00, 0x52, 0x10, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x32, 0x12, 0x4e, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x33, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x10, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x33, 0x12, 0x4c, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x34, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xe2,
-	0xdf, 0x1f, 0x00, 0x52, 0x10, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x6c, 0x34, 0x12, 0x62, 0x0a, 0x1b, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e,
-	0x75, 0x6c, 0x6c, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x17, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x6c, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x12, 0x62, 0x0a, 0x1b, 0x74, 0x5f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6e,
-	0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2,
-	0x01, 0x02, 0x10, 0x00, 0x52, 0x17, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x32, 0x12, 0x5b, 0x0a,
-	0x17, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2,
-	0x01, 0x02, 0x18, 0x00, 0x52, 0x14, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x6b, 0x69, 0x70, 0x31, 0x12, 0x5b, 0x0a, 0x17, 0x74, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f,
-	0x73, 0x6b, 0x69, 0x70, 0x32, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x18,
-	0x01, 0x52, 0x14, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x53, 0x6b, 0x69, 0x70, 0x32, 0x12, 0x44, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x31, 0x12, 0x4f, 0x0a,
-	0x11, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66,
-	0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xe2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x0e,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x32, 0x12, 0x4c,
-	0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x66, 0x6f,
-	0x66, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x33, 0x12, 0x4a, 0x0a, 0x11,
-	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f,
-	0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x34, 0x12, 0x60, 0x0a, 0x19, 0x74, 0x5f, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
-	0x6e, 0x75, 0x6c, 0x6c, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10,
-	0x01, 0x48, 0x00, 0x52, 0x15, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x12, 0x60, 0x0a, 0x19, 0x74, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6e, 0x6f,
-	0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x32, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01,
-	0x02, 0x10, 0x00, 0x48, 0x00, 0x52, 0x15, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f,
-	0x6e, 0x65, 0x6f, 0x66, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x32, 0x12, 0x59, 0x0a, 0x15,
-	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f,
-	0x73, 0x6b, 0x69, 0x70, 0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x18,
-	0x00, 0x48, 0x00, 0x52, 0x12, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65,
-	0x6f, 0x66, 0x53, 0x6b, 0x69, 0x70, 0x31, 0x12, 0x59, 0x0a, 0x15, 0x74, 0x5f, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x32,
-	0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x12,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x6b, 0x69,
-	0x70, 0x32, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0xed,
-	0x06, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x30, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e,
-	0x75, 0x6d, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x31, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x74, 0x5f,
-	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
-	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xda, 0x01, 0x00, 0x52,
-	0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x32, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75,
-	0x6d, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x31, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d,
-	0x33, 0x12, 0x36, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x34, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
-	0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x34, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x5f, 0x65,
-	0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02,
-	0x18, 0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x71, 0x31, 0x12, 0x41, 0x0a, 0x0a,
-	0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xda, 0x01, 0x02, 0x20, 0x01, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x65, 0x31, 0x12,
-	0x41, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x28, 0x02, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c,
-	0x74, 0x31, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x31,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x30, 0x03, 0x52, 0x08, 0x74, 0x45, 0x6e,
-	0x75, 0x6d, 0x47, 0x74, 0x31, 0x12, 0x43, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
-	0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x38, 0x04, 0x52,
-	0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x43, 0x0a, 0x0b, 0x74, 0x5f,
-	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda,
-	0x01, 0x02, 0x40, 0x08, 0x52, 0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x65, 0x31, 0x12,
-	0x44, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xda, 0x01, 0x05, 0x4a, 0x03, 0x00, 0x01, 0x02, 0x52, 0x08, 0x74, 0x45, 0x6e,
-	0x75, 0x6d, 0x49, 0x6e, 0x31, 0x12, 0x4b, 0x0a, 0x0e, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
-	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05,
-	0x52, 0x03, 0x03, 0x04, 0x08, 0x52, 0x0b, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x49,
-	0x6e, 0x31, 0x12, 0x4a, 0x0a, 0x0f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x5f,
-	0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
-	0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01,
-	0x52, 0x0c, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x22, 0xf5,
-	0x08, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x5f, 0x65,
-	0x6e, 0x75, 0x6d, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
-	0x75, 0x6d, 0x31, 0x48, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x40, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
-	0x12, 0x03, 0xda, 0x01, 0x00, 0x48, 0x01, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x32, 0x88,
-	0x01, 0x01, 0x12, 0x3d, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x33, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x12, 0x00, 0x48, 0x02, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x33, 0x88, 0x01,
-	0x01, 0x12, 0x3b, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x34, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
-	0x00, 0x48, 0x03, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x34, 0x88, 0x01, 0x01, 0x12, 0x46,
-	0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xda, 0x01, 0x02, 0x18, 0x00, 0x48, 0x04, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d,
-	0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
-	0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x20, 0x01, 0x48,
-	0x05, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x46,
-	0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xda, 0x01, 0x02, 0x28, 0x02, 0x48, 0x06, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d,
-	0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
-	0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x30, 0x03, 0x48,
-	0x07, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x48,
-	0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x38, 0x04, 0x48, 0x08, 0x52, 0x09, 0x74, 0x45, 0x6e, 0x75,
-	0x6d, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e,
-	0x75, 0x6d, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02,
-	0x40, 0x08, 0x48, 0x09, 0x52, 0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x65, 0x31, 0x88,
-	0x01, 0x01, 0x12, 0x49, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x31,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05, 0x4a, 0x03, 0x00, 0x01, 0x02, 0x48, 0x0a,
-	0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a,
-	0x0e, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2,
-	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05, 0x52, 0x03, 0x03, 0x04, 0x08, 0x48, 0x0b, 0x52,
-	0x0b, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x4f, 0x0a, 0x0f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75,
-	0x6d, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x48, 0x0c, 0x52,
-	0x0c, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x31, 0x42, 0x0b, 0x0a,
-	0x09, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x32, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74,
-	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x33, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x65, 0x6e,
-	0x75, 0x6d, 0x5f, 0x34, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
-	0x65, 0x71, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e,
-	0x65, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74,
-	0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x31,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x65, 0x31,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x31, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
-	0x6e, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e,
-	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x22, 0x91, 0x07, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x32,
-	0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x48, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75,
-	0x6d, 0x31, 0x12, 0x3d, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x32, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xda, 0x01, 0x00, 0x48, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d,
-	0x32, 0x12, 0x3a, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x33, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x33, 0x12, 0x38, 0x0a,
-	0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52,
-	0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x34, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75,
-	0x6d, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
-	0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x18, 0x00,
-	0x48, 0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x71, 0x31, 0x12, 0x43, 0x0a, 0x0a,
-	0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xda, 0x01, 0x02, 0x20, 0x01, 0x48, 0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x65,
-	0x31, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x31, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2,
-	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x28, 0x02, 0x48, 0x00, 0x52, 0x08, 0x74, 0x45,
-	0x6e, 0x75, 0x6d, 0x4c, 0x74, 0x31, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
-	0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x30, 0x03, 0x48,
-	0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x31, 0x12, 0x45, 0x0a, 0x0b, 0x74,
-	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xda, 0x01, 0x02, 0x38, 0x04, 0x48, 0x00, 0x52, 0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x74,
-	0x65, 0x31, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x65,
-	0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x40, 0x08, 0x48, 0x00, 0x52, 0x09,
-	0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x65, 0x31, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f, 0x65,
-	0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05,
-	0x4a, 0x03, 0x00, 0x01, 0x02, 0x48, 0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e,
-	0x31, 0x12, 0x4d, 0x0a, 0x0e, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
-	0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05, 0x52, 0x03, 0x03, 0x04,
-	0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31,
-	0x12, 0x4c, 0x0a, 0x0f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e,
-	0x75, 0x6d, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
-	0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x48, 0x00,
-	0x52, 0x0c, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x42, 0x0a,
-	0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0xd6, 0x03, 0x0a, 0x0f, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x61, 0x67, 0x73, 0x31, 0x12, 0x1a,
-	0x0a, 0x09, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xe2,
-	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xca, 0x01, 0x00, 0x52, 0x07, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x32, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x33, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x33, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
-	0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07,
-	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x34, 0x12, 0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x74,
-	0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x32, 0x0a, 0x0f, 0x74,
-	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x20,
-	0x02, 0x52, 0x0c, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12,
-	0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c,
-	0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xca, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e,
-	0x4c, 0x74, 0x31, 0x12, 0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c,
-	0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0c, 0x74, 0x42, 0x79, 0x74, 0x65,
-	0x73, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0d,
-	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a,
-	0x10, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65,
-	0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca,
-	0x01, 0x02, 0x40, 0x06, 0x52, 0x0d, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x47,
-	0x74, 0x65, 0x31, 0x22, 0xb9, 0x13, 0x0a, 0x19, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x70,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
-	0x31, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x31, 0x18,
-	0x65, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x31, 0x12,
-	0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x32, 0x18, 0x66, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xea, 0x01, 0x00, 0x52, 0x08,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x32, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x5f, 0x31, 0x30, 0x33, 0x18, 0x67, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x33, 0x12, 0x22,
-	0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x34, 0x18, 0x68, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31,
-	0x30, 0x34, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x35,
-	0x18, 0x69, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xea, 0x01,
-	0x05, 0x5a, 0x03, 0xc2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x35,
-	0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x36, 0x18, 0x6a,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x5a,
-	0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x36, 0x12, 0x35, 0x0a, 0x0a, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x37, 0x18, 0x6b, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31,
-	0x30, 0x37, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x38,
-	0x18, 0x6c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
-	0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xea, 0x01, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x31, 0x30, 0x38, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31,
-	0x30, 0x39, 0x18, 0x6d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x31, 0x30, 0x39, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31,
-	0x30, 0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x30,
-	0x12, 0x45, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x31, 0x18, 0x6f,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0e, 0xe2,
-	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xea, 0x01, 0x05, 0x5a, 0x03, 0xe2, 0x01, 0x00, 0x52, 0x08, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x31, 0x12, 0x42, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x31, 0x31, 0x32, 0x18, 0x70, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x5a,
-	0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x32, 0x12, 0x34, 0x0a, 0x0a, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x33, 0x18, 0x71, 0x20, 0x03, 0x28, 0x0e, 0x32,
-	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31,
-	0x33, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x34, 0x18,
-	0x72, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x09, 0xe2,
-	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xea, 0x01, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31,
-	0x31, 0x34, 0x12, 0x3c, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x35,
-	0x18, 0x73, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x06,
-	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x35,
-	0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x36, 0x18, 0x74,
-	0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf,
-	0x1f, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x36, 0x12, 0x44, 0x0a, 0x0a,
-	0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x37, 0x18, 0x75, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xea, 0x01, 0x05, 0x5a, 0x03, 0xda, 0x01, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31,
-	0x31, 0x37, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x38,
-	0x18, 0x76, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x5a, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x31, 0x31, 0x38, 0x12, 0x34, 0x0a, 0x10, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
-	0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x31, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x74, 0x4c,
-	0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20,
-	0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x18, 0x01,
-	0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x30, 0x0a,
-	0x0e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02,
-	0x20, 0x02, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12,
-	0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
-	0x31, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea,
-	0x01, 0x02, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x4c, 0x74,
-	0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xea, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e,
-	0x47, 0x74, 0x31, 0x12, 0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65,
-	0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0c, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0c, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x12, 0x3c, 0x0a, 0x14, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
-	0x65, 0x18, 0x20, 0x20, 0x03, 0x28, 0x01, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea,
-	0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x21,
-	0x20, 0x03, 0x28, 0x02, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50,
-	0x01, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x6c,
-	0x6f, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x22, 0x20, 0x03, 0x28, 0x05,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x10, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12,
-	0x3a, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x23, 0x20, 0x03, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x3c, 0x0a, 0x14, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x75, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
-	0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
-	0x71, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c,
-	0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x18, 0x25, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea,
-	0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75,
-	0x65, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18,
-	0x26, 0x20, 0x03, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02,
-	0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x53,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
-	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x27, 0x20,
-	0x03, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01,
-	0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x28, 0x20,
-	0x03, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01,
-	0x52, 0x13, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
-	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18,
-	0x29, 0x20, 0x03, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02,
-	0x50, 0x01, 0x52, 0x13, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x18, 0x2a, 0x20, 0x03, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
-	0x02, 0x50, 0x01, 0x52, 0x12, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
-	0x18, 0x2b, 0x20, 0x03, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
-	0x02, 0x50, 0x01, 0x52, 0x12, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x38, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x2c, 0x20,
-	0x03, 0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01,
-	0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x6f, 0x6f,
-	0x6c, 0x12, 0x50, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71,
-	0x75, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02,
-	0x50, 0x01, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x45,
-	0x6e, 0x75, 0x6d, 0x12, 0x5e, 0x0a, 0x15, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x2e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
-	0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09, 0x50, 0x01, 0x5a, 0x05, 0xe2, 0x01, 0x02, 0x18, 0x01, 0x52,
-	0x12, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e,
-	0x69, 0x71, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x2f, 0x20, 0x03, 0x28, 0x0c,
-	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x10, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22,
-	0xd0, 0x09, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x54, 0x61, 0x67, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x31, 0x12, 0x43, 0x0a, 0x12, 0x74, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10, 0xea, 0x01,
-	0x0d, 0x30, 0x01, 0x5a, 0x09, 0xc2, 0x01, 0x06, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x52, 0x0f,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x4f, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64,
-	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x20, 0x20, 0x03, 0x28, 0x01, 0x42, 0x22, 0xe2, 0xdf, 0x1f,
-	0x1e, 0x12, 0x1c, 0xea, 0x01, 0x19, 0x30, 0x01, 0x5a, 0x15, 0xaa, 0x01, 0x12, 0x39, 0x33, 0x33,
-	0x33, 0x33, 0x33, 0x33, 0x26, 0x40, 0x41, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52,
-	0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
-	0x12, 0x4d, 0x0a, 0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x21, 0x20, 0x03, 0x28, 0x02, 0x42, 0x22, 0xe2, 0xdf, 0x1f,
-	0x1e, 0x12, 0x1c, 0xea, 0x01, 0x19, 0x30, 0x01, 0x5a, 0x15, 0xaa, 0x01, 0x12, 0x39, 0x33, 0x33,
-	0x33, 0x33, 0x33, 0x33, 0x26, 0x40, 0x41, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52,
-	0x0e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12,
-	0x3f, 0x0a, 0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x18, 0x22, 0x20, 0x03, 0x28, 0x05, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10,
-	0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02,
-	0x52, 0x0e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x74, 0x33, 0x32,
-	0x12, 0x3f, 0x0a, 0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x23, 0x20, 0x03, 0x28, 0x03, 0x42, 0x14, 0xe2, 0xdf, 0x1f,
-	0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40,
-	0x02, 0x52, 0x0e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x12, 0x41, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x26, 0x20, 0x03, 0x28, 0x11, 0x42, 0x14, 0xe2,
-	0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38,
-	0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x12, 0x41, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69,
-	0x74, 0x65, 0x6d, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x27, 0x20, 0x03, 0x28, 0x12,
-	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2,
-	0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x45, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18,
-	0x28, 0x20, 0x03, 0x28, 0x0f, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b,
-	0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x11, 0x74, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x45,
-	0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x29, 0x20, 0x03, 0x28, 0x10, 0x42, 0x14, 0xe2, 0xdf,
-	0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a,
-	0x40, 0x02, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x66, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x41, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x24, 0x20, 0x03, 0x28,
-	0x0d, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07,
-	0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x41, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69,
-	0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x25,
-	0x20, 0x03, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30,
-	0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x43, 0x0a, 0x13, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x07, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e,
-	0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x10,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
-	0x12, 0x43, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x06, 0x42, 0x14, 0xe2,
-	0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38,
-	0x0a, 0x40, 0x02, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x3b, 0x0a, 0x10, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
-	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x08, 0x42,
-	0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09, 0x30, 0x01, 0x5a, 0x05, 0xd2, 0x01,
-	0x02, 0x18, 0x01, 0x52, 0x0d, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x6f,
-	0x6f, 0x6c, 0x12, 0x53, 0x0a, 0x10, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65,
-	0x6d, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x2d, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
-	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09, 0x30,
-	0x01, 0x5a, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x52, 0x0d, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x3d, 0x0a, 0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73,
-	0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x2e, 0x20, 0x03,
-	0x28, 0x0c, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09, 0x30, 0x01, 0x5a,
-	0x05, 0xca, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
-	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x2f, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09, 0x30, 0x01, 0x5a, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0xde, 0x1d, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
-	0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x4e, 0x0a, 0x09, 0x74,
-	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x31, 0x18, 0x65, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
-	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x31, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x31, 0x12, 0x59, 0x0a, 0x09, 0x74,
-	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x32, 0x18, 0x66, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32,
-	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x32, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x07, 0x74,
-	0x4d, 0x61, 0x70, 0x31, 0x30, 0x32, 0x12, 0x56, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f,
-	0x31, 0x30, 0x33, 0x18, 0x67, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31,
-	0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x33, 0x12, 0x54,
-	0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x34, 0x18, 0x68, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x34,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61,
-	0x70, 0x31, 0x30, 0x34, 0x12, 0x5e, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30,
-	0x35, 0x18, 0x69, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
-	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54,
-	0x4d, 0x61, 0x70, 0x31, 0x30, 0x35, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xf2, 0x01, 0x05, 0x5a, 0x03, 0xc2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61,
-	0x70, 0x31, 0x30, 0x35, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30,
-	0x36, 0x18, 0x6a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
-	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54,
-	0x4d, 0x61, 0x70, 0x31, 0x30, 0x36, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x5a, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30,
-	0x36, 0x12, 0x5e, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x37, 0x18, 0x6b,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
-	0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
-	0x31, 0x30, 0x37, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
-	0xf2, 0x01, 0x05, 0x62, 0x03, 0xc2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30,
-	0x37, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x38, 0x18, 0x6c,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
-	0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
-	0x31, 0x30, 0x38, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
-	0xf2, 0x01, 0x02, 0x62, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x38, 0x12, 0x4e,
-	0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x31, 0x18, 0x6f, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x31,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x31, 0x12, 0x59,
-	0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x32, 0x18, 0x70, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x32,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xf2, 0x01, 0x00,
-	0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x32, 0x12, 0x56, 0x0a, 0x09, 0x74, 0x5f, 0x6d,
-	0x61, 0x70, 0x5f, 0x31, 0x31, 0x33, 0x18, 0x71, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67,
-	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31,
-	0x33, 0x12, 0x54, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x34, 0x18, 0x72,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
-	0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
-	0x31, 0x31, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07,
-	0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x34, 0x12, 0x5e, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70,
-	0x5f, 0x31, 0x31, 0x35, 0x18, 0x73, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
-	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x35, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e,
-	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xf2, 0x01, 0x05, 0x5a, 0x03, 0xc2, 0x01, 0x00, 0x52, 0x07,
-	0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x35, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70,
-	0x5f, 0x31, 0x31, 0x36, 0x18, 0x74, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
-	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x36, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b,
-	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x5a, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61,
-	0x70, 0x31, 0x31, 0x36, 0x12, 0x5e, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31,
-	0x37, 0x18, 0x75, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
-	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54,
-	0x4d, 0x61, 0x70, 0x31, 0x31, 0x37, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
-	0x0a, 0x12, 0x08, 0xf2, 0x01, 0x05, 0x62, 0x03, 0xe2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61,
-	0x70, 0x31, 0x31, 0x37, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31,
-	0x38, 0x18, 0x76, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
-	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54,
-	0x4d, 0x61, 0x70, 0x31, 0x31, 0x38, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x62, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31,
-	0x38, 0x12, 0x6b, 0x0a, 0x0f, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e,
-	0x75, 0x6c, 0x6c, 0x31, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
-	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x0c, 0x74, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x12, 0x65,
-	0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18,
-	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70,
-	0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61,
-	0x70, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf,
-	0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x4c,
-	0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x65, 0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c,
-	0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67,
-	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x20, 0x02,
-	0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x65, 0x0a, 0x0d,
-	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61,
-	0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c,
-	0x65, 0x6e, 0x4c, 0x74, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xf2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e,
-	0x4c, 0x74, 0x31, 0x12, 0x65, 0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e,
-	0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
-	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0a,
-	0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x68, 0x0a, 0x0e, 0x74, 0x5f,
-	0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
-	0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65,
-	0x6e, 0x4c, 0x74, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xf2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0b, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e,
-	0x4c, 0x74, 0x65, 0x31, 0x12, 0x68, 0x0a, 0x0e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65,
-	0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67,
-	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x40,
-	0x06, 0x52, 0x0b, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x1a, 0x3a,
-	0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d,
-	0x61, 0x70, 0x31, 0x30, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30,
-	0x33, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x34, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a,
-	0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x35, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d,
-	0x61, 0x70, 0x31, 0x30, 0x36, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30,
-	0x37, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x38, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53,
-	0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	Name   string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config *Config            `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Items  []*Config          `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Labels map[string]*Config `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ports  []int32            `protobuf:"varint,5,rep,packed,name=ports,proto3" json:"ports,omitempty"`
+}
+
+func (x *ValidateAll1) Reset() {
+	*x = ValidateAll1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateAll1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateAll1) ProtoMessage() {}
+
+func (x *ValidateAll1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateAll1.ProtoReflect.Descriptor instead.
+func (*ValidateAll1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ValidateAll1) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ValidateAll1) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ValidateAll1) GetItems() []*Config {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ValidateAll1) GetLabels() map[string]*Config {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ValidateAll1) GetPorts() []int32 {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// ValidateError1 for test the data of ValidateError.
+type ValidateError1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserEmail string   `protobuf:"bytes,1,opt,name=user_email,json=userEmail,proto3" json:"user_email,omitempty"`
+	TagNames  []string `protobuf:"bytes,2,rep,name=tag_names,json=tagNames,proto3" json:"tag_names,omitempty"`
+	// Types that are assignable to Kind:
+	//	*ValidateError1_KindName
+	Kind isValidateError1_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *ValidateError1) Reset() {
+	*x = ValidateError1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ValidateError1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateError1) ProtoMessage() {}
+
+func (x *ValidateError1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateError1.ProtoReflect.Descriptor instead.
+func (*ValidateError1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ValidateError1) GetUserEmail() string {
+	if x != nil {
+		return x.UserEmail
+	}
+	return ""
+}
+
+func (x *ValidateError1) GetTagNames() []string {
+	if x != nil {
+		return x.TagNames
+	}
+	return nil
+}
+
+func (m *ValidateError1) GetKind() isValidateError1_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *ValidateError1) GetKindName() string {
+	if x, ok := x.GetKind().(*ValidateError1_KindName); ok {
+		return x.KindName
+	}
+	return ""
+}
+
+type isValidateError1_Kind interface {
+	isValidateError1_Kind()
+}
+
+type ValidateError1_KindName struct {
+	KindName string `protobuf:"bytes,3,opt,name=kind_name,json=kindName,proto3,oneof"`
+}
+
+func (*ValidateError1_KindName) isValidateError1_Kind() {}
+
+// FieldPath1 for test the full path of field in ValidateError.
+type FieldPath1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Order   *FieldPath1_Order           `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	Labels  map[string]*FieldPath1_Item `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Indexes map[int32]*FieldPath1_Item  `protobuf:"bytes,3,rep,name=indexes,proto3" json:"indexes,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *FieldPath1) Reset() {
+	*x = FieldPath1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldPath1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldPath1) ProtoMessage() {}
+
+func (x *FieldPath1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldPath1.ProtoReflect.Descriptor instead.
+func (*FieldPath1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *FieldPath1) GetOrder() *FieldPath1_Order {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+func (x *FieldPath1) GetLabels() map[string]*FieldPath1_Item {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *FieldPath1) GetIndexes() map[int32]*FieldPath1_Item {
+	if x != nil {
+		return x.Indexes
+	}
+	return nil
+}
+
+// CustomMessage1 for test the options message, error_code and messages.
+type CustomMessage1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port     int32    `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	UserName string   `protobuf:"bytes,2,opt,name=user_name,json=userName,proto3" json:"user_name,omitempty"`
+	Emails   []string `protobuf:"bytes,3,rep,name=emails,proto3" json:"emails,omitempty"`
+	// Types that are assignable to Kind:
+	//	*CustomMessage1_KindName
+	Kind isCustomMessage1_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *CustomMessage1) Reset() {
+	*x = CustomMessage1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CustomMessage1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CustomMessage1) ProtoMessage() {}
+
+func (x *CustomMessage1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CustomMessage1.ProtoReflect.Descriptor instead.
+func (*CustomMessage1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CustomMessage1) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *CustomMessage1) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CustomMessage1) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (m *CustomMessage1) GetKind() isCustomMessage1_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *CustomMessage1) GetKindName() string {
+	if x, ok := x.GetKind().(*CustomMessage1_KindName); ok {
+		return x.KindName
+	}
+	return ""
+}
+
+type isCustomMessage1_Kind interface {
+	isCustomMessage1_Kind()
+}
+
+type CustomMessage1_KindName struct {
+	KindName string `protobuf:"bytes,4,opt,name=kind_name,json=kindName,proto3,oneof"`
+}
+
+func (*CustomMessage1_KindName) isCustomMessage1_Kind() {}
+
+// MessageRules1 for test the cross-field constraints in message options.
+type MessageRules1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartTime  *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	MinCount   int32                  `protobuf:"varint,3,opt,name=min_count,json=minCount,proto3" json:"min_count,omitempty"`
+	MaxCount   int32                  `protobuf:"varint,4,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+	Timeout    *durationpb.Duration   `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	MaxTimeout *durationpb.Duration   `protobuf:"bytes,6,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+	OptMin     *int64                 `protobuf:"varint,7,opt,name=opt_min,json=optMin,proto3,oneof" json:"opt_min,omitempty"`
+	OptMax     *int64                 `protobuf:"varint,8,opt,name=opt_max,json=optMax,proto3,oneof" json:"opt_max,omitempty"`
+	Email      string                 `protobuf:"bytes,10,opt,name=email,proto3" json:"email,omitempty"`
+	Phone      string                 `protobuf:"bytes,11,opt,name=phone,proto3" json:"phone,omitempty"`
+	ById       int64                  `protobuf:"varint,12,opt,name=by_id,json=byId,proto3" json:"by_id,omitempty"`
+	ByName     string                 `protobuf:"bytes,13,opt,name=by_name,json=byName,proto3" json:"by_name,omitempty"`
+	Tags       []string               `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	Username   string                 `protobuf:"bytes,15,opt,name=username,proto3" json:"username,omitempty"`
+	Password   []byte                 `protobuf:"bytes,16,opt,name=password,proto3" json:"password,omitempty"`
+	Cert       []byte                 `protobuf:"bytes,17,opt,name=cert,proto3" json:"cert,omitempty"`
+	Key        []byte                 `protobuf:"bytes,18,opt,name=key,proto3" json:"key,omitempty"`
+	Token      string                 `protobuf:"bytes,19,opt,name=token,proto3" json:"token,omitempty"`
+	Child      *MessageRules1         `protobuf:"bytes,20,opt,name=child,proto3" json:"child,omitempty"`
+}
+
+func (x *MessageRules1) Reset() {
+	*x = MessageRules1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessageRules1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageRules1) ProtoMessage() {}
+
+func (x *MessageRules1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageRules1.ProtoReflect.Descriptor instead.
+func (*MessageRules1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MessageRules1) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetMinCount() int32 {
+	if x != nil {
+		return x.MinCount
+	}
+	return 0
+}
+
+func (x *MessageRules1) GetMaxCount() int32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+func (x *MessageRules1) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetMaxTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetOptMin() int64 {
+	if x != nil && x.OptMin != nil {
+		return *x.OptMin
+	}
+	return 0
+}
+
+func (x *MessageRules1) GetOptMax() int64 {
+	if x != nil && x.OptMax != nil {
+		return *x.OptMax
+	}
+	return 0
+}
+
+func (x *MessageRules1) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *MessageRules1) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *MessageRules1) GetById() int64 {
+	if x != nil {
+		return x.ById
+	}
+	return 0
+}
+
+func (x *MessageRules1) GetByName() string {
+	if x != nil {
+		return x.ByName
+	}
+	return ""
+}
+
+func (x *MessageRules1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MessageRules1) GetPassword() []byte {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetCert() []byte {
+	if x != nil {
+		return x.Cert
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *MessageRules1) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *MessageRules1) GetChild() *MessageRules1 {
+	if x != nil {
+		return x.Child
+	}
+	return nil
+}
+
+// ExprRules1 for test the expressions in message options.
+type ExprRules1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Prefix      string                 `protobuf:"bytes,2,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Items       []*ExprRules1_Item     `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	MaxItems    int32                  `protobuf:"varint,4,opt,name=max_items,json=maxItems,proto3" json:"max_items,omitempty"`
+	Start       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=start,proto3" json:"start,omitempty"`
+	End         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=end,proto3" json:"end,omitempty"`
+	Status      ExprRules1_Status      `protobuf:"varint,7,opt,name=status,proto3,enum=govalidatortest.ExprRules1_Status" json:"status,omitempty"`
+	Tags        []string               `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
+	Level       uint32                 `protobuf:"varint,9,opt,name=level,proto3" json:"level,omitempty"`
+	Code        string                 `protobuf:"bytes,10,opt,name=code,proto3" json:"code,omitempty"`
+	Opt         *int64                 `protobuf:"varint,11,opt,name=opt,proto3,oneof" json:"opt,omitempty"`
+	Labels      map[string]int32       `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	LabelsLimit int32                  `protobuf:"varint,13,opt,name=labels_limit,json=labelsLimit,proto3" json:"labels_limit,omitempty"`
+	// Types that are assignable to By:
+	//	*ExprRules1_ById
+	//	*ExprRules1_ByName
+	By isExprRules1_By `protobuf_oneof:"by"`
+}
+
+func (x *ExprRules1) Reset() {
+	*x = ExprRules1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprRules1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprRules1) ProtoMessage() {}
+
+func (x *ExprRules1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprRules1.ProtoReflect.Descriptor instead.
+func (*ExprRules1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ExprRules1) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExprRules1) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *ExprRules1) GetItems() []*ExprRules1_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ExprRules1) GetMaxItems() int32 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+func (x *ExprRules1) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ExprRules1) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ExprRules1) GetStatus() ExprRules1_Status {
+	if x != nil {
+		return x.Status
+	}
+	return ExprRules1_Unknown
+}
+
+func (x *ExprRules1) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ExprRules1) GetLevel() uint32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *ExprRules1) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ExprRules1) GetOpt() int64 {
+	if x != nil && x.Opt != nil {
+		return *x.Opt
+	}
+	return 0
+}
+
+func (x *ExprRules1) GetLabels() map[string]int32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ExprRules1) GetLabelsLimit() int32 {
+	if x != nil {
+		return x.LabelsLimit
+	}
+	return 0
+}
+
+func (m *ExprRules1) GetBy() isExprRules1_By {
+	if m != nil {
+		return m.By
+	}
+	return nil
+}
+
+func (x *ExprRules1) GetById() int64 {
+	if x, ok := x.GetBy().(*ExprRules1_ById); ok {
+		return x.ById
+	}
+	return 0
+}
+
+func (x *ExprRules1) GetByName() string {
+	if x, ok := x.GetBy().(*ExprRules1_ByName); ok {
+		return x.ByName
+	}
+	return ""
+}
+
+type isExprRules1_By interface {
+	isExprRules1_By()
+}
+
+type ExprRules1_ById struct {
+	ById int64 `protobuf:"varint,14,opt,name=by_id,json=byId,proto3,oneof"`
+}
+
+type ExprRules1_ByName struct {
+	ByName string `protobuf:"bytes,15,opt,name=by_name,json=byName,proto3,oneof"`
+}
+
+func (*ExprRules1_ById) isExprRules1_By() {}
+
+func (*ExprRules1_ByName) isExprRules1_By() {}
+
+// ExprRules2 for test the macros that don't use the variable.
+type ExprRules2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items  []string         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Labels map[string]int32 `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *ExprRules2) Reset() {
+	*x = ExprRules2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprRules2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprRules2) ProtoMessage() {}
+
+func (x *ExprRules2) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprRules2.ProtoReflect.Descriptor instead.
+func (*ExprRules2) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ExprRules2) GetItems() []string {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ExprRules2) GetLabels() map[string]int32 {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+// ExprRules3 for test the comparisons of the null timestamp and duration.
+type ExprRules3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	Timeouts   []*durationpb.Duration `protobuf:"bytes,3,rep,name=timeouts,proto3" json:"timeouts,omitempty"`
+	MaxTimeout *durationpb.Duration   `protobuf:"bytes,4,opt,name=max_timeout,json=maxTimeout,proto3" json:"max_timeout,omitempty"`
+}
+
+func (x *ExprRules3) Reset() {
+	*x = ExprRules3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprRules3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprRules3) ProtoMessage() {}
+
+func (x *ExprRules3) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprRules3.ProtoReflect.Descriptor instead.
+func (*ExprRules3) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ExprRules3) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *ExprRules3) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *ExprRules3) GetTimeouts() []*durationpb.Duration {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+func (x *ExprRules3) GetMaxTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTimeout
+	}
+	return nil
+}
+
+// WellKnownTypes1 for test the tags of well-known types.
+type WellKnownTypes1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TsRange      *timestamppb.Timestamp            `protobuf:"bytes,1,opt,name=ts_range,json=tsRange,proto3" json:"ts_range,omitempty"`
+	TsPast       *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=ts_past,json=tsPast,proto3" json:"ts_past,omitempty"`
+	TsFuture     *timestamppb.Timestamp            `protobuf:"bytes,3,opt,name=ts_future,json=tsFuture,proto3" json:"ts_future,omitempty"`
+	TsWithin     *timestamppb.Timestamp            `protobuf:"bytes,4,opt,name=ts_within,json=tsWithin,proto3" json:"ts_within,omitempty"`
+	Timeout      *durationpb.Duration              `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	Delays       []*durationpb.Duration            `protobuf:"bytes,6,rep,name=delays,proto3" json:"delays,omitempty"`
+	Count        *wrapperspb.Int64Value            `protobuf:"bytes,7,opt,name=count,proto3" json:"count,omitempty"`
+	Port         *wrapperspb.UInt32Value           `protobuf:"bytes,8,opt,name=port,proto3" json:"port,omitempty"`
+	Ratio        *wrapperspb.DoubleValue           `protobuf:"bytes,9,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	Name         *wrapperspb.StringValue           `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	Enabled      *wrapperspb.BoolValue             `protobuf:"bytes,11,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Data         *wrapperspb.BytesValue            `protobuf:"bytes,12,opt,name=data,proto3" json:"data,omitempty"`
+	OptionalName *wrapperspb.StringValue           `protobuf:"bytes,13,opt,name=optional_name,json=optionalName,proto3" json:"optional_name,omitempty"`
+	Levels       map[string]*wrapperspb.Int32Value `protobuf:"bytes,14,rep,name=levels,proto3" json:"levels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Detail       *anypb.Any                        `protobuf:"bytes,15,opt,name=detail,proto3" json:"detail,omitempty"`
+	Extra        *anypb.Any                        `protobuf:"bytes,16,opt,name=extra,proto3" json:"extra,omitempty"`
+}
+
+func (x *WellKnownTypes1) Reset() {
+	*x = WellKnownTypes1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WellKnownTypes1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WellKnownTypes1) ProtoMessage() {}
+
+func (x *WellKnownTypes1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WellKnownTypes1.ProtoReflect.Descriptor instead.
+func (*WellKnownTypes1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WellKnownTypes1) GetTsRange() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TsRange
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetTsPast() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TsPast
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetTsFuture() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TsFuture
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetTsWithin() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TsWithin
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetDelays() []*durationpb.Duration {
+	if x != nil {
+		return x.Delays
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetCount() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Count
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetPort() *wrapperspb.UInt32Value {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetRatio() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Ratio
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetEnabled() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Enabled
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetData() *wrapperspb.BytesValue {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetOptionalName() *wrapperspb.StringValue {
+	if x != nil {
+		return x.OptionalName
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetLevels() map[string]*wrapperspb.Int32Value {
+	if x != nil {
+		return x.Levels
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetDetail() *anypb.Any {
+	if x != nil {
+		return x.Detail
+	}
+	return nil
+}
+
+func (x *WellKnownTypes1) GetExtra() *anypb.Any {
+	if x != nil {
+		return x.Extra
+	}
+	return nil
+}
+
+type FieldPath1_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sku string `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+}
+
+func (x *FieldPath1_Item) Reset() {
+	*x = FieldPath1_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldPath1_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldPath1_Item) ProtoMessage() {}
+
+func (x *FieldPath1_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldPath1_Item.ProtoReflect.Descriptor instead.
+func (*FieldPath1_Item) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *FieldPath1_Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+type FieldPath1_Order struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*FieldPath1_Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Notes []string           `protobuf:"bytes,2,rep,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *FieldPath1_Order) Reset() {
+	*x = FieldPath1_Order{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldPath1_Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldPath1_Order) ProtoMessage() {}
+
+func (x *FieldPath1_Order) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldPath1_Order.ProtoReflect.Descriptor instead.
+func (*FieldPath1_Order) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *FieldPath1_Order) GetItems() []*FieldPath1_Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *FieldPath1_Order) GetNotes() []string {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+type ExprRules1_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sku   string  `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *ExprRules1_Item) Reset() {
+	*x = ExprRules1_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExprRules1_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExprRules1_Item) ProtoMessage() {}
+
+func (x *ExprRules1_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExprRules1_Item.ProtoReflect.Descriptor instead.
+func (*ExprRules1_Item) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP(), []int{39, 0}
+}
+
+func (x *ExprRules1_Item) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ExprRules1_Item) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+var File_xgo_tests_govalidatortest_govalidator_test_proto protoreflect.FileDescriptor
+
+var file_xgo_tests_govalidatortest_govalidator_test_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x78, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0f, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
+	0x65, 0x73, 0x74, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61,
+	0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x50, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x24, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf,
+	0x1f, 0x10, 0x12, 0x0e, 0xc2, 0x01, 0x0b, 0x1a, 0x09, 0x31, 0x32, 0x37, 0x2e, 0x30, 0x2e, 0x30,
+	0x2e, 0x31, 0x52, 0x02, 0x69, 0x70, 0x12, 0x20, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xb2, 0x01, 0x03, 0x18,
+	0x90, 0x3f, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xdc, 0x02, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x54, 0x61, 0x67, 0x73, 0x31, 0x12, 0x27, 0x0a, 0x0e,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x27,
+	0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x33, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x33,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
+	0x34, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x34, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
+	0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x35, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x35, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x42, 0x1a, 0x0a, 0x0b, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x0b, 0xba, 0xe0, 0x1f, 0x07, 0x12, 0x05,
+	0xa2, 0x01, 0x02, 0x08, 0x01, 0x42, 0x1a, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x32, 0x12, 0x0b, 0xba, 0xe0, 0x1f, 0x07, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08,
+	0x00, 0x42, 0x18, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x33,
+	0x12, 0x09, 0xba, 0xe0, 0x1f, 0x05, 0x12, 0x03, 0xa2, 0x01, 0x00, 0x42, 0x15, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x34, 0x12, 0x06, 0xba, 0xe0, 0x1f, 0x02,
+	0x12, 0x00, 0x42, 0x13, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x35, 0x12, 0x04, 0xba, 0xe0, 0x1f, 0x00, 0x22, 0xd4, 0x09, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x31, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x12, 0x24, 0x0a,
+	0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42,
+	0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x32, 0x12, 0x21, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x12, 0x1f, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07,
+	0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf,
+	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f,
+	0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x71, 0x31, 0x12, 0x32, 0x0a, 0x0b, 0x74,
+	0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02,
+	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc, 0xcc,
+	0xcc, 0xcc, 0x00, 0x40, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4e, 0x65, 0x31, 0x12,
+	0x32, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x29,
+	0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x4c, 0x74, 0x31, 0x12, 0x32, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67,
+	0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
+	0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x52, 0x09, 0x74, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2,
+	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x14,
+	0x40, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a,
+	0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47,
+	0x74, 0x65, 0x31, 0x12, 0x43, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x69,
+	0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d,
+	0xaa, 0x01, 0x1a, 0x4a, 0x18, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33,
+	0x33, 0x33, 0x33, 0xf3, 0x3f, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x52, 0x09, 0x74,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x4a, 0x0a, 0x0f, 0x74, 0x5f, 0x66, 0x6c,
+	0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc,
+	0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x02, 0x40, 0x52, 0x0c, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4e, 0x6f,
+	0x74, 0x49, 0x6e, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x31, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x52,
+	0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x12, 0x23, 0x0a, 0x09, 0x74, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x12, 0x21,
+	0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x34, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x71,
+	0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa,
+	0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52, 0x0a, 0x74, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2,
+	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00,
+	0x40, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x31, 0x12, 0x34, 0x0a,
+	0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x2a, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x29, 0xcd,
+	0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x4c, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
+	0x67, 0x74, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
+	0x0c, 0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x52, 0x0a, 0x74,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x31, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x14, 0x40, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x74, 0x65,
+	0x31, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74,
+	0x65, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
+	0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x52, 0x0b, 0x74, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x65, 0x31, 0x12, 0x45, 0x0a, 0x0c, 0x74, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x4a, 0x18, 0x9a, 0x99, 0x99, 0x99,
+	0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0xf3, 0x3f, 0xcd, 0xcc, 0xcc, 0xcc,
+	0xcc, 0xcc, 0xf4, 0x3f, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x31,
+	0x12, 0x4c, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f,
+	0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a,
+	0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x02, 0x40, 0x52,
+	0x0d, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x22, 0xcd,
+	0x0d, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x1e, 0x0a, 0x08, 0x74, 0x5f,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x07,
+	0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x5f,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x09, 0xe2, 0xdf,
+	0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x48, 0x01, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x32, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48,
+	0x02, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a,
+	0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x42,
+	0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x34,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x65,
+	0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
+	0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x48, 0x04, 0x52, 0x09,
+	0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b,
+	0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc,
+	0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x48, 0x05, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4e,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
+	0x12, 0x0c, 0xaa, 0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x48, 0x06,
+	0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x37,
+	0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x48, 0x07, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2,
+	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x14,
+	0x40, 0x48, 0x08, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4c, 0x74, 0x65, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67, 0x74,
+	0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
+	0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x48, 0x09, 0x52, 0x0a,
+	0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a,
+	0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x4a, 0x18, 0x9a,
+	0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0xf3, 0x3f, 0xcd,
+	0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x0a, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x0f, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02,
+	0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc, 0xcc,
+	0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x02, 0x40, 0x48, 0x0b, 0x52, 0x0c, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4e,
+	0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x01, 0x48, 0x0c, 0x52, 0x08, 0x74,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x09, 0x74, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x18, 0x20, 0x20, 0x01, 0x28, 0x01, 0x42, 0x09, 0xe2,
+	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x48, 0x0d, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x32, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x33, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x12, 0x00, 0x48, 0x0e, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x88, 0x01,
+	0x01, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x0f, 0x52, 0x08, 0x74, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99,
+	0x99, 0xf1, 0x3f, 0x48, 0x10, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x71,
+	0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x5f, 0x6e, 0x65, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
+	0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x48, 0x11,
+	0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x31, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09,
+	0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x48, 0x12, 0x52, 0x0a, 0x74, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66,
+	0x66, 0x66, 0x10, 0x40, 0x48, 0x13, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47,
+	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf,
+	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x14, 0x40,
+	0x48, 0x14, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x74, 0x65, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67,
+	0x74, 0x65, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
+	0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x48, 0x15, 0x52,
+	0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x4a, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x31, 0x18,
+	0x2e, 0x20, 0x01, 0x28, 0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a,
+	0x4a, 0x18, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+	0xf3, 0x3f, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x16, 0x52, 0x0a, 0x74, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x10, 0x74,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
+	0x2f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a,
+	0x52, 0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99,
+	0x01, 0x40, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x02, 0x40, 0x48, 0x17, 0x52, 0x0d, 0x74, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x42, 0x0b,
+	0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x33, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x34, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x65,
+	0x71, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e,
+	0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c,
+	0x74, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x67,
+	0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c,
+	0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f,
+	0x67, 0x74, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x5f, 0x69, 0x6e, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x32, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x33, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x71,
+	0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e,
+	0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
+	0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x22, 0x8e,
+	0x0a, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x54, 0x61, 0x67,
+	0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x1b, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f,
+	0x61, 0x74, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x31, 0x12, 0x26, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x32,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01,
+	0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x12, 0x23, 0x0a, 0x08,
+	0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x33, 0x12, 0x21, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x34, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x34, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f,
+	0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
+	0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x71, 0x31, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x5f,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x42,
+	0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc,
+	0xcc, 0x00, 0x40, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4e, 0x65, 0x31,
+	0x12, 0x34, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x31, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09,
+	0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x6c,
+	0x6f, 0x61, 0x74, 0x4c, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
+	0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x48,
+	0x00, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x31, 0x12, 0x36, 0x0a, 0x0c,
+	0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66,
+	0x66, 0x66, 0x66, 0x66, 0x14, 0x40, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x4c, 0x74, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f,
+	0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
+	0x12, 0x0c, 0xaa, 0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x48, 0x00,
+	0x52, 0x0a, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x47, 0x74, 0x65, 0x31, 0x12, 0x45, 0x0a, 0x0b,
+	0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x02, 0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x4a, 0x18, 0x9a, 0x99,
+	0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0xf3, 0x3f, 0xcd, 0xcc,
+	0xcc, 0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74,
+	0x49, 0x6e, 0x31, 0x12, 0x4c, 0x0a, 0x0f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x42, 0x23, 0xe2, 0xdf,
+	0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00,
+	0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x02,
+	0x40, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x4e, 0x6f, 0x74, 0x49, 0x6e,
+	0x31, 0x12, 0x1d, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x01, 0x48, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31,
+	0x12, 0x28, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x18, 0x20, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xaa, 0x01, 0x00, 0x48, 0x00,
+	0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x33, 0x18, 0x21, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x33, 0x12, 0x23, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x34, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf,
+	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x19, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x71, 0x31, 0x12, 0x36,
+	0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x29,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21,
+	0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x00, 0x40, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x4e, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf,
+	0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x29, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc, 0x08, 0x40,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x74, 0x31, 0x12, 0x36,
+	0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x2b,
+	0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31,
+	0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x10, 0x40, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x47, 0x74, 0x31, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2,
+	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x39, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x14,
+	0x40, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4c, 0x74, 0x65, 0x31,
+	0x12, 0x38, 0x0a, 0x0d, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x67, 0x74, 0x65,
+	0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa,
+	0x01, 0x09, 0x41, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66, 0x18, 0x40, 0x48, 0x00, 0x52, 0x0b, 0x74,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x47, 0x74, 0x65, 0x31, 0x12, 0x47, 0x0a, 0x0c, 0x74, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x01,
+	0x42, 0x23, 0xe2, 0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x4a, 0x18, 0x9a, 0x99, 0x99,
+	0x99, 0x99, 0x99, 0xf1, 0x3f, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0xf3, 0x3f, 0xcd, 0xcc, 0xcc,
+	0xcc, 0xcc, 0xcc, 0xf4, 0x3f, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x49, 0x6e, 0x31, 0x12, 0x4e, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
+	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x01, 0x42, 0x23, 0xe2,
+	0xdf, 0x1f, 0x1f, 0x12, 0x1d, 0xaa, 0x01, 0x1a, 0x52, 0x18, 0xcd, 0xcc, 0xcc, 0xcc, 0xcc, 0xcc,
+	0x00, 0x40, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0x01, 0x40, 0x66, 0x66, 0x66, 0x66, 0x66, 0x66,
+	0x02, 0x40, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x74,
+	0x49, 0x6e, 0x31, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22,
+	0xe2, 0x19, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x1a, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x74, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2,
+	0x01, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12, 0x22, 0x0a, 0x09, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12,
+	0x20, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x34, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
+	0x02, 0x18, 0x01, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x2b,
+	0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02,
+	0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x4c, 0x74, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47,
+	0x74, 0x65, 0x31, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69,
+	0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
+	0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x49, 0x6e, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf,
+	0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0c, 0x74, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1a, 0x0a, 0x09, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12,
+	0x03, 0xb2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x12, 0x22, 0x0a,
+	0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x33, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x34, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65,
+	0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xb2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31,
+	0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18,
+	0x1f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
+	0x20, 0x02, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2b, 0x0a,
+	0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x09, 0x74, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e,
+	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0c,
+	0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1c, 0x0a, 0x0a,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x11,
+	0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x11, 0x42, 0x09,
+	0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x32, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x11, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52,
+	0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x11, 0x42, 0x04, 0xe2,
+	0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x12, 0x2d, 0x0a,
+	0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01,
+	0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a, 0x0c,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x33, 0x20, 0x01,
+	0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x52,
+	0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x34, 0x20, 0x01, 0x28,
+	0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0a,
+	0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x11,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0a, 0x74,
+	0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x11,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0b, 0x74,
+	0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28,
+	0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0b,
+	0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28,
+	0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02,
+	0x03, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x37, 0x0a,
+	0x10, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2,
+	0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x12, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x31, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x12, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xb2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x12, 0x24, 0x0a,
+	0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x3f, 0x20, 0x01, 0x28,
+	0x12, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x33, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x12, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74,
+	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x31, 0x18, 0x49, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
+	0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0a, 0x74, 0x53, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x37, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4d, 0x20, 0x01, 0x28,
+	0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02,
+	0x03, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31,
+	0x12, 0x20, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x31,
+	0x18, 0x51, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x31, 0x12, 0x2b, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x32, 0x18, 0x52, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xb2, 0x01, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32, 0x12,
+	0x28, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x18,
+	0x53, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x0a, 0x74,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x54, 0x20, 0x01, 0x28, 0x0f, 0x42,
+	0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x34, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f,
+	0x65, 0x71, 0x31, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x45, 0x71, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x0f, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x5d, 0x20, 0x01,
+	0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52,
+	0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x33, 0x0a,
+	0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31,
+	0x18, 0x5e, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
+	0x02, 0x38, 0x05, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74,
+	0x65, 0x31, 0x12, 0x33, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x5f, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x60, 0x20, 0x01, 0x28, 0x0f, 0x42,
+	0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52,
+	0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x3b, 0x0a,
+	0x12, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x31, 0x18, 0x61, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
+	0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x65, 0x20, 0x01, 0x28, 0x10,
+	0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x31, 0x12, 0x2b, 0x0a, 0x0c,
+	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x66, 0x20, 0x01,
+	0x28, 0x10, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x52, 0x0a, 0x74,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x32, 0x12, 0x28, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x67, 0x20, 0x01, 0x28, 0x10, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x33, 0x12, 0x26, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x5f, 0x34, 0x18, 0x68, 0x20, 0x01, 0x28, 0x10, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52,
+	0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x34, 0x12, 0x31, 0x0a, 0x0e, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x6e, 0x20,
+	0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01,
+	0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x31,
+	0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31,
+	0x18, 0x6f, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
+	0x02, 0x20, 0x02, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x65,
+	0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x6c, 0x74, 0x31, 0x18, 0x70, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x4c, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x71, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x33, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x72, 0x20, 0x01, 0x28, 0x10,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0d, 0x74,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0f,
+	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18,
+	0x73, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
+	0x40, 0x06, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x65,
+	0x31, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x69, 0x6e, 0x31, 0x18, 0x74, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
+	0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x75, 0x20,
+	0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03,
+	0x01, 0x02, 0x03, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f,
+	0x74, 0x49, 0x6e, 0x31, 0x22, 0x9f, 0x26, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
+	0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x1f,
+	0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x2a, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x01, 0x52,
+	0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x09, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x02, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x33, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52,
+	0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x04, 0x52,
+	0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48,
+	0x05, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28,
+	0x03, 0x48, 0x06, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
+	0x02, 0x30, 0x04, 0x48, 0x07, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c,
+	0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x08, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x09, 0x52, 0x0a, 0x74, 0x49,
+	0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0b, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03,
+	0x48, 0x0a, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x3a, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
+	0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x0b, 0x52, 0x0c, 0x74, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x09,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x0c, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a,
+	0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x0d, 0x52, 0x07, 0x74,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x09, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x12, 0x00, 0x48, 0x0e, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x88,
+	0x01, 0x01, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x0f, 0x52, 0x07, 0x74,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x10, 0x52, 0x09, 0x74,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0b, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x11, 0x52,
+	0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48,
+	0x12, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30,
+	0x04, 0x48, 0x13, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65,
+	0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
+	0x01, 0x02, 0x38, 0x05, 0x48, 0x14, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x15, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0b, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e,
+	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x16,
+	0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3a,
+	0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x25, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2,
+	0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x17, 0x52, 0x0c, 0x74, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x74, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x11, 0x48, 0x18,
+	0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a,
+	0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28,
+	0x11, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x19, 0x52, 0x08,
+	0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0a, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x11, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x1a, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x11, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00,
+	0x48, 0x1b, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12,
+	0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
+	0x18, 0x01, 0x48, 0x1c, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x6e, 0x65, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x1d, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x34, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x1e, 0x52, 0x0a, 0x74, 0x53,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x35, 0x20, 0x01, 0x28,
+	0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x1f,
+	0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31,
+	0x18, 0x36, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
+	0x02, 0x38, 0x05, 0x48, 0x20, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x21, 0x52, 0x0b, 0x74, 0x53, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28,
+	0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02,
+	0x03, 0x48, 0x22, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf,
+	0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x23, 0x52, 0x0d,
+	0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x3d,
+	0x20, 0x01, 0x28, 0x12, 0x48, 0x24, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x12, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2,
+	0x01, 0x00, 0x48, 0x25, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x88, 0x01,
+	0x01, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18,
+	0x3f, 0x20, 0x01, 0x28, 0x12, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x26, 0x52,
+	0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x12,
+	0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x27, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x28, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x12, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x29, 0x52, 0x0a,
+	0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a,
+	0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20,
+	0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03,
+	0x48, 0x2a, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74,
+	0x31, 0x18, 0x49, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
+	0x01, 0x02, 0x30, 0x04, 0x48, 0x2b, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47,
+	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x2c, 0x52, 0x0b, 0x74, 0x53, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01,
+	0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48,
+	0x2d, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2,
+	0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x2e, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4d, 0x20, 0x01,
+	0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01,
+	0x02, 0x03, 0x48, 0x2f, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74,
+	0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0f, 0x48, 0x30, 0x52, 0x0a,
+	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a,
+	0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x52, 0x20,
+	0x01, 0x28, 0x0f, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x31,
+	0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32, 0x88, 0x01, 0x01, 0x12,
+	0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x18,
+	0x53, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x32, 0x52,
+	0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x2b,
+	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x54,
+	0x20, 0x01, 0x28, 0x0f, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x33, 0x52, 0x0a, 0x74, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x5a, 0x20,
+	0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01,
+	0x48, 0x34, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x71, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x35, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x5c, 0x20,
+	0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03,
+	0x48, 0x36, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x5d, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x37, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0f, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x5e,
+	0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38,
+	0x05, 0x48, 0x38, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x5f, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x39, 0x52, 0x0d, 0x74,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x60, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2,
+	0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x3a, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x12, 0x74, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31,
+	0x18, 0x61, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01,
+	0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x3b, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0c,
+	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x65, 0x20, 0x01,
+	0x28, 0x10, 0x48, 0x3c, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x5f, 0x32, 0x18, 0x66, 0x20, 0x01, 0x28, 0x10, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12,
+	0x03, 0xb2, 0x01, 0x00, 0x48, 0x3d, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x67, 0x20, 0x01, 0x28, 0x10, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x12, 0x00, 0x48, 0x3e, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x33, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x34, 0x18, 0x68, 0x20, 0x01, 0x28, 0x10, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00,
+	0x48, 0x3f, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x34, 0x88, 0x01,
+	0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x65, 0x71, 0x31, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x40, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x6f, 0x20, 0x01, 0x28,
+	0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x41,
+	0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x6c, 0x74, 0x31, 0x18, 0x70, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x42, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x71, 0x20, 0x01, 0x28,
+	0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x43,
+	0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x38, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x6c, 0x74, 0x65, 0x31, 0x18, 0x72, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x44, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0f, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x73,
+	0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40,
+	0x06, 0x48, 0x45, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x74, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2,
+	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x46, 0x52,
+	0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x40, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x75, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2, 0xdf,
+	0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x47, 0x52, 0x0f,
+	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88,
+	0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x42, 0x0d, 0x0a, 0x0b, 0x5f,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a,
+	0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74,
+	0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a,
+	0x11, 0x5f, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
+	0x6e, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x5f, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x5f, 0x32, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x33, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67,
+	0x74, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x15, 0x0a,
+	0x13, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x5f, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x11,
+	0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74,
+	0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x42,
+	0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x22, 0xfc, 0x1a, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x12, 0x27, 0x0a, 0x09, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x09,
+	0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x32, 0x12, 0x24, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48,
+	0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x04, 0xe2,
+	0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x12, 0x2d,
+	0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01,
+	0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a,
+	0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48,
+	0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0b,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00,
+	0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00,
+	0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0b,
+	0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02,
+	0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x37,
+	0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2,
+	0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x49, 0x6e, 0x74, 0x33,
+	0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x27, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x03, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xb2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x12, 0x24,
+	0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x07, 0x74, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x33, 0x12, 0x22, 0x0a, 0x09, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52,
+	0x07, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x09, 0x74, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
+	0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x09, 0x74,
+	0x49, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x37, 0x0a, 0x0f, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x25, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02,
+	0x03, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e,
+	0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18,
+	0x29, 0x20, 0x01, 0x28, 0x11, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18,
+	0x2a, 0x20, 0x01, 0x28, 0x11, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00,
+	0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12, 0x26, 0x0a, 0x0a,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x11,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x11, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00,
+	0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x32, 0x20, 0x01, 0x28, 0x11,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x2f, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00,
+	0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0c,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x34, 0x20, 0x01,
+	0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2f, 0x0a,
+	0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x35, 0x20,
+	0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x04,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x31,
+	0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18,
+	0x36, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
+	0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65,
+	0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74,
+	0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01,
+	0x28, 0x11, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52, 0x03, 0x01,
+	0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74,
+	0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x12, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x12, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2,
+	0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x12, 0x26,
+	0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x3f, 0x20, 0x01,
+	0x28, 0x12, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x12, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00,
+	0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2f, 0x0a, 0x0c,
+	0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01,
+	0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x18, 0x01, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2f, 0x0a,
+	0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20,
+	0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2f,
+	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48,
+	0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12,
+	0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18,
+	0x49, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
+	0x30, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31,
+	0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65,
+	0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
+	0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c,
+	0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf,
+	0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0a,
+	0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a, 0x10, 0x74, 0x5f,
+	0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4d,
+	0x20, 0x01, 0x28, 0x12, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52,
+	0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e,
+	0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x51, 0x20, 0x01, 0x28, 0x0f, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x52, 0x20, 0x01, 0x28, 0x0f, 0x42,
+	0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32, 0x12, 0x2a, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x53, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x33, 0x12, 0x28, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x5f, 0x34, 0x18, 0x54, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x12, 0x33,
+	0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31,
+	0x18, 0x5a, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
+	0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x45, 0x71, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x5b, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x5c, 0x20, 0x01, 0x28, 0x0f,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52,
+	0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x33, 0x0a,
+	0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18,
+	0x5d, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02,
+	0x30, 0x04, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47,
+	0x74, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x5e, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x5f, 0x20, 0x01,
+	0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x06, 0x48,
+	0x00, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31,
+	0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69,
+	0x6e, 0x31, 0x18, 0x60, 0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
+	0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x3d, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x61,
+	0x20, 0x01, 0x28, 0x0f, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01, 0x05, 0x52,
+	0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x65, 0x20, 0x01, 0x28, 0x10, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x10, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xb2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x0a,
+	0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x32, 0x12, 0x2a, 0x0a, 0x0c, 0x74, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x67, 0x20, 0x01, 0x28, 0x10,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x12, 0x28, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x68, 0x20, 0x01, 0x28, 0x10, 0x42, 0x04, 0xe2, 0xdf,
+	0x1f, 0x00, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x34,
+	0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65,
+	0x71, 0x31, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xb2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x70, 0x20, 0x01,
+	0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12,
+	0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74,
+	0x31, 0x18, 0x71, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2,
+	0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x47, 0x74, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x72, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x73,
+	0x20, 0x01, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x40,
+	0x06, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74,
+	0x65, 0x31, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x74, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xb2, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x3d, 0x0a, 0x12, 0x74, 0x5f,
+	0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31,
+	0x18, 0x75, 0x20, 0x01, 0x28, 0x10, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xb2, 0x01,
+	0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65,
+	0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0xaf, 0x11, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x55,
+	0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12,
+	0x1c, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x31, 0x12, 0x27, 0x0a,
+	0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x52, 0x08, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x12, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x22, 0x0a, 0x0a,
+	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34,
+	0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
+	0x02, 0x18, 0x01, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12,
+	0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
+	0x20, 0x02, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x2d,
+	0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28,
+	0x03, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a,
+	0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04,
+	0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d,
+	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05,
+	0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a,
+	0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40,
+	0x06, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30,
+	0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a,
+	0x03, 0x01, 0x02, 0x03, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31,
+	0x12, 0x37, 0x0a, 0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74,
+	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
+	0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x32,
+	0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00,
+	0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x74,
+	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0a, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x55, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x2d, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0b, 0x74, 0x55, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e,
+	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0a,
+	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x37, 0x0a, 0x10, 0x74, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x25,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52,
+	0x03, 0x01, 0x02, 0x03, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74,
+	0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x07, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x31, 0x12, 0x29, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x07, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xba, 0x01, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32, 0x12, 0x26,
+	0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x07, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x07, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
+	0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x12, 0x2f, 0x0a, 0x0d,
+	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01,
+	0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x2f, 0x0a,
+	0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x33,
+	0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20,
+	0x02, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x2f,
+	0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18,
+	0x34, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
+	0x28, 0x03, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12,
+	0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31,
+	0x18, 0x35, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
+	0x02, 0x30, 0x04, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x31,
+	0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74,
+	0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xba, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c,
+	0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2,
+	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0b, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
+	0x39, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05,
+	0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0e, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e,
+	0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28, 0x06, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x31, 0x12, 0x29, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x06, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
+	0x12, 0x03, 0xba, 0x01, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x32,
+	0x12, 0x26, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x18,
+	0x3f, 0x20, 0x01, 0x28, 0x06, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x09, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x12, 0x24, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x06, 0x42, 0x04, 0xe2,
+	0xdf, 0x1f, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x34, 0x12, 0x2f,
+	0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18,
+	0x46, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
+	0x18, 0x01, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12,
+	0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31,
+	0x18, 0x47, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
+	0x02, 0x20, 0x02, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e, 0x65, 0x31,
+	0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74,
+	0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
+	0x01, 0x02, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74,
+	0x31, 0x12, 0x2f, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67,
+	0x74, 0x31, 0x18, 0x49, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xba, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47,
+	0x74, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x6c, 0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x06, 0x42,
+	0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52,
+	0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a, 0x11,
+	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba,
+	0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0e, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x22, 0xe0, 0x19, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x31, 0x12, 0x21, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12,
+	0x03, 0xba, 0x01, 0x00, 0x48, 0x01, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32,
+	0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48,
+	0x02, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x27,
+	0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48, 0x04, 0x52, 0x0a, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74,
+	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x05,
+	0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
+	0x28, 0x03, 0x48, 0x06, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x07, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x08, 0x52, 0x0b, 0x74,
+	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
+	0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40,
+	0x06, 0x48, 0x09, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f,
+	0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
+	0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x0a, 0x52, 0x0a, 0x74, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52,
+	0x03, 0x01, 0x02, 0x03, 0x48, 0x0b, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e,
+	0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x48, 0x0c, 0x52, 0x08,
+	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2c, 0x0a, 0x0a, 0x74,
+	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x0d, 0x52, 0x08, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x0e, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x33, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x0f,
+	0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a,
+	0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01,
+	0x48, 0x10, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65,
+	0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
+	0x01, 0x02, 0x20, 0x02, 0x48, 0x11, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x12, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x13, 0x52, 0x0a,
+	0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
+	0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38,
+	0x05, 0x48, 0x14, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x65, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x15, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48,
+	0x16, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x17, 0x52, 0x0d, 0x74, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x23,
+	0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29, 0x20,
+	0x01, 0x28, 0x07, 0x48, 0x18, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x07, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xba, 0x01, 0x00, 0x48, 0x19, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x32,
+	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x07, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00,
+	0x48, 0x1a, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x88, 0x01, 0x01,
+	0x12, 0x29, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x18,
+	0x2c, 0x20, 0x01, 0x28, 0x07, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x1b, 0x52, 0x09, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x32, 0x20, 0x01,
+	0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48,
+	0x1c, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x71, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e,
+	0x65, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x1d, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33,
+	0x32, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x34, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x1e, 0x52, 0x0b, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
+	0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x35,
+	0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30,
+	0x04, 0x48, 0x1f, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x20, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20,
+	0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06,
+	0x48, 0x21, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x22, 0x52, 0x0b, 0x74, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x11,
+	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba,
+	0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x23, 0x52, 0x0e, 0x74, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0b,
+	0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18, 0x3d, 0x20, 0x01, 0x28,
+	0x06, 0x48, 0x24, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x32,
+	0x18, 0x3e, 0x20, 0x01, 0x28, 0x06, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01,
+	0x00, 0x48, 0x25, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x32, 0x88, 0x01,
+	0x01, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33,
+	0x18, 0x3f, 0x20, 0x01, 0x28, 0x06, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x26,
+	0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x88, 0x01, 0x01, 0x12, 0x29,
+	0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20,
+	0x01, 0x28, 0x06, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x27, 0x52, 0x09, 0x74, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x34, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28, 0x06,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48, 0x28, 0x52,
+	0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31,
+	0x18, 0x47, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
+	0x02, 0x20, 0x02, 0x48, 0x29, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4e,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x2a, 0x52, 0x0b, 0x74, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0d, 0x74,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x49, 0x20, 0x01,
+	0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x48,
+	0x2b, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c,
+	0x74, 0x65, 0x31, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x2c, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0e, 0x74, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28,
+	0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x2d,
+	0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x37, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x69,
+	0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
+	0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x2e, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x11, 0x74, 0x5f,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
+	0x4d, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05,
+	0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x2f, 0x52, 0x0e, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74,
+	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74,
+	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f,
+	0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x31, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x32, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x33, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x34,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71,
+	0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e,
+	0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f,
+	0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f,
+	0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x31, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x33, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e,
+	0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x6c, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x14,
+	0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x31, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x32, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x33, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x34, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42,
+	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74,
+	0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x5f, 0x69, 0x6e, 0x31, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x22, 0x99, 0x12, 0x0a, 0x13, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x55, 0x69, 0x6e, 0x74, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f,
+	0x66, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x31,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x32,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01,
+	0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x32, 0x12, 0x26, 0x0a,
+	0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48,
+	0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x34, 0x12, 0x2f, 0x0a, 0x0c, 0x74,
+	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00,
+	0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x2f, 0x0a, 0x0c,
+	0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x2f, 0x0a,
+	0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74, 0x31, 0x12, 0x2f,
+	0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30,
+	0x04, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x47, 0x74, 0x31, 0x12,
+	0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x65, 0x31,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
+	0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4c, 0x74,
+	0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x67,
+	0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
+	0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a, 0x10, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03,
+	0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x4e, 0x6f,
+	0x74, 0x49, 0x6e, 0x31, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x31, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xba, 0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x32, 0x12,
+	0x26, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x33, 0x12, 0x24, 0x0a, 0x0a, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
+	0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x34, 0x12, 0x2f, 0x0a,
+	0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x71, 0x31, 0x12, 0x2f,
+	0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20,
+	0x02, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12,
+	0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x31,
+	0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31,
+	0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01,
+	0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74,
+	0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74,
+	0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4c, 0x74, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0c, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x24, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2,
+	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x39, 0x0a, 0x10, 0x74,
+	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
+	0x25, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05,
+	0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x07, 0x48, 0x00, 0x52, 0x09, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x07, 0x42, 0x09, 0xe2,
+	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba, 0x01, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x32, 0x12, 0x28, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x07, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
+	0x12, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x33, 0x12,
+	0x26, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x34, 0x18, 0x2c,
+	0x20, 0x01, 0x28, 0x07, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x34, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x32, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x71, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28,
+	0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00,
+	0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x65, 0x31, 0x12, 0x31, 0x0a,
+	0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x34,
+	0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28,
+	0x03, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x31,
+	0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74,
+	0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba,
+	0x01, 0x02, 0x30, 0x04, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x47, 0x74, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x36, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x38, 0x05, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x37, 0x20, 0x01, 0x28, 0x07,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52,
+	0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x47, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a,
+	0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x38,
+	0x20, 0x01, 0x28, 0x07, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a,
+	0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x49, 0x6e, 0x31, 0x12, 0x3b, 0x0a, 0x11, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x39, 0x20, 0x01, 0x28, 0x07, 0x42, 0x0e,
+	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x31, 0x18,
+	0x3d, 0x20, 0x01, 0x28, 0x06, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x31, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x32, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x06, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xba,
+	0x01, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x32, 0x12,
+	0x28, 0x0a, 0x0b, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x33, 0x18, 0x3f,
+	0x20, 0x01, 0x28, 0x06, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x09,
+	0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x33, 0x12, 0x26, 0x0a, 0x0b, 0x74, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x34, 0x18, 0x40, 0x20, 0x01, 0x28, 0x06, 0x42, 0x04,
+	0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x09, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x34, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x65,
+	0x71, 0x31, 0x18, 0x46, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xba, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x45, 0x71, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36,
+	0x34, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x47, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x20, 0x02, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x4e, 0x65, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x48, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x28, 0x03, 0x48, 0x00, 0x52, 0x0b, 0x74,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74, 0x31, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x49, 0x20, 0x01, 0x28,
+	0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02, 0x30, 0x04, 0x48, 0x00,
+	0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x47, 0x74, 0x31, 0x12, 0x33, 0x0a,
+	0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18,
+	0x4a, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xba, 0x01, 0x02,
+	0x38, 0x05, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x4c, 0x74,
+	0x65, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f,
+	0x67, 0x74, 0x65, 0x31, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xba, 0x01, 0x02, 0x40, 0x06, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x47, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0d, 0x74, 0x5f, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e,
+	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00,
+	0x52, 0x0b, 0x74, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x49, 0x6e, 0x31, 0x12, 0x3b, 0x0a,
+	0x11, 0x74, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69,
+	0x6e, 0x31, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x06, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08,
+	0xba, 0x01, 0x05, 0x52, 0x03, 0x01, 0x02, 0x03, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0x8a, 0x07, 0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x42, 0x6f, 0x6f, 0x6c, 0x54, 0x61, 0x67, 0x73, 0x31, 0x12, 0x27, 0x0a, 0x10, 0x74, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x31, 0x12, 0x32, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x6c, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0xe2, 0xdf,
+	0x1f, 0x05, 0x12, 0x03, 0xd2, 0x01, 0x00, 0x52, 0x0d, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x32, 0x12, 0x2f, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x0d, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x33, 0x12, 0x2d, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x0d, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x34, 0x12, 0x38, 0x0a, 0x12, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x52,
+	0x0f, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x45, 0x71, 0x31,
+	0x12, 0x2e, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0e, 0x74,
+	0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x32, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
+	0x05, 0x12, 0x03, 0xd2, 0x01, 0x00, 0x48, 0x02, 0x52, 0x0e, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x32, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x11, 0x74,
+	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x33,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x03,
+	0x52, 0x0e, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x33,
+	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x34, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x42, 0x04,
+	0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x04, 0x52, 0x0e, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x34, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x13, 0x74, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x65, 0x71, 0x31,
+	0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xd2, 0x01,
+	0x02, 0x18, 0x01, 0x48, 0x05, 0x52, 0x10, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x6e, 0x66, 0x6f, 0x66,
+	0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e, 0x66, 0x6f,
+	0x66, 0x5f, 0x32, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x08, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12,
+	0x03, 0xd2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x6e, 0x66,
+	0x6f, 0x66, 0x32, 0x12, 0x2d, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e,
+	0x66, 0x6f, 0x66, 0x5f, 0x33, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x6e, 0x66, 0x6f,
+	0x66, 0x33, 0x12, 0x2b, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e, 0x66,
+	0x6f, 0x66, 0x5f, 0x34, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x08, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00,
+	0x48, 0x00, 0x52, 0x0b, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x34, 0x12,
+	0x36, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f,
+	0x65, 0x71, 0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x42, 0x6f, 0x6f, 0x6c, 0x4f,
+	0x6e, 0x65, 0x6f, 0x66, 0x45, 0x71, 0x31, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74,
+	0x79, 0x70, 0x31, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x31, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x32, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x5f, 0x33, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x34, 0x42, 0x16, 0x0a, 0x14, 0x5f,
+	0x74, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f,
+	0x65, 0x71, 0x31, 0x22, 0x84, 0x0b, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x54, 0x61, 0x67, 0x73, 0x12, 0x46, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x31, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10,
+	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31,
+	0x12, 0x51, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xe2, 0x01,
+	0x00, 0x52, 0x10, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x6c, 0x32, 0x12, 0x4e, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12,
+	0x00, 0x52, 0x10, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x6c, 0x33, 0x12, 0x4c, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52,
+	0x10, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x34, 0x12, 0x62, 0x0a, 0x1b, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x31,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x01, 0x52, 0x17, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x4e, 0x6f, 0x74,
+	0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x12, 0x62, 0x0a, 0x1b, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e,
+	0x75, 0x6c, 0x6c, 0x32, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x00,
+	0x52, 0x17, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x32, 0x12, 0x5b, 0x0a, 0x17, 0x74, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73,
+	0x6b, 0x69, 0x70, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x18, 0x00,
+	0x52, 0x14, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x53, 0x6b, 0x69, 0x70, 0x31, 0x12, 0x5b, 0x0a, 0x17, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x6b, 0x69, 0x70,
+	0x32, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x14, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x6b,
+	0x69, 0x70, 0x32, 0x12, 0x44, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x31, 0x12, 0x4f, 0x0a, 0x11, 0x74, 0x5f, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x32, 0x18, 0x2a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x09, 0xe2,
+	0xdf, 0x1f, 0x05, 0x12, 0x03, 0xe2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x32, 0x12, 0x4c, 0x0a, 0x11, 0x74, 0x5f,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x33, 0x18,
+	0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x4f, 0x6e, 0x66, 0x6f, 0x66, 0x33, 0x12, 0x4a, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x66, 0x6f, 0x66, 0x5f, 0x34, 0x18, 0x2c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xe2, 0xdf,
+	0x1f, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e,
+	0x66, 0x6f, 0x66, 0x34, 0x12, 0x60, 0x0a, 0x19, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c,
+	0x31, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52,
+	0x15, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x4e, 0x6f,
+	0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x12, 0x60, 0x0a, 0x19, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75,
+	0x6c, 0x6c, 0x32, 0x18, 0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x00, 0x48,
+	0x00, 0x52, 0x15, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66,
+	0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x32, 0x12, 0x59, 0x0a, 0x15, 0x74, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x6b, 0x69, 0x70,
+	0x31, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x18, 0x00, 0x48, 0x00, 0x52,
+	0x12, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x6b,
+	0x69, 0x70, 0x31, 0x12, 0x59, 0x0a, 0x15, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x32, 0x18, 0x36, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x18, 0x01, 0x48, 0x00, 0x52, 0x12, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x6b, 0x69, 0x70, 0x32, 0x42, 0x0a,
+	0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0xed, 0x06, 0x0a, 0x15, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x31, 0x12, 0x30, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x31,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x52, 0x06,
+	0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x3b, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
+	0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31,
+	0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xda, 0x01, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e,
+	0x75, 0x6d, 0x32, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x33, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x33, 0x12, 0x36, 0x0a,
+	0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x06, 0x74,
+	0x45, 0x6e, 0x75, 0x6d, 0x34, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
+	0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x18, 0x00, 0x52, 0x08,
+	0x74, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x71, 0x31, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x20,
+	0x01, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x65, 0x31, 0x12, 0x41, 0x0a, 0x0a, 0x74,
+	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda,
+	0x01, 0x02, 0x28, 0x02, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x74, 0x31, 0x12, 0x41,
+	0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xda, 0x01, 0x02, 0x30, 0x03, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74,
+	0x31, 0x12, 0x43, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x65, 0x31,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x38, 0x04, 0x52, 0x09, 0x74, 0x45, 0x6e,
+	0x75, 0x6d, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x43, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
+	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x40, 0x08,
+	0x52, 0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x65, 0x31, 0x12, 0x44, 0x0a, 0x0a, 0x74,
+	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda,
+	0x01, 0x05, 0x4a, 0x03, 0x00, 0x01, 0x02, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e,
+	0x31, 0x12, 0x4b, 0x0a, 0x0e, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05, 0x52, 0x03, 0x03, 0x04,
+	0x08, 0x52, 0x0b, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x4a,
+	0x0a, 0x0f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
+	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x52, 0x0c, 0x74, 0x45,
+	0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x22, 0xf5, 0x08, 0x0a, 0x16, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x45, 0x6e, 0x75, 0x6d, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
+	0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x48,
+	0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x08,
+	0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xda, 0x01,
+	0x00, 0x48, 0x01, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x32, 0x88, 0x01, 0x01, 0x12, 0x3d,
+	0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00,
+	0x48, 0x02, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x33, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a,
+	0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52,
+	0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x34, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01,
+	0x02, 0x18, 0x00, 0x48, 0x04, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x71, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x31,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x20, 0x01, 0x48, 0x05, 0x52, 0x08, 0x74,
+	0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01,
+	0x02, 0x28, 0x02, 0x48, 0x06, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x74, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x31,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x30, 0x03, 0x48, 0x07, 0x52, 0x08, 0x74,
+	0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda,
+	0x01, 0x02, 0x38, 0x04, 0x48, 0x08, 0x52, 0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x74, 0x65,
+	0x31, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67,
+	0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x40, 0x08, 0x48, 0x09,
+	0x52, 0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x49,
+	0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xda, 0x01, 0x05, 0x4a, 0x03, 0x00, 0x01, 0x02, 0x48, 0x0a, 0x52, 0x08, 0x74, 0x45,
+	0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x0e, 0x74, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12,
+	0x08, 0xda, 0x01, 0x05, 0x52, 0x03, 0x03, 0x04, 0x08, 0x48, 0x0b, 0x52, 0x0b, 0x74, 0x45, 0x6e,
+	0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x0f, 0x74,
+	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x48, 0x0c, 0x52, 0x0c, 0x74, 0x45, 0x6e,
+	0x75, 0x6d, 0x49, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x31, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x32, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75,
+	0x6d, 0x5f, 0x33, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x34,
+	0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x71, 0x31, 0x42,
+	0x0d, 0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0d,
+	0x0a, 0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0d, 0x0a, 0x0b,
+	0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f,
+	0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75,
+	0x6d, 0x73, 0x22, 0x91, 0x07, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x45, 0x6e, 0x75, 0x6d,
+	0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x32, 0x0a, 0x08, 0x74, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x31, 0x48, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x3d,
+	0x0a, 0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xda, 0x01, 0x00, 0x48, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x32, 0x12, 0x3a, 0x0a,
+	0x08, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48,
+	0x00, 0x52, 0x06, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x33, 0x12, 0x38, 0x0a, 0x08, 0x74, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x06, 0x74, 0x45, 0x6e,
+	0x75, 0x6d, 0x34, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x65, 0x71,
+	0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x18, 0x00, 0x48, 0x00, 0x52, 0x08,
+	0x74, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x71, 0x31, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e,
+	0x75, 0x6d, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x20,
+	0x01, 0x48, 0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x65, 0x31, 0x12, 0x43, 0x0a,
+	0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xda, 0x01, 0x02, 0x28, 0x02, 0x48, 0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c,
+	0x74, 0x31, 0x12, 0x43, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x31,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x30, 0x03, 0x48, 0x00, 0x52, 0x08, 0x74,
+	0x45, 0x6e, 0x75, 0x6d, 0x47, 0x74, 0x31, 0x12, 0x45, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75,
+	0x6d, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x38,
+	0x04, 0x48, 0x00, 0x52, 0x09, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x45,
+	0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x40, 0x08, 0x48, 0x00, 0x52, 0x09, 0x74, 0x45, 0x6e, 0x75,
+	0x6d, 0x47, 0x74, 0x65, 0x31, 0x12, 0x46, 0x0a, 0x0a, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f,
+	0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x31, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05, 0x4a, 0x03, 0x00, 0x01,
+	0x02, 0x48, 0x00, 0x52, 0x08, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x31, 0x12, 0x4d, 0x0a,
+	0x0e, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e, 0xe2,
+	0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xda, 0x01, 0x05, 0x52, 0x03, 0x03, 0x04, 0x08, 0x48, 0x00, 0x52,
+	0x0b, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x4c, 0x0a, 0x0f,
+	0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x69, 0x6e, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x45,
+	0x6e, 0x75, 0x6d, 0x49, 0x6e, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e,
+	0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0xd6, 0x03, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x54, 0x61, 0x67, 0x73, 0x31, 0x12, 0x1a, 0x0a, 0x09, 0x74, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x31, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12,
+	0x03, 0xca, 0x01, 0x00, 0x52, 0x07, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x32, 0x12, 0x22, 0x0a,
+	0x09, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x33, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x34, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x34, 0x12, 0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c,
+	0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0c, 0x74, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0c, 0x74,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0f, 0x74,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x28,
+	0x03, 0x52, 0x0c, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12,
+	0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
+	0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05,
+	0xca, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0c, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e,
+	0x47, 0x74, 0x31, 0x12, 0x34, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c,
+	0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0d, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x10, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x40, 0x06,
+	0x52, 0x0d, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x22,
+	0x91, 0x0a, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x54,
+	0x61, 0x67, 0x73, 0x31, 0x12, 0x35, 0x0a, 0x0c, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x13, 0xe2, 0xdf, 0x1f, 0x0f,
+	0x12, 0x0d, 0xb2, 0x01, 0x0a, 0x5a, 0x08, 0x5b, 0x31, 0x2c, 0x20, 0x31, 0x30, 0x30, 0x29, 0x52,
+	0x0a, 0x74, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x31, 0x12, 0x37, 0x0a, 0x0c, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x12, 0x0a, 0xb2, 0x01, 0x07, 0x5a, 0x05, 0x28, 0x30,
+	0x2c, 0x20, 0x5d, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x49, 0x6e, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x32, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x12, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01, 0x02, 0x60, 0x05, 0x52, 0x0f, 0x74,
+	0x49, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x37,
+	0x0a, 0x0d, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x31, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x13, 0xe2, 0xdf, 0x1f, 0x0f, 0x12, 0x0d, 0xba, 0x01, 0x0a,
+	0x5a, 0x08, 0x28, 0x31, 0x30, 0x2c, 0x20, 0x32, 0x30, 0x5d, 0x52, 0x0b, 0x74, 0x55, 0x69, 0x6e,
+	0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x13, 0x74, 0x5f, 0x75, 0x69, 0x6e,
+	0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x31, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xba, 0x01, 0x03, 0x60,
+	0x80, 0x08, 0x48, 0x01, 0x52, 0x10, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x4f, 0x66, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0f, 0x74, 0x5f, 0x64,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x31, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x42, 0x11, 0xe2, 0xdf, 0x1f, 0x0d, 0x12, 0x0b, 0xaa, 0x01, 0x08, 0x5a, 0x06, 0x5b,
+	0x30, 0x2c, 0x20, 0x31, 0x29, 0x52, 0x0d, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x31, 0x12, 0x39, 0x0a, 0x0e, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x42, 0x13, 0xe2, 0xdf,
+	0x1f, 0x0f, 0x12, 0x0d, 0xaa, 0x01, 0x0a, 0x5a, 0x08, 0x28, 0x2d, 0x30, 0x2e, 0x35, 0x2c, 0x20,
+	0x5d, 0x52, 0x0c, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x31, 0x12,
+	0x45, 0x0a, 0x15, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12,
+	0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x61, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99,
+	0xa9, 0x3f, 0x52, 0x12, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x48, 0x0a, 0x14, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
+	0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x31, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x02, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x61,
+	0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xb9, 0x3f, 0x48, 0x02, 0x52, 0x11, 0x74, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x4f, 0x66, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x35, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6e,
+	0x69, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xaa, 0x01, 0x02, 0x68, 0x01, 0x52, 0x0e, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x31, 0x12, 0x3a, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x32, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xaa, 0x01, 0x02, 0x68, 0x01, 0x48, 0x03,
+	0x52, 0x0e, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x32,
+	0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x18, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f,
+	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x31, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xaa, 0x01, 0x02,
+	0x70, 0x02, 0x52, 0x15, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x44, 0x65, 0x63, 0x69, 0x6d,
+	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x31, 0x12, 0x42, 0x0a, 0x17, 0x74, 0x5f, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x73, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x02, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xaa, 0x01, 0x02, 0x70, 0x01, 0x52, 0x14, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x44,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x73, 0x31, 0x12, 0x34, 0x0a,
+	0x0c, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xaa, 0x01, 0x09, 0x21, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x52, 0x0a, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65,
+	0x4e, 0x65, 0x31, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x13, 0x20, 0x01, 0x28, 0x02, 0x42, 0x1b, 0xe2, 0xdf,
+	0x1f, 0x17, 0x12, 0x15, 0xaa, 0x01, 0x12, 0x52, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0,
+	0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x52, 0x0c, 0x74, 0x46, 0x6c, 0x6f, 0x61,
+	0x74, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x49, 0x0a, 0x10, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x01, 0x42, 0x1b, 0xe2, 0xdf, 0x1f, 0x17, 0x12, 0x15, 0xaa, 0x01, 0x12, 0x52, 0x10, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x48, 0x04,
+	0x52, 0x0d, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x42, 0x0a, 0x0b, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x31, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x05, 0x42, 0x22, 0xe2, 0xdf, 0x1f, 0x1e, 0x12, 0x1c, 0xea,
+	0x01, 0x19, 0x5a, 0x17, 0xb2, 0x01, 0x14, 0x5a, 0x07, 0x5b, 0x2d, 0x31, 0x2c, 0x20, 0x31, 0x5d,
+	0x60, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x09, 0x74, 0x49, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x12, 0x5f, 0x0a, 0x11, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62,
+	0x6c, 0x65, 0x5f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
+	0x15, 0xe2, 0xdf, 0x1f, 0x11, 0x12, 0x0f, 0xaa, 0x01, 0x0c, 0x5a, 0x08, 0x5b, 0x30, 0x2c, 0x20,
+	0x31, 0x30, 0x30, 0x5d, 0x70, 0x02, 0x52, 0x0f, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x57,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x69, 0x6e,
+	0x74, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x32, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x31,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x5f, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x6f, 0x66, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x65, 0x32, 0x42, 0x13,
+	0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x31, 0x22, 0x93, 0x07, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x54, 0x61, 0x67, 0x73, 0x32, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0d, 0xe2, 0xdf,
+	0x1f, 0x09, 0x12, 0x07, 0xca, 0x01, 0x04, 0x52, 0x02, 0x01, 0x02, 0x52, 0x09, 0x74, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x45, 0x71, 0x31, 0x12, 0x30, 0x0a, 0x0b, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x10, 0xe2, 0xdf, 0x1f,
+	0x0c, 0x12, 0x0a, 0xca, 0x01, 0x07, 0x5a, 0x01, 0x01, 0x5a, 0x02, 0x61, 0x62, 0x52, 0x09, 0x74,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x6e, 0x31, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xca, 0x01, 0x05, 0x62, 0x00, 0x62, 0x01,
+	0xff, 0x52, 0x0c, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12,
+	0x38, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x31, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x12, 0x0a,
+	0xca, 0x01, 0x07, 0xa2, 0x01, 0x04, 0x89, 0x50, 0x4e, 0x47, 0x52, 0x0d, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x12, 0x36, 0x0a, 0x0f, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xca, 0x01, 0x05, 0xaa, 0x01, 0x02,
+	0x00, 0x01, 0x52, 0x0d, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x31, 0x12, 0x3a, 0x0a, 0x11, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0e, 0xe2, 0xdf,
+	0x1f, 0x0a, 0x12, 0x08, 0xca, 0x01, 0x05, 0xb2, 0x01, 0x02, 0x22, 0x60, 0x52, 0x0f, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x12, 0x3d, 0x0a,
+	0x0e, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x31, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x17, 0xe2, 0xdf, 0x1f, 0x13, 0x12, 0x11, 0xca, 0x01, 0x0e,
+	0xba, 0x01, 0x0b, 0x5e, 0x5b, 0x61, 0x2d, 0x66, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x24, 0x52, 0x0c,
+	0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x67, 0x65, 0x78, 0x31, 0x12, 0x2c, 0x0a, 0x0b,
+	0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x70, 0x31, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xca, 0x01, 0x03, 0xf0, 0x01, 0x01, 0x52,
+	0x09, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x70, 0x31, 0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x5f, 0x31, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xca, 0x01, 0x03, 0xf8, 0x01, 0x01,
+	0x52, 0x0b, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x70, 0x76, 0x34, 0x31, 0x12, 0x31, 0x0a,
+	0x0e, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x5f, 0x31, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xca, 0x01, 0x03,
+	0x80, 0x02, 0x01, 0x52, 0x0b, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x49, 0x70, 0x76, 0x36, 0x31,
+	0x12, 0x31, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x75, 0x74, 0x66, 0x38,
+	0x5f, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xca, 0x01, 0x03, 0xc0, 0x02, 0x01, 0x52, 0x0b, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x55, 0x74,
+	0x66, 0x38, 0x31, 0x12, 0x38, 0x0a, 0x0d, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x31, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10,
+	0x12, 0x0e, 0xea, 0x01, 0x0b, 0x5a, 0x09, 0xca, 0x01, 0x06, 0x5a, 0x01, 0x01, 0x5a, 0x01, 0x02,
+	0x52, 0x0b, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x12, 0x65, 0x0a,
+	0x0c, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x6d, 0x61, 0x70, 0x31, 0x18, 0x15, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x54, 0x61, 0x67, 0x73, 0x32, 0x2e, 0x54, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x31,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0xe2, 0xdf, 0x1f, 0x0d, 0x12, 0x0b, 0xf2, 0x01, 0x08,
+	0x62, 0x06, 0xca, 0x01, 0x03, 0xf8, 0x01, 0x01, 0x52, 0x0a, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x4d, 0x61, 0x70, 0x31, 0x12, 0x54, 0x0a, 0x10, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x31, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0xe2, 0xdf, 0x1f,
+	0x09, 0x12, 0x07, 0xca, 0x01, 0x04, 0xa2, 0x01, 0x01, 0x77, 0x52, 0x0e, 0x74, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x31, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x4d, 0x61, 0x70, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xb9, 0x13, 0x0a, 0x19, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x52, 0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x1c, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x31, 0x30, 0x31, 0x18, 0x65, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x31, 0x30, 0x31, 0x12, 0x27, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x31, 0x30, 0x32, 0x18, 0x66, 0x20, 0x03, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12,
+	0x03, 0xea, 0x01, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x32, 0x12, 0x24,
+	0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x33, 0x18, 0x67, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x31, 0x30, 0x33, 0x12, 0x22, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31,
+	0x30, 0x34, 0x18, 0x68, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x34, 0x12, 0x2c, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x31, 0x30, 0x35, 0x18, 0x69, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0e, 0xe2, 0xdf,
+	0x1f, 0x0a, 0x12, 0x08, 0xea, 0x01, 0x05, 0x5a, 0x03, 0xc2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x31, 0x30, 0x35, 0x12, 0x29, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x31, 0x30, 0x36, 0x18, 0x6a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xea, 0x01, 0x02, 0x5a, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30,
+	0x36, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x37, 0x18,
+	0x6b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x37, 0x12, 0x40, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x31, 0x30, 0x38, 0x18, 0x6c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xea, 0x01, 0x00,
+	0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x38, 0x12, 0x3d, 0x0a, 0x0a, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x30, 0x39, 0x18, 0x6d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52,
+	0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x30, 0x39, 0x12, 0x3b, 0x0a, 0x0a, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x30, 0x18, 0x6e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x31, 0x31, 0x30, 0x12, 0x45, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x31, 0x31, 0x31, 0x18, 0x6f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xea, 0x01, 0x05, 0x5a, 0x03,
+	0xe2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x31, 0x12, 0x42, 0x0a,
+	0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x32, 0x18, 0x70, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
+	0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xea, 0x01, 0x02, 0x5a, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31,
+	0x32, 0x12, 0x34, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x33, 0x18,
+	0x71, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x52, 0x08, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x33, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x31, 0x31, 0x34, 0x18, 0x72, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e,
+	0x75, 0x6d, 0x31, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xea, 0x01, 0x00, 0x52, 0x08,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x34, 0x12, 0x3c, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x31, 0x31, 0x35, 0x18, 0x73, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x31, 0x31, 0x35, 0x12, 0x3a, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x31, 0x31, 0x36, 0x18, 0x74, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75,
+	0x6d, 0x31, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31,
+	0x31, 0x36, 0x12, 0x44, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x31, 0x31, 0x37,
+	0x18, 0x75, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0e,
+	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xea, 0x01, 0x05, 0x5a, 0x03, 0xda, 0x01, 0x00, 0x52, 0x08,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x37, 0x12, 0x41, 0x0a, 0x0a, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x31, 0x31, 0x38, 0x18, 0x76, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45,
+	0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x5a,
+	0x00, 0x52, 0x08, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x31, 0x31, 0x38, 0x12, 0x34, 0x0a, 0x10, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x31, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x0d, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c,
+	0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
+	0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xea, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e,
+	0x45, 0x71, 0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c,
+	0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12, 0x30, 0x0a, 0x0e, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x30, 0x04, 0x52, 0x0b, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x32, 0x0a, 0x0f, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x38, 0x05, 0x52,
+	0x0c, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a,
+	0x0f, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
+	0x02, 0x40, 0x06, 0x52, 0x0c, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65,
+	0x31, 0x12, 0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x20, 0x20, 0x03, 0x28, 0x01, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x3a, 0x0a,
+	0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x18, 0x21, 0x20, 0x03, 0x28, 0x02, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x69, 0x71, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x3a, 0x0a, 0x13, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x18, 0x22, 0x20, 0x03, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
+	0x02, 0x50, 0x01, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x3a, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x23, 0x20, 0x03,
+	0x28, 0x03, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52,
+	0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x12, 0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0d, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12,
+	0x3c, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x25, 0x20, 0x03, 0x28, 0x04, 0x42, 0x0b, 0xe2,
+	0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x3c, 0x0a,
+	0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x26, 0x20, 0x03, 0x28, 0x11, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x3c, 0x0a, 0x14, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x18, 0x27, 0x20, 0x03, 0x28, 0x12, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x33, 0x32, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0f, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x13, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x40, 0x0a, 0x16, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x29, 0x20, 0x03, 0x28, 0x10, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x13, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x3e, 0x0a,
+	0x15, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x07, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x12, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x3e, 0x0a,
+	0x15, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x06, 0x42, 0x0b, 0xe2, 0xdf,
+	0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x12, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x38, 0x0a,
+	0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x62,
+	0x6f, 0x6f, 0x6c, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69,
+	0x71, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x50, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x2d, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x50, 0x01, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x71, 0x75, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x5e, 0x0a, 0x15, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09, 0x50, 0x01, 0x5a, 0x05,
+	0xe2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x12, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71,
+	0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x3a, 0x0a, 0x13, 0x74, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x2f, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
+	0x02, 0x50, 0x01, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x71, 0x75, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0xd0, 0x09, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x52,
+	0x65, 0x70, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x61, 0x67, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x31,
+	0x12, 0x43, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x1f, 0x20, 0x03, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf,
+	0x1f, 0x12, 0x12, 0x10, 0xea, 0x01, 0x0d, 0x30, 0x01, 0x5a, 0x09, 0xc2, 0x01, 0x06, 0xca, 0x02,
+	0x03, 0x69, 0x64, 0x2d, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4f, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x20, 0x20, 0x03, 0x28,
+	0x01, 0x42, 0x22, 0xe2, 0xdf, 0x1f, 0x1e, 0x12, 0x1c, 0xea, 0x01, 0x19, 0x30, 0x01, 0x5a, 0x15,
+	0xaa, 0x01, 0x12, 0x39, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x26, 0x40, 0x41, 0x9a, 0x99, 0x99,
+	0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x4d, 0x0a, 0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x21, 0x20, 0x03, 0x28,
+	0x02, 0x42, 0x22, 0xe2, 0xdf, 0x1f, 0x1e, 0x12, 0x1c, 0xea, 0x01, 0x19, 0x30, 0x01, 0x5a, 0x15,
+	0xaa, 0x01, 0x12, 0x39, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x26, 0x40, 0x41, 0x9a, 0x99, 0x99,
+	0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52, 0x0e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x3f, 0x0a, 0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x22, 0x20, 0x03, 0x28, 0x05,
+	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2,
+	0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x3f, 0x0a, 0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x23, 0x20, 0x03, 0x28,
+	0x03, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07,
+	0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x41, 0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x26, 0x20,
+	0x03, 0x28, 0x11, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01,
+	0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x41, 0x0a, 0x12, 0x74, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x18, 0x27, 0x20, 0x03, 0x28, 0x12, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01,
+	0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x45, 0x0a,
+	0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x73, 0x66, 0x69,
+	0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x28, 0x20, 0x03, 0x28, 0x0f, 0x42, 0x14, 0xe2, 0xdf, 0x1f,
+	0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40,
+	0x02, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x33, 0x32, 0x12, 0x45, 0x0a, 0x14, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x29, 0x20, 0x03,
+	0x28, 0x10, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a,
+	0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x11, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x41, 0x0a, 0x12, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33,
+	0x32, 0x18, 0x24, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea,
+	0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x41,
+	0x0a, 0x12, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x18, 0x25, 0x20, 0x03, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10,
+	0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02,
+	0x52, 0x0f, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x55, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x12, 0x43, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x2a, 0x20, 0x03, 0x28, 0x07, 0x42, 0x14,
+	0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04,
+	0x38, 0x0a, 0x40, 0x02, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x43, 0x0a, 0x13, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74,
+	0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x2b, 0x20,
+	0x03, 0x28, 0x06, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xea, 0x01, 0x0b, 0x30, 0x01,
+	0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x3b, 0x0a, 0x10, 0x74,
+	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18,
+	0x2c, 0x20, 0x03, 0x28, 0x08, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09,
+	0x30, 0x01, 0x5a, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x53, 0x0a, 0x10, 0x74, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x2d, 0x20, 0x03,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
+	0x12, 0x0c, 0xea, 0x01, 0x09, 0x30, 0x01, 0x5a, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x52, 0x0d,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x3d, 0x0a,
+	0x11, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x2e, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c,
+	0xea, 0x01, 0x09, 0x30, 0x01, 0x5a, 0x05, 0xca, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0e, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x5a, 0x0a, 0x13,
+	0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x2f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xea, 0x01, 0x09, 0x30, 0x01, 0x5a,
+	0x05, 0xe2, 0x01, 0x02, 0x10, 0x01, 0x52, 0x10, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xde, 0x1d, 0x0a, 0x14, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x31, 0x12, 0x4e, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x31, 0x18, 0x65,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
+	0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
+	0x31, 0x30, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30,
+	0x31, 0x12, 0x59, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x32, 0x18, 0x66,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
+	0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
+	0x31, 0x30, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xf2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x32, 0x12, 0x56, 0x0a, 0x09,
+	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x33, 0x18, 0x67, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x33, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61,
+	0x70, 0x31, 0x30, 0x33, 0x12, 0x54, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30,
+	0x34, 0x18, 0x68, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54,
+	0x4d, 0x61, 0x70, 0x31, 0x30, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xe2, 0xdf, 0x1f,
+	0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x34, 0x12, 0x5e, 0x0a, 0x09, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x35, 0x18, 0x69, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x35, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xf2, 0x01, 0x05, 0x5a, 0x03, 0xc2, 0x01,
+	0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x35, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x31, 0x30, 0x36, 0x18, 0x6a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x36, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x5a, 0x00, 0x52, 0x07,
+	0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x36, 0x12, 0x5e, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x31, 0x30, 0x37, 0x18, 0x6b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x37, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e,
+	0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xf2, 0x01, 0x05, 0x62, 0x03, 0xc2, 0x01, 0x00, 0x52, 0x07,
+	0x74, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x37, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x31, 0x30, 0x38, 0x18, 0x6c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x38, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b,
+	0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x62, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61,
+	0x70, 0x31, 0x30, 0x38, 0x12, 0x4e, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31,
+	0x31, 0x18, 0x6f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54,
+	0x4d, 0x61, 0x70, 0x31, 0x31, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x74, 0x4d, 0x61,
+	0x70, 0x31, 0x31, 0x31, 0x12, 0x59, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31,
+	0x32, 0x18, 0x70, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54,
+	0x4d, 0x61, 0x70, 0x31, 0x31, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x09, 0xe2, 0xdf, 0x1f,
+	0x05, 0x12, 0x03, 0xf2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x32, 0x12,
+	0x56, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x33, 0x18, 0x71, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
+	0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31,
+	0x33, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x07,
+	0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x33, 0x12, 0x54, 0x0a, 0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x31, 0x31, 0x34, 0x18, 0x72, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c,
+	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
+	0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x34, 0x12, 0x5e, 0x0a,
+	0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x35, 0x18, 0x73, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x35, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xf2, 0x01, 0x05, 0x5a,
+	0x03, 0xc2, 0x01, 0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x35, 0x12, 0x5b, 0x0a,
+	0x09, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x36, 0x18, 0x74, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x36, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x5a,
+	0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x36, 0x12, 0x5e, 0x0a, 0x09, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x37, 0x18, 0x75, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x37, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xf2, 0x01, 0x05, 0x62, 0x03, 0xe2, 0x01,
+	0x00, 0x52, 0x07, 0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x37, 0x12, 0x5b, 0x0a, 0x09, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x31, 0x31, 0x38, 0x18, 0x76, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x38, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x62, 0x00, 0x52, 0x07,
+	0x74, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x38, 0x12, 0x6b, 0x0a, 0x0f, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x6e, 0x75, 0x6c, 0x6c, 0x31, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x4e,
+	0x75, 0x6c, 0x6c, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xf2, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x74, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x4e,
+	0x75, 0x6c, 0x6c, 0x31, 0x12, 0x65, 0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x01, 0x52,
+	0x0a, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x65, 0x0a, 0x0d, 0x74,
+	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
+	0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65,
+	0x6e, 0x4e, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xf2, 0x01, 0x02, 0x20, 0x02, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4e,
+	0x65, 0x31, 0x12, 0x65, 0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
+	0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31,
+	0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12, 0x65, 0x0a, 0x0d, 0x74, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47,
+	0x74, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2,
+	0x01, 0x02, 0x30, 0x04, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31,
+	0x12, 0x68, 0x0a, 0x0e, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
+	0x65, 0x31, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e,
+	0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x38, 0x05, 0x52, 0x0b, 0x74,
+	0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x68, 0x0a, 0x0e, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
+	0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65,
+	0x6e, 0x47, 0x74, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07,
+	0x12, 0x05, 0xf2, 0x01, 0x02, 0x40, 0x06, 0x52, 0x0b, 0x74, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e,
+	0x47, 0x74, 0x65, 0x31, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x31, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70,
+	0x31, 0x30, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x35, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x36, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x54, 0x4d, 0x61, 0x70, 0x31, 0x30, 0x37, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70,
+	0x31, 0x30, 0x38, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x31, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61,
+	0x70, 0x31, 0x31, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53,
+	0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
 	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x32, 0x45, 0x6e,
+	0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x34, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
 	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70,
-	0x31, 0x31, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x31, 0x31, 0x35, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61,
 	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
 	0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a,
-	0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x36, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
 	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x35, 0x45, 0x6e, 0x74,
+	0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x37, 0x45, 0x6e, 0x74,
 	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
 	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31,
-	0x31, 0x36, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x31, 0x38, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
 	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
 	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x53, 0x0a, 0x0c,
-	0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x37, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x53, 0x0a, 0x0c, 0x54, 0x4d, 0x61, 0x70, 0x31, 0x31, 0x38, 0x45, 0x6e, 0x74, 0x72,
+	0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11,
+	0x54, 0x4d, 0x61, 0x70, 0x4e, 0x6f, 0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x45, 0x6e, 0x74, 0x72,
 	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x4d, 0x61, 0x70, 0x4e, 0x6f,
-	0x74, 0x4e, 0x75, 0x6c, 0x6c, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x4c,
-	0x65, 0x6e, 0x45, 0x71, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65,
-	0x6e, 0x4e, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e,
-	0x4c, 0x74, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47,
-	0x74, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4c, 0x74,
-	0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74,
-	0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x81, 0x10, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70,
-	0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x12, 0x75, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61,
-	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
-	0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10,
-	0xf2, 0x01, 0x0d, 0x30, 0x01, 0x5a, 0x09, 0xc2, 0x01, 0x06, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d,
-	0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x70, 0x0a, 0x0f, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f,
+	0x54, 0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x54,
+	0x4d, 0x61, 0x70, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d,
+	0x61, 0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61,
+	0x70, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61,
+	0x70, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x10, 0x0a, 0x10, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x12, 0x75,
+	0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
+	0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x16,
+	0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10, 0xf2, 0x01, 0x0d, 0x30, 0x01, 0x5a, 0x09, 0xc2, 0x01, 0x06,
+	0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x70, 0x0a, 0x0f, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b,
+	0x65, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79,
+	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01,
+	0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0c, 0x74, 0x4d, 0x61, 0x70, 0x4b,
+	0x65, 0x79, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x70, 0x0a, 0x0f, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b,
+	0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x36, 0x34,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b,
+	0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0c, 0x74, 0x4d, 0x61,
+	0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0f, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61,
+	0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12,
+	0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52,
+	0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x73,
+	0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
 	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
-	0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2,
-	0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38,
-	0x0a, 0x40, 0x02, 0x52, 0x0c, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x12, 0x70, 0x0a, 0x0f, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x6f, 0x76,
+	0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14,
+	0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04,
+	0x38, 0x0a, 0x40, 0x02, 0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x12, 0x79, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65,
+	0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2,
+	0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74,
+	0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x79,
+	0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x36, 0x34, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
 	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d,
-	0x61, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01,
-	0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0c, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79,
-	0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31,
-	0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01,
-	0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b,
-	0x65, 0x79, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61,
-	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x10, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
-	0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e,
-	0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0d,
-	0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x79, 0x0a,
-	0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61,
-	0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07,
-	0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x79, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61,
-	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x14,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
-	0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53,
-	0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf,
-	0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a,
-	0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65,
-	0x64, 0x36, 0x34, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79,
-	0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31,
-	0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01,
-	0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b,
-	0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61,
-	0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0e, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
-	0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e,
-	0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0d,
-	0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x76, 0x0a,
-	0x11, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a,
+	0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65,
+	0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61,
+	0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12,
+	0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52,
+	0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x73,
+	0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
 	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
 	0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70,
-	0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01,
-	0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x76, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b,
-	0x65, 0x79, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b,
-	0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2,
-	0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74,
-	0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x1a, 0x40, 0x0a,
-	0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x3f, 0x0a, 0x11, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x36, 0x34,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e, 0x74,
-	0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14,
+	0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04,
+	0x38, 0x0a, 0x40, 0x02, 0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x12, 0x76, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79,
+	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b,
+	0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74, 0x4d, 0x61,
+	0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x76, 0x0a, 0x11, 0x74,
+	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61,
+	0x70, 0x54, 0x61, 0x67, 0x73, 0x4b, 0x65, 0x79, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65,
+	0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2,
+	0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x5a, 0x07, 0xba, 0x01, 0x04, 0x38,
+	0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3f, 0x0a, 0x11, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65,
+	0x79, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b,
+	0x65, 0x79, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x11, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61,
-	0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x10, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a,
-	0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61,
+	0x70, 0x4b, 0x65, 0x79, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x12, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54,
+	0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0f,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x46,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
+	0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x10, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79, 0x55, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x4b, 0x65, 0x79,
+	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
 	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac, 0x1a, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x12, 0x7d,
-	0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e,
-	0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10, 0xf2, 0x01, 0x0d, 0x30,
-	0x01, 0x62, 0x09, 0xc2, 0x01, 0x06, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x52, 0x0f, 0x74, 0x4d,
-	0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x89, 0x01,
-	0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x64, 0x6f,
-	0x75, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e,
-	0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x4b,
+	0x65, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x07, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d,
+	0x61, 0x70, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x06, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xac, 0x1a,
+	0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x31, 0x12, 0x7d, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12,
+	0x12, 0x10, 0xf2, 0x01, 0x0d, 0x30, 0x01, 0x62, 0x09, 0xc2, 0x01, 0x06, 0xca, 0x02, 0x03, 0x69,
+	0x64, 0x2d, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x12, 0x89, 0x01, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x22, 0xe2, 0xdf, 0x1f, 0x1e,
+	0x12, 0x1c, 0xf2, 0x01, 0x19, 0x30, 0x01, 0x62, 0x15, 0xaa, 0x01, 0x12, 0x39, 0x33, 0x33, 0x33,
+	0x33, 0x33, 0x33, 0x26, 0x40, 0x41, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52, 0x0f,
+	0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12,
+	0x86, 0x01, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f,
+	0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x6f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31,
+	0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x42, 0x22, 0xe2, 0xdf, 0x1f, 0x1e, 0x12, 0x1c, 0xf2, 0x01, 0x19, 0x30,
 	0x01, 0x62, 0x15, 0xaa, 0x01, 0x12, 0x39, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x26, 0x40, 0x41,
-	0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x11, 0x74, 0x5f,
-	0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70,
-	0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x22,
-	0xe2, 0xdf, 0x1f, 0x1e, 0x12, 0x1c, 0xf2, 0x01, 0x19, 0x30, 0x01, 0x62, 0x15, 0xaa, 0x01, 0x12,
-	0x39, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x26, 0x40, 0x41, 0x9a, 0x99, 0x99, 0x99, 0x99, 0x99,
-	0xf1, 0x3f, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f,
-	0x61, 0x74, 0x12, 0x78, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x9a, 0x99, 0x99, 0x99, 0x99, 0x99, 0xf1, 0x3f, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12, 0x78, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x61,
+	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61,
+	0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf,
+	0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a,
+	0x40, 0x02, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74,
+	0x33, 0x32, 0x12, 0x78, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
 	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
 	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x33,
-	0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01,
+	0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01,
 	0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74, 0x4d,
-	0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x78, 0x0a, 0x11,
-	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x7b, 0x0a, 0x12,
+	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74,
+	0x33, 0x32, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d,
+	0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62,
+	0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x7b, 0x0a, 0x12, 0x74, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
+	0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70,
+	0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2, 0x01,
+	0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x74, 0x5f, 0x6d, 0x61, 0x70,
+	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70,
+	0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07,
+	0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x11, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x74,
+	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x36, 0x34, 0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54,
+	0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b,
+	0x30, 0x01, 0x62, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x11, 0x74, 0x4d, 0x61,
+	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x7b,
+	0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x75, 0x69,
+	0x6e, 0x74, 0x33, 0x32, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e,
+	0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30,
+	0x01, 0x62, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x7b, 0x0a, 0x12, 0x74,
+	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
 	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
 	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61,
-	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2,
-	0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x7b, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x0f, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07,
+	0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x7e, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x61,
+	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18,
+	0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70,
+	0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xba,
+	0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x10, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x12, 0x7e, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x61,
+	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18,
+	0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70,
+	0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xba,
+	0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x10, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61,
+	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x17, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
 	0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67,
 	0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf,
-	0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a,
-	0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e,
-	0x74, 0x33, 0x32, 0x12, 0x7b, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69,
-	0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12,
-	0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02, 0x52,
-	0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
-	0x12, 0x81, 0x01, 0x0a, 0x14, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x3a, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f,
-	0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40,
-	0x02, 0x52, 0x11, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78,
-	0x65, 0x64, 0x33, 0x32, 0x12, 0x81, 0x01, 0x0a, 0x14, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x73, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x12, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61,
-	0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xb2, 0x01,
-	0x04, 0x38, 0x0a, 0x40, 0x02, 0x52, 0x11, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x12, 0x7b, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61,
-	0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x13,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54,
-	0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14,
-	0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xba, 0x01, 0x04,
-	0x38, 0x0a, 0x40, 0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55,
-	0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x7b, 0x0a, 0x12, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x14, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f,
-	0x10, 0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40,
-	0x02, 0x52, 0x0f, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74,
-	0x36, 0x34, 0x12, 0x7e, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x39, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10,
-	0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02,
-	0x52, 0x10, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x33, 0x32, 0x12, 0x7e, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x39, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
-	0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69,
-	0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10,
-	0x12, 0x0e, 0xf2, 0x01, 0x0b, 0x30, 0x01, 0x62, 0x07, 0xba, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x02,
-	0x52, 0x10, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x36, 0x34, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67,
-	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xf2, 0x01, 0x09, 0x30,
-	0x01, 0x62, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x73, 0x0a, 0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70,
-	0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x19, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
-	0x0c, 0xf2, 0x01, 0x09, 0x30, 0x01, 0x62, 0x05, 0xda, 0x01, 0x02, 0x58, 0x01, 0x52, 0x0d, 0x74,
-	0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x76, 0x0a, 0x11,
-	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
+	0x12, 0x0c, 0xf2, 0x01, 0x09, 0x30, 0x01, 0x62, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x0d,
+	0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x73, 0x0a,
+	0x10, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x65, 0x6e, 0x75,
+	0x6d, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
 	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
 	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61,
-	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xf2, 0x01, 0x09, 0x30, 0x01, 0x62, 0x05, 0xca,
-	0x01, 0x02, 0x18, 0x03, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x79, 0x74, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x13, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1a, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xf2, 0x01, 0x09, 0x30, 0x01, 0x62, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x01,
-	0x52, 0x10, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d,
-	0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a,
-	0x13, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x45,
+	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xf2, 0x01, 0x09, 0x30, 0x01, 0x62, 0x05, 0xda, 0x01,
+	0x02, 0x58, 0x01, 0x52, 0x0d, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e,
+	0x75, 0x6d, 0x12, 0x76, 0x0a, 0x11, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x18, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x37, 0x2e,
+	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xf2, 0x01,
+	0x09, 0x30, 0x01, 0x62, 0x05, 0xca, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0e, 0x74, 0x4d, 0x61, 0x70,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x7c, 0x0a, 0x13, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x61, 0x70, 0x54, 0x61, 0x67, 0x73, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x31, 0x2e, 0x54, 0x4d, 0x61,
+	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xf2, 0x01, 0x09, 0x30, 0x01, 0x62,
+	0x05, 0xe2, 0x01, 0x02, 0x10, 0x01, 0x52, 0x10, 0x74, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14,
+	0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x45,
 	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74,
-	0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
-	0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x11, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x12,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x54,
-	0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x49, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61,
+	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x11, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a,
+	0x14, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34,
 	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x12, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
 	0x01, 0x1a, 0x44, 0x0a, 0x16, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x53, 0x66,
-	0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
 	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x10, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54,
-	0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0f, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x44, 0x0a, 0x16, 0x54, 0x4d, 0x61, 0x70, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x53, 0x66, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x10, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a,
+	0x14, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x42, 0x0a, 0x14, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x55, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x54, 0x4d,
+	0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e,
 	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
-	0x43, 0x0a, 0x15, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x33, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x07, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x43, 0x0a, 0x15, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x06, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61,
-	0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x58, 0x0a, 0x12, 0x54,
-	0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c,
-	0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x15, 0x54, 0x4d, 0x61, 0x70,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x25, 0x0a, 0x17, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
-	0x6c, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12,
-	0x26, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xc2, 0x01, 0x00, 0x52, 0x08, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x12, 0x23, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x12, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x12, 0x21, 0x0a, 0x09,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x12,
-	0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x71, 0x31, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x1a, 0x01, 0x62, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x71, 0x31, 0x12,
-	0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x22, 0x01, 0x62, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x31, 0x12,
-	0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x31, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x2a, 0x01, 0x63, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x74, 0x31, 0x12,
-	0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x31, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x32, 0x01, 0x63, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x31, 0x12,
-	0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x65, 0x31,
-	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0x3a, 0x01, 0x63, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x74, 0x65,
-	0x31, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74,
-	0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0x42, 0x01, 0x63, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47,
-	0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
-	0x0c, 0xc2, 0x01, 0x09, 0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62, 0x4a, 0x01, 0x63, 0x52, 0x0a, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x31, 0x12, 0x3b, 0x0a, 0x10, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x52, 0x01,
-	0x78, 0x52, 0x01, 0x79, 0x52, 0x01, 0x7a, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xa0, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72,
-	0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xa8, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72,
-	0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xb0, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72,
-	0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18,
-	0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xb8, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72,
-	0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31,
-	0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xc0, 0x01, 0x03, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
-	0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
-	0x65, 0x31, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0xc8, 0x01, 0x03, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
-	0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x05, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xf8, 0x01, 0x05, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x67, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0x80, 0x02, 0x05, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6c, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0x88, 0x02, 0x05, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x67, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
-	0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x02, 0x05, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x16,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65,
-	0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x02, 0x05, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x40,
-	0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78,
-	0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xe2, 0xdf, 0x1f, 0x14, 0x12, 0x12, 0xc2,
-	0x01, 0x0f, 0xc2, 0x02, 0x0c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x2e, 0x6a, 0x61, 0x72,
-	0x24, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x31,
-	0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
-	0x12, 0x0c, 0xc2, 0x01, 0x09, 0xca, 0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x0e,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x12, 0x41,
-	0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
-	0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xd2, 0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x52,
-	0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x31, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75,
-	0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
-	0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xda, 0x02, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52,
-	0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x12,
-	0x41, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x73,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xe2, 0x02, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
-	0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x53, 0x75, 0x66, 0x66, 0x69,
-	0x78, 0x31, 0x12, 0x3d, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f,
-	0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xea, 0x02, 0x03, 0x61, 0x62, 0x63, 0x52,
-	0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x31, 0x12, 0x42, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xf2, 0x02, 0x03, 0x61, 0x62,
-	0x63, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x73, 0x31, 0x12, 0x44, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18,
-	0x2f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06,
-	0xfa, 0x02, 0x03, 0x78, 0x79, 0x7a, 0x52, 0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79, 0x31, 0x12, 0x4b, 0x0a, 0x1a, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x82, 0x03, 0x03, 0x78, 0x79, 0x7a,
-	0x52, 0x16, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79, 0x31, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x18, 0x51, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x05, 0x01, 0x52, 0x0b, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x74, 0x66, 0x38, 0x12, 0x32, 0x0a, 0x0e, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x47, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x04, 0x01,
-	0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x63, 0x69, 0x69, 0x12, 0x3d,
-	0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x48, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x04, 0x01, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x63, 0x69, 0x69, 0x12, 0x36, 0x0a,
-	0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61,
-	0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xc8, 0x04, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f,
-	0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x3a, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x04, 0x01, 0x52,
-	0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73,
-	0x65, 0x12, 0x3a, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x70,
-	0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x04, 0x01, 0x52, 0x10, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x12, 0x32, 0x0a,
-	0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18,
-	0x4c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xe0, 0x04, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68,
-	0x61, 0x12, 0x34, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
-	0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x04, 0x01, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x4e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xf0, 0x04, 0x01, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70,
-	0x68, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0b, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x06, 0x01, 0x52, 0x09, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x06, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x34, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x06, 0x01, 0x52, 0x0b, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x36, 0x12, 0x35, 0x0a, 0x10, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x68,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0,
-	0x06, 0x01, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70,
-	0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x06, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x49, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x6a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xd0, 0x06, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x36, 0x41,
-	0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x69, 0x64, 0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
-	0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x06, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x43, 0x69, 0x64, 0x72, 0x12, 0x34, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x06, 0x01, 0x52, 0x0d, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x76, 0x34, 0x12, 0x34, 0x0a, 0x0f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x18, 0x6d,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8,
-	0x06, 0x01, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x76,
-	0x36, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61,
-	0x63, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xf0, 0x06, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
-	0x63, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63,
-	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x06, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x12, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x70, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0x80, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70,
-	0x34, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x71, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x07, 0x01, 0x52,
-	0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x72, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x07, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x64, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x12, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x73, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x98, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x34,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x74, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0, 0x07, 0x01, 0x52, 0x0f,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x39, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78,
-	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x75, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x38, 0x0a, 0x11, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x76, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xb0, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x19, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63, 0x31, 0x31, 0x32,
-	0x33, 0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xb8, 0x07, 0x01, 0x52, 0x16, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x12, 0x41, 0x0a,
-	0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x07, 0x01, 0x52, 0x13, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74,
-	0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x79, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x07, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x7a, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x07, 0x01, 0x52, 0x0b,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x71, 0x64, 0x6e, 0x12, 0x2e, 0x0a, 0x0c, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x7b, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x07, 0x01, 0x52,
-	0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x12, 0x2e, 0x0a, 0x0c, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x7c, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x07, 0x01, 0x52,
-	0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x3d, 0x0a, 0x14, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f,
-	0x64, 0x65, 0x64, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xe8, 0x07, 0x01, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x55, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x12, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72, 0x6f, 0x6e,
-	0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0x80, 0x05, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e,
-	0x69, 0x78, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x7e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x08, 0x01, 0x52, 0x0c, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x7f, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x08, 0x01, 0x52,
-	0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0c,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x77, 0x74, 0x18, 0x80, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x08,
-	0x01, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x77, 0x74, 0x12, 0x31, 0x0a,
-	0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x81,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xf8, 0x08, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c,
-	0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d,
-	0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x09, 0x01, 0x52, 0x12,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-	0x65, 0x64, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x09, 0x01, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x12, 0x3c, 0x0a, 0x13, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x75, 0x72, 0x6c,
-	0x18, 0x84, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0x90, 0x09, 0x01, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61,
-	0x73, 0x65, 0x36, 0x34, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18,
-	0x85, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0x98, 0x09, 0x01, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x78,
-	0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x86, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b, 0x12, 0x19, 0xc2, 0x01, 0x16, 0xa2,
-	0x09, 0x13, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x20, 0x31, 0x35, 0x3a,
-	0x30, 0x34, 0x3a, 0x30, 0x35, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61,
-	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x87, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x09, 0x01,
-	0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
-	0x65, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x18, 0x88, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xb0, 0x09, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x55, 0x75, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x75, 0x75, 0x69, 0x64, 0x31, 0x18, 0x89, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x09, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x31, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x33, 0x18, 0x8a, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x09, 0x01,
-	0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x33, 0x12, 0x33,
-	0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x34,
-	0x18, 0x8b, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xc8, 0x09, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75,
-	0x69, 0x64, 0x34, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x35, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x09, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x35, 0x22, 0xb5, 0x36, 0x0a, 0x18, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x20, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05,
-	0x12, 0x03, 0xc2, 0x01, 0x00, 0x48, 0x01, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x32, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48,
-	0x02, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x88, 0x01, 0x01, 0x12, 0x26,
-	0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x34, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x1a, 0x01, 0x62, 0x48, 0x04, 0x52, 0x0a, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x22, 0x01, 0x62, 0x48,
-	0x05, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x31,
-	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0x2a, 0x01, 0x63, 0x48, 0x06, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c,
-	0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x32, 0x01, 0x63, 0x48, 0x07, 0x52, 0x0a, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x3a, 0x01, 0x63, 0x48,
-	0x08, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74,
-	0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0x42, 0x01, 0x63, 0x48, 0x09, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12,
-	0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62, 0x4a,
-	0x01, 0x63, 0x48, 0x0a, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2,
-	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x52, 0x01, 0x78, 0x52, 0x01, 0x79, 0x52, 0x01,
-	0x7a, 0x48, 0x0b, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x49,
-	0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0,
-	0x01, 0x03, 0x48, 0x0c, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
-	0x72, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x6e, 0x65, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xa8, 0x01, 0x03, 0x48, 0x0d, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
-	0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72,
-	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x01, 0x03, 0x48, 0x0e, 0x52, 0x11,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74,
-	0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x17, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x01,
-	0x03, 0x48, 0x0f, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72,
-	0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x16, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
-	0x74, 0x65, 0x31, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xc0, 0x01, 0x03, 0x48, 0x10, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x45, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61,
-	0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x01, 0x03, 0x48, 0x11,
-	0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e,
-	0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xf0, 0x01, 0x05, 0x48, 0x12, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
-	0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65,
-	0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x01, 0x05, 0x48, 0x13, 0x52, 0x11, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x88, 0x01,
-	0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79,
-	0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x02, 0x05, 0x48, 0x14,
-	0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e,
-	0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18,
-	0x21, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x88, 0x02, 0x05, 0x48, 0x15, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79,
-	0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x16, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e,
-	0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x02, 0x05, 0x48, 0x16, 0x52, 0x12, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x88,
-	0x01, 0x01, 0x12, 0x45, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x02, 0x05,
-	0x48, 0x17, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c,
-	0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x31, 0x18, 0x28, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x18, 0xe2, 0xdf, 0x1f, 0x14, 0x12, 0x12, 0xc2, 0x01, 0x0f, 0xc2, 0x02, 0x0c,
-	0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x2e, 0x6a, 0x61, 0x72, 0x24, 0x48, 0x18, 0x52, 0x0d,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x41, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65,
-	0x66, 0x69, 0x78, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
-	0x12, 0x0c, 0xc2, 0x01, 0x09, 0xca, 0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x19,
-	0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xd2, 0x02, 0x06, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x48, 0x1a, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e,
-	0x6f, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x10, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18,
-	0x2b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09,
-	0xda, 0x02, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x48, 0x1b, 0x52, 0x0e, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x88, 0x01, 0x01, 0x12, 0x46,
-	0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75,
-	0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
-	0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xe2, 0x02, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x48,
-	0x1c, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x53, 0x75, 0x66, 0x66,
-	0x69, 0x78, 0x31, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x2d, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xea, 0x02, 0x03,
-	0x61, 0x62, 0x63, 0x48, 0x1d, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x73, 0x31, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12,
-	0x09, 0xc2, 0x01, 0x06, 0xf2, 0x02, 0x03, 0x61, 0x62, 0x63, 0x48, 0x1e, 0x52, 0x12, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31,
-	0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18, 0x2f, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xfa, 0x02,
-	0x03, 0x78, 0x79, 0x7a, 0x48, 0x1f, 0x52, 0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79, 0x31, 0x88, 0x01, 0x01, 0x12, 0x50,
-	0x0a, 0x1a, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18, 0x30, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x82, 0x03, 0x03,
-	0x78, 0x79, 0x7a, 0x48, 0x20, 0x52, 0x16, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
-	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79, 0x31, 0x88, 0x01, 0x01,
-	0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x74, 0x66,
-	0x38, 0x18, 0x51, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0x88, 0x05, 0x01, 0x48, 0x21, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x55, 0x74, 0x66, 0x38, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x04, 0x01, 0x48, 0x22, 0x52,
-	0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x63, 0x69, 0x69, 0x88, 0x01, 0x01,
-	0x12, 0x42, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69,
-	0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x48, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x04, 0x01, 0x48, 0x23, 0x52, 0x11,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x63, 0x69,
-	0x69, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x04, 0x01, 0x48, 0x24, 0x52, 0x0e,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x88, 0x01,
-	0x01, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f,
-	0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x04, 0x01, 0x48, 0x25, 0x52, 0x10, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x88,
-	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
-	0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x04, 0x01, 0x48, 0x26, 0x52, 0x10,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x04, 0x01, 0x48, 0x27, 0x52, 0x0c, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0f,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x4d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xe8, 0x04, 0x01, 0x48, 0x28, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x4e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xf0, 0x04, 0x01, 0x48, 0x29, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
-	0x6c, 0x70, 0x68, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a,
-	0x0b, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x18, 0x65, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x06, 0x01,
-	0x48, 0x2a, 0x52, 0x09, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x88, 0x01, 0x01,
-	0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76,
-	0x34, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xb0, 0x06, 0x01, 0x48, 0x2b, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x49, 0x70, 0x76, 0x34, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x06, 0x01, 0x48, 0x2c, 0x52, 0x0b,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x36, 0x88, 0x01, 0x01, 0x12, 0x3a,
-	0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0xc0, 0x06, 0x01, 0x48, 0x2d, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x11, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x69, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xc8, 0x06, 0x01, 0x48, 0x2e, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70,
-	0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6a, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x06,
-	0x01, 0x48, 0x2f, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x36, 0x41,
-	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x06, 0x01, 0x48, 0x30, 0x52, 0x0b, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
-	0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34,
-	0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xe0, 0x06, 0x01, 0x48, 0x31, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
-	0x69, 0x64, 0x72, 0x76, 0x34, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x18, 0x6d, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x06, 0x01, 0x48,
-	0x32, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x76, 0x36,
-	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6d, 0x61, 0x63, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xf0, 0x06, 0x01, 0x48, 0x33, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x4d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6f, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x06,
-	0x01, 0x48, 0x34, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x41,
-	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x70, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x07, 0x01,
-	0x48, 0x35, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x34, 0x41,
-	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x71, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x07, 0x01,
-	0x48, 0x36, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x36, 0x41,
-	0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x72, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x07, 0x01, 0x48,
-	0x37, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x73, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x07, 0x01, 0x48, 0x38,
-	0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x34, 0x41, 0x64, 0x64,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x74, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0, 0x07, 0x01, 0x48, 0x39,
-	0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x36, 0x41, 0x64, 0x64,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x75, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x07, 0x01, 0x48, 0x3a,
-	0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x41, 0x64, 0x64,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x07, 0x01, 0x48, 0x3b, 0x52,
-	0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x19, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33,
-	0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xb8, 0x07, 0x01, 0x48, 0x3c, 0x52, 0x16, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48,
-	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x88, 0x01,
-	0x01, 0x12, 0x46, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f,
-	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x78, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x07, 0x01, 0x48,
-	0x3d, 0x52, 0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x11, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x79,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8,
-	0x07, 0x01, 0x48, 0x3e, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74,
-	0x61, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x7a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x07, 0x01, 0x48, 0x3f, 0x52, 0x0b,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x71, 0x64, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x33,
-	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x7b,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8,
-	0x07, 0x01, 0x48, 0x40, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x69,
-	0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x75, 0x72, 0x6c, 0x18, 0x7c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xe0, 0x07, 0x01, 0x48, 0x41, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
-	0x18, 0x7d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xe8, 0x07, 0x01, 0x48, 0x42, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55,
-	0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x12,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72,
-	0x6f, 0x6e, 0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0x80, 0x05, 0x01, 0x48, 0x43, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x72, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a,
-	0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x7e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xe0, 0x08, 0x01, 0x48, 0x44, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x08, 0x01, 0x48, 0x45, 0x52, 0x0b, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a,
-	0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x77, 0x74, 0x18, 0x80, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0,
-	0x08, 0x01, 0x48, 0x46, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x77, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x68, 0x74, 0x6d, 0x6c, 0x18, 0x81, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x08, 0x01, 0x48, 0x47, 0x52, 0x0b, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x15, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63,
-	0x6f, 0x64, 0x65, 0x64, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x09, 0x01, 0x48, 0x48, 0x52, 0x12, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x09, 0x01, 0x48, 0x49, 0x52, 0x0d, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x88, 0x01, 0x01, 0x12, 0x41,
-	0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36,
-	0x34, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x84, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x09, 0x01, 0x48, 0x4a, 0x52, 0x10, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x55, 0x72, 0x6c, 0x88, 0x01,
-	0x01, 0x12, 0x44, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65,
-	0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x85, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x09, 0x01, 0x48, 0x4b,
-	0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63,
-	0x69, 0x6d, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x86, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b, 0x12, 0x19, 0xc2, 0x01, 0x16, 0xa2, 0x09,
-	0x13, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x20, 0x31, 0x35, 0x3a, 0x30,
-	0x34, 0x3a, 0x30, 0x35, 0x48, 0x4c, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44,
-	0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x11, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
-	0x87, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xa8, 0x09, 0x01, 0x48, 0x4d, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54,
-	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x88, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x09, 0x01,
-	0x48, 0x4e, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x31, 0x18, 0x89, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x09, 0x01, 0x48, 0x4f, 0x52, 0x0c, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x31, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x33, 0x18, 0x8a,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xc0, 0x09, 0x01, 0x48, 0x50, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75,
-	0x69, 0x64, 0x33, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x8b, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x09, 0x01, 0x48, 0x51, 0x52,
-	0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x34, 0x88, 0x01, 0x01,
-	0x12, 0x38, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69,
-	0x64, 0x35, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xd0, 0x09, 0x01, 0x48, 0x52, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x35, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x33, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31,
-	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
-	0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e,
-	0x5f, 0x6e, 0x65, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x18,
-	0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72,
-	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
-	0x74, 0x65, 0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x18,
-	0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65,
-	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e,
-	0x65, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x18, 0x0a, 0x16,
-	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c,
-	0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65,
-	0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
-	0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x31,
-	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
-	0x65, 0x66, 0x69, 0x78, 0x31, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x42, 0x13, 0x0a,
-	0x11, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69,
-	0x78, 0x31, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
-	0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x5f, 0x61, 0x6e, 0x79, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x73,
-	0x63, 0x69, 0x69, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x42,
-	0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x70, 0x70,
-	0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x18, 0x0a,
-	0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x42, 0x13, 0x0a, 0x11, 0x5f,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70,
-	0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x10, 0x0a, 0x0e,
-	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x42, 0x12,
-	0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72,
-	0x76, 0x34, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x63, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x15, 0x0a,
-	0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x34, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
-	0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42,
-	0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69,
-	0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x1c, 0x0a, 0x1a,
-	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x69, 0x42, 0x0f,
-	0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x42,
-	0x17, 0x0a, 0x15, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c,
-	0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x42,
-	0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x6a, 0x77, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
-	0x61, 0x73, 0x65, 0x36, 0x34, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x17, 0x0a,
-	0x15, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x78, 0x61, 0x64,
-	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x14, 0x0a, 0x12,
-	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
-	0x6e, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x31, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x33, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x34, 0x42, 0x11, 0x0a,
-	0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x35,
-	0x22, 0xcf, 0x26, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x1d, 0x0a, 0x09, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
-	0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf,
-	0x1f, 0x05, 0x12, 0x03, 0xc2, 0x01, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x32, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x00,
-	0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x12, 0x23, 0x0a, 0x09, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2,
-	0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x12,
-	0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x71, 0x31, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x1a, 0x01, 0x62, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x71,
-	0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65,
-	0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0x22, 0x01, 0x62, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x4e, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0x2a, 0x01, 0x63, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x4c, 0x74, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x20, 0x01, 0x28, 0x06, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x40, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x6f, 0x6f, 0x6c,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x58, 0x0a, 0x12, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x45, 0x6e,
+	0x75, 0x6d, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2c, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x31,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x54,
+	0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c,
+	0x0a, 0x15, 0x54, 0x4d, 0x61, 0x70, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x9f, 0x25, 0x0a,
+	0x17, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03,
+	0xc2, 0x01, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x12, 0x23, 0x0a,
+	0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x12, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x33, 0x12, 0x21, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x34, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x1a, 0x01, 0x62, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x45, 0x71, 0x31, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x22, 0x01, 0x62, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4e, 0x65, 0x31, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x2a, 0x01, 0x63, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x74, 0x31, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
 	0x67, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x32, 0x01, 0x63, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x3a, 0x01, 0x63, 0x48, 0x00, 0x52, 0x0b,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x42, 0x01, 0x63,
-	0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x65, 0x31, 0x12,
-	0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x31, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09,
-	0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62, 0x4a, 0x01, 0x63, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x31, 0x12, 0x3d, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x52, 0x01, 0x78, 0x52,
-	0x01, 0x79, 0x52, 0x01, 0x7a, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18,
-	0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xa0, 0x01, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68,
-	0x61, 0x72, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65,
-	0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xa8, 0x01, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x67, 0x74, 0x31, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xb0, 0x01, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x40, 0x0a, 0x15,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x32, 0x01, 0x63, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x47, 0x74, 0x31, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x3a, 0x01, 0x63, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x42, 0x01, 0x63, 0x52, 0x0b, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x65, 0x31, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62,
+	0x4a, 0x01, 0x63, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x31, 0x12,
+	0x3b, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f,
+	0x69, 0x6e, 0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
+	0x0c, 0xc2, 0x01, 0x09, 0x52, 0x01, 0x78, 0x52, 0x01, 0x79, 0x52, 0x01, 0x7a, 0x52, 0x0d, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x3e, 0x0a, 0x15,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x3e, 0x0a, 0x15,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x3e, 0x0a, 0x15,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x3e, 0x0a, 0x15,
 	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65,
 	0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x01, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12, 0x42,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x01, 0x03, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12, 0x40, 0x0a, 0x16,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x01, 0x03, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x12, 0x40,
 	0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f,
-	0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x01, 0x03, 0x48, 0x00, 0x52, 0x12,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74,
-	0x65, 0x31, 0x12, 0x42, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63,
-	0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x19, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x01, 0x03,
-	0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c,
-	0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18,
-	0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xf0, 0x01, 0x05, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79,
-	0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65,
-	0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xf8, 0x01, 0x05, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
-	0x67, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0x80, 0x02, 0x05, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x12, 0x40, 0x0a, 0x15,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65,
-	0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x02, 0x05, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x12, 0x42,
-	0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f,
-	0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x02, 0x05, 0x48, 0x00, 0x52, 0x12,
+	0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x01, 0x03, 0x52, 0x12, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31,
+	0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x05, 0x52, 0x11, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31,
+	0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x01, 0x05, 0x52, 0x11, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31,
+	0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x02, 0x05, 0x52, 0x11, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31,
+	0x12, 0x3e, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x02, 0x05, 0x52, 0x11, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31,
+	0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x02, 0x05, 0x52, 0x12,
 	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74,
-	0x65, 0x31, 0x12, 0x42, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x65, 0x31, 0x12, 0x40, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
 	0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x23, 0x20, 0x01,
 	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x02, 0x05,
-	0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c,
-	0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xe2, 0xdf, 0x1f, 0x14, 0x12, 0x12, 0xc2, 0x01, 0x0f, 0xc2, 0x02, 0x0c, 0x5e, 0x5b, 0x30,
-	0x2d, 0x39, 0x5d, 0x2b, 0x2e, 0x6a, 0x61, 0x72, 0x24, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x31, 0x12, 0x3e, 0x0a, 0x10, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x18, 0x29,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xca,
-	0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x12, 0x43, 0x0a, 0x13, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2,
-	0x01, 0x09, 0xd2, 0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x00, 0x52, 0x10, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x12,
-	0x3e, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x66, 0x66,
-	0x69, 0x78, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
-	0x0c, 0xc2, 0x01, 0x09, 0xda, 0x02, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x48, 0x00, 0x52,
-	0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x12,
-	0x43, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x73,
-	0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf,
-	0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xe2, 0x02, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78,
-	0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x53, 0x75, 0x66,
-	0x66, 0x69, 0x78, 0x31, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xea, 0x02, 0x03, 0x61, 0x62,
-	0x63, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x73, 0x31, 0x12, 0x44, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x2e,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xf2,
-	0x02, 0x03, 0x61, 0x62, 0x63, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x4e, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x12, 0x46, 0x0a, 0x16, 0x74,
+	0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e,
+	0x4c, 0x74, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xe2,
+	0xdf, 0x1f, 0x14, 0x12, 0x12, 0xc2, 0x01, 0x0f, 0xc2, 0x02, 0x0c, 0x5e, 0x5b, 0x30, 0x2d, 0x39,
+	0x5d, 0x2b, 0x2e, 0x6a, 0x61, 0x72, 0x24, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x67, 0x65, 0x78, 0x31, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xca, 0x02, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x31, 0x12, 0x41, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2a, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xd2, 0x02, 0x06, 0x70,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f,
+	0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x12, 0x3c, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xda, 0x02, 0x06, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x31, 0x12, 0x41, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xe2, 0x02, 0x06,
+	0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e,
+	0x6f, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x12, 0x3d, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x2d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xea,
+	0x02, 0x03, 0x61, 0x62, 0x63, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x12, 0x42, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31,
+	0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01,
+	0x06, 0xf2, 0x02, 0x03, 0x61, 0x62, 0x63, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4e, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x12, 0x44, 0x0a, 0x16, 0x74,
 	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
 	0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f,
-	0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xfa, 0x02, 0x03, 0x78, 0x79, 0x7a, 0x48, 0x00, 0x52, 0x13,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41,
-	0x6e, 0x79, 0x31, 0x12, 0x4d, 0x0a, 0x1a, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79,
-	0x31, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2,
-	0x01, 0x06, 0x82, 0x03, 0x03, 0x78, 0x79, 0x7a, 0x48, 0x00, 0x52, 0x16, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e,
-	0x79, 0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
-	0x74, 0x66, 0x38, 0x18, 0x51, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0x88, 0x05, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x74, 0x66, 0x38, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x04, 0x01, 0x48, 0x00, 0x52, 0x0c,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x63, 0x69, 0x69, 0x12, 0x3f, 0x0a, 0x14,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x61,
-	0x73, 0x63, 0x69, 0x69, 0x18, 0x48, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
-	0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x04, 0x01, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x41, 0x73, 0x63, 0x69, 0x69, 0x12, 0x38, 0x0a,
-	0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61,
-	0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xc8, 0x04, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x3c, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4a, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x04,
-	0x01, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x77, 0x65,
-	0x72, 0x63, 0x61, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4b, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x04, 0x01, 0x48,
-	0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x70, 0x65, 0x72, 0x63,
-	0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xfa, 0x02, 0x03, 0x78, 0x79, 0x7a, 0x52, 0x13, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79,
+	0x31, 0x12, 0x4b, 0x0a, 0x1a, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18,
+	0x30, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06,
+	0x82, 0x03, 0x03, 0x78, 0x79, 0x7a, 0x52, 0x16, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e,
+	0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79, 0x31, 0x12, 0x30,
+	0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x18,
+	0x51, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0x88, 0x05, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x74, 0x66, 0x38,
+	0x12, 0x32, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63,
+	0x69, 0x69, 0x18, 0x47, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xb8, 0x04, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
+	0x73, 0x63, 0x69, 0x69, 0x12, 0x3d, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x48, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x04, 0x01,
+	0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x63, 0x69, 0x69, 0x12, 0x36, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x04, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x3a, 0x0a, 0x12, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73,
+	0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xd0, 0x04, 0x01, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x6f,
+	0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x04,
+	0x01, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x70, 0x65, 0x72, 0x63,
+	0x61, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
 	0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x4c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x04, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x36, 0x0a, 0x0f, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4d, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x04, 0x01,
-	0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65,
-	0x72, 0x12, 0x41, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c,
-	0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4e, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x04, 0x01, 0x48, 0x00,
-	0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x4e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x70, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xa8, 0x06, 0x01, 0x48, 0x00, 0x52, 0x09, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x49, 0x70, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x34, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x67, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x06, 0x01, 0x48, 0x00, 0x52,
-	0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x36, 0x12, 0x37, 0x0a, 0x10,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xc0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49,
-	0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x69, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x06, 0x01, 0x48, 0x00,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x04, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12, 0x34, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x04, 0x01, 0x52, 0x0d,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x3f, 0x0a,
+	0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x04, 0x01, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x0b, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x18, 0x65, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x06,
+	0x01, 0x52, 0x09, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x12, 0x30, 0x0a, 0x0d,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x66, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x06,
+	0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x34, 0x12, 0x30,
+	0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18,
+	0x67, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xb8, 0x06, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x36,
+	0x12, 0x35, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x06, 0x01, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x69, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x06, 0x01,
 	0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72,
-	0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36,
+	0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36,
 	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x12, 0x32, 0x0a, 0x0d, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x6b, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x06, 0x01,
-	0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x12,
-	0x36, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72,
-	0x76, 0x34, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0xe0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x43, 0x69, 0x64, 0x72, 0x76, 0x34, 0x12, 0x36, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x06, 0x01, 0x48, 0x00,
-	0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x76, 0x36, 0x12,
-	0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x63, 0x18,
-	0x6e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xf0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61,
-	0x63, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63,
-	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x12,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x18, 0x70, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0x80, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x54, 0x63, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
-	0x71, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x88, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63,
-	0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x06, 0x01, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x06, 0x01, 0x52, 0x0b,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x12, 0x34, 0x0a, 0x0f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x18, 0x6c,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0,
+	0x06, 0x01, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x76,
+	0x34, 0x12, 0x34, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69,
+	0x64, 0x72, 0x76, 0x36, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x06, 0x01, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x69, 0x64, 0x72, 0x76, 0x36, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x06, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x63, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6f, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x06, 0x01,
+	0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x39, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70,
+	0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x70, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x12, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x71, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0x88, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63,
+	0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
 	0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x72, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x07, 0x01, 0x48,
-	0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x41, 0x64, 0x64,
-	0x72, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64,
-	0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x73, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b,
-	0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x74, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
-	0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x12, 0x74,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64,
-	0x72, 0x18, 0x75, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xa8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x55, 0x6e, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3a, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x76, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x07,
-	0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x19, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63, 0x31, 0x31, 0x32,
-	0x33, 0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xb8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x16, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x12,
-	0x43, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74,
-	0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x07, 0x01, 0x48, 0x00, 0x52,
-	0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65,
-	0x50, 0x6f, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x79, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x07, 0x01, 0x48, 0x00, 0x52,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x07, 0x01, 0x52,
+	0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x39, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x34,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x73, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x55, 0x64, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x12, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x74, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0xa0, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70,
+	0x36, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x75, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x07, 0x01, 0x52,
+	0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72,
+	0x12, 0x38, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73,
+	0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x07, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x47, 0x0a, 0x19, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x72, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x07, 0x01, 0x52, 0x16, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66, 0x63, 0x31,
+	0x31, 0x32, 0x33, 0x12, 0x41, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x78, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x07,
+	0x01, 0x52, 0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x37, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x79, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x07, 0x01, 0x52,
 	0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x12,
-	0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x71, 0x64, 0x6e,
+	0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x71, 0x64, 0x6e,
 	0x18, 0x7a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0xd0, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46,
-	0x71, 0x64, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
-	0x75, 0x72, 0x69, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xd8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x55, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x7c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18,
-	0x7d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0xe8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72,
-	0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x8d,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
-	0x80, 0x05, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e,
-	0x69, 0x78, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x7e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x08, 0x01, 0x48, 0x00, 0x52, 0x0c,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x32, 0x0a, 0x0d,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x7f, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x08,
-	0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x73, 0x6f, 0x6e,
-	0x12, 0x31, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x77, 0x74,
-	0x18, 0x80, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xf0, 0x08, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x4a, 0x77, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x03, 0xd0, 0x07, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x71, 0x64,
+	0x6e, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72,
+	0x69, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xd8, 0x07, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72,
+	0x69, 0x12, 0x2e, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72,
+	0x6c, 0x18, 0x7c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xe0, 0x07, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72,
+	0x6c, 0x12, 0x3d, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72,
+	0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x07, 0x01, 0x52, 0x11, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x12, 0x3a, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69,
+	0x78, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x05, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x0e,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x7e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0,
+	0x08, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x12, 0x30, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xe8, 0x08, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x73,
+	0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a,
+	0x77, 0x74, 0x18, 0x80, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xf0, 0x08, 0x01, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4a, 0x77, 0x74, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
 	0x68, 0x74, 0x6d, 0x6c, 0x18, 0x81, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x08, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x42, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-	0x64, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0x80, 0x09, 0x01, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x0f,
-	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18,
-	0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
-	0x03, 0x88, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
-	0x61, 0x73, 0x65, 0x36, 0x34, 0x12, 0x3e, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x84, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x09,
-	0x01, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65,
-	0x36, 0x34, 0x55, 0x72, 0x6c, 0x12, 0x41, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x85, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98,
-	0x09, 0x01, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x65, 0x78,
-	0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x4e, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x86, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b, 0x12, 0x19, 0xc2, 0x01, 0x16, 0xa2,
-	0x09, 0x13, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x20, 0x31, 0x35, 0x3a,
-	0x30, 0x34, 0x3a, 0x30, 0x35, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x87, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8,
-	0x09, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d,
-	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x88, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0e, 0x74, 0x5f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x08, 0x01, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18,
+	0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0x80, 0x09, 0x01, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d,
+	0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x35, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x83, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x09, 0x01,
+	0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x12,
+	0x3c, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65,
+	0x36, 0x34, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x84, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x09, 0x01, 0x52, 0x10, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a,
+	0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65,
+	0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x85, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x09, 0x01, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x48, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x4c,
+	0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x86, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b,
+	0x12, 0x19, 0xc2, 0x01, 0x16, 0xa2, 0x09, 0x13, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d,
+	0x30, 0x32, 0x20, 0x31, 0x35, 0x3a, 0x30, 0x34, 0x3a, 0x30, 0x35, 0x52, 0x0f, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x11,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x87, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xa8, 0x09, 0x01, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x88, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x09, 0x01, 0x52, 0x0b, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f,
 	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x31, 0x18, 0x89, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x09,
-	0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64,
-	0x31, 0x12, 0x35, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75,
-	0x69, 0x64, 0x33, 0x18, 0x8a, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
-	0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x33, 0x12, 0x35, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x8b, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x09, 0x01, 0x48,
-	0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x34, 0x12,
-	0x35, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64,
-	0x35, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
-	0xc2, 0x01, 0x03, 0xd0, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x55, 0x75, 0x69, 0x64, 0x35, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x31, 0x22, 0x95, 0x09, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x64, 0x31, 0x12, 0x3d, 0x0a,
-	0x0f, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xe2, 0xdf, 0x1f, 0x11, 0x12, 0x0f, 0xc2, 0x01,
-	0x0c, 0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x52, 0x0d, 0x74,
-	0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x42, 0x0a, 0x0f,
-	0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xe2, 0xdf, 0x1f, 0x11, 0x12, 0x0f, 0xc2, 0x01, 0x0c,
-	0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x48, 0x01, 0x52, 0x0d,
-	0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x88, 0x01, 0x01,
-	0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xe2, 0xdf, 0x1f, 0x09, 0x12, 0x07, 0xb2,
-	0x01, 0x04, 0x38, 0x0a, 0x40, 0x01, 0x52, 0x0b, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e,
-	0x74, 0x36, 0x34, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x69,
-	0x6e, 0x74, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0d, 0xe2, 0xdf, 0x1f, 0x09,
-	0x12, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x01, 0x48, 0x02, 0x52, 0x0b, 0x74, 0x42, 0x61,
-	0x73, 0x69, 0x63, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x0d, 0x74,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x1e, 0xe2, 0xdf, 0x1f, 0x1a, 0x12, 0x18, 0xea, 0x01, 0x15, 0x38, 0x03, 0x40,
-	0x01, 0x5a, 0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x01, 0x88, 0x02, 0x05, 0xca, 0x02, 0x03, 0x69,
-	0x64, 0x2d, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x38, 0x0a, 0x0c, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x03, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10, 0xea, 0x01, 0x0d,
-	0x38, 0x03, 0x40, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x01, 0x52, 0x0a, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x8d, 0x01, 0x0a, 0x0d, 0x74, 0x5f,
-	0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x14, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
-	0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x64, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x2f, 0xe2, 0xdf, 0x1f,
-	0x2b, 0x12, 0x29, 0xf2, 0x01, 0x26, 0x38, 0x03, 0x40, 0x01, 0x5a, 0x0f, 0xc2, 0x01, 0x0c, 0x80,
-	0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x62, 0x0f, 0xc2, 0x01, 0x0c,
-	0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x52, 0x0b, 0x74, 0x4d,
-	0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x77, 0x0a, 0x0b, 0x74, 0x5f, 0x6d,
-	0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x36,
-	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x75, 0x6c,
-	0x74, 0x69, 0x43, 0x6f, 0x6e, 0x64, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36,
-	0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b, 0x12, 0x19, 0xf2, 0x01,
-	0x16, 0x38, 0x03, 0x40, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x28, 0x0a, 0x30, 0x05, 0x62, 0x07,
-	0xb2, 0x01, 0x04, 0x28, 0x0a, 0x30, 0x05, 0x52, 0x09, 0x74, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74,
-	0x36, 0x34, 0x12, 0x3c, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xe2, 0xdf, 0x1f, 0x11, 0x12,
-	0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d,
-	0x48, 0x00, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
-	0x12, 0x30, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18,
-	0x1f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xe2, 0xdf, 0x1f, 0x09, 0x12, 0x07, 0xb2, 0x01, 0x04,
-	0x38, 0x0a, 0x40, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x49, 0x6e, 0x74,
-	0x36, 0x34, 0x12, 0xa5, 0x01, 0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x32, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c,
+	0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x31, 0x12,
+	0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x33, 0x18, 0x8a, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xc0, 0x09, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55,
+	0x75, 0x69, 0x64, 0x33, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x8b, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x09, 0x01, 0x52, 0x0c, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x34, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x35, 0x18, 0x8c, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x09, 0x01,
+	0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x35, 0x22, 0xb5,
+	0x36, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61,
+	0x67, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x31, 0x12, 0x20, 0x0a, 0x09, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a,
+	0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xc2, 0x01, 0x00, 0x48, 0x01, 0x52, 0x08, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x12, 0x00, 0x48, 0x02, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x33, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x03, 0x52,
+	0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x1a, 0x01, 0x62,
+	0x48, 0x04, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x71, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65,
+	0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0x22, 0x01, 0x62, 0x48, 0x05, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x2a, 0x01, 0x63, 0x48, 0x06, 0x52, 0x0a, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x32, 0x01, 0x63, 0x48,
+	0x07, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x65,
+	0x31, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0x3a, 0x01, 0x63, 0x48, 0x08, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x42, 0x01, 0x63, 0x48, 0x09, 0x52, 0x0b,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x4a,
+	0x01, 0x61, 0x4a, 0x01, 0x62, 0x4a, 0x01, 0x63, 0x48, 0x0a, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x10, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x52, 0x01,
+	0x78, 0x52, 0x01, 0x79, 0x52, 0x01, 0x7a, 0x48, 0x0b, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e,
+	0x5f, 0x65, 0x71, 0x31, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0, 0x01, 0x03, 0x48, 0x0c, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x01, 0x03, 0x48, 0x0d, 0x52,
+	0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4e,
+	0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x16,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0,
+	0x01, 0x03, 0x48, 0x0e, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
+	0x6c, 0x74, 0x31, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xb8, 0x01, 0x03, 0x48, 0x0f, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x45, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x01, 0x03, 0x48, 0x10, 0x52,
+	0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47,
+	0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x65, 0x31,
+	0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0xc8, 0x01, 0x03, 0x48, 0x11, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
+	0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a,
+	0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c,
+	0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x05, 0x48, 0x12, 0x52, 0x11, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x01, 0x05, 0x48,
+	0x13, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65,
+	0x6e, 0x4e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0x80, 0x02, 0x05, 0x48, 0x14, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x31, 0x88, 0x01, 0x01, 0x12, 0x43, 0x0a, 0x15,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x02, 0x05, 0x48, 0x15, 0x52, 0x11, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x45, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x22, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x02, 0x05, 0x48,
+	0x16, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65,
+	0x6e, 0x47, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
+	0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0x98, 0x02, 0x05, 0x48, 0x17, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12,
+	0x45, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x65,
+	0x78, 0x31, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xe2, 0xdf, 0x1f, 0x14, 0x12, 0x12,
+	0xc2, 0x01, 0x0f, 0xc2, 0x02, 0x0c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x2e, 0x6a, 0x61,
+	0x72, 0x24, 0x48, 0x18, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67,
+	0x65, 0x78, 0x31, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xca, 0x02, 0x06, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x48, 0x19, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x13, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31,
+	0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01,
+	0x09, 0xd2, 0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x1a, 0x52, 0x10, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x41, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
+	0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xda, 0x02, 0x06, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x48,
+	0x1b, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78,
+	0x31, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xe2, 0x02, 0x06, 0x73,
+	0x75, 0x66, 0x66, 0x69, 0x78, 0x48, 0x1c, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x4e, 0x6f, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x12,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x31, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09,
+	0xc2, 0x01, 0x06, 0xea, 0x02, 0x03, 0x61, 0x62, 0x63, 0x48, 0x1d, 0x52, 0x10, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x88, 0x01, 0x01,
+	0x12, 0x47, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xf2, 0x02, 0x03, 0x61, 0x62, 0x63,
+	0x48, 0x1e, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x16, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61,
+	0x6e, 0x79, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12,
+	0x09, 0xc2, 0x01, 0x06, 0xfa, 0x02, 0x03, 0x78, 0x79, 0x7a, 0x48, 0x1f, 0x52, 0x13, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79,
+	0x31, 0x88, 0x01, 0x01, 0x12, 0x50, 0x0a, 0x1a, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e,
+	0x79, 0x31, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09,
+	0xc2, 0x01, 0x06, 0x82, 0x03, 0x03, 0x78, 0x79, 0x7a, 0x48, 0x20, 0x52, 0x16, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41,
+	0x6e, 0x79, 0x31, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x18, 0x51, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x05, 0x01, 0x48, 0x21, 0x52, 0x0b, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x74, 0x66, 0x38, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a,
+	0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18,
+	0x47, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xb8, 0x04, 0x01, 0x48, 0x22, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x73,
+	0x63, 0x69, 0x69, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x48,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0,
+	0x04, 0x01, 0x48, 0x23, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69,
+	0x6e, 0x74, 0x41, 0x73, 0x63, 0x69, 0x69, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x10, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x49,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8,
+	0x04, 0x01, 0x48, 0x24, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f,
+	0x6c, 0x65, 0x61, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4a, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x04,
+	0x01, 0x48, 0x25, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x77, 0x65,
+	0x72, 0x63, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x18, 0x4b,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8,
+	0x04, 0x01, 0x48, 0x26, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x70, 0x70,
+	0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x4c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x04, 0x01, 0x48,
+	0x27, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x88,
+	0x01, 0x01, 0x12, 0x39, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x04, 0x01, 0x48, 0x28, 0x52, 0x0d, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a,
+	0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x4e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x04, 0x01, 0x48, 0x29, 0x52, 0x12, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0b, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x70, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xa8, 0x06, 0x01, 0x48, 0x2a, 0x52, 0x09, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x49, 0x70, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x66, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x06, 0x01, 0x48, 0x2b, 0x52, 0x0b, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x34, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
+	0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18, 0x67,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8,
+	0x06, 0x01, 0x48, 0x2c, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76,
+	0x36, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x06, 0x01, 0x48, 0x2d, 0x52, 0x0d,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01,
+	0x12, 0x3c, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x34,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x06, 0x01, 0x48, 0x2e, 0x52, 0x0e, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3c,
+	0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xd0, 0x06, 0x01, 0x48, 0x2f, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x49, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x18, 0x6b, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x06,
+	0x01, 0x48, 0x30, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x06, 0x01, 0x48, 0x31, 0x52, 0x0d, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x76, 0x34, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76,
+	0x36, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xe8, 0x06, 0x01, 0x48, 0x32, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x43, 0x69, 0x64, 0x72, 0x76, 0x36, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x06, 0x01, 0x48, 0x33, 0x52,
+	0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x63, 0x88, 0x01, 0x01, 0x12, 0x3c,
+	0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x6f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xf8, 0x06, 0x01, 0x48, 0x34, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x54, 0x63, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x70, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0x80, 0x07, 0x01, 0x48, 0x35, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x54, 0x63, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x18, 0x71, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0x88, 0x07, 0x01, 0x48, 0x36, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x54, 0x63, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x11,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x72, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0x90, 0x07, 0x01, 0x48, 0x37, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x55, 0x64, 0x70, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x73, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0x98, 0x07, 0x01, 0x48, 0x38, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55,
+	0x64, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x74, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0xa0, 0x07, 0x01, 0x48, 0x39, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55,
+	0x64, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x12, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x75, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0xa8, 0x07, 0x01, 0x48, 0x3a, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55,
+	0x6e, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x11, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x76, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xb0, 0x07, 0x01, 0x48, 0x3b, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x4c, 0x0a, 0x19, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72,
+	0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x07, 0x01, 0x48, 0x3c, 0x52, 0x16, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66, 0x63,
+	0x31, 0x31, 0x32, 0x33, 0x88, 0x01, 0x01, 0x12, 0x46, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xc0, 0x07, 0x01, 0x48, 0x3d, 0x52, 0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x3c, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x79, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x07, 0x01, 0x48, 0x3e, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a,
+	0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x7a,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0,
+	0x07, 0x01, 0x48, 0x3f, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x71, 0x64,
+	0x6e, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x72, 0x69, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x07, 0x01, 0x48, 0x40, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x7c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x07, 0x01, 0x48, 0x41, 0x52,
+	0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x42,
+	0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65,
+	0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x07, 0x01, 0x48, 0x42, 0x52, 0x11, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
+	0x6e, 0x69, 0x78, 0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x05, 0x01, 0x48, 0x43, 0x52,
+	0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x72, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x7e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x08, 0x01, 0x48, 0x44, 0x52, 0x0c, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x35, 0x0a, 0x0d,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x7f, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x08,
+	0x01, 0x48, 0x45, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x73, 0x6f, 0x6e,
+	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x6a, 0x77, 0x74, 0x18, 0x80, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x08, 0x01, 0x48, 0x46, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x4a, 0x77, 0x74, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x81, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x08, 0x01, 0x48,
+	0x47, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x88, 0x01,
+	0x01, 0x12, 0x45, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74,
+	0x6d, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x09, 0x01, 0x48,
+	0x48, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x83, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x09, 0x01,
+	0x48, 0x49, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36,
+	0x34, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x84, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90, 0x09, 0x01,
+	0x48, 0x4a, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36,
+	0x34, 0x55, 0x72, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18,
+	0x85, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0x98, 0x09, 0x01, 0x48, 0x4b, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48,
+	0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x88, 0x01, 0x01, 0x12, 0x51, 0x0a,
+	0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x86, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b, 0x12,
+	0x19, 0xc2, 0x01, 0x16, 0xa2, 0x09, 0x13, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30,
+	0x32, 0x20, 0x31, 0x35, 0x3a, 0x30, 0x34, 0x3a, 0x30, 0x35, 0x48, 0x4c, 0x52, 0x0f, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x3e, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x87, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x09, 0x01, 0x48, 0x4d, 0x52, 0x0f, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x88, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xb0, 0x09, 0x01, 0x48, 0x4e, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x55, 0x75, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x31, 0x18, 0x89, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x09, 0x01, 0x48,
+	0x4f, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x31, 0x88,
+	0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
+	0x75, 0x69, 0x64, 0x33, 0x18, 0x8a, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x09, 0x01, 0x48, 0x50, 0x52, 0x0c, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x33, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x34, 0x18, 0x8b,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xc8, 0x09, 0x01, 0x48, 0x51, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75,
+	0x69, 0x64, 0x34, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x35, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x09, 0x01, 0x48, 0x52, 0x52,
+	0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x35, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x42, 0x0c,
+	0x0a, 0x0a, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x42, 0x0c, 0x0a, 0x0a,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x31, 0x42, 0x10, 0x0a, 0x0e,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x65, 0x31,
+	0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e,
+	0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e,
+	0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31,
+	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68,
+	0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e,
+	0x5f, 0x67, 0x74, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x19,
+	0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72,
+	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f,
+	0x6c, 0x74, 0x65, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x65, 0x71, 0x31, 0x42, 0x18,
+	0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67,
+	0x74, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x42, 0x19, 0x0a, 0x17,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c,
+	0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
+	0x65, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x72, 0x65, 0x67, 0x65, 0x78, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x42, 0x16, 0x0a, 0x14, 0x5f,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x31, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31,
+	0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x31, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x42, 0x1d, 0x0a, 0x1b,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72,
+	0x69, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x63, 0x61, 0x73, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x42, 0x10, 0x0a,
+	0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x42,
+	0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76,
+	0x36, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x69, 0x64, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x61, 0x63, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75,
+	0x64, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x36,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x72, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33,
+	0x42, 0x19, 0x0a, 0x17, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72,
+	0x69, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x66,
+	0x71, 0x64, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x72, 0x69, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x75, 0x72, 0x6c, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78,
+	0x5f, 0x63, 0x72, 0x6f, 0x6e, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a, 0x77, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x42, 0x18, 0x0a,
+	0x16, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x42, 0x16, 0x0a, 0x14, 0x5f,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f,
+	0x75, 0x72, 0x6c, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x42, 0x14, 0x0a, 0x12,
+	0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
+	0x6d, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x31, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x33,
+	0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x34, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x75, 0x69, 0x64, 0x35, 0x22, 0xcf, 0x26, 0x0a, 0x15, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x61, 0x67, 0x73, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31,
+	0x12, 0x1d, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12,
+	0x28, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x09, 0xe2, 0xdf, 0x1f, 0x05, 0x12, 0x03, 0xc2, 0x01, 0x00, 0x48, 0x00, 0x52,
+	0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x12, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x33,
+	0x12, 0x23, 0x0a, 0x09, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x34, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x04, 0xe2, 0xdf, 0x1f, 0x00, 0x48, 0x00, 0x52, 0x08, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x34, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x1a, 0x01, 0x62, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x45, 0x71, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x22, 0x01, 0x62, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x65, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x2a, 0x01, 0x63, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x74, 0x31, 0x12, 0x30, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x32, 0x01, 0x63, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x47, 0x74, 0x31, 0x12, 0x32, 0x0a,
+	0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x74, 0x65, 0x31, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x3a,
+	0x01, 0x63, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4c, 0x74, 0x65,
+	0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x67, 0x74,
+	0x65, 0x31, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0x42, 0x01, 0x63, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x47, 0x74, 0x65, 0x31, 0x12, 0x36, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x69, 0x6e, 0x31, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f,
+	0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62, 0x4a, 0x01, 0x63, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x31, 0x12, 0x3d, 0x0a,
+	0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x69, 0x6e,
+	0x31, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2,
+	0x01, 0x09, 0x52, 0x01, 0x78, 0x52, 0x01, 0x79, 0x52, 0x01, 0x7a, 0x48, 0x00, 0x52, 0x0d, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x49, 0x6e, 0x31, 0x12, 0x40, 0x0a, 0x15,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0, 0x01, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x40,
+	0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f,
+	0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x01, 0x03, 0x48, 0x00, 0x52, 0x11, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31,
+	0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61,
+	0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x01, 0x03, 0x48, 0x00, 0x52,
+	0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x47,
+	0x74, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63,
+	0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x01, 0x03, 0x48,
+	0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65,
+	0x6e, 0x4c, 0x74, 0x31, 0x12, 0x42, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0,
+	0x01, 0x03, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61,
+	0x72, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x12, 0x42, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
+	0x65, 0x31, 0x18, 0x19, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xc8, 0x01, 0x03, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x72, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x40, 0x0a, 0x15,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65,
+	0x6e, 0x5f, 0x65, 0x71, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x05, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x45, 0x71, 0x31, 0x12, 0x40,
+	0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f,
+	0x6c, 0x65, 0x6e, 0x5f, 0x6e, 0x65, 0x31, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x01, 0x05, 0x48, 0x00, 0x52, 0x11, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4e, 0x65, 0x31,
+	0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x31, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x02, 0x05, 0x48, 0x00, 0x52,
+	0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x47,
+	0x74, 0x31, 0x12, 0x40, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74, 0x31, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x02, 0x05, 0x48,
+	0x00, 0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65,
+	0x6e, 0x4c, 0x74, 0x31, 0x12, 0x42, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x67, 0x74, 0x65, 0x31, 0x18, 0x22,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x90,
+	0x02, 0x05, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x79, 0x74,
+	0x65, 0x4c, 0x65, 0x6e, 0x47, 0x74, 0x65, 0x31, 0x12, 0x42, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x5f, 0x6c, 0x65, 0x6e, 0x5f, 0x6c, 0x74,
+	0x65, 0x31, 0x18, 0x23, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0x98, 0x02, 0x05, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x42, 0x79, 0x74, 0x65, 0x4c, 0x65, 0x6e, 0x4c, 0x74, 0x65, 0x31, 0x12, 0x42, 0x0a, 0x0f,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x31, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xe2, 0xdf, 0x1f, 0x14, 0x12, 0x12, 0xc2, 0x01, 0x0f,
+	0xc2, 0x02, 0x0c, 0x5e, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x2b, 0x2e, 0x6a, 0x61, 0x72, 0x24, 0x48,
+	0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x65, 0x78, 0x31,
+	0x12, 0x3e, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x31, 0x18, 0x29, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
+	0x12, 0x0c, 0xc2, 0x01, 0x09, 0xca, 0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x48, 0x00,
+	0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31,
+	0x12, 0x43, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x12, 0xe2,
+	0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xd2, 0x02, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x31, 0x12, 0x3e, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xda, 0x02, 0x06, 0x73, 0x75, 0x66,
+	0x66, 0x69, 0x78, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x53, 0x75,
+	0x66, 0x66, 0x69, 0x78, 0x31, 0x12, 0x43, 0x0a, 0x13, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x18, 0x2c, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12, 0x0c, 0xc2, 0x01, 0x09, 0xe2, 0x02, 0x06,
+	0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4e, 0x6f, 0x53, 0x75, 0x66, 0x66, 0x69, 0x78, 0x31, 0x12, 0x3f, 0x0a, 0x12, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31,
+	0x18, 0x2d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01,
+	0x06, 0xea, 0x02, 0x03, 0x61, 0x62, 0x63, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x31, 0x12, 0x44, 0x0a, 0x15, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x73, 0x31, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b,
+	0x12, 0x09, 0xc2, 0x01, 0x06, 0xf2, 0x02, 0x03, 0x61, 0x62, 0x63, 0x48, 0x00, 0x52, 0x12, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x31, 0x12, 0x46, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18, 0x2f, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xfa, 0x02, 0x03, 0x78,
+	0x79, 0x7a, 0x48, 0x00, 0x52, 0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79, 0x31, 0x12, 0x4d, 0x0a, 0x1a, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x79, 0x31, 0x18, 0x30, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2,
+	0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x82, 0x03, 0x03, 0x78, 0x79, 0x7a, 0x48, 0x00,
+	0x52, 0x16, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4e, 0x6f, 0x74, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x41, 0x6e, 0x79, 0x31, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x74, 0x66, 0x38, 0x18, 0x51, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x05, 0x01, 0x48, 0x00, 0x52,
+	0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x74, 0x66, 0x38, 0x12, 0x34, 0x0a, 0x0e,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x47,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8,
+	0x04, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x73, 0x63,
+	0x69, 0x69, 0x12, 0x3f, 0x0a, 0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x72, 0x69, 0x6e, 0x74, 0x5f, 0x61, 0x73, 0x63, 0x69, 0x69, 0x18, 0x48, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x04, 0x01, 0x48, 0x00,
+	0x52, 0x11, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x41, 0x73,
+	0x63, 0x69, 0x69, 0x12, 0x38, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f,
+	0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x18, 0x49, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc8, 0x04, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x3c, 0x0a,
+	0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x63,
+	0x61, 0x73, 0x65, 0x18, 0x4a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xd0, 0x04, 0x01, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4c, 0x6f, 0x77, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x12, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73,
+	0x65, 0x18, 0x4b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xd8, 0x04, 0x01, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x55, 0x70, 0x70, 0x65, 0x72, 0x63, 0x61, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x0e, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x18, 0x4c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x04, 0x01, 0x48,
+	0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x70, 0x68, 0x61, 0x12,
+	0x36, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x4d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xe8, 0x04, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x70, 0x68, 0x61, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x4e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0xf0, 0x04, 0x01, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x41,
+	0x6c, 0x70, 0x68, 0x61, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x0b, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x06, 0x01, 0x48, 0x00, 0x52,
+	0x09, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x34, 0x18, 0x66, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x06, 0x01, 0x48,
+	0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x76, 0x34, 0x12, 0x32,
+	0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x76, 0x36, 0x18,
+	0x67, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xb8, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70,
+	0x76, 0x36, 0x12, 0x37, 0x0a, 0x10, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69,
+	0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x68, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x11, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x18, 0x69, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0xc8, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49,
+	0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x69, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6a, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x06, 0x01, 0x48,
+	0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x49, 0x70, 0x36, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69,
+	0x64, 0x72, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06,
+	0xc2, 0x01, 0x03, 0xd8, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x69, 0x64, 0x72, 0x12, 0x36, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x34, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0d,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x69, 0x64, 0x72, 0x76, 0x34, 0x12, 0x36, 0x0a,
+	0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x69, 0x64, 0x72, 0x76, 0x36,
+	0x18, 0x6d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01,
+	0x03, 0xe8, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x43,
+	0x69, 0x64, 0x72, 0x76, 0x36, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x6d, 0x61, 0x63, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x06, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x4d, 0x61, 0x63, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x6f, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x06, 0x01,
+	0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x41, 0x64,
+	0x64, 0x72, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x63, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x70, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x34, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x63, 0x70, 0x36,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x71, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x54, 0x63, 0x70, 0x36, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x11,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x72, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0x90, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x55, 0x64, 0x70, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x75, 0x64, 0x70, 0x34, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x73, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x07,
+	0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x34,
+	0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x5f, 0x75, 0x64, 0x70, 0x36, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x74, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa0, 0x07, 0x01, 0x48, 0x00,
+	0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x64, 0x70, 0x36, 0x41, 0x64, 0x64,
+	0x72, 0x12, 0x3b, 0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e,
+	0x69, 0x78, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x75, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3a,
+	0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x76, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xb0, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x49, 0x0a, 0x19, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x72, 0x66, 0x63, 0x31, 0x31, 0x32, 0x33, 0x18, 0x77, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x16, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x66,
+	0x63, 0x31, 0x31, 0x32, 0x33, 0x12, 0x43, 0x0a, 0x16, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x78, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xc0, 0x07, 0x01, 0x48, 0x00, 0x52, 0x13, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f,
+	0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x11, 0x74, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x75, 0x72, 0x69, 0x18,
+	0x79, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xc8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0e, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61,
+	0x74, 0x61, 0x55, 0x72, 0x69, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x66, 0x71, 0x64, 0x6e, 0x18, 0x7a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x07, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x46, 0x71, 0x64, 0x6e, 0x12, 0x30, 0x0a, 0x0c, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x69, 0x18, 0x7b, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd8, 0x07, 0x01, 0x48, 0x00, 0x52,
+	0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x69, 0x12, 0x30, 0x0a, 0x0c, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x7c, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0, 0x07, 0x01, 0x48,
+	0x00, 0x52, 0x0a, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x12, 0x3f, 0x0a,
+	0x14, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x72, 0x6c, 0x5f, 0x65, 0x6e,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x7d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe8, 0x07, 0x01, 0x48, 0x00, 0x52, 0x11, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x55, 0x72, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x3c,
+	0x0a, 0x12, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f,
+	0x63, 0x72, 0x6f, 0x6e, 0x18, 0x8d, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f,
+	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x05, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x69, 0x78, 0x43, 0x72, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0e,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x7e,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0,
+	0x08, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x32, 0x0a, 0x0d, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x18, 0x7f, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xe8, 0x08, 0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x4a, 0x73, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x0c, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x5f, 0x6a, 0x77, 0x74, 0x18, 0x80, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2,
+	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x08, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4a, 0x77, 0x74, 0x12, 0x33, 0x0a, 0x0d, 0x74, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x18, 0x81, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf8, 0x08, 0x01, 0x48,
+	0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x12, 0x42,
+	0x0a, 0x15, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x74, 0x6d, 0x6c, 0x5f,
+	0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x80, 0x09, 0x01, 0x48, 0x00, 0x52, 0x12,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x74, 0x6d, 0x6c, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+	0x65, 0x64, 0x12, 0x37, 0x0a, 0x0f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x61, 0x73, 0x65, 0x36, 0x34, 0x18, 0x83, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf,
+	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0x88, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x12, 0x3e, 0x0a, 0x13, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x36, 0x34, 0x5f, 0x75,
+	0x72, 0x6c, 0x18, 0x84, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0x90, 0x09, 0x01, 0x48, 0x00, 0x52, 0x10, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x42, 0x61, 0x73, 0x65, 0x36, 0x34, 0x55, 0x72, 0x6c, 0x12, 0x41, 0x0a, 0x14, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x18, 0x85, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0x98, 0x09, 0x01, 0x48, 0x00, 0x52, 0x12, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x48, 0x65, 0x78, 0x61, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x4e,
+	0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x86, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b,
+	0x12, 0x19, 0xc2, 0x01, 0x16, 0xa2, 0x09, 0x13, 0x32, 0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d,
+	0x30, 0x32, 0x20, 0x31, 0x35, 0x3a, 0x30, 0x34, 0x3a, 0x30, 0x35, 0x48, 0x00, 0x52, 0x0f, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x3b,
+	0x0a, 0x11, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x87, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08,
+	0x12, 0x06, 0xc2, 0x01, 0x03, 0xa8, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x33, 0x0a, 0x0d, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x88, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xb0, 0x09,
+	0x01, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64,
+	0x12, 0x35, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69,
+	0x64, 0x31, 0x18, 0x89, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xb8, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x31, 0x12, 0x35, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x33, 0x18, 0x8a, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xc0, 0x09, 0x01, 0x48, 0x00,
+	0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x33, 0x12, 0x35,
+	0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x34,
+	0x18, 0x8b, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xc8, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0c, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x55, 0x75, 0x69, 0x64, 0x34, 0x12, 0x35, 0x0a, 0x0e, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x35, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xd0, 0x09, 0x01, 0x48, 0x00, 0x52, 0x0c,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x55, 0x75, 0x69, 0x64, 0x35, 0x42, 0x0a, 0x0a, 0x08,
+	0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x22, 0x95, 0x09, 0x0a, 0x16, 0x56, 0x61, 0x6c,
 	0x69, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f,
-	0x6e, 0x64, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x42, 0x47, 0xe2, 0xdf, 0x1f, 0x43, 0x12, 0x41, 0xf2, 0x01, 0x3e, 0x38,
-	0x03, 0x40, 0x01, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x61, 0x31, 0x4a, 0x02, 0x61, 0x32,
-	0x4a, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x34, 0x52, 0x02, 0x61, 0x35,
-	0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x61, 0x31, 0x4a, 0x02, 0x61, 0x32, 0x4a, 0x02, 0x61,
-	0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x34, 0x52, 0x02, 0x61, 0x35, 0x52, 0x0b, 0x74,
-	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d,
-	0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x4d,
-	0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x22, 0x9d, 0x04, 0x0a, 0x0f, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x12, 0x27,
-	0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x4c, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x61, 0x73,
-	0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x26, 0xe2, 0xdf, 0x1f, 0x16, 0x0a, 0x14, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74,
-	0x79, 0x70, 0x65, 0x31, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
-	0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x52, 0x0c, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x25, 0xe2, 0xdf,
-	0x1f, 0x16, 0x0a, 0x14, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x31, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
-	0x02, 0x18, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x79, 0x0a, 0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x25, 0xe2, 0xdf, 0x1f, 0x16, 0x0a, 0x14,
-	0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x05, 0xa2,
-	0x01, 0x02, 0x08, 0x01, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52,
-	0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4d, 0x0a, 0x0d, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x26, 0xe2, 0xdf, 0x1f, 0x16, 0x0a, 0x14, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0xe2, 0xdf,
-	0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x6f, 0x6e,
+	0x6e, 0x64, 0x31, 0x12, 0x3d, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xe2, 0xdf,
+	0x1f, 0x11, 0x12, 0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03,
+	0x69, 0x64, 0x2d, 0x52, 0x0d, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x31, 0x12, 0x42, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x15, 0xe2, 0xdf, 0x1f,
+	0x11, 0x12, 0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69,
+	0x64, 0x2d, 0x48, 0x01, 0x52, 0x0d, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x32, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69,
+	0x63, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xe2,
+	0xdf, 0x1f, 0x09, 0x12, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x01, 0x52, 0x0b, 0x74, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x36, 0x0a, 0x0d, 0x74, 0x5f, 0x62,
+	0x61, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x0d, 0xe2, 0xdf, 0x1f, 0x09, 0x12, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x01, 0x48,
+	0x02, 0x52, 0x0b, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x88, 0x01,
+	0x01, 0x12, 0x42, 0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1e, 0xe2, 0xdf, 0x1f, 0x1a, 0x12, 0x18,
+	0xea, 0x01, 0x15, 0x38, 0x03, 0x40, 0x01, 0x5a, 0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x01, 0x88,
+	0x02, 0x05, 0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x38, 0x0a, 0x0c, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x03, 0x42, 0x16, 0xe2, 0xdf, 0x1f,
+	0x12, 0x12, 0x10, 0xea, 0x01, 0x0d, 0x38, 0x03, 0x40, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04, 0x38,
+	0x0a, 0x40, 0x01, 0x52, 0x0a, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12,
+	0x8d, 0x01, 0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x31, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x64, 0x31,
+	0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x2f, 0xe2, 0xdf, 0x1f, 0x2b, 0x12, 0x29, 0xf2, 0x01, 0x26, 0x38, 0x03, 0x40, 0x01,
+	0x5a, 0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69, 0x64,
+	0x2d, 0x62, 0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x05, 0x88, 0x02, 0x07, 0xca, 0x02, 0x03, 0x69,
+	0x64, 0x2d, 0x52, 0x0b, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12,
+	0x77, 0x0a, 0x0b, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x15,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x64, 0x31, 0x2e, 0x54, 0x4d,
+	0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1f, 0xe2, 0xdf,
+	0x1f, 0x1b, 0x12, 0x19, 0xf2, 0x01, 0x16, 0x38, 0x03, 0x40, 0x01, 0x5a, 0x07, 0xb2, 0x01, 0x04,
+	0x28, 0x0a, 0x30, 0x05, 0x62, 0x07, 0xb2, 0x01, 0x04, 0x28, 0x0a, 0x30, 0x05, 0x52, 0x09, 0x74,
+	0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x3c, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x15, 0xe2, 0xdf, 0x1f, 0x11, 0x12, 0x0f, 0xc2, 0x01, 0x0c, 0x80, 0x02, 0x05, 0x88, 0x02, 0x07,
+	0xca, 0x02, 0x03, 0x69, 0x64, 0x2d, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x30, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0d, 0xe2, 0xdf, 0x1f,
+	0x09, 0x12, 0x07, 0xb2, 0x01, 0x04, 0x38, 0x0a, 0x40, 0x01, 0x48, 0x00, 0x52, 0x0a, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0xa5, 0x01, 0x0a, 0x0d, 0x74, 0x5f, 0x6d,
+	0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x16, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x43, 0x6f, 0x6e, 0x64, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x47, 0xe2, 0xdf, 0x1f, 0x43,
+	0x12, 0x41, 0xf2, 0x01, 0x3e, 0x38, 0x03, 0x40, 0x01, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02,
+	0x61, 0x31, 0x4a, 0x02, 0x61, 0x32, 0x4a, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02,
+	0x61, 0x34, 0x52, 0x02, 0x61, 0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x61, 0x31, 0x4a,
+	0x02, 0x61, 0x32, 0x4a, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x34, 0x52,
+	0x02, 0x61, 0x35, 0x52, 0x0b, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32,
+	0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x4d, 0x61, 0x70, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3e,
+	0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x6f, 0x6e, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x31, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x74,
+	0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32,
+	0x22, 0x8d, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x31, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x48, 0x0a,
+	0x0e, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2, 0xdf, 0x1f, 0x1e, 0x0a, 0x14, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08,
+	0x01, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x52, 0x0c, 0x74, 0x42, 0x61, 0x73, 0x69,
+	0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x45, 0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x21,
+	0xe2, 0xdf, 0x1f, 0x1d, 0x0a, 0x14, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x31, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x12, 0x05, 0xea, 0x01, 0x02, 0x18,
+	0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x75,
+	0x0a, 0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x21, 0xe2, 0xdf, 0x1f, 0x1d, 0x0a, 0x14, 0x0a, 0x0b,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x05, 0xa2, 0x01, 0x02,
+	0x08, 0x01, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x49, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xe2, 0xdf,
+	0x1f, 0x1e, 0x0a, 0x14, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x31, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03,
+	0x48, 0x01, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x42, 0x30,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x21, 0xba,
+	0xe0, 0x1f, 0x1d, 0x0a, 0x14, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x31, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01,
+	0x22, 0xb5, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x32, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x50, 0x0a,
+	0x0e, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xe2, 0xdf, 0x1f, 0x26, 0x0a, 0x1c, 0x0a, 0x0e, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2,
+	0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01,
+	0x03, 0x52, 0x0c, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x4d, 0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x29, 0xe2, 0xdf, 0x1f, 0x25, 0x0a, 0x1c, 0x0a, 0x0e,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a,
+	0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x05, 0xea, 0x01, 0x02, 0x18,
+	0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x7d,
+	0x0a, 0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0c,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x29, 0xe2, 0xdf, 0x1f, 0x25, 0x0a, 0x1c, 0x0a, 0x0e,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a,
+	0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18,
+	0x03, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a,
+	0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x2a, 0xe2, 0xdf, 0x1f, 0x26, 0x0a, 0x1c, 0x0a, 0x0e, 0x6f, 0x6e,
+	0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01,
+	0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03,
+	0x48, 0x01, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42,
+	0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x42, 0x38,
+	0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x29, 0xba,
+	0xe0, 0x1f, 0x25, 0x0a, 0x1c, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x22, 0x96, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x33, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12,
+	0x4e, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x28, 0xe2, 0xdf, 0x1f, 0x24, 0x0a, 0x1a, 0x0a,
+	0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2,
+	0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01,
+	0x03, 0x52, 0x0c, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x4b, 0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x27, 0xe2, 0xdf, 0x1f, 0x23, 0x0a, 0x1a, 0x0a, 0x0c,
+	0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01,
+	0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x05, 0xea, 0x01, 0x02, 0x18, 0x03, 0x52,
+	0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x7b, 0x0a, 0x0c,
+	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x33, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x27, 0xe2, 0xdf, 0x1f, 0x23, 0x0a, 0x1a, 0x0a, 0x0c, 0x73, 0x65,
+	0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a,
+	0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0a, 0x74,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4f, 0x0a, 0x0d, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x28, 0xe2, 0xdf, 0x1f, 0x24, 0x0a, 0x1a, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x6e,
 	0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d,
 	0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
 	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
 	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x42, 0x30, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x21, 0xba, 0xe0, 0x1f, 0x1d, 0x0a, 0x14, 0x0a,
-	0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x12, 0x05, 0xa2, 0x01,
-	0x02, 0x08, 0x01, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x22, 0xc6, 0x04, 0x0a, 0x0f, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0x12, 0x27,
-	0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x54, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x61, 0x73,
-	0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x2e, 0xe2, 0xdf, 0x1f, 0x1e, 0x0a, 0x1c, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x52,
-	0x0c, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x51, 0x0a,
-	0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b,
-	0x20, 0x03, 0x28, 0x09, 0x42, 0x2d, 0xe2, 0xdf, 0x1f, 0x1e, 0x0a, 0x1c, 0x0a, 0x0e, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01,
-	0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
-	0x02, 0x18, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x81, 0x01, 0x0a, 0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
-	0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x32, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x2d, 0xe2, 0xdf, 0x1f, 0x1e, 0x0a,
-	0x1c, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0xe2, 0xdf, 0x1f,
-	0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x12, 0x55, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2e, 0xe2, 0xdf, 0x1f,
-	0x1e, 0x0a, 0x1c, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0xe2,
-	0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x6f,
-	0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x54,
-	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x42, 0x38, 0x0a, 0x0b, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x29, 0xba, 0xe0, 0x1f, 0x25, 0x0a, 0x1c,
-	0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
-	0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x05, 0xa2, 0x01,
-	0x02, 0x08, 0x01, 0x22, 0xa6, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x33, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73,
-	0x65, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x52, 0x0a, 0x0e, 0x74, 0x5f,
-	0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x2c, 0xe2, 0xdf, 0x1f, 0x1c, 0x0a, 0x1a, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03,
-	0x52, 0x0c, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4f,
-	0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2b, 0xe2, 0xdf, 0x1f, 0x1c, 0x0a, 0x1a, 0x0a, 0x0c, 0x73,
-	0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07,
-	0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02,
-	0x18, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x7f, 0x0a, 0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
-	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x33, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x2b, 0xe2, 0xdf, 0x1f, 0x1c, 0x0a, 0x1a, 0x0a,
-	0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2,
-	0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2,
-	0x01, 0x02, 0x18, 0x03, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x53, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2c, 0xe2, 0xdf, 0x1f, 0x1c, 0x0a, 0x1a, 0x0a,
-	0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2,
-	0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
-	0x01, 0x03, 0xf0, 0x01, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x42, 0x36, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x32, 0x12, 0x27, 0xba, 0xe0, 0x1f, 0x23, 0x0a, 0x1a, 0x0a, 0x0c, 0x73, 0x65, 0x65,
-	0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a, 0xc2, 0x01, 0x07, 0x1a, 0x05,
-	0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x22, 0xc1, 0x04, 0x0a,
-	0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x34,
-	0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x31, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x53, 0x0a, 0x0e, 0x74, 0x5f, 0x62,
-	0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2d, 0xe2, 0xdf, 0x1f, 0x1d, 0x0a, 0x1b, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
-	0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02,
-	0x03, 0x88, 0x02, 0x05, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03,
-	0x52, 0x0c, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x50,
-	0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x2c, 0xe2, 0xdf, 0x1f, 0x1d, 0x0a, 0x1b, 0x0a, 0x0e, 0x6f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x36, 0x0a, 0x0b, 0x6f, 0x6e, 0x65,
+	0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x27, 0xba, 0xe0, 0x1f, 0x23, 0x0a, 0x1a,
+	0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x0a,
+	0xc2, 0x01, 0x07, 0x1a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08,
+	0x01, 0x22, 0xb0, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x34, 0x12, 0x27, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x4f,
+	0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xe2, 0xdf, 0x1f, 0x25, 0x0a, 0x1b, 0x0a, 0x0e,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09,
+	0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01,
+	0x03, 0x52, 0x0c, 0x74, 0x42, 0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x4c, 0x0a, 0x0d, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x42, 0x28, 0xe2, 0xdf, 0x1f, 0x24, 0x0a, 0x1b, 0x0a, 0x0e,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09,
+	0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x05, 0xea, 0x01, 0x02, 0x18, 0x03,
+	0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x7c, 0x0a,
+	0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x34, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x28, 0xe2, 0xdf, 0x1f, 0x24, 0x0a, 0x1b, 0x0a, 0x0e, 0x6f,
 	0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2,
-	0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01,
-	0x02, 0x18, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x80, 0x01, 0x0a, 0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49,
-	0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x34, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x2c, 0xe2, 0xdf, 0x1f, 0x1d, 0x0a,
-	0x1b, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0xe2, 0xdf, 0x1f, 0x07,
-	0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x2d, 0xe2, 0xdf, 0x1f, 0x1d,
+	0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52,
+	0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x50, 0x0a, 0x0d, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x29, 0xe2, 0xdf, 0x1f, 0x25, 0x0a, 0x1b, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f,
+	0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80,
+	0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x48, 0x01, 0x52,
+	0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x3d, 0x0a,
+	0x0f, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b,
+	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x42, 0x37, 0x0a, 0x0b, 0x6f,
+	0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x28, 0xba, 0xe0, 0x1f, 0x24,
 	0x0a, 0x1b, 0x0a, 0x0e, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0xe2, 0xdf, 0x1f,
-	0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x48, 0x01, 0x52, 0x0c, 0x6f, 0x6e, 0x65,
-	0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61,
-	0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x0d, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f,
-	0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x31, 0x42, 0x37, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x28, 0xba, 0xe0, 0x1f, 0x24, 0x0a, 0x1b, 0x0a, 0x0e,
-	0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x31, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09,
-	0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01,
-	0x22, 0xa1, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x35, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x65, 0x64,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x51, 0x0a, 0x0e, 0x74, 0x5f, 0x62, 0x61, 0x73,
-	0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x2b, 0xe2, 0xdf, 0x1f, 0x1b, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05,
-	0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x52, 0x0c, 0x74, 0x42,
-	0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0d, 0x74, 0x5f,
+	0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x05, 0xa2,
+	0x01, 0x02, 0x08, 0x01, 0x22, 0x91, 0x04, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x35, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x4d, 0x0a, 0x0e, 0x74,
+	0x5f, 0x62, 0x61, 0x73, 0x69, 0x63, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x27, 0xe2, 0xdf, 0x1f, 0x23, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65,
+	0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02,
+	0x03, 0x88, 0x02, 0x05, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x52, 0x0c, 0x74, 0x42,
+	0x61, 0x73, 0x69, 0x63, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x4a, 0x0a, 0x0d, 0x74, 0x5f,
 	0x6c, 0x69, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0b, 0x20, 0x03, 0x28,
-	0x09, 0x42, 0x2a, 0xe2, 0xdf, 0x1f, 0x1b, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f,
+	0x09, 0x42, 0x26, 0xe2, 0xdf, 0x1f, 0x22, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f,
 	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88,
-	0x02, 0x05, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0b, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x7e, 0x0a, 0x0c, 0x74, 0x5f,
-	0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x30, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
-	0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x35, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x42, 0x2a, 0xe2, 0xdf, 0x1f, 0x1b, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64,
-	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03,
-	0x88, 0x02, 0x05, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0a,
-	0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a, 0x0d, 0x6f, 0x6e,
-	0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x2b, 0xe2, 0xdf, 0x1f, 0x1b, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88,
-	0x02, 0x05, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0, 0x01, 0x03, 0x48, 0x00,
-	0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x1a, 0x3d,
-	0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0x35, 0x0a,
-	0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32, 0x12, 0x26, 0xba, 0xe0,
-	0x1f, 0x22, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x05, 0xa2,
-	0x01, 0x02, 0x08, 0x01, 0x22, 0x9c, 0x06, 0x0a, 0x0f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x36, 0x12, 0x5b, 0x0a, 0x0f, 0x73, 0x65, 0x65, 0x64,
-	0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x33, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
+	0x02, 0x05, 0x12, 0x05, 0xea, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0b, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x7a, 0x0a, 0x0c, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x67,
+	0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x35, 0x2e, 0x54,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x26,
+	0xe2, 0xdf, 0x1f, 0x22, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12,
+	0x05, 0xf2, 0x01, 0x02, 0x18, 0x03, 0x52, 0x0a, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x4e, 0x0a, 0x0d, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x5f, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xe2, 0xdf, 0x1f, 0x23, 0x0a,
+	0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12,
+	0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02, 0x05, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xf0,
+	0x01, 0x03, 0x48, 0x00, 0x52, 0x0c, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x32, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x1a, 0x3d, 0x0a, 0x0f, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x42, 0x35, 0x0a, 0x0b, 0x6f, 0x6e, 0x65, 0x6f, 0x66, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x32,
+	0x12, 0x26, 0xba, 0xe0, 0x1f, 0x22, 0x0a, 0x19, 0x0a, 0x0c, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x80, 0x02, 0x03, 0x88, 0x02,
+	0x05, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x22, 0x96, 0x06, 0x0a, 0x0f, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x36, 0x12, 0x5b, 0x0a, 0x0f,
+	0x73, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x36, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x65, 0x65, 0x64,
+	0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0xf0, 0x01, 0x0a, 0x0d, 0x74, 0x5f,
+	0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74,
 	0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x36, 0x2e, 0x53, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x73, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0xf3, 0x01, 0x0a, 0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f,
-	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e,
-	0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x36, 0x2e,
-	0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x42, 0x9b, 0x01, 0xe2, 0xdf, 0x1f, 0x54, 0x0a, 0x52, 0x0a, 0x0f, 0x73, 0x65, 0x65, 0x64, 0x5f,
-	0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x3f, 0xf2, 0x01, 0x3c, 0x30,
-	0x01, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x61, 0x31, 0x4a, 0x02, 0x61, 0x32, 0x4a, 0x02,
-	0x61, 0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x34, 0x52, 0x02, 0x61, 0x35, 0x62, 0x1b,
-	0xc2, 0x01, 0x18, 0x4a, 0x02, 0x62, 0x31, 0x4a, 0x02, 0x62, 0x32, 0x4a, 0x02, 0x62, 0x33, 0x52,
-	0x02, 0x62, 0x33, 0x52, 0x02, 0x62, 0x34, 0x52, 0x02, 0x62, 0x35, 0xe2, 0xdf, 0x1f, 0x3f, 0x12,
-	0x3d, 0xf2, 0x01, 0x3a, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x63, 0x31, 0x4a, 0x02, 0x63,
-	0x32, 0x4a, 0x02, 0x63, 0x33, 0x52, 0x02, 0x63, 0x33, 0x52, 0x02, 0x63, 0x34, 0x52, 0x02, 0x63,
-	0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x64, 0x31, 0x4a, 0x02, 0x64, 0x32, 0x4a, 0x02,
-	0x64, 0x33, 0x52, 0x02, 0x64, 0x33, 0x52, 0x02, 0x64, 0x34, 0x52, 0x02, 0x64, 0x35, 0x52, 0x0b,
-	0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0xf3, 0x01, 0x0a, 0x0d,
-	0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
-	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x36, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x9b, 0x01, 0xe2, 0xdf, 0x1f, 0x54, 0x0a, 0x52, 0x0a,
-	0x0f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x3f, 0xf2, 0x01, 0x3c, 0x30, 0x01, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x61, 0x31,
-	0x4a, 0x02, 0x61, 0x32, 0x4a, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x34,
-	0x52, 0x02, 0x61, 0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x62, 0x31, 0x4a, 0x02, 0x62,
-	0x32, 0x4a, 0x02, 0x62, 0x33, 0x52, 0x02, 0x62, 0x33, 0x52, 0x02, 0x62, 0x34, 0x52, 0x02, 0x62,
-	0x35, 0xe2, 0xdf, 0x1f, 0x3f, 0x12, 0x3d, 0xf2, 0x01, 0x3a, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a,
-	0x02, 0x63, 0x31, 0x4a, 0x02, 0x63, 0x32, 0x4a, 0x02, 0x63, 0x33, 0x52, 0x02, 0x63, 0x33, 0x52,
-	0x02, 0x63, 0x34, 0x52, 0x02, 0x63, 0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x64, 0x31,
-	0x4a, 0x02, 0x64, 0x32, 0x4a, 0x02, 0x64, 0x33, 0x52, 0x02, 0x64, 0x33, 0x52, 0x02, 0x64, 0x34,
-	0x52, 0x02, 0x64, 0x35, 0x52, 0x0b, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x32, 0x1a, 0x40, 0x0a, 0x12, 0x53, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x2a, 0x4b, 0x0a, 0x05, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x0b, 0x0a, 0x07,
-	0x4a, 0x61, 0x6e, 0x75, 0x61, 0x72, 0x79, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x65, 0x62,
-	0x72, 0x75, 0x61, 0x72, 0x79, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x61, 0x72, 0x63, 0x68,
-	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x70, 0x72, 0x69, 0x6c, 0x10, 0x03, 0x12, 0x07, 0x0a,
-	0x03, 0x4d, 0x61, 0x79, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x75, 0x6e, 0x65, 0x10, 0x08,
-	0x42, 0x17, 0x5a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6e, 0x73, 0x36, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x98, 0x01, 0xe2, 0xdf, 0x1f, 0x93, 0x01, 0x0a, 0x52, 0x0a, 0x0f,
+	0x73, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
+	0x3f, 0xf2, 0x01, 0x3c, 0x30, 0x01, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x61, 0x31, 0x4a,
+	0x02, 0x61, 0x32, 0x4a, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x34, 0x52,
+	0x02, 0x61, 0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x62, 0x31, 0x4a, 0x02, 0x62, 0x32,
+	0x4a, 0x02, 0x62, 0x33, 0x52, 0x02, 0x62, 0x33, 0x52, 0x02, 0x62, 0x34, 0x52, 0x02, 0x62, 0x35,
+	0x12, 0x3d, 0xf2, 0x01, 0x3a, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x63, 0x31, 0x4a, 0x02,
+	0x63, 0x32, 0x4a, 0x02, 0x63, 0x33, 0x52, 0x02, 0x63, 0x33, 0x52, 0x02, 0x63, 0x34, 0x52, 0x02,
+	0x63, 0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x64, 0x31, 0x4a, 0x02, 0x64, 0x32, 0x4a,
+	0x02, 0x64, 0x33, 0x52, 0x02, 0x64, 0x33, 0x52, 0x02, 0x64, 0x34, 0x52, 0x02, 0x64, 0x35, 0x52,
+	0x0b, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31, 0x12, 0xf0, 0x01, 0x0a,
+	0x0d, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x36, 0x2e, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x98, 0x01, 0xe2, 0xdf, 0x1f, 0x93, 0x01, 0x0a,
+	0x52, 0x0a, 0x0f, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x6d, 0x61, 0x70, 0x5f, 0x73, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x12, 0x3f, 0xf2, 0x01, 0x3c, 0x30, 0x01, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02,
+	0x61, 0x31, 0x4a, 0x02, 0x61, 0x32, 0x4a, 0x02, 0x61, 0x33, 0x52, 0x02, 0x61, 0x33, 0x52, 0x02,
+	0x61, 0x34, 0x52, 0x02, 0x61, 0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x62, 0x31, 0x4a,
+	0x02, 0x62, 0x32, 0x4a, 0x02, 0x62, 0x33, 0x52, 0x02, 0x62, 0x33, 0x52, 0x02, 0x62, 0x34, 0x52,
+	0x02, 0x62, 0x35, 0x12, 0x3d, 0xf2, 0x01, 0x3a, 0x5a, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x63,
+	0x31, 0x4a, 0x02, 0x63, 0x32, 0x4a, 0x02, 0x63, 0x33, 0x52, 0x02, 0x63, 0x33, 0x52, 0x02, 0x63,
+	0x34, 0x52, 0x02, 0x63, 0x35, 0x62, 0x1b, 0xc2, 0x01, 0x18, 0x4a, 0x02, 0x64, 0x31, 0x4a, 0x02,
+	0x64, 0x32, 0x4a, 0x02, 0x64, 0x33, 0x52, 0x02, 0x64, 0x33, 0x52, 0x02, 0x64, 0x34, 0x52, 0x02,
+	0x64, 0x35, 0x52, 0x0b, 0x74, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32, 0x1a,
+	0x40, 0x0a, 0x12, 0x53, 0x65, 0x65, 0x64, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x31,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x3e, 0x0a, 0x10, 0x54, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x32,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x89, 0x03, 0x0a, 0x0c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c,
+	0x6c, 0x31, 0x12, 0x25, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x11, 0xe2, 0xdf, 0x1f, 0x0d, 0x12, 0x0b, 0xc2, 0x01, 0x08, 0xc0, 0x01, 0x03, 0xca, 0x02,
+	0x02, 0x6e, 0x2d, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3a, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42,
+	0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xea, 0x01, 0x02, 0x38, 0x02, 0x52, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c,
+	0x6c, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x19,
+	0xe2, 0xdf, 0x1f, 0x15, 0x12, 0x13, 0xf2, 0x01, 0x10, 0x5a, 0x0e, 0xc2, 0x01, 0x0b, 0x4a, 0x03,
+	0x65, 0x6e, 0x76, 0x4a, 0x04, 0x7a, 0x6f, 0x6e, 0x65, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x05,
+	0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x12, 0x0a, 0xea, 0x01, 0x07, 0x5a, 0x05, 0xb2, 0x01, 0x02,
+	0x30, 0x00, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x52, 0x0a, 0x0b, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xa6, 0x01,
+	0x0a, 0x0e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x31,
+	0x12, 0x2b, 0x0a, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0,
+	0x08, 0x01, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x33, 0x0a,
+	0x09, 0x74, 0x61, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10, 0xea, 0x01, 0x0d, 0x38, 0x01, 0x5a, 0x09, 0xc2,
+	0x01, 0x06, 0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62, 0x52, 0x08, 0x74, 0x61, 0x67, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x12, 0x1d, 0x0a, 0x09, 0x6b, 0x69, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x6b, 0x69, 0x6e, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x42, 0x13, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0b, 0xba, 0xe0, 0x1f, 0x07, 0x12,
+	0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x22, 0xad, 0x04, 0x0a, 0x0a, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x31, 0x12, 0x37, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x3f,
+	0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x31, 0x2e, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12,
+	0x54, 0x0a, 0x07, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x31, 0x2e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c,
+	0x12, 0x0a, 0xf2, 0x01, 0x07, 0x5a, 0x05, 0xb2, 0x01, 0x02, 0x30, 0x00, 0x52, 0x07, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x65, 0x73, 0x1a, 0x2a, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x22, 0x0a,
+	0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c,
+	0x12, 0x0a, 0xc2, 0x01, 0x07, 0xca, 0x02, 0x04, 0x73, 0x6b, 0x75, 0x2d, 0x52, 0x03, 0x73, 0x6b,
+	0x75, 0x1a, 0x68, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74,
+	0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x11, 0xe2, 0xdf, 0x1f, 0x0d, 0x12, 0x0b, 0xea, 0x01, 0x08, 0x5a, 0x06, 0xc2, 0x01,
+	0x03, 0xc8, 0x01, 0x03, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x1a, 0x5b, 0x0a, 0x0b, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x50, 0x61, 0x74, 0x68, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5c, 0x0a, 0x0c, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xf6, 0x02, 0x0a, 0x0e, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x31, 0x12, 0x56, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x42, 0xe2, 0xdf, 0x1f, 0x3e, 0x12, 0x09, 0xb2,
+	0x01, 0x06, 0x38, 0xff, 0xff, 0x03, 0x40, 0x01, 0x1a, 0x23, 0x7b, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x7d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x62, 0x65, 0x20, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65,
+	0x6e, 0x20, 0x31, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x36, 0x35, 0x35, 0x33, 0x35, 0x22, 0x0c, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x7d, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x60, 0xe2, 0xdf, 0x1f, 0x5c, 0x12, 0x0b, 0xc2, 0x01, 0x08, 0xc0,
+	0x01, 0x03, 0xca, 0x02, 0x02, 0x6e, 0x2d, 0x2a, 0x4d, 0x0a, 0x0d, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x2e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x3c, 0x7b, 0x6a, 0x73, 0x6f, 0x6e, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x20, 0x6d, 0x75, 0x73, 0x74, 0x20, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x27, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x7d, 0x27, 0x2c,
+	0x20, 0x67, 0x6f, 0x74, 0x20, 0x27, 0x7b, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x7d, 0x27, 0x20, 0x28,
+	0x7b, 0x74, 0x61, 0x67, 0x7d, 0x29, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x4a, 0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x42, 0x32, 0xe2, 0xdf, 0x1f, 0x2e, 0x12, 0x0b, 0xea, 0x01, 0x08, 0x5a, 0x06, 0xc2, 0x01, 0x03,
+	0xe0, 0x08, 0x01, 0x1a, 0x1f, 0x7b, 0x7b, 0x7b, 0x70, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x7d, 0x20,
+	0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x61, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x09,
+	0x6b, 0x69, 0x6e, 0x64, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x08, 0x6b, 0x69, 0x6e, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x22, 0x0a, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x1a, 0xba, 0xe0, 0x1f, 0x16, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01,
+	0x22, 0x0d, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x22,
+	0xdc, 0x06, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73,
+	0x31, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xb2, 0x01,
+	0x02, 0x40, 0x00, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12,
+	0x3a, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x48, 0x00, 0x52, 0x06,
+	0x6f, 0x70, 0x74, 0x4d, 0x69, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x1c, 0x0a, 0x07, 0x6f, 0x70, 0x74,
+	0x5f, 0x6d, 0x61, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x06, 0x6f, 0x70,
+	0x74, 0x4d, 0x61, 0x78, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x13, 0x0a, 0x05, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x62, 0x79, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x79, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x65, 0x72,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x05, 0x63, 0x68, 0x69,
+	0x6c, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x31, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x3a,
+	0xb4, 0x01, 0xc2, 0xe0, 0x1f, 0xaf, 0x01, 0x0a, 0x15, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x20, 0x3c, 0x20, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x0a, 0x14,
+	0x6d, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3c, 0x3d, 0x6d, 0x61, 0x78, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x20, 0x21, 0x3d,
+	0x20, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x0a, 0x12, 0x6f, 0x70,
+	0x74, 0x5f, 0x6d, 0x69, 0x6e, 0x20, 0x3c, 0x3d, 0x20, 0x6f, 0x70, 0x74, 0x5f, 0x6d, 0x61, 0x78,
+	0x12, 0x0e, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x1a, 0x16, 0x0a, 0x05, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x0a, 0x07, 0x62, 0x79, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x22, 0x14, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x2a, 0x16,
+	0x0a, 0x0b, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x0a, 0x07, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x6d,
+	0x69, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x6f, 0x70, 0x74, 0x5f, 0x6d, 0x61, 0x78, 0x22, 0xa9,
+	0x09, 0x0a, 0x0a, 0x45, 0x78, 0x70, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x31, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x31, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x30,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x3a,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22,
+	0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x15, 0x0a, 0x03, 0x6f, 0x70, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x48, 0x01, 0x52, 0x03, 0x6f, 0x70, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x3f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x31, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x15, 0x0a, 0x05, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x03, 0x48, 0x00, 0x52, 0x04, 0x62, 0x79, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x07, 0x62, 0x79,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x62,
+	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a,
+	0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x2e, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x10, 0x02,
+	0x3a, 0xe9, 0x03, 0xc2, 0xe0, 0x1f, 0xe4, 0x03, 0x32, 0x47, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x29, 0x20, 0x3c, 0x3d, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x20, 0x26, 0x26, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x29, 0x32, 0x34, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x61, 0x6c,
+	0x6c, 0x28, 0x78, 0x2c, 0x20, 0x78, 0x2e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x20, 0x3e, 0x20, 0x30,
+	0x2e, 0x30, 0x20, 0x26, 0x26, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x78, 0x2e, 0x73, 0x6b, 0x75,
+	0x29, 0x20, 0x3e, 0x3d, 0x20, 0x33, 0x29, 0x32, 0x3b, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x21, 0x68, 0x61,
+	0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x65, 0x6e, 0x64, 0x32, 0x3a, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x20, 0x69, 0x6e, 0x20, 0x5b, 0x31, 0x2c, 0x20, 0x32, 0x5d, 0x20, 0x7c, 0x7c, 0x20, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x74, 0x61, 0x67, 0x73, 0x2e, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x28,
+	0x74, 0x2c, 0x20, 0x74, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x64, 0x72, 0x61, 0x66, 0x74, 0x27, 0x29,
+	0x32, 0x17, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x20, 0x2a, 0x20, 0x32,
+	0x20, 0x2b, 0x20, 0x31, 0x20, 0x3c, 0x20, 0x31, 0x30, 0x32, 0x32, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x27, 0x27, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x28,
+	0x27, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x5d, 0x7b, 0x33, 0x7d, 0x24, 0x27, 0x29, 0x32, 0x23, 0x21,
+	0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x74, 0x29, 0x20, 0x7c, 0x7c,
+	0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6f, 0x70, 0x74, 0x20, 0x25, 0x20, 0x32, 0x20, 0x3d, 0x3d,
+	0x20, 0x30, 0x32, 0x52, 0x21, 0x28, 0x27, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65,
+	0x64, 0x27, 0x20, 0x69, 0x6e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x29, 0x20, 0x26, 0x26, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x28, 0x6b, 0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x20, 0x3e, 0x3d, 0x20, 0x73, 0x69,
+	0x7a, 0x65, 0x28, 0x6b, 0x29, 0x29, 0x32, 0x24, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x62, 0x79, 0x5f, 0x69, 0x64, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x68, 0x61, 0x73, 0x28, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x62, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x42, 0x04, 0x0a, 0x02,
+	0x62, 0x79, 0x42, 0x06, 0x0a, 0x04, 0x5f, 0x6f, 0x70, 0x74, 0x22, 0xeb, 0x01, 0x0a, 0x0a, 0x45,
+	0x78, 0x70, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x32, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x3f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x27, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x45, 0x78, 0x70, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x32, 0x2e, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x4b, 0xc2, 0xe0, 0x1f,
+	0x47, 0x32, 0x1a, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x2e, 0x65, 0x78,
+	0x69, 0x73, 0x74, 0x73, 0x28, 0x78, 0x2c, 0x20, 0x74, 0x72, 0x75, 0x65, 0x29, 0x32, 0x29, 0x74,
+	0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x28, 0x6b,
+	0x2c, 0x20, 0x73, 0x69, 0x7a, 0x65, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x29, 0x20, 0x3c, 0x20, 0x33, 0x29, 0x22, 0xb3, 0x03, 0x0a, 0x0a, 0x45, 0x78, 0x70,
+	0x72, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x33, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x35, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x3a,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x3a, 0xd1, 0x01, 0xc2, 0xe0, 0x1f,
+	0xcc, 0x01, 0x32, 0x16, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3c,
+	0x3d, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x32, 0x18, 0x21, 0x28, 0x74, 0x68,
+	0x69, 0x73, 0x2e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x20, 0x3e, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e,
+	0x65, 0x6e, 0x64, 0x29, 0x32, 0x2b, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x73, 0x2e, 0x61, 0x6c, 0x6c, 0x28, 0x78, 0x2c, 0x20, 0x78, 0x20, 0x3c, 0x3d, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x29, 0x32, 0x2e, 0x21, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x73, 0x2e, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x28, 0x78, 0x2c, 0x20, 0x78, 0x20, 0x3e, 0x20,
+	0x74, 0x68, 0x69, 0x73, 0x2e, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x29, 0x32, 0x3b, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x21, 0x68, 0x61, 0x73, 0x28, 0x74, 0x68, 0x69, 0x73,
+	0x2e, 0x65, 0x6e, 0x64, 0x29, 0x20, 0x7c, 0x7c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x20, 0x3c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x2e, 0x65, 0x6e, 0x64, 0x22, 0xde,
+	0x0a, 0x0a, 0x0f, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x31, 0x12, 0x4d, 0x0a, 0x08, 0x74, 0x73, 0x5f, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10, 0xfa, 0x01, 0x0d, 0x1a, 0x03, 0x08, 0xe8, 0x07,
+	0x22, 0x06, 0x08, 0xd0, 0x0f, 0x10, 0xf4, 0x03, 0x52, 0x07, 0x74, 0x73, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x42, 0x0a, 0x07, 0x74, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0d,
+	0xe2, 0xdf, 0x1f, 0x09, 0x12, 0x07, 0xfa, 0x01, 0x04, 0x08, 0x01, 0x30, 0x01, 0x52, 0x06, 0x74,
+	0x73, 0x50, 0x61, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x09, 0x74, 0x73, 0x5f, 0x66, 0x75, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xfa, 0x01, 0x02, 0x38,
+	0x01, 0x52, 0x08, 0x74, 0x73, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x12, 0x47, 0x0a, 0x09, 0x74,
+	0x73, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a,
+	0x12, 0x08, 0xfa, 0x01, 0x05, 0x42, 0x03, 0x08, 0x90, 0x1c, 0x52, 0x08, 0x74, 0x73, 0x57, 0x69,
+	0x74, 0x68, 0x69, 0x6e, 0x12, 0x48, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x13, 0xe2, 0xdf, 0x1f, 0x0f, 0x12, 0x0d, 0x82, 0x02, 0x0a, 0x12, 0x02, 0x08, 0x3c, 0x2a,
+	0x04, 0x10, 0xc0, 0x84, 0x3d, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x45,
+	0x0a, 0x06, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e, 0x12,
+	0x0c, 0xea, 0x01, 0x09, 0x5a, 0x07, 0x82, 0x02, 0x04, 0x08, 0x01, 0x1a, 0x00, 0x52, 0x06, 0x64,
+	0x65, 0x6c, 0x61, 0x79, 0x73, 0x12, 0x42, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xb2, 0x01, 0x06, 0x10, 0x01, 0x38, 0x0a,
+	0x40, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x55, 0x49, 0x6e, 0x74, 0x33, 0x32,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0e, 0xe2, 0xdf, 0x1f, 0x0a, 0x12, 0x08, 0xba, 0x01, 0x05,
+	0x4a, 0x03, 0x50, 0xbb, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4f, 0x0a, 0x05, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1b, 0xe2, 0xdf, 0x1f, 0x17, 0x12, 0x15,
+	0xaa, 0x01, 0x12, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x31, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x45, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x13, 0xe2, 0xdf, 0x1f, 0x0f, 0x12, 0x0d,
+	0xc2, 0x01, 0x0a, 0x52, 0x03, 0x6e, 0x2d, 0x78, 0xca, 0x02, 0x02, 0x6e, 0x2d, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xca, 0x01, 0x02, 0x38, 0x03, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x12, 0x4e, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xe2, 0x01, 0x02, 0x10, 0x00, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x56, 0x0a, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x18, 0x0e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x57, 0x65, 0x6c, 0x6c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x31, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x10, 0xe2, 0xdf, 0x1f, 0x0c, 0x12, 0x0a, 0xf2, 0x01, 0x07, 0x62, 0x05, 0xb2,
+	0x01, 0x02, 0x30, 0x00, 0x52, 0x06, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x12, 0x94, 0x01, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x41, 0x6e, 0x79, 0x42, 0x66, 0xe2, 0xdf, 0x1f, 0x62, 0x12, 0x60, 0x8a, 0x02, 0x5d, 0x12, 0x2c,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x12, 0x62, 0x0a, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x42, 0x36, 0xe2, 0xdf, 0x1f, 0x32, 0x12, 0x30,
+	0x8a, 0x02, 0x2d, 0x08, 0x01, 0x1a, 0x29, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x52, 0x05, 0x65, 0x78, 0x74, 0x72, 0x61, 0x1a, 0x56, 0x0a, 0x0b, 0x4c, 0x65, 0x76, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a,
+	0x4b, 0x0a, 0x05, 0x45, 0x6e, 0x75, 0x6d, 0x31, 0x12, 0x0b, 0x0a, 0x07, 0x4a, 0x61, 0x6e, 0x75,
+	0x61, 0x72, 0x79, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x65, 0x62, 0x72, 0x75, 0x61, 0x72,
+	0x79, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4d, 0x61, 0x72, 0x63, 0x68, 0x10, 0x02, 0x12, 0x09,
+	0x0a, 0x05, 0x41, 0x70, 0x72, 0x69, 0x6c, 0x10, 0x03, 0x12, 0x07, 0x0a, 0x03, 0x4d, 0x61, 0x79,
+	0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x75, 0x6e, 0x65, 0x10, 0x08, 0x42, 0x17, 0x5a, 0x15,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x67, 0x6f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -14664,122 +16691,154 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescGZIP() []byte
 	return file_xgo_tests_govalidatortest_govalidator_test_proto_rawDescData
 }
 
-var file_xgo_tests_govalidatortest_govalidator_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes = make([]protoimpl.MessageInfo, 94)
+var file_xgo_tests_govalidatortest_govalidator_test_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes = make([]protoimpl.MessageInfo, 115)
 var file_xgo_tests_govalidatortest_govalidator_test_proto_goTypes = []interface{}{
 	(Enum1)(0),                        // 0: govalidatortest.Enum1
-	(*Config)(nil),                    // 1: govalidatortest.Config
-	(*ValidOneOfTags1)(nil),           // 2: govalidatortest.ValidOneOfTags1
-	(*ValidFloatTagsGeneral1)(nil),    // 3: govalidatortest.ValidFloatTagsGeneral1
-	(*ValidFloatTagsOptional1)(nil),   // 4: govalidatortest.ValidFloatTagsOptional1
-	(*ValidFloatTagsOneOf1)(nil),      // 5: govalidatortest.ValidFloatTagsOneOf1
-	(*ValidIntTagsGeneral1)(nil),      // 6: govalidatortest.ValidIntTagsGeneral1
-	(*ValidIntTagsOptional1)(nil),     // 7: govalidatortest.ValidIntTagsOptional1
-	(*ValidIntTagsOneOf1)(nil),        // 8: govalidatortest.ValidIntTagsOneOf1
-	(*ValidUintTagsGeneral1)(nil),     // 9: govalidatortest.ValidUintTagsGeneral1
-	(*ValidUintTagsOptional1)(nil),    // 10: govalidatortest.ValidUintTagsOptional1
-	(*ValidUintTagsOneOf1)(nil),       // 11: govalidatortest.ValidUintTagsOneOf1
-	(*ValidBoolTags1)(nil),            // 12: govalidatortest.ValidBoolTags1
-	(*ValidMessageTags)(nil),          // 13: govalidatortest.ValidMessageTags
-	(*ValidEnumTagsGeneral1)(nil),     // 14: govalidatortest.ValidEnumTagsGeneral1
-	(*ValidEnumTagsOptional1)(nil),    // 15: govalidatortest.ValidEnumTagsOptional1
-	(*ValidEnumTagsOneOf1)(nil),       // 16: govalidatortest.ValidEnumTagsOneOf1
-	(*ValidBytesTags1)(nil),           // 17: govalidatortest.ValidBytesTags1
-	(*ValidRepeatedTagsGeneral1)(nil), // 18: govalidatortest.ValidRepeatedTagsGeneral1
-	(*ValidRepeatedTagsItem1)(nil),    // 19: govalidatortest.ValidRepeatedTagsItem1
-	(*ValidMapTagsGeneral1)(nil),      // 20: govalidatortest.ValidMapTagsGeneral1
-	(*ValidMapTagsKey1)(nil),          // 21: govalidatortest.ValidMapTagsKey1
-	(*ValidMapTagsValue1)(nil),        // 22: govalidatortest.ValidMapTagsValue1
-	(*ValidStringTagsGeneral1)(nil),   // 23: govalidatortest.ValidStringTagsGeneral1
-	(*ValidStringTagsOptional1)(nil),  // 24: govalidatortest.ValidStringTagsOptional1
-	(*ValidStringTagsOneOf1)(nil),     // 25: govalidatortest.ValidStringTagsOneOf1
-	(*ValidOptionsMultiCond1)(nil),    // 26: govalidatortest.ValidOptionsMultiCond1
-	(*CheckIfOptions1)(nil),           // 27: govalidatortest.CheckIfOptions1
-	(*CheckIfOptions2)(nil),           // 28: govalidatortest.CheckIfOptions2
-	(*CheckIfOptions3)(nil),           // 29: govalidatortest.CheckIfOptions3
-	(*CheckIfOptions4)(nil),           // 30: govalidatortest.CheckIfOptions4
-	(*CheckIfOptions5)(nil),           // 31: govalidatortest.CheckIfOptions5
-	(*CheckIfOptions6)(nil),           // 32: govalidatortest.CheckIfOptions6
-	nil,                               // 33: govalidatortest.ValidMapTagsGeneral1.TMap101Entry
-	nil,                               // 34: govalidatortest.ValidMapTagsGeneral1.TMap102Entry
-	nil,                               // 35: govalidatortest.ValidMapTagsGeneral1.TMap103Entry
-	nil,                               // 36: govalidatortest.ValidMapTagsGeneral1.TMap104Entry
-	nil,                               // 37: govalidatortest.ValidMapTagsGeneral1.TMap105Entry
-	nil,                               // 38: govalidatortest.ValidMapTagsGeneral1.TMap106Entry
-	nil,                               // 39: govalidatortest.ValidMapTagsGeneral1.TMap107Entry
-	nil,                               // 40: govalidatortest.ValidMapTagsGeneral1.TMap108Entry
-	nil,                               // 41: govalidatortest.ValidMapTagsGeneral1.TMap111Entry
-	nil,                               // 42: govalidatortest.ValidMapTagsGeneral1.TMap112Entry
-	nil,                               // 43: govalidatortest.ValidMapTagsGeneral1.TMap113Entry
-	nil,                               // 44: govalidatortest.ValidMapTagsGeneral1.TMap114Entry
-	nil,                               // 45: govalidatortest.ValidMapTagsGeneral1.TMap115Entry
-	nil,                               // 46: govalidatortest.ValidMapTagsGeneral1.TMap116Entry
-	nil,                               // 47: govalidatortest.ValidMapTagsGeneral1.TMap117Entry
-	nil,                               // 48: govalidatortest.ValidMapTagsGeneral1.TMap118Entry
-	nil,                               // 49: govalidatortest.ValidMapTagsGeneral1.TMapNotNull1Entry
-	nil,                               // 50: govalidatortest.ValidMapTagsGeneral1.TMapLenEq1Entry
-	nil,                               // 51: govalidatortest.ValidMapTagsGeneral1.TMapLenNe1Entry
-	nil,                               // 52: govalidatortest.ValidMapTagsGeneral1.TMapLenLt1Entry
-	nil,                               // 53: govalidatortest.ValidMapTagsGeneral1.TMapLenGt1Entry
-	nil,                               // 54: govalidatortest.ValidMapTagsGeneral1.TMapLenLte1Entry
-	nil,                               // 55: govalidatortest.ValidMapTagsGeneral1.TMapLenGte1Entry
-	nil,                               // 56: govalidatortest.ValidMapTagsKey1.TMapKeyStringEntry
-	nil,                               // 57: govalidatortest.ValidMapTagsKey1.TMapKeyInt32Entry
-	nil,                               // 58: govalidatortest.ValidMapTagsKey1.TMapKeyInt64Entry
-	nil,                               // 59: govalidatortest.ValidMapTagsKey1.TMapKeySint32Entry
-	nil,                               // 60: govalidatortest.ValidMapTagsKey1.TMapKeySint64Entry
-	nil,                               // 61: govalidatortest.ValidMapTagsKey1.TMapKeySfixed32Entry
-	nil,                               // 62: govalidatortest.ValidMapTagsKey1.TMapKeySfixed64Entry
-	nil,                               // 63: govalidatortest.ValidMapTagsKey1.TMapKeyUint32Entry
-	nil,                               // 64: govalidatortest.ValidMapTagsKey1.TMapKeyUint64Entry
-	nil,                               // 65: govalidatortest.ValidMapTagsKey1.TMapKeyFixed32Entry
-	nil,                               // 66: govalidatortest.ValidMapTagsKey1.TMapKeyFixed64Entry
-	nil,                               // 67: govalidatortest.ValidMapTagsValue1.TMapValueStringEntry
-	nil,                               // 68: govalidatortest.ValidMapTagsValue1.TMapValueDoubleEntry
-	nil,                               // 69: govalidatortest.ValidMapTagsValue1.TMapValueFloatEntry
-	nil,                               // 70: govalidatortest.ValidMapTagsValue1.TMapValueInt32Entry
-	nil,                               // 71: govalidatortest.ValidMapTagsValue1.TMapValueInt64Entry
-	nil,                               // 72: govalidatortest.ValidMapTagsValue1.TMapValueSint32Entry
-	nil,                               // 73: govalidatortest.ValidMapTagsValue1.TMapValueSint64Entry
-	nil,                               // 74: govalidatortest.ValidMapTagsValue1.TMapValueSfixed32Entry
-	nil,                               // 75: govalidatortest.ValidMapTagsValue1.TMapValueSfixed64Entry
-	nil,                               // 76: govalidatortest.ValidMapTagsValue1.TMapValueUint32Entry
-	nil,                               // 77: govalidatortest.ValidMapTagsValue1.TMapValueUint64Entry
-	nil,                               // 78: govalidatortest.ValidMapTagsValue1.TMapValueFixed32Entry
-	nil,                               // 79: govalidatortest.ValidMapTagsValue1.TMapValueFixed64Entry
-	nil,                               // 80: govalidatortest.ValidMapTagsValue1.TMapValueBoolEntry
-	nil,                               // 81: govalidatortest.ValidMapTagsValue1.TMapValueEnumEntry
-	nil,                               // 82: govalidatortest.ValidMapTagsValue1.TMapValueBytesEntry
-	nil,                               // 83: govalidatortest.ValidMapTagsValue1.TMapValueMessageEntry
-	nil,                               // 84: govalidatortest.ValidOptionsMultiCond1.TMapString1Entry
-	nil,                               // 85: govalidatortest.ValidOptionsMultiCond1.TMapInt64Entry
-	nil,                               // 86: govalidatortest.ValidOptionsMultiCond1.TMapString2Entry
-	nil,                               // 87: govalidatortest.CheckIfOptions1.TMapStringEntry
-	nil,                               // 88: govalidatortest.CheckIfOptions2.TMapStringEntry
-	nil,                               // 89: govalidatortest.CheckIfOptions3.TMapStringEntry
-	nil,                               // 90: govalidatortest.CheckIfOptions4.TMapStringEntry
-	nil,                               // 91: govalidatortest.CheckIfOptions5.TMapStringEntry
-	nil,                               // 92: govalidatortest.CheckIfOptions6.SeedMapStringEntry
-	nil,                               // 93: govalidatortest.CheckIfOptions6.TMapString1Entry
-	nil,                               // 94: govalidatortest.CheckIfOptions6.TMapString2Entry
+	(ExprRules1_Status)(0),            // 1: govalidatortest.ExprRules1.Status
+	(*Config)(nil),                    // 2: govalidatortest.Config
+	(*ValidOneOfTags1)(nil),           // 3: govalidatortest.ValidOneOfTags1
+	(*ValidFloatTagsGeneral1)(nil),    // 4: govalidatortest.ValidFloatTagsGeneral1
+	(*ValidFloatTagsOptional1)(nil),   // 5: govalidatortest.ValidFloatTagsOptional1
+	(*ValidFloatTagsOneOf1)(nil),      // 6: govalidatortest.ValidFloatTagsOneOf1
+	(*ValidIntTagsGeneral1)(nil),      // 7: govalidatortest.ValidIntTagsGeneral1
+	(*ValidIntTagsOptional1)(nil),     // 8: govalidatortest.ValidIntTagsOptional1
+	(*ValidIntTagsOneOf1)(nil),        // 9: govalidatortest.ValidIntTagsOneOf1
+	(*ValidUintTagsGeneral1)(nil),     // 10: govalidatortest.ValidUintTagsGeneral1
+	(*ValidUintTagsOptional1)(nil),    // 11: govalidatortest.ValidUintTagsOptional1
+	(*ValidUintTagsOneOf1)(nil),       // 12: govalidatortest.ValidUintTagsOneOf1
+	(*ValidBoolTags1)(nil),            // 13: govalidatortest.ValidBoolTags1
+	(*ValidMessageTags)(nil),          // 14: govalidatortest.ValidMessageTags
+	(*ValidEnumTagsGeneral1)(nil),     // 15: govalidatortest.ValidEnumTagsGeneral1
+	(*ValidEnumTagsOptional1)(nil),    // 16: govalidatortest.ValidEnumTagsOptional1
+	(*ValidEnumTagsOneOf1)(nil),       // 17: govalidatortest.ValidEnumTagsOneOf1
+	(*ValidBytesTags1)(nil),           // 18: govalidatortest.ValidBytesTags1
+	(*ValidNumberTags1)(nil),          // 19: govalidatortest.ValidNumberTags1
+	(*ValidBytesTags2)(nil),           // 20: govalidatortest.ValidBytesTags2
+	(*ValidRepeatedTagsGeneral1)(nil), // 21: govalidatortest.ValidRepeatedTagsGeneral1
+	(*ValidRepeatedTagsItem1)(nil),    // 22: govalidatortest.ValidRepeatedTagsItem1
+	(*ValidMapTagsGeneral1)(nil),      // 23: govalidatortest.ValidMapTagsGeneral1
+	(*ValidMapTagsKey1)(nil),          // 24: govalidatortest.ValidMapTagsKey1
+	(*ValidMapTagsValue1)(nil),        // 25: govalidatortest.ValidMapTagsValue1
+	(*ValidStringTagsGeneral1)(nil),   // 26: govalidatortest.ValidStringTagsGeneral1
+	(*ValidStringTagsOptional1)(nil),  // 27: govalidatortest.ValidStringTagsOptional1
+	(*ValidStringTagsOneOf1)(nil),     // 28: govalidatortest.ValidStringTagsOneOf1
+	(*ValidOptionsMultiCond1)(nil),    // 29: govalidatortest.ValidOptionsMultiCond1
+	(*CheckIfOptions1)(nil),           // 30: govalidatortest.CheckIfOptions1
+	(*CheckIfOptions2)(nil),           // 31: govalidatortest.CheckIfOptions2
+	(*CheckIfOptions3)(nil),           // 32: govalidatortest.CheckIfOptions3
+	(*CheckIfOptions4)(nil),           // 33: govalidatortest.CheckIfOptions4
+	(*CheckIfOptions5)(nil),           // 34: govalidatortest.CheckIfOptions5
+	(*CheckIfOptions6)(nil),           // 35: govalidatortest.CheckIfOptions6
+	(*ValidateAll1)(nil),              // 36: govalidatortest.ValidateAll1
+	(*ValidateError1)(nil),            // 37: govalidatortest.ValidateError1
+	(*FieldPath1)(nil),                // 38: govalidatortest.FieldPath1
+	(*CustomMessage1)(nil),            // 39: govalidatortest.CustomMessage1
+	(*MessageRules1)(nil),             // 40: govalidatortest.MessageRules1
+	(*ExprRules1)(nil),                // 41: govalidatortest.ExprRules1
+	(*ExprRules2)(nil),                // 42: govalidatortest.ExprRules2
+	(*ExprRules3)(nil),                // 43: govalidatortest.ExprRules3
+	(*WellKnownTypes1)(nil),           // 44: govalidatortest.WellKnownTypes1
+	nil,                               // 45: govalidatortest.ValidBytesTags2.TBytesMap1Entry
+	nil,                               // 46: govalidatortest.ValidMapTagsGeneral1.TMap101Entry
+	nil,                               // 47: govalidatortest.ValidMapTagsGeneral1.TMap102Entry
+	nil,                               // 48: govalidatortest.ValidMapTagsGeneral1.TMap103Entry
+	nil,                               // 49: govalidatortest.ValidMapTagsGeneral1.TMap104Entry
+	nil,                               // 50: govalidatortest.ValidMapTagsGeneral1.TMap105Entry
+	nil,                               // 51: govalidatortest.ValidMapTagsGeneral1.TMap106Entry
+	nil,                               // 52: govalidatortest.ValidMapTagsGeneral1.TMap107Entry
+	nil,                               // 53: govalidatortest.ValidMapTagsGeneral1.TMap108Entry
+	nil,                               // 54: govalidatortest.ValidMapTagsGeneral1.TMap111Entry
+	nil,                               // 55: govalidatortest.ValidMapTagsGeneral1.TMap112Entry
+	nil,                               // 56: govalidatortest.ValidMapTagsGeneral1.TMap113Entry
+	nil,                               // 57: govalidatortest.ValidMapTagsGeneral1.TMap114Entry
+	nil,                               // 58: govalidatortest.ValidMapTagsGeneral1.TMap115Entry
+	nil,                               // 59: govalidatortest.ValidMapTagsGeneral1.TMap116Entry
+	nil,                               // 60: govalidatortest.ValidMapTagsGeneral1.TMap117Entry
+	nil,                               // 61: govalidatortest.ValidMapTagsGeneral1.TMap118Entry
+	nil,                               // 62: govalidatortest.ValidMapTagsGeneral1.TMapNotNull1Entry
+	nil,                               // 63: govalidatortest.ValidMapTagsGeneral1.TMapLenEq1Entry
+	nil,                               // 64: govalidatortest.ValidMapTagsGeneral1.TMapLenNe1Entry
+	nil,                               // 65: govalidatortest.ValidMapTagsGeneral1.TMapLenLt1Entry
+	nil,                               // 66: govalidatortest.ValidMapTagsGeneral1.TMapLenGt1Entry
+	nil,                               // 67: govalidatortest.ValidMapTagsGeneral1.TMapLenLte1Entry
+	nil,                               // 68: govalidatortest.ValidMapTagsGeneral1.TMapLenGte1Entry
+	nil,                               // 69: govalidatortest.ValidMapTagsKey1.TMapKeyStringEntry
+	nil,                               // 70: govalidatortest.ValidMapTagsKey1.TMapKeyInt32Entry
+	nil,                               // 71: govalidatortest.ValidMapTagsKey1.TMapKeyInt64Entry
+	nil,                               // 72: govalidatortest.ValidMapTagsKey1.TMapKeySint32Entry
+	nil,                               // 73: govalidatortest.ValidMapTagsKey1.TMapKeySint64Entry
+	nil,                               // 74: govalidatortest.ValidMapTagsKey1.TMapKeySfixed32Entry
+	nil,                               // 75: govalidatortest.ValidMapTagsKey1.TMapKeySfixed64Entry
+	nil,                               // 76: govalidatortest.ValidMapTagsKey1.TMapKeyUint32Entry
+	nil,                               // 77: govalidatortest.ValidMapTagsKey1.TMapKeyUint64Entry
+	nil,                               // 78: govalidatortest.ValidMapTagsKey1.TMapKeyFixed32Entry
+	nil,                               // 79: govalidatortest.ValidMapTagsKey1.TMapKeyFixed64Entry
+	nil,                               // 80: govalidatortest.ValidMapTagsValue1.TMapValueStringEntry
+	nil,                               // 81: govalidatortest.ValidMapTagsValue1.TMapValueDoubleEntry
+	nil,                               // 82: govalidatortest.ValidMapTagsValue1.TMapValueFloatEntry
+	nil,                               // 83: govalidatortest.ValidMapTagsValue1.TMapValueInt32Entry
+	nil,                               // 84: govalidatortest.ValidMapTagsValue1.TMapValueInt64Entry
+	nil,                               // 85: govalidatortest.ValidMapTagsValue1.TMapValueSint32Entry
+	nil,                               // 86: govalidatortest.ValidMapTagsValue1.TMapValueSint64Entry
+	nil,                               // 87: govalidatortest.ValidMapTagsValue1.TMapValueSfixed32Entry
+	nil,                               // 88: govalidatortest.ValidMapTagsValue1.TMapValueSfixed64Entry
+	nil,                               // 89: govalidatortest.ValidMapTagsValue1.TMapValueUint32Entry
+	nil,                               // 90: govalidatortest.ValidMapTagsValue1.TMapValueUint64Entry
+	nil,                               // 91: govalidatortest.ValidMapTagsValue1.TMapValueFixed32Entry
+	nil,                               // 92: govalidatortest.ValidMapTagsValue1.TMapValueFixed64Entry
+	nil,                               // 93: govalidatortest.ValidMapTagsValue1.TMapValueBoolEntry
+	nil,                               // 94: govalidatortest.ValidMapTagsValue1.TMapValueEnumEntry
+	nil,                               // 95: govalidatortest.ValidMapTagsValue1.TMapValueBytesEntry
+	nil,                               // 96: govalidatortest.ValidMapTagsValue1.TMapValueMessageEntry
+	nil,                               // 97: govalidatortest.ValidOptionsMultiCond1.TMapString1Entry
+	nil,                               // 98: govalidatortest.ValidOptionsMultiCond1.TMapInt64Entry
+	nil,                               // 99: govalidatortest.ValidOptionsMultiCond1.TMapString2Entry
+	nil,                               // 100: govalidatortest.CheckIfOptions1.TMapStringEntry
+	nil,                               // 101: govalidatortest.CheckIfOptions2.TMapStringEntry
+	nil,                               // 102: govalidatortest.CheckIfOptions3.TMapStringEntry
+	nil,                               // 103: govalidatortest.CheckIfOptions4.TMapStringEntry
+	nil,                               // 104: govalidatortest.CheckIfOptions5.TMapStringEntry
+	nil,                               // 105: govalidatortest.CheckIfOptions6.SeedMapStringEntry
+	nil,                               // 106: govalidatortest.CheckIfOptions6.TMapString1Entry
+	nil,                               // 107: govalidatortest.CheckIfOptions6.TMapString2Entry
+	nil,                               // 108: govalidatortest.ValidateAll1.LabelsEntry
+	(*FieldPath1_Item)(nil),           // 109: govalidatortest.FieldPath1.Item
+	(*FieldPath1_Order)(nil),          // 110: govalidatortest.FieldPath1.Order
+	nil,                               // 111: govalidatortest.FieldPath1.LabelsEntry
+	nil,                               // 112: govalidatortest.FieldPath1.IndexesEntry
+	(*ExprRules1_Item)(nil),           // 113: govalidatortest.ExprRules1.Item
+	nil,                               // 114: govalidatortest.ExprRules1.LabelsEntry
+	nil,                               // 115: govalidatortest.ExprRules2.LabelsEntry
+	nil,                               // 116: govalidatortest.WellKnownTypes1.LevelsEntry
+	(*wrapperspb.DoubleValue)(nil),    // 117: google.protobuf.DoubleValue
+	(*wrapperspb.BytesValue)(nil),     // 118: google.protobuf.BytesValue
+	(*timestamppb.Timestamp)(nil),     // 119: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 120: google.protobuf.Duration
+	(*wrapperspb.Int64Value)(nil),     // 121: google.protobuf.Int64Value
+	(*wrapperspb.UInt32Value)(nil),    // 122: google.protobuf.UInt32Value
+	(*wrapperspb.StringValue)(nil),    // 123: google.protobuf.StringValue
+	(*wrapperspb.BoolValue)(nil),      // 124: google.protobuf.BoolValue
+	(*anypb.Any)(nil),                 // 125: google.protobuf.Any
+	(*wrapperspb.Int32Value)(nil),     // 126: google.protobuf.Int32Value
 }
 var file_xgo_tests_govalidatortest_govalidator_test_proto_depIdxs = []int32{
-	1,   // 0: govalidatortest.ValidMessageTags.t_message_general_1:type_name -> govalidatortest.Config
-	1,   // 1: govalidatortest.ValidMessageTags.t_message_general_2:type_name -> govalidatortest.Config
-	1,   // 2: govalidatortest.ValidMessageTags.t_message_general_3:type_name -> govalidatortest.Config
-	1,   // 3: govalidatortest.ValidMessageTags.t_message_general_4:type_name -> govalidatortest.Config
-	1,   // 4: govalidatortest.ValidMessageTags.t_message_general_not_null1:type_name -> govalidatortest.Config
-	1,   // 5: govalidatortest.ValidMessageTags.t_message_general_not_null2:type_name -> govalidatortest.Config
-	1,   // 6: govalidatortest.ValidMessageTags.t_message_general_skip1:type_name -> govalidatortest.Config
-	1,   // 7: govalidatortest.ValidMessageTags.t_message_general_skip2:type_name -> govalidatortest.Config
-	1,   // 8: govalidatortest.ValidMessageTags.t_message_onfof_1:type_name -> govalidatortest.Config
-	1,   // 9: govalidatortest.ValidMessageTags.t_message_onfof_2:type_name -> govalidatortest.Config
-	1,   // 10: govalidatortest.ValidMessageTags.t_message_onfof_3:type_name -> govalidatortest.Config
-	1,   // 11: govalidatortest.ValidMessageTags.t_message_onfof_4:type_name -> govalidatortest.Config
-	1,   // 12: govalidatortest.ValidMessageTags.t_message_oneof_not_null1:type_name -> govalidatortest.Config
-	1,   // 13: govalidatortest.ValidMessageTags.t_message_oneof_not_null2:type_name -> govalidatortest.Config
-	1,   // 14: govalidatortest.ValidMessageTags.t_message_oneof_skip1:type_name -> govalidatortest.Config
-	1,   // 15: govalidatortest.ValidMessageTags.t_message_oneof_skip2:type_name -> govalidatortest.Config
+	2,   // 0: govalidatortest.ValidMessageTags.t_message_general_1:type_name -> govalidatortest.Config
+	2,   // 1: govalidatortest.ValidMessageTags.t_message_general_2:type_name -> govalidatortest.Config
+	2,   // 2: govalidatortest.ValidMessageTags.t_message_general_3:type_name -> govalidatortest.Config
+	2,   // 3: govalidatortest.ValidMessageTags.t_message_general_4:type_name -> govalidatortest.Config
+	2,   // 4: govalidatortest.ValidMessageTags.t_message_general_not_null1:type_name -> govalidatortest.Config
+	2,   // 5: govalidatortest.ValidMessageTags.t_message_general_not_null2:type_name -> govalidatortest.Config
+	2,   // 6: govalidatortest.ValidMessageTags.t_message_general_skip1:type_name -> govalidatortest.Config
+	2,   // 7: govalidatortest.ValidMessageTags.t_message_general_skip2:type_name -> govalidatortest.Config
+	2,   // 8: govalidatortest.ValidMessageTags.t_message_onfof_1:type_name -> govalidatortest.Config
+	2,   // 9: govalidatortest.ValidMessageTags.t_message_onfof_2:type_name -> govalidatortest.Config
+	2,   // 10: govalidatortest.ValidMessageTags.t_message_onfof_3:type_name -> govalidatortest.Config
+	2,   // 11: govalidatortest.ValidMessageTags.t_message_onfof_4:type_name -> govalidatortest.Config
+	2,   // 12: govalidatortest.ValidMessageTags.t_message_oneof_not_null1:type_name -> govalidatortest.Config
+	2,   // 13: govalidatortest.ValidMessageTags.t_message_oneof_not_null2:type_name -> govalidatortest.Config
+	2,   // 14: govalidatortest.ValidMessageTags.t_message_oneof_skip1:type_name -> govalidatortest.Config
+	2,   // 15: govalidatortest.ValidMessageTags.t_message_oneof_skip2:type_name -> govalidatortest.Config
 	0,   // 16: govalidatortest.ValidEnumTagsGeneral1.t_enum_1:type_name -> govalidatortest.Enum1
 	0,   // 17: govalidatortest.ValidEnumTagsGeneral1.t_enum_2:type_name -> govalidatortest.Enum1
 	0,   // 18: govalidatortest.ValidEnumTagsGeneral1.t_enum_3:type_name -> govalidatortest.Enum1
@@ -14819,99 +16878,144 @@ var file_xgo_tests_govalidatortest_govalidator_test_proto_depIdxs = []int32{
 	0,   // 52: govalidatortest.ValidEnumTagsOneOf1.t_enum_in1:type_name -> govalidatortest.Enum1
 	0,   // 53: govalidatortest.ValidEnumTagsOneOf1.t_enum_not_in1:type_name -> govalidatortest.Enum1
 	0,   // 54: govalidatortest.ValidEnumTagsOneOf1.t_enum_in_enums:type_name -> govalidatortest.Enum1
-	1,   // 55: govalidatortest.ValidRepeatedTagsGeneral1.t_list_107:type_name -> govalidatortest.Config
-	1,   // 56: govalidatortest.ValidRepeatedTagsGeneral1.t_list_108:type_name -> govalidatortest.Config
-	1,   // 57: govalidatortest.ValidRepeatedTagsGeneral1.t_list_109:type_name -> govalidatortest.Config
-	1,   // 58: govalidatortest.ValidRepeatedTagsGeneral1.t_list_110:type_name -> govalidatortest.Config
-	1,   // 59: govalidatortest.ValidRepeatedTagsGeneral1.t_list_111:type_name -> govalidatortest.Config
-	1,   // 60: govalidatortest.ValidRepeatedTagsGeneral1.t_list_112:type_name -> govalidatortest.Config
-	0,   // 61: govalidatortest.ValidRepeatedTagsGeneral1.t_list_113:type_name -> govalidatortest.Enum1
-	0,   // 62: govalidatortest.ValidRepeatedTagsGeneral1.t_list_114:type_name -> govalidatortest.Enum1
-	0,   // 63: govalidatortest.ValidRepeatedTagsGeneral1.t_list_115:type_name -> govalidatortest.Enum1
-	0,   // 64: govalidatortest.ValidRepeatedTagsGeneral1.t_list_116:type_name -> govalidatortest.Enum1
-	0,   // 65: govalidatortest.ValidRepeatedTagsGeneral1.t_list_117:type_name -> govalidatortest.Enum1
-	0,   // 66: govalidatortest.ValidRepeatedTagsGeneral1.t_list_118:type_name -> govalidatortest.Enum1
-	0,   // 67: govalidatortest.ValidRepeatedTagsGeneral1.t_list_unique_enum:type_name -> govalidatortest.Enum1
-	1,   // 68: govalidatortest.ValidRepeatedTagsGeneral1.t_list_unique_message:type_name -> govalidatortest.Config
-	0,   // 69: govalidatortest.ValidRepeatedTagsItem1.t_list_item_enum:type_name -> govalidatortest.Enum1
-	1,   // 70: govalidatortest.ValidRepeatedTagsItem1.t_list_item_message:type_name -> govalidatortest.Config
-	33,  // 71: govalidatortest.ValidMapTagsGeneral1.t_map_101:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap101Entry
-	34,  // 72: govalidatortest.ValidMapTagsGeneral1.t_map_102:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap102Entry
-	35,  // 73: govalidatortest.ValidMapTagsGeneral1.t_map_103:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap103Entry
-	36,  // 74: govalidatortest.ValidMapTagsGeneral1.t_map_104:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap104Entry
-	37,  // 75: govalidatortest.ValidMapTagsGeneral1.t_map_105:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap105Entry
-	38,  // 76: govalidatortest.ValidMapTagsGeneral1.t_map_106:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap106Entry
-	39,  // 77: govalidatortest.ValidMapTagsGeneral1.t_map_107:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap107Entry
-	40,  // 78: govalidatortest.ValidMapTagsGeneral1.t_map_108:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap108Entry
-	41,  // 79: govalidatortest.ValidMapTagsGeneral1.t_map_111:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap111Entry
-	42,  // 80: govalidatortest.ValidMapTagsGeneral1.t_map_112:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap112Entry
-	43,  // 81: govalidatortest.ValidMapTagsGeneral1.t_map_113:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap113Entry
-	44,  // 82: govalidatortest.ValidMapTagsGeneral1.t_map_114:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap114Entry
-	45,  // 83: govalidatortest.ValidMapTagsGeneral1.t_map_115:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap115Entry
-	46,  // 84: govalidatortest.ValidMapTagsGeneral1.t_map_116:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap116Entry
-	47,  // 85: govalidatortest.ValidMapTagsGeneral1.t_map_117:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap117Entry
-	48,  // 86: govalidatortest.ValidMapTagsGeneral1.t_map_118:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap118Entry
-	49,  // 87: govalidatortest.ValidMapTagsGeneral1.t_map_not_null1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapNotNull1Entry
-	50,  // 88: govalidatortest.ValidMapTagsGeneral1.t_map_len_eq1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenEq1Entry
-	51,  // 89: govalidatortest.ValidMapTagsGeneral1.t_map_len_ne1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenNe1Entry
-	52,  // 90: govalidatortest.ValidMapTagsGeneral1.t_map_len_lt1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenLt1Entry
-	53,  // 91: govalidatortest.ValidMapTagsGeneral1.t_map_len_gt1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenGt1Entry
-	54,  // 92: govalidatortest.ValidMapTagsGeneral1.t_map_len_lte1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenLte1Entry
-	55,  // 93: govalidatortest.ValidMapTagsGeneral1.t_map_len_gte1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenGte1Entry
-	56,  // 94: govalidatortest.ValidMapTagsKey1.t_map_key_string:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyStringEntry
-	57,  // 95: govalidatortest.ValidMapTagsKey1.t_map_key_int32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyInt32Entry
-	58,  // 96: govalidatortest.ValidMapTagsKey1.t_map_key_int64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyInt64Entry
-	59,  // 97: govalidatortest.ValidMapTagsKey1.t_map_key_sint32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySint32Entry
-	60,  // 98: govalidatortest.ValidMapTagsKey1.t_map_key_sint64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySint64Entry
-	61,  // 99: govalidatortest.ValidMapTagsKey1.t_map_key_sfixed32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySfixed32Entry
-	62,  // 100: govalidatortest.ValidMapTagsKey1.t_map_key_sfixed64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySfixed64Entry
-	63,  // 101: govalidatortest.ValidMapTagsKey1.t_map_key_uint32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyUint32Entry
-	64,  // 102: govalidatortest.ValidMapTagsKey1.t_map_key_uint64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyUint64Entry
-	65,  // 103: govalidatortest.ValidMapTagsKey1.t_map_key_fixed32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyFixed32Entry
-	66,  // 104: govalidatortest.ValidMapTagsKey1.t_map_key_fixed64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyFixed64Entry
-	67,  // 105: govalidatortest.ValidMapTagsValue1.t_map_value_string:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueStringEntry
-	68,  // 106: govalidatortest.ValidMapTagsValue1.t_map_value_double:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueDoubleEntry
-	69,  // 107: govalidatortest.ValidMapTagsValue1.t_map_value_float:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueFloatEntry
-	70,  // 108: govalidatortest.ValidMapTagsValue1.t_map_value_int32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueInt32Entry
-	71,  // 109: govalidatortest.ValidMapTagsValue1.t_map_value_int64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueInt64Entry
-	72,  // 110: govalidatortest.ValidMapTagsValue1.t_map_value_sint32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSint32Entry
-	73,  // 111: govalidatortest.ValidMapTagsValue1.t_map_value_sint64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSint64Entry
-	74,  // 112: govalidatortest.ValidMapTagsValue1.t_map_value_sfixed32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSfixed32Entry
-	75,  // 113: govalidatortest.ValidMapTagsValue1.t_map_value_sfixed64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSfixed64Entry
-	76,  // 114: govalidatortest.ValidMapTagsValue1.t_map_value_uint32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueUint32Entry
-	77,  // 115: govalidatortest.ValidMapTagsValue1.t_map_value_uint64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueUint64Entry
-	78,  // 116: govalidatortest.ValidMapTagsValue1.t_map_value_fixed32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueFixed32Entry
-	79,  // 117: govalidatortest.ValidMapTagsValue1.t_map_value_fixed64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueFixed64Entry
-	80,  // 118: govalidatortest.ValidMapTagsValue1.t_map_value_bool:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueBoolEntry
-	81,  // 119: govalidatortest.ValidMapTagsValue1.t_map_value_enum:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueEnumEntry
-	82,  // 120: govalidatortest.ValidMapTagsValue1.t_map_value_bytes:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueBytesEntry
-	83,  // 121: govalidatortest.ValidMapTagsValue1.t_map_value_message:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueMessageEntry
-	84,  // 122: govalidatortest.ValidOptionsMultiCond1.t_map_string1:type_name -> govalidatortest.ValidOptionsMultiCond1.TMapString1Entry
-	85,  // 123: govalidatortest.ValidOptionsMultiCond1.t_map_int64:type_name -> govalidatortest.ValidOptionsMultiCond1.TMapInt64Entry
-	86,  // 124: govalidatortest.ValidOptionsMultiCond1.t_map_string2:type_name -> govalidatortest.ValidOptionsMultiCond1.TMapString2Entry
-	87,  // 125: govalidatortest.CheckIfOptions1.t_map_string:type_name -> govalidatortest.CheckIfOptions1.TMapStringEntry
-	88,  // 126: govalidatortest.CheckIfOptions2.t_map_string:type_name -> govalidatortest.CheckIfOptions2.TMapStringEntry
-	89,  // 127: govalidatortest.CheckIfOptions3.t_map_string:type_name -> govalidatortest.CheckIfOptions3.TMapStringEntry
-	90,  // 128: govalidatortest.CheckIfOptions4.t_map_string:type_name -> govalidatortest.CheckIfOptions4.TMapStringEntry
-	91,  // 129: govalidatortest.CheckIfOptions5.t_map_string:type_name -> govalidatortest.CheckIfOptions5.TMapStringEntry
-	92,  // 130: govalidatortest.CheckIfOptions6.seed_map_string:type_name -> govalidatortest.CheckIfOptions6.SeedMapStringEntry
-	93,  // 131: govalidatortest.CheckIfOptions6.t_map_string1:type_name -> govalidatortest.CheckIfOptions6.TMapString1Entry
-	94,  // 132: govalidatortest.CheckIfOptions6.t_map_string2:type_name -> govalidatortest.CheckIfOptions6.TMapString2Entry
-	1,   // 133: govalidatortest.ValidMapTagsGeneral1.TMap111Entry.value:type_name -> govalidatortest.Config
-	1,   // 134: govalidatortest.ValidMapTagsGeneral1.TMap112Entry.value:type_name -> govalidatortest.Config
-	1,   // 135: govalidatortest.ValidMapTagsGeneral1.TMap113Entry.value:type_name -> govalidatortest.Config
-	1,   // 136: govalidatortest.ValidMapTagsGeneral1.TMap114Entry.value:type_name -> govalidatortest.Config
-	1,   // 137: govalidatortest.ValidMapTagsGeneral1.TMap115Entry.value:type_name -> govalidatortest.Config
-	1,   // 138: govalidatortest.ValidMapTagsGeneral1.TMap116Entry.value:type_name -> govalidatortest.Config
-	1,   // 139: govalidatortest.ValidMapTagsGeneral1.TMap117Entry.value:type_name -> govalidatortest.Config
-	1,   // 140: govalidatortest.ValidMapTagsGeneral1.TMap118Entry.value:type_name -> govalidatortest.Config
-	0,   // 141: govalidatortest.ValidMapTagsValue1.TMapValueEnumEntry.value:type_name -> govalidatortest.Enum1
-	1,   // 142: govalidatortest.ValidMapTagsValue1.TMapValueMessageEntry.value:type_name -> govalidatortest.Config
-	143, // [143:143] is the sub-list for method output_type
-	143, // [143:143] is the sub-list for method input_type
-	143, // [143:143] is the sub-list for extension type_name
-	143, // [143:143] is the sub-list for extension extendee
-	0,   // [0:143] is the sub-list for field type_name
+	117, // 55: govalidatortest.ValidNumberTags1.t_double_wrapper1:type_name -> google.protobuf.DoubleValue
+	45,  // 56: govalidatortest.ValidBytesTags2.t_bytes_map1:type_name -> govalidatortest.ValidBytesTags2.TBytesMap1Entry
+	118, // 57: govalidatortest.ValidBytesTags2.t_bytes_wrapper1:type_name -> google.protobuf.BytesValue
+	2,   // 58: govalidatortest.ValidRepeatedTagsGeneral1.t_list_107:type_name -> govalidatortest.Config
+	2,   // 59: govalidatortest.ValidRepeatedTagsGeneral1.t_list_108:type_name -> govalidatortest.Config
+	2,   // 60: govalidatortest.ValidRepeatedTagsGeneral1.t_list_109:type_name -> govalidatortest.Config
+	2,   // 61: govalidatortest.ValidRepeatedTagsGeneral1.t_list_110:type_name -> govalidatortest.Config
+	2,   // 62: govalidatortest.ValidRepeatedTagsGeneral1.t_list_111:type_name -> govalidatortest.Config
+	2,   // 63: govalidatortest.ValidRepeatedTagsGeneral1.t_list_112:type_name -> govalidatortest.Config
+	0,   // 64: govalidatortest.ValidRepeatedTagsGeneral1.t_list_113:type_name -> govalidatortest.Enum1
+	0,   // 65: govalidatortest.ValidRepeatedTagsGeneral1.t_list_114:type_name -> govalidatortest.Enum1
+	0,   // 66: govalidatortest.ValidRepeatedTagsGeneral1.t_list_115:type_name -> govalidatortest.Enum1
+	0,   // 67: govalidatortest.ValidRepeatedTagsGeneral1.t_list_116:type_name -> govalidatortest.Enum1
+	0,   // 68: govalidatortest.ValidRepeatedTagsGeneral1.t_list_117:type_name -> govalidatortest.Enum1
+	0,   // 69: govalidatortest.ValidRepeatedTagsGeneral1.t_list_118:type_name -> govalidatortest.Enum1
+	0,   // 70: govalidatortest.ValidRepeatedTagsGeneral1.t_list_unique_enum:type_name -> govalidatortest.Enum1
+	2,   // 71: govalidatortest.ValidRepeatedTagsGeneral1.t_list_unique_message:type_name -> govalidatortest.Config
+	0,   // 72: govalidatortest.ValidRepeatedTagsItem1.t_list_item_enum:type_name -> govalidatortest.Enum1
+	2,   // 73: govalidatortest.ValidRepeatedTagsItem1.t_list_item_message:type_name -> govalidatortest.Config
+	46,  // 74: govalidatortest.ValidMapTagsGeneral1.t_map_101:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap101Entry
+	47,  // 75: govalidatortest.ValidMapTagsGeneral1.t_map_102:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap102Entry
+	48,  // 76: govalidatortest.ValidMapTagsGeneral1.t_map_103:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap103Entry
+	49,  // 77: govalidatortest.ValidMapTagsGeneral1.t_map_104:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap104Entry
+	50,  // 78: govalidatortest.ValidMapTagsGeneral1.t_map_105:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap105Entry
+	51,  // 79: govalidatortest.ValidMapTagsGeneral1.t_map_106:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap106Entry
+	52,  // 80: govalidatortest.ValidMapTagsGeneral1.t_map_107:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap107Entry
+	53,  // 81: govalidatortest.ValidMapTagsGeneral1.t_map_108:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap108Entry
+	54,  // 82: govalidatortest.ValidMapTagsGeneral1.t_map_111:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap111Entry
+	55,  // 83: govalidatortest.ValidMapTagsGeneral1.t_map_112:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap112Entry
+	56,  // 84: govalidatortest.ValidMapTagsGeneral1.t_map_113:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap113Entry
+	57,  // 85: govalidatortest.ValidMapTagsGeneral1.t_map_114:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap114Entry
+	58,  // 86: govalidatortest.ValidMapTagsGeneral1.t_map_115:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap115Entry
+	59,  // 87: govalidatortest.ValidMapTagsGeneral1.t_map_116:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap116Entry
+	60,  // 88: govalidatortest.ValidMapTagsGeneral1.t_map_117:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap117Entry
+	61,  // 89: govalidatortest.ValidMapTagsGeneral1.t_map_118:type_name -> govalidatortest.ValidMapTagsGeneral1.TMap118Entry
+	62,  // 90: govalidatortest.ValidMapTagsGeneral1.t_map_not_null1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapNotNull1Entry
+	63,  // 91: govalidatortest.ValidMapTagsGeneral1.t_map_len_eq1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenEq1Entry
+	64,  // 92: govalidatortest.ValidMapTagsGeneral1.t_map_len_ne1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenNe1Entry
+	65,  // 93: govalidatortest.ValidMapTagsGeneral1.t_map_len_lt1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenLt1Entry
+	66,  // 94: govalidatortest.ValidMapTagsGeneral1.t_map_len_gt1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenGt1Entry
+	67,  // 95: govalidatortest.ValidMapTagsGeneral1.t_map_len_lte1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenLte1Entry
+	68,  // 96: govalidatortest.ValidMapTagsGeneral1.t_map_len_gte1:type_name -> govalidatortest.ValidMapTagsGeneral1.TMapLenGte1Entry
+	69,  // 97: govalidatortest.ValidMapTagsKey1.t_map_key_string:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyStringEntry
+	70,  // 98: govalidatortest.ValidMapTagsKey1.t_map_key_int32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyInt32Entry
+	71,  // 99: govalidatortest.ValidMapTagsKey1.t_map_key_int64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyInt64Entry
+	72,  // 100: govalidatortest.ValidMapTagsKey1.t_map_key_sint32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySint32Entry
+	73,  // 101: govalidatortest.ValidMapTagsKey1.t_map_key_sint64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySint64Entry
+	74,  // 102: govalidatortest.ValidMapTagsKey1.t_map_key_sfixed32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySfixed32Entry
+	75,  // 103: govalidatortest.ValidMapTagsKey1.t_map_key_sfixed64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeySfixed64Entry
+	76,  // 104: govalidatortest.ValidMapTagsKey1.t_map_key_uint32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyUint32Entry
+	77,  // 105: govalidatortest.ValidMapTagsKey1.t_map_key_uint64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyUint64Entry
+	78,  // 106: govalidatortest.ValidMapTagsKey1.t_map_key_fixed32:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyFixed32Entry
+	79,  // 107: govalidatortest.ValidMapTagsKey1.t_map_key_fixed64:type_name -> govalidatortest.ValidMapTagsKey1.TMapKeyFixed64Entry
+	80,  // 108: govalidatortest.ValidMapTagsValue1.t_map_value_string:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueStringEntry
+	81,  // 109: govalidatortest.ValidMapTagsValue1.t_map_value_double:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueDoubleEntry
+	82,  // 110: govalidatortest.ValidMapTagsValue1.t_map_value_float:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueFloatEntry
+	83,  // 111: govalidatortest.ValidMapTagsValue1.t_map_value_int32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueInt32Entry
+	84,  // 112: govalidatortest.ValidMapTagsValue1.t_map_value_int64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueInt64Entry
+	85,  // 113: govalidatortest.ValidMapTagsValue1.t_map_value_sint32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSint32Entry
+	86,  // 114: govalidatortest.ValidMapTagsValue1.t_map_value_sint64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSint64Entry
+	87,  // 115: govalidatortest.ValidMapTagsValue1.t_map_value_sfixed32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSfixed32Entry
+	88,  // 116: govalidatortest.ValidMapTagsValue1.t_map_value_sfixed64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueSfixed64Entry
+	89,  // 117: govalidatortest.ValidMapTagsValue1.t_map_value_uint32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueUint32Entry
+	90,  // 118: govalidatortest.ValidMapTagsValue1.t_map_value_uint64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueUint64Entry
+	91,  // 119: govalidatortest.ValidMapTagsValue1.t_map_value_fixed32:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueFixed32Entry
+	92,  // 120: govalidatortest.ValidMapTagsValue1.t_map_value_fixed64:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueFixed64Entry
+	93,  // 121: govalidatortest.ValidMapTagsValue1.t_map_value_bool:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueBoolEntry
+	94,  // 122: govalidatortest.ValidMapTagsValue1.t_map_value_enum:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueEnumEntry
+	95,  // 123: govalidatortest.ValidMapTagsValue1.t_map_value_bytes:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueBytesEntry
+	96,  // 124: govalidatortest.ValidMapTagsValue1.t_map_value_message:type_name -> govalidatortest.ValidMapTagsValue1.TMapValueMessageEntry
+	97,  // 125: govalidatortest.ValidOptionsMultiCond1.t_map_string1:type_name -> govalidatortest.ValidOptionsMultiCond1.TMapString1Entry
+	98,  // 126: govalidatortest.ValidOptionsMultiCond1.t_map_int64:type_name -> govalidatortest.ValidOptionsMultiCond1.TMapInt64Entry
+	99,  // 127: govalidatortest.ValidOptionsMultiCond1.t_map_string2:type_name -> govalidatortest.ValidOptionsMultiCond1.TMapString2Entry
+	100, // 128: govalidatortest.CheckIfOptions1.t_map_string:type_name -> govalidatortest.CheckIfOptions1.TMapStringEntry
+	101, // 129: govalidatortest.CheckIfOptions2.t_map_string:type_name -> govalidatortest.CheckIfOptions2.TMapStringEntry
+	102, // 130: govalidatortest.CheckIfOptions3.t_map_string:type_name -> govalidatortest.CheckIfOptions3.TMapStringEntry
+	103, // 131: govalidatortest.CheckIfOptions4.t_map_string:type_name -> govalidatortest.CheckIfOptions4.TMapStringEntry
+	104, // 132: govalidatortest.CheckIfOptions5.t_map_string:type_name -> govalidatortest.CheckIfOptions5.TMapStringEntry
+	105, // 133: govalidatortest.CheckIfOptions6.seed_map_string:type_name -> govalidatortest.CheckIfOptions6.SeedMapStringEntry
+	106, // 134: govalidatortest.CheckIfOptions6.t_map_string1:type_name -> govalidatortest.CheckIfOptions6.TMapString1Entry
+	107, // 135: govalidatortest.CheckIfOptions6.t_map_string2:type_name -> govalidatortest.CheckIfOptions6.TMapString2Entry
+	2,   // 136: govalidatortest.ValidateAll1.config:type_name -> govalidatortest.Config
+	2,   // 137: govalidatortest.ValidateAll1.items:type_name -> govalidatortest.Config
+	108, // 138: govalidatortest.ValidateAll1.labels:type_name -> govalidatortest.ValidateAll1.LabelsEntry
+	110, // 139: govalidatortest.FieldPath1.order:type_name -> govalidatortest.FieldPath1.Order
+	111, // 140: govalidatortest.FieldPath1.labels:type_name -> govalidatortest.FieldPath1.LabelsEntry
+	112, // 141: govalidatortest.FieldPath1.indexes:type_name -> govalidatortest.FieldPath1.IndexesEntry
+	119, // 142: govalidatortest.MessageRules1.start_time:type_name -> google.protobuf.Timestamp
+	119, // 143: govalidatortest.MessageRules1.end_time:type_name -> google.protobuf.Timestamp
+	120, // 144: govalidatortest.MessageRules1.timeout:type_name -> google.protobuf.Duration
+	120, // 145: govalidatortest.MessageRules1.max_timeout:type_name -> google.protobuf.Duration
+	40,  // 146: govalidatortest.MessageRules1.child:type_name -> govalidatortest.MessageRules1
+	113, // 147: govalidatortest.ExprRules1.items:type_name -> govalidatortest.ExprRules1.Item
+	119, // 148: govalidatortest.ExprRules1.start:type_name -> google.protobuf.Timestamp
+	119, // 149: govalidatortest.ExprRules1.end:type_name -> google.protobuf.Timestamp
+	1,   // 150: govalidatortest.ExprRules1.status:type_name -> govalidatortest.ExprRules1.Status
+	114, // 151: govalidatortest.ExprRules1.labels:type_name -> govalidatortest.ExprRules1.LabelsEntry
+	115, // 152: govalidatortest.ExprRules2.labels:type_name -> govalidatortest.ExprRules2.LabelsEntry
+	119, // 153: govalidatortest.ExprRules3.start:type_name -> google.protobuf.Timestamp
+	119, // 154: govalidatortest.ExprRules3.end:type_name -> google.protobuf.Timestamp
+	120, // 155: govalidatortest.ExprRules3.timeouts:type_name -> google.protobuf.Duration
+	120, // 156: govalidatortest.ExprRules3.max_timeout:type_name -> google.protobuf.Duration
+	119, // 157: govalidatortest.WellKnownTypes1.ts_range:type_name -> google.protobuf.Timestamp
+	119, // 158: govalidatortest.WellKnownTypes1.ts_past:type_name -> google.protobuf.Timestamp
+	119, // 159: govalidatortest.WellKnownTypes1.ts_future:type_name -> google.protobuf.Timestamp
+	119, // 160: govalidatortest.WellKnownTypes1.ts_within:type_name -> google.protobuf.Timestamp
+	120, // 161: govalidatortest.WellKnownTypes1.timeout:type_name -> google.protobuf.Duration
+	120, // 162: govalidatortest.WellKnownTypes1.delays:type_name -> google.protobuf.Duration
+	121, // 163: govalidatortest.WellKnownTypes1.count:type_name -> google.protobuf.Int64Value
+	122, // 164: govalidatortest.WellKnownTypes1.port:type_name -> google.protobuf.UInt32Value
+	117, // 165: govalidatortest.WellKnownTypes1.ratio:type_name -> google.protobuf.DoubleValue
+	123, // 166: govalidatortest.WellKnownTypes1.name:type_name -> google.protobuf.StringValue
+	124, // 167: govalidatortest.WellKnownTypes1.enabled:type_name -> google.protobuf.BoolValue
+	118, // 168: govalidatortest.WellKnownTypes1.data:type_name -> google.protobuf.BytesValue
+	123, // 169: govalidatortest.WellKnownTypes1.optional_name:type_name -> google.protobuf.StringValue
+	116, // 170: govalidatortest.WellKnownTypes1.levels:type_name -> govalidatortest.WellKnownTypes1.LevelsEntry
+	125, // 171: govalidatortest.WellKnownTypes1.detail:type_name -> google.protobuf.Any
+	125, // 172: govalidatortest.WellKnownTypes1.extra:type_name -> google.protobuf.Any
+	2,   // 173: govalidatortest.ValidMapTagsGeneral1.TMap111Entry.value:type_name -> govalidatortest.Config
+	2,   // 174: govalidatortest.ValidMapTagsGeneral1.TMap112Entry.value:type_name -> govalidatortest.Config
+	2,   // 175: govalidatortest.ValidMapTagsGeneral1.TMap113Entry.value:type_name -> govalidatortest.Config
+	2,   // 176: govalidatortest.ValidMapTagsGeneral1.TMap114Entry.value:type_name -> govalidatortest.Config
+	2,   // 177: govalidatortest.ValidMapTagsGeneral1.TMap115Entry.value:type_name -> govalidatortest.Config
+	2,   // 178: govalidatortest.ValidMapTagsGeneral1.TMap116Entry.value:type_name -> govalidatortest.Config
+	2,   // 179: govalidatortest.ValidMapTagsGeneral1.TMap117Entry.value:type_name -> govalidatortest.Config
+	2,   // 180: govalidatortest.ValidMapTagsGeneral1.TMap118Entry.value:type_name -> govalidatortest.Config
+	0,   // 181: govalidatortest.ValidMapTagsValue1.TMapValueEnumEntry.value:type_name -> govalidatortest.Enum1
+	2,   // 182: govalidatortest.ValidMapTagsValue1.TMapValueMessageEntry.value:type_name -> govalidatortest.Config
+	2,   // 183: govalidatortest.ValidateAll1.LabelsEntry.value:type_name -> govalidatortest.Config
+	109, // 184: govalidatortest.FieldPath1.Order.items:type_name -> govalidatortest.FieldPath1.Item
+	109, // 185: govalidatortest.FieldPath1.LabelsEntry.value:type_name -> govalidatortest.FieldPath1.Item
+	109, // 186: govalidatortest.FieldPath1.IndexesEntry.value:type_name -> govalidatortest.FieldPath1.Item
+	126, // 187: govalidatortest.WellKnownTypes1.LevelsEntry.value:type_name -> google.protobuf.Int32Value
+	188, // [188:188] is the sub-list for method output_type
+	188, // [188:188] is the sub-list for method input_type
+	188, // [188:188] is the sub-list for extension type_name
+	188, // [188:188] is the sub-list for extension extendee
+	0,   // [0:188] is the sub-list for field type_name
 }
 
 func init() { file_xgo_tests_govalidatortest_govalidator_test_proto_init() }
@@ -15125,7 +17229,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidRepeatedTagsGeneral1); i {
+			switch v := v.(*ValidNumberTags1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15137,7 +17241,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidRepeatedTagsItem1); i {
+			switch v := v.(*ValidBytesTags2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15149,7 +17253,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidMapTagsGeneral1); i {
+			switch v := v.(*ValidRepeatedTagsGeneral1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15161,7 +17265,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidMapTagsKey1); i {
+			switch v := v.(*ValidRepeatedTagsItem1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15173,7 +17277,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidMapTagsValue1); i {
+			switch v := v.(*ValidMapTagsGeneral1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15185,7 +17289,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidStringTagsGeneral1); i {
+			switch v := v.(*ValidMapTagsKey1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15197,7 +17301,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidStringTagsOptional1); i {
+			switch v := v.(*ValidMapTagsValue1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15209,7 +17313,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidStringTagsOneOf1); i {
+			switch v := v.(*ValidStringTagsGeneral1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15221,7 +17325,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ValidOptionsMultiCond1); i {
+			switch v := v.(*ValidStringTagsOptional1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15233,7 +17337,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckIfOptions1); i {
+			switch v := v.(*ValidStringTagsOneOf1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15245,7 +17349,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckIfOptions2); i {
+			switch v := v.(*ValidOptionsMultiCond1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15257,7 +17361,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckIfOptions3); i {
+			switch v := v.(*CheckIfOptions1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15269,7 +17373,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckIfOptions4); i {
+			switch v := v.(*CheckIfOptions2); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15281,7 +17385,7 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckIfOptions5); i {
+			switch v := v.(*CheckIfOptions3); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -15293,6 +17397,30 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 			}
 		}
 		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIfOptions4); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckIfOptions5); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckIfOptions6); i {
 			case 0:
 				return &v.state
@@ -15304,6 +17432,150 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 				return nil
 			}
 		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateAll1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateError1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldPath1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CustomMessage1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageRules1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExprRules1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExprRules2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExprRules3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WellKnownTypes1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldPath1_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldPath1_Order); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExprRules1_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[1].OneofWrappers = []interface{}{
 		(*ValidOneOfTags1_Oneof1String1)(nil),
@@ -15498,8 +17770,9 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 		(*ValidEnumTagsOneOf1_TEnumNotIn1)(nil),
 		(*ValidEnumTagsOneOf1_TEnumInEnums)(nil),
 	}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[23].OneofWrappers = []interface{}{}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[17].OneofWrappers = []interface{}{}
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[25].OneofWrappers = []interface{}{}
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*ValidStringTagsOneOf1_TString1)(nil),
 		(*ValidStringTagsOneOf1_TString2)(nil),
 		(*ValidStringTagsOneOf1_TString3)(nil),
@@ -15584,35 +17857,46 @@ func file_xgo_tests_govalidatortest_govalidator_test_proto_init() {
 		(*ValidStringTagsOneOf1_TStringUuid4)(nil),
 		(*ValidStringTagsOneOf1_TStringUuid5)(nil),
 	}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[25].OneofWrappers = []interface{}{
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*ValidOptionsMultiCond1_OneofString1)(nil),
 		(*ValidOptionsMultiCond1_OneofInt64)(nil),
 	}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[28].OneofWrappers = []interface{}{
 		(*CheckIfOptions1_Oneof1String1)(nil),
 		(*CheckIfOptions1_Oneof2String)(nil),
 	}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[27].OneofWrappers = []interface{}{
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[29].OneofWrappers = []interface{}{
 		(*CheckIfOptions2_Oneof1String1)(nil),
 		(*CheckIfOptions2_Oneof2String)(nil),
 	}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[30].OneofWrappers = []interface{}{
 		(*CheckIfOptions3_Oneof2String)(nil),
 	}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[29].OneofWrappers = []interface{}{
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[31].OneofWrappers = []interface{}{
 		(*CheckIfOptions4_Oneof1String1)(nil),
 		(*CheckIfOptions4_Oneof2String)(nil),
 	}
-	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[30].OneofWrappers = []interface{}{
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[32].OneofWrappers = []interface{}{
 		(*CheckIfOptions5_Oneof2String)(nil),
 	}
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*ValidateError1_KindName)(nil),
+	}
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*CustomMessage1_KindName)(nil),
+	}
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[38].OneofWrappers = []interface{}{}
+	file_xgo_tests_govalidatortest_govalidator_test_proto_msgTypes[39].OneofWrappers = []interface{}{
+		(*ExprRules1_ById)(nil),
+		(*ExprRules1_ByName)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_xgo_tests_govalidatortest_govalidator_test_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   94,
+			NumEnums:      2,
+			NumMessages:   115,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,179 @@
+package tests
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsondecoder"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsonencoder"
+	"github.com/yu31/protoc-plugin/xgo/pkg/jsonlines"
+	"github.com/yu31/protoc-plugin/xgo/tests/gojsontest"
+	"google.golang.org/protobuf/proto"
+)
+
+func Test_JSONLines_RoundTrip(t *testing.T) {
+	messages := []*gojsontest.UnmarshalOptions1{
+		{TString: "s1", ArrayString: []string{"a1"}, MapString: map[string]string{"k1": "v1"}},
+		{TString: "<s2>\n", TInt32: 2, TConfig: &gojsontest.UnmarshalOptions1_Config{Ip: "127.0.0.1", Port: 80}},
+		{TString: "s3", TChild: &gojsontest.UnmarshalOptions1{TString: "c3"}},
+	}
+
+	var buf bytes.Buffer
+	w := jsonlines.NewWriterWithOptions(&buf, jsonencoder.Options{Indent: "  "})
+	for _, m := range messages {
+		require.Nil(t, w.Write(m))
+	}
+	require.Nil(t, w.Close())
+	require.Equal(t, 3, w.Line())
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	require.Equal(t, 3, len(lines))
+	for i, m := range messages {
+		b, err := m.MarshalJSONWithOptions(jsonencoder.Options{})
+		require.Nil(t, err)
+		require.Equal(t, string(b), lines[i])
+	}
+	require.Contains(t, lines[1], `"<s2>\n"`)
+
+	r := jsonlines.NewReader(&buf)
+	for _, m := range messages {
+		x := &gojsontest.UnmarshalOptions1{}
+		require.Nil(t, r.Read(x))
+		require.True(t, proto.Equal(m, x))
+	}
+	require.Equal(t, io.EOF, r.Read(&gojsontest.UnmarshalOptions1{}))
+	require.Equal(t, 3, r.Line())
+
+	// The HTML characters are escaped by NewWriter.
+	buf.Reset()
+	w = jsonlines.NewWriter(&buf)
+	require.Nil(t, w.Write(messages[1]))
+	require.Nil(t, w.Flush())
+	require.Contains(t, buf.String(), `"\u003cs2\u003e\n"`)
+	require.Nil(t, w.Close())
+}
+
+func Test_JSONLines_Reader(t *testing.T) {
+	data := "{\"t_string\":\"s1\"}\r\n\n  \n{\"t_string\":\"s2\"}"
+	r := jsonlines.NewReader(strings.NewReader(data))
+
+	x1 := &gojsontest.UnmarshalOptions1{}
+	require.Nil(t, r.Read(x1))
+	require.Equal(t, "s1", x1.TString)
+	require.Equal(t, 1, r.Line())
+
+	x2 := &gojsontest.UnmarshalOptions1{}
+	require.Nil(t, r.Read(x2))
+	require.Equal(t, "s2", x2.TString)
+	require.Equal(t, 4, r.Line())
+
+	require.Equal(t, io.EOF, r.Read(&gojsontest.UnmarshalOptions1{}))
+
+	// The strings in decoded message must not refer to the reused buffer.
+	require.Equal(t, "s1", x1.TString)
+
+	// Values that not generated by protoc-gen-gojson.
+	r = jsonlines.NewReader(strings.NewReader("{\"a\":1}\n[1,2]\n"))
+	var v1 map[string]int
+	require.Nil(t, r.Read(&v1))
+	require.Equal(t, map[string]int{"a": 1}, v1)
+	var v2 []int
+	require.Nil(t, r.Read(&v2))
+	require.Equal(t, []int{1, 2}, v2)
+}
+
+func Test_JSONLines_Reuse(t *testing.T) {
+	m := &gojsontest.UnmarshalOptions1{
+		TString:   "s1",
+		TInt32:    1,
+		TConfig:   &gojsontest.UnmarshalOptions1_Config{Ip: "127.0.0.1", Port: 80},
+		MapString: map[string]string{"k1": "v1"},
+	}
+
+	// The generated messages are encoded into the pooled encoder directly.
+	w := jsonlines.NewWriter(ioutil.Discard)
+	require.Nil(t, w.Write(m))
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = w.Write(m)
+	})
+	require.Equal(t, float64(0), allocs)
+	require.Nil(t, w.Close())
+
+	// The lines share the chunks and the decoded strings are not overwritten by the next lines.
+	var buf bytes.Buffer
+	for i := 0; i < 1000; i++ {
+		buf.WriteString(`{"t_string":"s` + strconv.Itoa(i) + `","TConfig":{"ip":"ip` + strconv.Itoa(i) + `"}}` + "\n")
+	}
+	r := jsonlines.NewReader(&buf)
+	var values []*gojsontest.UnmarshalOptions1
+	for {
+		x := &gojsontest.UnmarshalOptions1{}
+		if err := r.Read(x); err == io.EOF {
+			break
+		} else {
+			require.Nil(t, err)
+		}
+		values = append(values, x)
+	}
+	require.Equal(t, 1000, len(values))
+	for i, x := range values {
+		require.Equal(t, "s"+strconv.Itoa(i), x.TString)
+		require.Equal(t, "ip"+strconv.Itoa(i), x.TConfig.Ip)
+	}
+}
+
+func Test_JSONLines_Errors(t *testing.T) {
+	data := strings.Join([]string{
+		`{"t_string":"s1"}`,
+		`{"t_string":1}`,
+		`{"t_string":"s3"`,
+		`{"t_string":"s4","t_unknown":1}`,
+		`{"t_string":"` + strings.Repeat("x", 8192) + `"}`,
+		`{"t_string":"s6"}`,
+	}, "\n")
+	opts := jsondecoder.Options{DisallowUnknownFields: true, MaxBytes: 4096}
+
+	r := jsonlines.NewReaderWithOptions(strings.NewReader(data), opts)
+	require.Nil(t, r.Read(&gojsontest.UnmarshalOptions1{}))
+	err := r.Read(&gojsontest.UnmarshalOptions1{})
+	require.NotNil(t, err)
+	require.Equal(t, `jsonlines: line 2: json: cannot unmarshal 1 into field t_string of type string`, err.Error())
+	lineErr := &jsonlines.LineError{}
+	require.True(t, errors.As(err, &lineErr))
+	require.Equal(t, 2, lineErr.Line)
+
+	// Skip bad lines and collect the errors.
+	r = jsonlines.NewReaderWithOptions(strings.NewReader(data), opts)
+	r.SkipBadLines = true
+
+	var values []string
+	for {
+		x := &gojsontest.UnmarshalOptions1{}
+		if err := r.Read(x); err == io.EOF {
+			break
+		} else {
+			require.Nil(t, err)
+		}
+		values = append(values, x.TString)
+	}
+	require.Equal(t, []string{"s1", "s6"}, values)
+
+	errs := r.Errors()
+	require.Equal(t, 4, len(errs))
+	require.Equal(t, 2, errs[0].Line)
+	require.Equal(t, 3, errs[1].Line)
+	var syntaxErr *jsondecoder.SyntaxError
+	require.True(t, errors.As(errs[1], &syntaxErr))
+	require.Equal(t, 4, errs[2].Line)
+	require.Equal(t, `jsonlines: line 4: json: unknown field "t_unknown"`, errs[2].Error())
+	require.Equal(t, 5, errs[3].Line)
+	var limitErr *jsondecoder.LimitError
+	require.True(t, errors.As(errs[3], &limitErr))
+	require.Equal(t, "MaxBytes", limitErr.Limit)
+}
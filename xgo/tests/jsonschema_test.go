@@ -0,0 +1,271 @@
+package tests
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yu31/protoc-plugin/xgo/tests/jsonschematest"
+)
+
+type jsonSchemaMessage interface {
+	JSONSchema() string
+}
+
+// loadJSONSchema returns the root schema and the schema of message that defined in "$defs".
+func loadJSONSchema(t *testing.T, msg jsonSchemaMessage, name string) (map[string]interface{}, map[string]interface{}) {
+	var root map[string]interface{}
+	require.Nil(t, json.Unmarshal([]byte(msg.JSONSchema()), &root))
+
+	defs := root["$defs"].(map[string]interface{})
+	require.Contains(t, defs, name)
+	return root, defs[name].(map[string]interface{})
+}
+
+func loadJSONSchemaProperty(t *testing.T, schema map[string]interface{}, key string) map[string]interface{} {
+	properties := schema["properties"].(map[string]interface{})
+	require.Contains(t, properties, key)
+	return properties[key].(map[string]interface{})
+}
+
+func Test_JSONSchema_Root(t *testing.T) {
+	root, schema := loadJSONSchema(t, &jsonschematest.Node{}, "jsonschematest.Node")
+
+	require.Equal(t, "https://json-schema.org/draft/2020-12/schema", root["$schema"])
+	require.Equal(t, "#/$defs/jsonschematest.Node", root["$ref"])
+	require.Equal(t, "object", schema["type"])
+
+	next := loadJSONSchemaProperty(t, schema, "next")
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"$ref": "#/$defs/jsonschematest.Node"},
+		map[string]interface{}{"type": "null"},
+	}, next["anyOf"])
+}
+
+func Test_JSONSchema_Scalars(t *testing.T) {
+	_, schema := loadJSONSchema(t, &jsonschematest.Scalars1{}, "jsonschematest.Scalars1")
+
+	properties := schema["properties"].(map[string]interface{})
+	require.NotContains(t, properties, "type_ignore")
+	require.NotContains(t, properties, "TypeInt32")
+	require.Equal(t, []interface{}{"type_required"}, schema["required"])
+
+	int32Schema := loadJSONSchemaProperty(t, schema, "type_int32")
+	require.Equal(t, "integer", int32Schema["type"])
+	require.Equal(t, float64(1), int32Schema["minimum"])
+	require.Equal(t, float64(100), int32Schema["maximum"])
+
+	uint32Schema := loadJSONSchemaProperty(t, schema, "type_uint32")
+	require.Equal(t, []interface{}{float64(1), float64(2), float64(3)}, uint32Schema["enum"])
+
+	doubleSchema := loadJSONSchemaProperty(t, schema, "type_double")
+	require.Equal(t, "number", doubleSchema["type"])
+	require.Equal(t, 0.5, doubleSchema["exclusiveMinimum"])
+	require.Equal(t, 9.5, doubleSchema["exclusiveMaximum"])
+
+	require.Equal(t, true, loadJSONSchemaProperty(t, schema, "type_bool")["const"])
+
+	bytesSchema := loadJSONSchemaProperty(t, schema, "type_bytes")
+	require.Equal(t, []interface{}{"string", "null"}, bytesSchema["type"])
+	require.Equal(t, "base64", bytesSchema["contentEncoding"])
+	require.Equal(t, "string", loadJSONSchemaProperty(t, schema, "type_bytes_omit")["type"])
+
+	// The tags with check_if are ignored.
+	require.Equal(t, map[string]interface{}{"type": "string"}, loadJSONSchemaProperty(t, schema, "type_check_if"))
+}
+
+func Test_JSONSchema_Strings(t *testing.T) {
+	_, schema := loadJSONSchema(t, &jsonschematest.Strings1{}, "jsonschematest.Strings1")
+
+	require.Equal(t, "email", loadJSONSchemaProperty(t, schema, "email")["format"])
+	require.Equal(t, "uuid", loadJSONSchemaProperty(t, schema, "uuid")["format"])
+	require.Equal(t, "ipv4", loadJSONSchemaProperty(t, schema, "ipv4")["format"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"format": "ipv4"},
+		map[string]interface{}{"format": "ipv6"},
+	}, loadJSONSchemaProperty(t, schema, "ip")["anyOf"])
+
+	length := loadJSONSchemaProperty(t, schema, "length")
+	require.Equal(t, float64(2), length["minLength"])
+	require.Equal(t, float64(9), length["maxLength"])
+
+	require.Equal(t, "^[a-z]+$", loadJSONSchemaProperty(t, schema, "regex")["pattern"])
+
+	prefix := loadJSONSchemaProperty(t, schema, "prefix")
+	require.Equal(t, `^x\.`, prefix["pattern"])
+	require.Equal(t, []interface{}{map[string]interface{}{"pattern": "<y>$"}}, prefix["allOf"])
+
+	excluded := loadJSONSchemaProperty(t, schema, "excluded")
+	require.Equal(t, map[string]interface{}{"enum": []interface{}{"a", "b"}}, excluded["not"])
+	require.Equal(t, []interface{}{
+		map[string]interface{}{"not": map[string]interface{}{"pattern": "^c"}},
+	}, excluded["allOf"])
+
+	require.Equal(t, []interface{}{"a", "b"}, loadJSONSchemaProperty(t, schema, "one_of")["enum"])
+}
+
+func Test_JSONSchema_Enums(t *testing.T) {
+	_, schema := loadJSONSchema(t, &jsonschematest.Enums1{}, "jsonschematest.Enums1")
+
+	status1 := loadJSONSchemaProperty(t, schema, "status1")
+	require.Equal(t, "integer", status1["type"])
+	require.Equal(t, []interface{}{float64(0), float64(1), float64(2), float64(3)}, status1["enum"])
+
+	status2 := loadJSONSchemaProperty(t, schema, "status2")
+	require.Equal(t, "string", status2["type"])
+	require.Equal(t, []interface{}{"STATUS_UNSPECIFIED", "STATUS_RUNNING", "STATUS_STOPPED", "STATUS_DELETED"}, status2["enum"])
+
+	// Filtered by validator tags.
+	status3 := loadJSONSchemaProperty(t, schema, "status3")
+	require.Equal(t, []interface{}{"STATUS_RUNNING", "STATUS_STOPPED"}, status3["enum"])
+
+	_, schema = loadJSONSchema(t, &jsonschematest.Enums2{}, "jsonschematest.Enums2")
+	require.Equal(t, "string", loadJSONSchemaProperty(t, schema, "status1")["type"])
+}
+
+func Test_JSONSchema_Collections(t *testing.T) {
+	_, schema := loadJSONSchema(t, &jsonschematest.Collections1{}, "jsonschematest.Collections1")
+
+	list1 := loadJSONSchemaProperty(t, schema, "list1")
+	require.Equal(t, []interface{}{"array", "null"}, list1["type"])
+	require.Equal(t, float64(1), list1["minItems"])
+	require.Equal(t, float64(5), list1["maxItems"])
+	require.Equal(t, true, list1["uniqueItems"])
+	require.Equal(t, map[string]interface{}{"type": "string", "minLength": float64(1)}, list1["items"])
+
+	require.Equal(t, "array", loadJSONSchemaProperty(t, schema, "list2")["type"])
+
+	map1 := loadJSONSchemaProperty(t, schema, "map1")
+	require.Equal(t, "object", map1["type"])
+	require.Equal(t, float64(9), map1["maxProperties"])
+	require.Equal(t, map[string]interface{}{"pattern": "^k"}, map1["propertyNames"])
+	require.Equal(t, float64(0), map1["additionalProperties"].(map[string]interface{})["minimum"])
+
+	map2 := loadJSONSchemaProperty(t, schema, "map2")
+	require.Equal(t, []interface{}{"object", "null"}, map2["type"])
+	require.Equal(t, map[string]interface{}{"pattern": "^-?[0-9]+$"}, map2["propertyNames"])
+	require.Equal(t, map[string]interface{}{"$ref": "#/$defs/jsonschematest.Node"}, map2["additionalProperties"])
+
+	nodes := loadJSONSchemaProperty(t, schema, "nodes")
+	require.Equal(t, map[string]interface{}{"$ref": "#/$defs/jsonschematest.Node"}, nodes["items"])
+
+	map3 := loadJSONSchemaProperty(t, schema, "map3")
+	require.Equal(t, map[string]interface{}{"enum": []interface{}{"true", "false"}}, map3["propertyNames"])
+
+	map4 := loadJSONSchemaProperty(t, schema, "map4")
+	require.Equal(t, []interface{}{"array", "null"}, map4["type"])
+	require.Equal(t, float64(1), map4["minItems"])
+	item := map4["items"].(map[string]interface{})
+	require.Equal(t, []interface{}{"key", "value"}, item["required"])
+	require.Equal(t, false, item["additionalProperties"])
+	properties := item["properties"].(map[string]interface{})
+	require.Equal(t, "integer", properties["key"].(map[string]interface{})["type"])
+	require.Equal(t, map[string]interface{}{"type": "string"}, properties["value"])
+}
+
+func Test_JSONSchema_Messages(t *testing.T) {
+	root, schema := loadJSONSchema(t, &jsonschematest.Messages1{}, "jsonschematest.Messages1")
+
+	require.Equal(t, false, schema["additionalProperties"])
+	require.Contains(t, loadJSONSchemaProperty(t, schema, "node1"), "anyOf")
+	require.Equal(t, map[string]interface{}{"$ref": "#/$defs/jsonschematest.Node"}, loadJSONSchemaProperty(t, schema, "node2"))
+	require.Equal(t, map[string]interface{}{"$ref": "#/$defs/jsonschematest.Node"}, loadJSONSchemaProperty(t, schema, "node3"))
+	require.Contains(t, root["$defs"], "jsonschematest.Node")
+
+	// The ignored message accepts any value.
+	root, _ = loadJSONSchema(t, &jsonschematest.Reference1{}, "jsonschematest.Reference1")
+	require.Equal(t, map[string]interface{}{}, root["$defs"].(map[string]interface{})["jsonschematest.Ignore1"])
+}
+
+func Test_JSONSchema_OneOf(t *testing.T) {
+	_, schema := loadJSONSchema(t, &jsonschematest.OneOf1{}, "jsonschematest.OneOf1")
+
+	properties := schema["properties"].(map[string]interface{})
+	require.NotContains(t, properties, "hidden")
+	require.NotContains(t, properties, "ignored")
+	require.NotContains(t, properties, "other")
+	require.Contains(t, properties, "address")
+	require.Contains(t, properties, "node")
+
+	kind := loadJSONSchemaProperty(t, schema, "kind")
+	require.Equal(t, []interface{}{"object", "null"}, kind["type"])
+	require.Equal(t, float64(1), kind["minProperties"])
+	require.Equal(t, float64(1), kind["maxProperties"])
+	require.Contains(t, kind["properties"], "name")
+	require.Contains(t, kind["properties"], "age")
+
+	_, schema = loadJSONSchema(t, &jsonschematest.OneOf2{}, "jsonschematest.OneOf2")
+	require.Equal(t, "object", loadJSONSchemaProperty(t, schema, "kind")["type"])
+}
+
+func Test_JSONSchema_Bounds(t *testing.T) {
+	_, schema := loadJSONSchema(t, &jsonschematest.Bounds1{}, "jsonschematest.Bounds1")
+
+	// The bounds that overflow are kept exclusive.
+	int64Gt := loadJSONSchemaProperty(t, schema, "t_int64_gt")
+	require.NotContains(t, int64Gt, "minimum")
+	require.Equal(t, float64(math.MaxInt64), int64Gt["exclusiveMinimum"])
+	require.Equal(t, float64(math.MinInt64), loadJSONSchemaProperty(t, schema, "t_int64_lt")["exclusiveMaximum"])
+	require.Equal(t, float64(math.MaxUint64), loadJSONSchemaProperty(t, schema, "t_uint64_gt")["exclusiveMinimum"])
+	uint64Lt := loadJSONSchemaProperty(t, schema, "t_uint64_lt")
+	require.NotContains(t, uint64Lt, "maximum")
+	require.Equal(t, float64(0), uint64Lt["exclusiveMaximum"])
+
+	// The tighter bound wins.
+	length := loadJSONSchemaProperty(t, schema, "t_length")
+	require.Equal(t, float64(6), length["minLength"])
+	require.Equal(t, float64(2), length["maxLength"])
+	list := loadJSONSchemaProperty(t, schema, "t_list")
+	require.Equal(t, float64(4), list["minItems"])
+	require.Equal(t, float64(2), list["maxItems"])
+
+	// The regex is translated to ECMA-262.
+	require.Equal(t, "^[Aa][Bb]$", loadJSONSchemaProperty(t, schema, "t_regex")["pattern"])
+}
+
+func Test_JSONSchema_Floats(t *testing.T) {
+	_, schema := loadJSONSchema(t, &jsonschematest.Floats1{}, "jsonschematest.Floats1")
+
+	nonFinite := func(key string) interface{} {
+		anyOf := loadJSONSchemaProperty(t, schema, key)["anyOf"].([]interface{})
+		require.Equal(t, 2, len(anyOf))
+		require.Equal(t, "number", anyOf[0].(map[string]interface{})["type"])
+		return anyOf[1].(map[string]interface{})["enum"]
+	}
+	require.Equal(t, []interface{}{"NaN", "+Inf", "-Inf"}, nonFinite("t_double1"))
+	require.Equal(t, []interface{}{"+Inf"}, nonFinite("t_double2"))
+	require.Equal(t, []interface{}{"NaN", "Infinity", "-Infinity"}, nonFinite("t_float1"))
+
+	// The non-finite values are rejected by the validator or the encoder.
+	require.Equal(t, map[string]interface{}{"type": "number"}, loadJSONSchemaProperty(t, schema, "t_double3"))
+	require.Equal(t, map[string]interface{}{"type": "number"}, loadJSONSchemaProperty(t, schema, "t_float2"))
+}
+
+func Test_JSONSchema_Files(t *testing.T) {
+	// The schema files that generated next to the code are same as the method JSONSchema.
+	messages := map[string]jsonSchemaMessage{
+		"Node":         &jsonschematest.Node{},
+		"Scalars1":     &jsonschematest.Scalars1{},
+		"Strings1":     &jsonschematest.Strings1{},
+		"Enums1":       &jsonschematest.Enums1{},
+		"Enums2":       &jsonschematest.Enums2{},
+		"Collections1": &jsonschematest.Collections1{},
+		"Messages1":    &jsonschematest.Messages1{},
+		"OneOf1":       &jsonschematest.OneOf1{},
+		"OneOf2":       &jsonschematest.OneOf2{},
+		"Reference1":   &jsonschematest.Reference1{},
+		"Bounds1":      &jsonschematest.Bounds1{},
+		"Floats1":      &jsonschematest.Floats1{},
+	}
+	for name, msg := range messages {
+		b, err := ioutil.ReadFile("jsonschematest/" + name + ".schema.json")
+		require.Nil(t, err, name)
+		require.JSONEq(t, msg.JSONSchema(), string(b), name)
+	}
+
+	// The ignored message has no schema file.
+	_, err := ioutil.ReadFile("jsonschematest/Ignore1.schema.json")
+	require.NotNil(t, err)
+}
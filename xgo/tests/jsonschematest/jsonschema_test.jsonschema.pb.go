@@ -0,0 +1,663 @@
+// Code generated by protoc-gen-jsonschema. DO NOT EDIT.
+// versions:
+// 		protoc-gen-jsonschema 0.0.1
+// source: xgo/tests/jsonschematest/jsonschema_test.proto
+
+package jsonschematest
+
+import (
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+)
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Node
+// that generated by protoc-gen-gojson.
+func (this *Node) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Node",
+  "$defs": {
+    "jsonschematest.Node": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "next": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/jsonschematest.Node"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Scalars1
+// that generated by protoc-gen-gojson.
+func (this *Scalars1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Scalars1",
+  "$defs": {
+    "jsonschematest.Scalars1": {
+      "type": "object",
+      "properties": {
+        "type_int32": {
+          "type": "integer",
+          "minimum": 1,
+          "maximum": 100
+        },
+        "type_int64": {
+          "type": "integer"
+        },
+        "type_uint32": {
+          "type": "integer",
+          "minimum": 0,
+          "maximum": 4294967295,
+          "enum": [
+            1,
+            2,
+            3
+          ]
+        },
+        "type_uint64": {
+          "type": "integer",
+          "minimum": 0
+        },
+        "type_double": {
+          "type": "number",
+          "exclusiveMinimum": 0.5,
+          "exclusiveMaximum": 9.5
+        },
+        "type_bool": {
+          "type": "boolean",
+          "const": true
+        },
+        "type_bytes": {
+          "type": [
+            "string",
+            "null"
+          ],
+          "contentEncoding": "base64"
+        },
+        "type_bytes_omit": {
+          "type": "string",
+          "contentEncoding": "base64"
+        },
+        "type_required": {
+          "type": "string"
+        },
+        "type_check_if": {
+          "type": "string"
+        }
+      },
+      "required": [
+        "type_required"
+      ]
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Strings1
+// that generated by protoc-gen-gojson.
+func (this *Strings1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Strings1",
+  "$defs": {
+    "jsonschematest.Strings1": {
+      "type": "object",
+      "properties": {
+        "email": {
+          "type": "string",
+          "format": "email"
+        },
+        "uuid": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ipv4": {
+          "type": "string",
+          "format": "ipv4"
+        },
+        "ip": {
+          "type": "string",
+          "anyOf": [
+            {
+              "format": "ipv4"
+            },
+            {
+              "format": "ipv6"
+            }
+          ]
+        },
+        "length": {
+          "type": "string",
+          "minLength": 2,
+          "maxLength": 9
+        },
+        "regex": {
+          "type": "string",
+          "pattern": "^[a-z]+$"
+        },
+        "prefix": {
+          "type": "string",
+          "pattern": "^x\\.",
+          "allOf": [
+            {
+              "pattern": "<y>$"
+            }
+          ]
+        },
+        "excluded": {
+          "type": "string",
+          "allOf": [
+            {
+              "not": {
+                "pattern": "^c"
+              }
+            }
+          ],
+          "not": {
+            "enum": [
+              "a",
+              "b"
+            ]
+          }
+        },
+        "one_of": {
+          "type": "string",
+          "enum": [
+            "a",
+            "b"
+          ]
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Enums1
+// that generated by protoc-gen-gojson.
+func (this *Enums1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Enums1",
+  "$defs": {
+    "jsonschematest.Enums1": {
+      "type": "object",
+      "properties": {
+        "status1": {
+          "type": "integer",
+          "enum": [
+            0,
+            1,
+            2,
+            3
+          ]
+        },
+        "status2": {
+          "type": "string",
+          "enum": [
+            "STATUS_UNSPECIFIED",
+            "STATUS_RUNNING",
+            "STATUS_STOPPED",
+            "STATUS_DELETED"
+          ]
+        },
+        "status3": {
+          "type": "string",
+          "enum": [
+            "STATUS_RUNNING",
+            "STATUS_STOPPED"
+          ]
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Enums2
+// that generated by protoc-gen-gojson.
+func (this *Enums2) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Enums2",
+  "$defs": {
+    "jsonschematest.Enums2": {
+      "type": "object",
+      "properties": {
+        "status1": {
+          "type": "string",
+          "enum": [
+            "STATUS_UNSPECIFIED",
+            "STATUS_RUNNING",
+            "STATUS_STOPPED",
+            "STATUS_DELETED"
+          ]
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Collections1
+// that generated by protoc-gen-gojson.
+func (this *Collections1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Collections1",
+  "$defs": {
+    "jsonschematest.Collections1": {
+      "type": "object",
+      "properties": {
+        "list1": {
+          "type": [
+            "array",
+            "null"
+          ],
+          "items": {
+            "type": "string",
+            "minLength": 1
+          },
+          "minItems": 1,
+          "maxItems": 5,
+          "uniqueItems": true
+        },
+        "list2": {
+          "type": "array",
+          "items": {
+            "type": "integer"
+          }
+        },
+        "map1": {
+          "type": "object",
+          "propertyNames": {
+            "pattern": "^k"
+          },
+          "additionalProperties": {
+            "type": "integer",
+            "minimum": 0,
+            "maximum": 2147483647
+          },
+          "maxProperties": 9
+        },
+        "map2": {
+          "type": [
+            "object",
+            "null"
+          ],
+          "propertyNames": {
+            "pattern": "^-?[0-9]+$"
+          },
+          "additionalProperties": {
+            "$ref": "#/$defs/jsonschematest.Node"
+          }
+        },
+        "nodes": {
+          "type": [
+            "array",
+            "null"
+          ],
+          "items": {
+            "$ref": "#/$defs/jsonschematest.Node"
+          }
+        },
+        "map3": {
+          "type": [
+            "object",
+            "null"
+          ],
+          "propertyNames": {
+            "enum": [
+              "true",
+              "false"
+            ]
+          },
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "map4": {
+          "type": [
+            "array",
+            "null"
+          ],
+          "items": {
+            "type": "object",
+            "properties": {
+              "key": {
+                "type": "integer",
+                "minimum": 0,
+                "maximum": 4294967295
+              },
+              "value": {
+                "type": "string"
+              }
+            },
+            "required": [
+              "key",
+              "value"
+            ],
+            "additionalProperties": false
+          },
+          "minItems": 1
+        }
+      }
+    },
+    "jsonschematest.Node": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "next": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/jsonschematest.Node"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Messages1
+// that generated by protoc-gen-gojson.
+func (this *Messages1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Messages1",
+  "$defs": {
+    "jsonschematest.Messages1": {
+      "type": "object",
+      "properties": {
+        "node1": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/jsonschematest.Node"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        },
+        "node2": {
+          "$ref": "#/$defs/jsonschematest.Node"
+        },
+        "node3": {
+          "$ref": "#/$defs/jsonschematest.Node"
+        }
+      },
+      "additionalProperties": false
+    },
+    "jsonschematest.Node": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "next": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/jsonschematest.Node"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message OneOf1
+// that generated by protoc-gen-gojson.
+func (this *OneOf1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.OneOf1",
+  "$defs": {
+    "jsonschematest.OneOf1": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": [
+            "object",
+            "null"
+          ],
+          "properties": {
+            "name": {
+              "type": "string"
+            },
+            "age": {
+              "type": "integer",
+              "minimum": -2147483648,
+              "maximum": 2147483647
+            }
+          },
+          "minProperties": 1,
+          "maxProperties": 1,
+          "additionalProperties": false
+        },
+        "address": {
+          "type": "string"
+        },
+        "node": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/jsonschematest.Node"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        }
+      }
+    },
+    "jsonschematest.Node": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "next": {
+          "anyOf": [
+            {
+              "$ref": "#/$defs/jsonschematest.Node"
+            },
+            {
+              "type": "null"
+            }
+          ]
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message OneOf2
+// that generated by protoc-gen-gojson.
+func (this *OneOf2) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.OneOf2",
+  "$defs": {
+    "jsonschematest.OneOf2": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "object",
+          "properties": {
+            "name": {
+              "type": "string"
+            }
+          },
+          "minProperties": 1,
+          "maxProperties": 1,
+          "additionalProperties": false
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Reference1
+// that generated by protoc-gen-gojson.
+func (this *Reference1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Reference1",
+  "$defs": {
+    "jsonschematest.Reference1": {
+      "type": "object",
+      "properties": {
+        "ignore1": {
+          "$ref": "#/$defs/jsonschematest.Ignore1"
+        }
+      }
+    },
+    "jsonschematest.Ignore1": {}
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Bounds1
+// that generated by protoc-gen-gojson.
+func (this *Bounds1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Bounds1",
+  "$defs": {
+    "jsonschematest.Bounds1": {
+      "type": "object",
+      "properties": {
+        "t_int64_gt": {
+          "type": "integer",
+          "exclusiveMinimum": 9223372036854775807
+        },
+        "t_int64_lt": {
+          "type": "integer",
+          "exclusiveMaximum": -9223372036854775808
+        },
+        "t_uint64_gt": {
+          "type": "integer",
+          "minimum": 0,
+          "exclusiveMinimum": 18446744073709551615
+        },
+        "t_uint64_lt": {
+          "type": "integer",
+          "minimum": 0,
+          "exclusiveMaximum": 0
+        },
+        "t_length": {
+          "type": "string",
+          "minLength": 6,
+          "maxLength": 2
+        },
+        "t_list": {
+          "type": [
+            "array",
+            "null"
+          ],
+          "items": {
+            "type": "string"
+          },
+          "minItems": 4,
+          "maxItems": 2
+        },
+        "t_regex": {
+          "type": "string",
+          "pattern": "^[Aa][Bb]$"
+        }
+      }
+    }
+  }
+}`
+}
+
+// JSONSchema returns the JSON Schema (draft 2020-12) of the json format of message Floats1
+// that generated by protoc-gen-gojson.
+func (this *Floats1) JSONSchema() string {
+	return `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "$ref": "#/$defs/jsonschematest.Floats1",
+  "$defs": {
+    "jsonschematest.Floats1": {
+      "type": "object",
+      "properties": {
+        "t_double1": {
+          "anyOf": [
+            {
+              "type": "number"
+            },
+            {
+              "type": "string",
+              "enum": [
+                "NaN",
+                "+Inf",
+                "-Inf"
+              ]
+            }
+          ]
+        },
+        "t_double2": {
+          "anyOf": [
+            {
+              "type": "number",
+              "exclusiveMinimum": 0
+            },
+            {
+              "type": "string",
+              "enum": [
+                "+Inf"
+              ]
+            }
+          ]
+        },
+        "t_double3": {
+          "type": "number"
+        },
+        "t_float1": {
+          "anyOf": [
+            {
+              "type": "number"
+            },
+            {
+              "type": "string",
+              "enum": [
+                "NaN",
+                "Infinity",
+                "-Infinity"
+              ]
+            }
+          ]
+        },
+        "t_float2": {
+          "type": "number"
+        }
+      }
+    }
+  }
+}`
+}
@@ -0,0 +1,1576 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.1
+// 	protoc        v3.19.3
+// source: xgo/tests/jsonschematest/jsonschema_test.proto
+
+package jsonschematest
+
+import (
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbjson"
+	_ "github.com/yu31/protoc-plugin/xgo/pb/pbvalidator"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Status int32
+
+const (
+	Status_STATUS_UNSPECIFIED Status = 0
+	Status_STATUS_RUNNING     Status = 1
+	Status_STATUS_STOPPED     Status = 2
+	Status_STATUS_DELETED     Status = 3
+)
+
+// Enum value maps for Status.
+var (
+	Status_name = map[int32]string{
+		0: "STATUS_UNSPECIFIED",
+		1: "STATUS_RUNNING",
+		2: "STATUS_STOPPED",
+		3: "STATUS_DELETED",
+	}
+	Status_value = map[string]int32{
+		"STATUS_UNSPECIFIED": 0,
+		"STATUS_RUNNING":     1,
+		"STATUS_STOPPED":     2,
+		"STATUS_DELETED":     3,
+	}
+)
+
+func (x Status) Enum() *Status {
+	p := new(Status)
+	*p = x
+	return p
+}
+
+func (x Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_enumTypes[0].Descriptor()
+}
+
+func (Status) Type() protoreflect.EnumType {
+	return &file_xgo_tests_jsonschematest_jsonschema_test_proto_enumTypes[0]
+}
+
+func (x Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Status.Descriptor instead.
+func (Status) EnumDescriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{0}
+}
+
+type Node struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Next *Node  `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Node) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Node) GetNext() *Node {
+	if x != nil {
+		return x.Next
+	}
+	return nil
+}
+
+type Scalars1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TypeInt32     int32   `protobuf:"varint,1,opt,name=TypeInt32,proto3" json:"TypeInt32,omitempty"`
+	TypeInt64     int64   `protobuf:"varint,2,opt,name=TypeInt64,proto3" json:"TypeInt64,omitempty"`
+	TypeUint32    uint32  `protobuf:"varint,3,opt,name=TypeUint32,proto3" json:"TypeUint32,omitempty"`
+	TypeUint64    uint64  `protobuf:"varint,4,opt,name=TypeUint64,proto3" json:"TypeUint64,omitempty"`
+	TypeDouble    float64 `protobuf:"fixed64,5,opt,name=TypeDouble,proto3" json:"TypeDouble,omitempty"`
+	TypeBool      bool    `protobuf:"varint,6,opt,name=TypeBool,proto3" json:"TypeBool,omitempty"`
+	TypeBytes     []byte  `protobuf:"bytes,7,opt,name=TypeBytes,proto3" json:"TypeBytes,omitempty"`
+	TypeBytesOmit []byte  `protobuf:"bytes,8,opt,name=TypeBytesOmit,proto3" json:"TypeBytesOmit,omitempty"`
+	TypeIgnore    string  `protobuf:"bytes,9,opt,name=TypeIgnore,proto3" json:"TypeIgnore,omitempty"`
+	TypeRequired  string  `protobuf:"bytes,10,opt,name=TypeRequired,proto3" json:"TypeRequired,omitempty"`
+	TypeCheckIf   string  `protobuf:"bytes,11,opt,name=TypeCheckIf,proto3" json:"TypeCheckIf,omitempty"`
+}
+
+func (x *Scalars1) Reset() {
+	*x = Scalars1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scalars1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scalars1) ProtoMessage() {}
+
+func (x *Scalars1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scalars1.ProtoReflect.Descriptor instead.
+func (*Scalars1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Scalars1) GetTypeInt32() int32 {
+	if x != nil {
+		return x.TypeInt32
+	}
+	return 0
+}
+
+func (x *Scalars1) GetTypeInt64() int64 {
+	if x != nil {
+		return x.TypeInt64
+	}
+	return 0
+}
+
+func (x *Scalars1) GetTypeUint32() uint32 {
+	if x != nil {
+		return x.TypeUint32
+	}
+	return 0
+}
+
+func (x *Scalars1) GetTypeUint64() uint64 {
+	if x != nil {
+		return x.TypeUint64
+	}
+	return 0
+}
+
+func (x *Scalars1) GetTypeDouble() float64 {
+	if x != nil {
+		return x.TypeDouble
+	}
+	return 0
+}
+
+func (x *Scalars1) GetTypeBool() bool {
+	if x != nil {
+		return x.TypeBool
+	}
+	return false
+}
+
+func (x *Scalars1) GetTypeBytes() []byte {
+	if x != nil {
+		return x.TypeBytes
+	}
+	return nil
+}
+
+func (x *Scalars1) GetTypeBytesOmit() []byte {
+	if x != nil {
+		return x.TypeBytesOmit
+	}
+	return nil
+}
+
+func (x *Scalars1) GetTypeIgnore() string {
+	if x != nil {
+		return x.TypeIgnore
+	}
+	return ""
+}
+
+func (x *Scalars1) GetTypeRequired() string {
+	if x != nil {
+		return x.TypeRequired
+	}
+	return ""
+}
+
+func (x *Scalars1) GetTypeCheckIf() string {
+	if x != nil {
+		return x.TypeCheckIf
+	}
+	return ""
+}
+
+type Strings1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email    string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Uuid     string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Ipv4     string `protobuf:"bytes,3,opt,name=ipv4,proto3" json:"ipv4,omitempty"`
+	Ip       string `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	Length   string `protobuf:"bytes,5,opt,name=length,proto3" json:"length,omitempty"`
+	Regex    string `protobuf:"bytes,6,opt,name=regex,proto3" json:"regex,omitempty"`
+	Prefix   string `protobuf:"bytes,7,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Excluded string `protobuf:"bytes,8,opt,name=excluded,proto3" json:"excluded,omitempty"`
+	OneOf    string `protobuf:"bytes,9,opt,name=one_of,json=oneOf,proto3" json:"one_of,omitempty"`
+}
+
+func (x *Strings1) Reset() {
+	*x = Strings1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Strings1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Strings1) ProtoMessage() {}
+
+func (x *Strings1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Strings1.ProtoReflect.Descriptor instead.
+func (*Strings1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Strings1) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Strings1) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Strings1) GetIpv4() string {
+	if x != nil {
+		return x.Ipv4
+	}
+	return ""
+}
+
+func (x *Strings1) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Strings1) GetLength() string {
+	if x != nil {
+		return x.Length
+	}
+	return ""
+}
+
+func (x *Strings1) GetRegex() string {
+	if x != nil {
+		return x.Regex
+	}
+	return ""
+}
+
+func (x *Strings1) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *Strings1) GetExcluded() string {
+	if x != nil {
+		return x.Excluded
+	}
+	return ""
+}
+
+func (x *Strings1) GetOneOf() string {
+	if x != nil {
+		return x.OneOf
+	}
+	return ""
+}
+
+type Enums1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status1 Status `protobuf:"varint,1,opt,name=status1,proto3,enum=jsonschematest.Status" json:"status1,omitempty"`
+	Status2 Status `protobuf:"varint,2,opt,name=status2,proto3,enum=jsonschematest.Status" json:"status2,omitempty"`
+	Status3 Status `protobuf:"varint,3,opt,name=status3,proto3,enum=jsonschematest.Status" json:"status3,omitempty"`
+}
+
+func (x *Enums1) Reset() {
+	*x = Enums1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enums1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enums1) ProtoMessage() {}
+
+func (x *Enums1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enums1.ProtoReflect.Descriptor instead.
+func (*Enums1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Enums1) GetStatus1() Status {
+	if x != nil {
+		return x.Status1
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Enums1) GetStatus2() Status {
+	if x != nil {
+		return x.Status2
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+func (x *Enums1) GetStatus3() Status {
+	if x != nil {
+		return x.Status3
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+type Enums2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status1 Status `protobuf:"varint,1,opt,name=status1,proto3,enum=jsonschematest.Status" json:"status1,omitempty"`
+}
+
+func (x *Enums2) Reset() {
+	*x = Enums2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Enums2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Enums2) ProtoMessage() {}
+
+func (x *Enums2) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Enums2.ProtoReflect.Descriptor instead.
+func (*Enums2) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Enums2) GetStatus1() Status {
+	if x != nil {
+		return x.Status1
+	}
+	return Status_STATUS_UNSPECIFIED
+}
+
+type Collections1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	List1 []string          `protobuf:"bytes,1,rep,name=list1,proto3" json:"list1,omitempty"`
+	List2 []int64           `protobuf:"varint,2,rep,packed,name=list2,proto3" json:"list2,omitempty"`
+	Map1  map[string]int32  `protobuf:"bytes,3,rep,name=map1,proto3" json:"map1,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Map2  map[int64]*Node   `protobuf:"bytes,4,rep,name=map2,proto3" json:"map2,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Nodes []*Node           `protobuf:"bytes,5,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Map3  map[bool]string   `protobuf:"bytes,6,rep,name=map3,proto3" json:"map3,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Map4  map[uint32]string `protobuf:"bytes,7,rep,name=map4,proto3" json:"map4,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *Collections1) Reset() {
+	*x = Collections1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collections1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collections1) ProtoMessage() {}
+
+func (x *Collections1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collections1.ProtoReflect.Descriptor instead.
+func (*Collections1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Collections1) GetList1() []string {
+	if x != nil {
+		return x.List1
+	}
+	return nil
+}
+
+func (x *Collections1) GetList2() []int64 {
+	if x != nil {
+		return x.List2
+	}
+	return nil
+}
+
+func (x *Collections1) GetMap1() map[string]int32 {
+	if x != nil {
+		return x.Map1
+	}
+	return nil
+}
+
+func (x *Collections1) GetMap2() map[int64]*Node {
+	if x != nil {
+		return x.Map2
+	}
+	return nil
+}
+
+func (x *Collections1) GetNodes() []*Node {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *Collections1) GetMap3() map[bool]string {
+	if x != nil {
+		return x.Map3
+	}
+	return nil
+}
+
+func (x *Collections1) GetMap4() map[uint32]string {
+	if x != nil {
+		return x.Map4
+	}
+	return nil
+}
+
+type Messages1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node1 *Node `protobuf:"bytes,1,opt,name=node1,proto3" json:"node1,omitempty"`
+	Node2 *Node `protobuf:"bytes,2,opt,name=node2,proto3" json:"node2,omitempty"`
+	Node3 *Node `protobuf:"bytes,3,opt,name=node3,proto3" json:"node3,omitempty"`
+}
+
+func (x *Messages1) Reset() {
+	*x = Messages1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Messages1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Messages1) ProtoMessage() {}
+
+func (x *Messages1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Messages1.ProtoReflect.Descriptor instead.
+func (*Messages1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Messages1) GetNode1() *Node {
+	if x != nil {
+		return x.Node1
+	}
+	return nil
+}
+
+func (x *Messages1) GetNode2() *Node {
+	if x != nil {
+		return x.Node2
+	}
+	return nil
+}
+
+func (x *Messages1) GetNode3() *Node {
+	if x != nil {
+		return x.Node3
+	}
+	return nil
+}
+
+type OneOf1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*OneOf1_Name
+	//	*OneOf1_Age
+	Kind isOneOf1_Kind `protobuf_oneof:"kind"`
+	// Types that are assignable to Hidden:
+	//	*OneOf1_Address
+	//	*OneOf1_Node
+	Hidden isOneOf1_Hidden `protobuf_oneof:"hidden"`
+	// Types that are assignable to Ignored:
+	//	*OneOf1_Other
+	Ignored isOneOf1_Ignored `protobuf_oneof:"ignored"`
+}
+
+func (x *OneOf1) Reset() {
+	*x = OneOf1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneOf1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneOf1) ProtoMessage() {}
+
+func (x *OneOf1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneOf1.ProtoReflect.Descriptor instead.
+func (*OneOf1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *OneOf1) GetKind() isOneOf1_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *OneOf1) GetName() string {
+	if x, ok := x.GetKind().(*OneOf1_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OneOf1) GetAge() int32 {
+	if x, ok := x.GetKind().(*OneOf1_Age); ok {
+		return x.Age
+	}
+	return 0
+}
+
+func (m *OneOf1) GetHidden() isOneOf1_Hidden {
+	if m != nil {
+		return m.Hidden
+	}
+	return nil
+}
+
+func (x *OneOf1) GetAddress() string {
+	if x, ok := x.GetHidden().(*OneOf1_Address); ok {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *OneOf1) GetNode() *Node {
+	if x, ok := x.GetHidden().(*OneOf1_Node); ok {
+		return x.Node
+	}
+	return nil
+}
+
+func (m *OneOf1) GetIgnored() isOneOf1_Ignored {
+	if m != nil {
+		return m.Ignored
+	}
+	return nil
+}
+
+func (x *OneOf1) GetOther() string {
+	if x, ok := x.GetIgnored().(*OneOf1_Other); ok {
+		return x.Other
+	}
+	return ""
+}
+
+type isOneOf1_Kind interface {
+	isOneOf1_Kind()
+}
+
+type OneOf1_Name struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+type OneOf1_Age struct {
+	Age int32 `protobuf:"varint,2,opt,name=age,proto3,oneof"`
+}
+
+func (*OneOf1_Name) isOneOf1_Kind() {}
+
+func (*OneOf1_Age) isOneOf1_Kind() {}
+
+type isOneOf1_Hidden interface {
+	isOneOf1_Hidden()
+}
+
+type OneOf1_Address struct {
+	Address string `protobuf:"bytes,3,opt,name=address,proto3,oneof"`
+}
+
+type OneOf1_Node struct {
+	Node *Node `protobuf:"bytes,4,opt,name=node,proto3,oneof"`
+}
+
+func (*OneOf1_Address) isOneOf1_Hidden() {}
+
+func (*OneOf1_Node) isOneOf1_Hidden() {}
+
+type isOneOf1_Ignored interface {
+	isOneOf1_Ignored()
+}
+
+type OneOf1_Other struct {
+	Other string `protobuf:"bytes,5,opt,name=other,proto3,oneof"`
+}
+
+func (*OneOf1_Other) isOneOf1_Ignored() {}
+
+type OneOf2 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Kind:
+	//	*OneOf2_Name
+	Kind isOneOf2_Kind `protobuf_oneof:"kind"`
+}
+
+func (x *OneOf2) Reset() {
+	*x = OneOf2{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OneOf2) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OneOf2) ProtoMessage() {}
+
+func (x *OneOf2) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OneOf2.ProtoReflect.Descriptor instead.
+func (*OneOf2) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *OneOf2) GetKind() isOneOf2_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return nil
+}
+
+func (x *OneOf2) GetName() string {
+	if x, ok := x.GetKind().(*OneOf2_Name); ok {
+		return x.Name
+	}
+	return ""
+}
+
+type isOneOf2_Kind interface {
+	isOneOf2_Kind()
+}
+
+type OneOf2_Name struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3,oneof"`
+}
+
+func (*OneOf2_Name) isOneOf2_Kind() {}
+
+type Ignore1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Ignore1) Reset() {
+	*x = Ignore1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Ignore1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Ignore1) ProtoMessage() {}
+
+func (x *Ignore1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Ignore1.ProtoReflect.Descriptor instead.
+func (*Ignore1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Ignore1) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Reference1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ignore1 *Ignore1 `protobuf:"bytes,1,opt,name=ignore1,proto3" json:"ignore1,omitempty"`
+}
+
+func (x *Reference1) Reset() {
+	*x = Reference1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Reference1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reference1) ProtoMessage() {}
+
+func (x *Reference1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reference1.ProtoReflect.Descriptor instead.
+func (*Reference1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Reference1) GetIgnore1() *Ignore1 {
+	if x != nil {
+		return x.Ignore1
+	}
+	return nil
+}
+
+type Bounds1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TInt64Gt  int64    `protobuf:"varint,1,opt,name=t_int64_gt,json=tInt64Gt,proto3" json:"t_int64_gt,omitempty"`
+	TInt64Lt  int64    `protobuf:"varint,2,opt,name=t_int64_lt,json=tInt64Lt,proto3" json:"t_int64_lt,omitempty"`
+	TUint64Gt uint64   `protobuf:"varint,3,opt,name=t_uint64_gt,json=tUint64Gt,proto3" json:"t_uint64_gt,omitempty"`
+	TUint64Lt uint64   `protobuf:"varint,4,opt,name=t_uint64_lt,json=tUint64Lt,proto3" json:"t_uint64_lt,omitempty"`
+	TLength   string   `protobuf:"bytes,5,opt,name=t_length,json=tLength,proto3" json:"t_length,omitempty"`
+	TList     []string `protobuf:"bytes,6,rep,name=t_list,json=tList,proto3" json:"t_list,omitempty"`
+	TRegex    string   `protobuf:"bytes,7,opt,name=t_regex,json=tRegex,proto3" json:"t_regex,omitempty"`
+}
+
+func (x *Bounds1) Reset() {
+	*x = Bounds1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Bounds1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bounds1) ProtoMessage() {}
+
+func (x *Bounds1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bounds1.ProtoReflect.Descriptor instead.
+func (*Bounds1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Bounds1) GetTInt64Gt() int64 {
+	if x != nil {
+		return x.TInt64Gt
+	}
+	return 0
+}
+
+func (x *Bounds1) GetTInt64Lt() int64 {
+	if x != nil {
+		return x.TInt64Lt
+	}
+	return 0
+}
+
+func (x *Bounds1) GetTUint64Gt() uint64 {
+	if x != nil {
+		return x.TUint64Gt
+	}
+	return 0
+}
+
+func (x *Bounds1) GetTUint64Lt() uint64 {
+	if x != nil {
+		return x.TUint64Lt
+	}
+	return 0
+}
+
+func (x *Bounds1) GetTLength() string {
+	if x != nil {
+		return x.TLength
+	}
+	return ""
+}
+
+func (x *Bounds1) GetTList() []string {
+	if x != nil {
+		return x.TList
+	}
+	return nil
+}
+
+func (x *Bounds1) GetTRegex() string {
+	if x != nil {
+		return x.TRegex
+	}
+	return ""
+}
+
+type Floats1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TDouble1 float64 `protobuf:"fixed64,1,opt,name=t_double1,json=tDouble1,proto3" json:"t_double1,omitempty"`
+	TDouble2 float64 `protobuf:"fixed64,2,opt,name=t_double2,json=tDouble2,proto3" json:"t_double2,omitempty"`
+	TDouble3 float64 `protobuf:"fixed64,3,opt,name=t_double3,json=tDouble3,proto3" json:"t_double3,omitempty"`
+	TFloat1  float32 `protobuf:"fixed32,4,opt,name=t_float1,json=tFloat1,proto3" json:"t_float1,omitempty"`
+	TFloat2  float32 `protobuf:"fixed32,5,opt,name=t_float2,json=tFloat2,proto3" json:"t_float2,omitempty"`
+}
+
+func (x *Floats1) Reset() {
+	*x = Floats1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Floats1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Floats1) ProtoMessage() {}
+
+func (x *Floats1) ProtoReflect() protoreflect.Message {
+	mi := &file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Floats1.ProtoReflect.Descriptor instead.
+func (*Floats1) Descriptor() ([]byte, []int) {
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Floats1) GetTDouble1() float64 {
+	if x != nil {
+		return x.TDouble1
+	}
+	return 0
+}
+
+func (x *Floats1) GetTDouble2() float64 {
+	if x != nil {
+		return x.TDouble2
+	}
+	return 0
+}
+
+func (x *Floats1) GetTDouble3() float64 {
+	if x != nil {
+		return x.TDouble3
+	}
+	return 0
+}
+
+func (x *Floats1) GetTFloat1() float32 {
+	if x != nil {
+		return x.TFloat1
+	}
+	return 0
+}
+
+func (x *Floats1) GetTFloat2() float32 {
+	if x != nil {
+		return x.TFloat2
+	}
+	return 0
+}
+
+var File_xgo_tests_jsonschematest_jsonschema_test_proto protoreflect.FileDescriptor
+
+var file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDesc = []byte{
+	0x0a, 0x2e, 0x78, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x04, 0x4e, 0x6f, 0x64,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22,
+	0xf6, 0x03, 0x0a, 0x08, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x73, 0x31, 0x12, 0x2b, 0x0a, 0x09,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x0d, 0xe2, 0xdf, 0x1f, 0x09, 0x12, 0x07, 0xb2, 0x01, 0x04, 0x30, 0x00, 0x38, 0x64, 0x52, 0x09,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x2e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0e, 0xe2, 0xdf, 0x1f,
+	0x0a, 0x12, 0x08, 0xba, 0x01, 0x05, 0x4a, 0x03, 0x01, 0x02, 0x03, 0x52, 0x0a, 0x54, 0x79, 0x70,
+	0x65, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x55,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x54, 0x79, 0x70,
+	0x65, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x3b, 0x0a, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x42, 0x1b, 0xe2, 0xdf, 0x1f,
+	0x17, 0x12, 0x15, 0xaa, 0x01, 0x12, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x23, 0x40, 0x31,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x3f, 0x52, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x44, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65, 0x42, 0x6f, 0x6f, 0x6c,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xd2, 0x01,
+	0x02, 0x18, 0x01, 0x52, 0x08, 0x54, 0x79, 0x70, 0x65, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x54, 0x79, 0x70, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x0d, 0x54,
+	0x79, 0x70, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x4f, 0x6d, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x06, 0x8a, 0xf7, 0x02, 0x02, 0x18, 0x01, 0x52, 0x0d, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x4f, 0x6d, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a,
+	0xf7, 0x02, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x54, 0x79, 0x70, 0x65, 0x49, 0x67, 0x6e, 0x6f, 0x72,
+	0x65, 0x12, 0x2a, 0x0a, 0x0c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0x8a, 0xf7, 0x02, 0x02, 0x28, 0x01, 0x52,
+	0x0c, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x41, 0x0a,
+	0x0b, 0x54, 0x79, 0x70, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x1f, 0xe2, 0xdf, 0x1f, 0x1b, 0x0a, 0x11, 0x0a, 0x08, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x05, 0xd2, 0x01, 0x02, 0x18, 0x01, 0x12, 0x06, 0xc2, 0x01, 0x03,
+	0xb0, 0x01, 0x03, 0x52, 0x0b, 0x54, 0x79, 0x70, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x49, 0x66,
+	0x3a, 0x06, 0xca, 0xb8, 0x02, 0x02, 0x08, 0x04, 0x22, 0xec, 0x02, 0x0a, 0x08, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x73, 0x31, 0x12, 0x22, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2, 0x01, 0x03, 0xe0,
+	0x08, 0x01, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x20, 0x0a, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12, 0x06, 0xc2,
+	0x01, 0x03, 0xc8, 0x09, 0x01, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x04, 0x69,
+	0x70, 0x76, 0x34, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xb0, 0x06, 0x01, 0x52, 0x04, 0x69, 0x70, 0x76, 0x34, 0x12, 0x1c, 0x0a,
+	0x02, 0x69, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe2, 0xdf, 0x1f, 0x08, 0x12,
+	0x06, 0xc2, 0x01, 0x03, 0xa8, 0x06, 0x01, 0x52, 0x02, 0x69, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0f, 0xe2, 0xdf, 0x1f,
+	0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0xb8, 0x01, 0x0a, 0xc0, 0x01, 0x02, 0x52, 0x06, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xc2, 0x01, 0x0b, 0xc2, 0x02,
+	0x08, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x5d, 0x2b, 0x24, 0x52, 0x05, 0x72, 0x65, 0x67, 0x65, 0x78,
+	0x12, 0x2c, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xc2, 0x01, 0x0b, 0xca, 0x02, 0x02, 0x78, 0x2e,
+	0xda, 0x02, 0x03, 0x3c, 0x79, 0x3e, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2f,
+	0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x13, 0xe2, 0xdf, 0x1f, 0x0f, 0x12, 0x0d, 0xc2, 0x01, 0x0a, 0x22, 0x01, 0x61, 0x52, 0x01,
+	0x62, 0xd2, 0x02, 0x01, 0x63, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x5f, 0x6f, 0x66, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0f, 0xe2, 0xdf, 0x1f, 0x0b, 0x12, 0x09, 0xc2, 0x01, 0x06, 0x4a, 0x01, 0x61, 0x4a, 0x01, 0x62,
+	0x52, 0x05, 0x6f, 0x6e, 0x65, 0x4f, 0x66, 0x22, 0xbb, 0x01, 0x0a, 0x06, 0x45, 0x6e, 0x75, 0x6d,
+	0x73, 0x31, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x31, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x31, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x06, 0x8a,
+	0xf7, 0x02, 0x02, 0x20, 0x01, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x32, 0x12, 0x45,
+	0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x16, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x13, 0x8a, 0xf7, 0x02, 0x02, 0x20, 0x01, 0xe2,
+	0xdf, 0x1f, 0x09, 0x12, 0x07, 0xda, 0x01, 0x04, 0x20, 0x03, 0x30, 0x00, 0x52, 0x07, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x33, 0x22, 0x42, 0x0a, 0x06, 0x45, 0x6e, 0x75, 0x6d, 0x73, 0x32, 0x12,
+	0x30, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x31, 0x3a, 0x06, 0xca, 0xb8, 0x02, 0x02, 0x18, 0x01, 0x22, 0xa3, 0x05, 0x0a, 0x0c, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x69,
+	0x73, 0x74, 0x31, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x17, 0xe2, 0xdf, 0x1f, 0x13, 0x12,
+	0x11, 0xea, 0x01, 0x0e, 0x38, 0x05, 0x40, 0x01, 0x50, 0x01, 0x5a, 0x06, 0xc2, 0x01, 0x03, 0xb0,
+	0x01, 0x00, 0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x31, 0x12, 0x1c, 0x0a, 0x05, 0x6c, 0x69, 0x73,
+	0x74, 0x32, 0x18, 0x02, 0x20, 0x03, 0x28, 0x03, 0x42, 0x06, 0x8a, 0xf7, 0x02, 0x02, 0x18, 0x01,
+	0x52, 0x05, 0x6c, 0x69, 0x73, 0x74, 0x32, 0x12, 0x59, 0x0a, 0x04, 0x6d, 0x61, 0x70, 0x31, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x31, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x1d, 0xe2,
+	0xdf, 0x1f, 0x19, 0x12, 0x17, 0xf2, 0x01, 0x14, 0x10, 0x01, 0x28, 0x0a, 0x5a, 0x07, 0xc2, 0x01,
+	0x04, 0xca, 0x02, 0x01, 0x6b, 0x62, 0x05, 0xb2, 0x01, 0x02, 0x40, 0x00, 0x52, 0x04, 0x6d, 0x61,
+	0x70, 0x31, 0x12, 0x3a, 0x0a, 0x04, 0x6d, 0x61, 0x70, 0x32, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73,
+	0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x2e, 0x4d,
+	0x61, 0x70, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6d, 0x61, 0x70, 0x32, 0x12, 0x2a,
+	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x04, 0x6d, 0x61,
+	0x70, 0x33, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x04, 0x6d, 0x61, 0x70, 0x33, 0x12, 0x4d, 0x0a, 0x04, 0x6d, 0x61, 0x70, 0x34, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x31, 0x2e, 0x4d, 0x61, 0x70, 0x34, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x11, 0x8a, 0xf7,
+	0x02, 0x02, 0x38, 0x01, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xf2, 0x01, 0x02, 0x40, 0x01, 0x52,
+	0x04, 0x6d, 0x61, 0x70, 0x34, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x61, 0x70, 0x31, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x4d,
+	0x0a, 0x09, 0x4d, 0x61, 0x70, 0x32, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2a, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a,
+	0x09, 0x4d, 0x61, 0x70, 0x33, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x37, 0x0a, 0x09, 0x4d, 0x61, 0x70, 0x34, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0xac, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x31, 0x12, 0x2a, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x31, 0x12, 0x32, 0x0a, 0x05, 0x6e, 0x6f, 0x64,
+	0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x06,
+	0x8a, 0xf7, 0x02, 0x02, 0x18, 0x01, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x32, 0x12, 0x37, 0x0a,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6a,
+	0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12, 0x05, 0xe2, 0x01, 0x02, 0x10, 0x01, 0x52,
+	0x05, 0x6e, 0x6f, 0x64, 0x65, 0x33, 0x3a, 0x06, 0xca, 0xb8, 0x02, 0x02, 0x30, 0x01, 0x22, 0xbf,
+	0x01, 0x0a, 0x06, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x31, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x12, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x03,
+	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x2a, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x48, 0x01, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x05, 0x6f,
+	0x74, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x05, 0x6f, 0x74,
+	0x68, 0x65, 0x72, 0x42, 0x06, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x42, 0x10, 0x0a, 0x06, 0x68,
+	0x69, 0x64, 0x64, 0x65, 0x6e, 0x12, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x20, 0x01, 0x42, 0x11, 0x0a,
+	0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x12, 0x06, 0xca, 0xb5, 0x03, 0x02, 0x10, 0x01,
+	0x22, 0x39, 0x0a, 0x06, 0x4f, 0x6e, 0x65, 0x4f, 0x66, 0x32, 0x12, 0x14, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x19, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x11, 0xca, 0xb5, 0x03, 0x02, 0x18, 0x01,
+	0xba, 0xe0, 0x1f, 0x07, 0x12, 0x05, 0xa2, 0x01, 0x02, 0x08, 0x01, 0x22, 0x25, 0x0a, 0x07, 0x49,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x31, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x06, 0xca, 0xb8, 0x02, 0x02,
+	0x10, 0x01, 0x22, 0x47, 0x0a, 0x0a, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x31,
+	0x12, 0x39, 0x0a, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65,
+	0x73, 0x74, 0x2e, 0x49, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x31, 0x42, 0x06, 0x8a, 0xf7, 0x02, 0x02,
+	0x18, 0x01, 0x52, 0x07, 0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x31, 0x22, 0xe0, 0x02, 0x0a, 0x07,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x31, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x5f, 0x67, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x13, 0xe2, 0xdf, 0x1f,
+	0x0f, 0x12, 0x0d, 0xb2, 0x01, 0x0a, 0x30, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x7f,
+	0x52, 0x08, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x47, 0x74, 0x12, 0x32, 0x0a, 0x0a, 0x74, 0x5f,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x14,
+	0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xb2, 0x01, 0x0b, 0x28, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	0x80, 0x80, 0x80, 0x01, 0x52, 0x08, 0x74, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x4c, 0x74, 0x12, 0x34,
+	0x0a, 0x0b, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x5f, 0x67, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x12, 0x0e, 0xba, 0x01, 0x0b, 0x30, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 0x52, 0x09, 0x74, 0x55, 0x69, 0x6e, 0x74,
+	0x36, 0x34, 0x47, 0x74, 0x12, 0x2b, 0x0a, 0x0b, 0x74, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34,
+	0x5f, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x0b, 0xe2, 0xdf, 0x1f, 0x07, 0x12,
+	0x05, 0xba, 0x01, 0x02, 0x28, 0x00, 0x52, 0x09, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x4c,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x15, 0xe2, 0xdf, 0x1f, 0x11, 0x12, 0x0f, 0xc2, 0x01, 0x0c, 0xb0, 0x01,
+	0x05, 0xb8, 0x01, 0x03, 0xc0, 0x01, 0x02, 0xc8, 0x01, 0x08, 0x52, 0x07, 0x74, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x11, 0xe2, 0xdf, 0x1f, 0x0d, 0x12, 0x0b, 0xea, 0x01, 0x08, 0x28, 0x09,
+	0x30, 0x01, 0x38, 0x02, 0x40, 0x04, 0x52, 0x05, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2f, 0x0a,
+	0x07, 0x74, 0x5f, 0x72, 0x65, 0x67, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16,
+	0xe2, 0xdf, 0x1f, 0x12, 0x12, 0x10, 0xc2, 0x01, 0x0d, 0xc2, 0x02, 0x0a, 0x28, 0x3f, 0x69, 0x29,
+	0x5c, 0x41, 0x61, 0x62, 0x5c, 0x7a, 0x52, 0x06, 0x74, 0x52, 0x65, 0x67, 0x65, 0x78, 0x22, 0xc7,
+	0x01, 0x0a, 0x07, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x73, 0x31, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x5f,
+	0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x74,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x31, 0x12, 0x2f, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x42, 0x12, 0xe2, 0xdf, 0x1f, 0x0e,
+	0x12, 0x0c, 0xaa, 0x01, 0x09, 0x31, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x08,
+	0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x32, 0x12, 0x28, 0x0a, 0x09, 0x74, 0x5f, 0x64, 0x6f,
+	0x75, 0x62, 0x6c, 0x65, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0b, 0xe2, 0xdf, 0x1f,
+	0x07, 0x12, 0x05, 0xaa, 0x01, 0x02, 0x68, 0x01, 0x52, 0x08, 0x74, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x33, 0x12, 0x21, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x31, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x02, 0x42, 0x06, 0x8a, 0xf7, 0x02, 0x02, 0x48, 0x02, 0x52, 0x07, 0x74, 0x46,
+	0x6c, 0x6f, 0x61, 0x74, 0x31, 0x12, 0x21, 0x0a, 0x08, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x61, 0x74,
+	0x32, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x42, 0x06, 0x8a, 0xf7, 0x02, 0x02, 0x48, 0x03, 0x52,
+	0x07, 0x74, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x32, 0x2a, 0x5c, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x42, 0x16, 0x5a, 0x14, 0x74, 0x65, 0x73, 0x74, 0x73, 0x2f,
+	0x6a, 0x73, 0x6f, 0x6e, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x74, 0x65, 0x73, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescOnce sync.Once
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescData = file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDesc
+)
+
+func file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescGZIP() []byte {
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescOnce.Do(func() {
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescData = protoimpl.X.CompressGZIP(file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescData)
+	})
+	return file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDescData
+}
+
+var file_xgo_tests_jsonschematest_jsonschema_test_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_xgo_tests_jsonschematest_jsonschema_test_proto_goTypes = []interface{}{
+	(Status)(0),          // 0: jsonschematest.Status
+	(*Node)(nil),         // 1: jsonschematest.Node
+	(*Scalars1)(nil),     // 2: jsonschematest.Scalars1
+	(*Strings1)(nil),     // 3: jsonschematest.Strings1
+	(*Enums1)(nil),       // 4: jsonschematest.Enums1
+	(*Enums2)(nil),       // 5: jsonschematest.Enums2
+	(*Collections1)(nil), // 6: jsonschematest.Collections1
+	(*Messages1)(nil),    // 7: jsonschematest.Messages1
+	(*OneOf1)(nil),       // 8: jsonschematest.OneOf1
+	(*OneOf2)(nil),       // 9: jsonschematest.OneOf2
+	(*Ignore1)(nil),      // 10: jsonschematest.Ignore1
+	(*Reference1)(nil),   // 11: jsonschematest.Reference1
+	(*Bounds1)(nil),      // 12: jsonschematest.Bounds1
+	(*Floats1)(nil),      // 13: jsonschematest.Floats1
+	nil,                  // 14: jsonschematest.Collections1.Map1Entry
+	nil,                  // 15: jsonschematest.Collections1.Map2Entry
+	nil,                  // 16: jsonschematest.Collections1.Map3Entry
+	nil,                  // 17: jsonschematest.Collections1.Map4Entry
+}
+var file_xgo_tests_jsonschematest_jsonschema_test_proto_depIdxs = []int32{
+	1,  // 0: jsonschematest.Node.next:type_name -> jsonschematest.Node
+	0,  // 1: jsonschematest.Enums1.status1:type_name -> jsonschematest.Status
+	0,  // 2: jsonschematest.Enums1.status2:type_name -> jsonschematest.Status
+	0,  // 3: jsonschematest.Enums1.status3:type_name -> jsonschematest.Status
+	0,  // 4: jsonschematest.Enums2.status1:type_name -> jsonschematest.Status
+	14, // 5: jsonschematest.Collections1.map1:type_name -> jsonschematest.Collections1.Map1Entry
+	15, // 6: jsonschematest.Collections1.map2:type_name -> jsonschematest.Collections1.Map2Entry
+	1,  // 7: jsonschematest.Collections1.nodes:type_name -> jsonschematest.Node
+	16, // 8: jsonschematest.Collections1.map3:type_name -> jsonschematest.Collections1.Map3Entry
+	17, // 9: jsonschematest.Collections1.map4:type_name -> jsonschematest.Collections1.Map4Entry
+	1,  // 10: jsonschematest.Messages1.node1:type_name -> jsonschematest.Node
+	1,  // 11: jsonschematest.Messages1.node2:type_name -> jsonschematest.Node
+	1,  // 12: jsonschematest.Messages1.node3:type_name -> jsonschematest.Node
+	1,  // 13: jsonschematest.OneOf1.node:type_name -> jsonschematest.Node
+	10, // 14: jsonschematest.Reference1.ignore1:type_name -> jsonschematest.Ignore1
+	1,  // 15: jsonschematest.Collections1.Map2Entry.value:type_name -> jsonschematest.Node
+	16, // [16:16] is the sub-list for method output_type
+	16, // [16:16] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_xgo_tests_jsonschematest_jsonschema_test_proto_init() }
+func file_xgo_tests_jsonschematest_jsonschema_test_proto_init() {
+	if File_xgo_tests_jsonschematest_jsonschema_test_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Node); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Scalars1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Strings1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enums1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Enums2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collections1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Messages1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneOf1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OneOf2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Ignore1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Reference1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bounds1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Floats1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*OneOf1_Name)(nil),
+		(*OneOf1_Age)(nil),
+		(*OneOf1_Address)(nil),
+		(*OneOf1_Node)(nil),
+		(*OneOf1_Other)(nil),
+	}
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes[8].OneofWrappers = []interface{}{
+		(*OneOf2_Name)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   17,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_xgo_tests_jsonschematest_jsonschema_test_proto_goTypes,
+		DependencyIndexes: file_xgo_tests_jsonschematest_jsonschema_test_proto_depIdxs,
+		EnumInfos:         file_xgo_tests_jsonschematest_jsonschema_test_proto_enumTypes,
+		MessageInfos:      file_xgo_tests_jsonschematest_jsonschema_test_proto_msgTypes,
+	}.Build()
+	File_xgo_tests_jsonschematest_jsonschema_test_proto = out.File
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_rawDesc = nil
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_goTypes = nil
+	file_xgo_tests_jsonschematest_jsonschema_test_proto_depIdxs = nil
+}